@@ -0,0 +1,171 @@
+// Package config describes the skills the tools work against - their
+// IDs, upsell buckets and DynamoDB tables - so nothing needs to be
+// compiled in. It's read from a YAML or JSON file, and any value can
+// be overridden from the environment.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentSchemaVersion is the newest record schema the tools understand
+const CurrentSchemaVersion = 1
+
+// Config is the top level of the configuration file
+type Config struct {
+	Region   string  `json:"region" yaml:"region"`
+	Profile  string  `json:"profile,omitempty" yaml:"profile,omitempty"`
+	Endpoint string  `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Skills   []Skill `json:"skills" yaml:"skills"`
+
+	// Where the configuration was read from, empty for the defaults
+	Source string `json:"-" yaml:"-"`
+}
+
+// Skill describes one skill and where its data lives
+type Skill struct {
+	Name          string            `json:"name" yaml:"name"`
+	SkillID       string            `json:"skillId,omitempty" yaml:"skillId,omitempty"`
+	Upsell        *Upsell           `json:"upsell,omitempty" yaml:"upsell,omitempty"`
+	Tables        map[string]string `json:"tables,omitempty" yaml:"tables,omitempty"`
+	SchemaVersion int               `json:"schemaVersion" yaml:"schemaVersion"`
+}
+
+// Upsell is where a skill writes its upsell session records. Records go
+// to the skill with the longest prefix they start with, so a skill with
+// no prefix takes what no other skill in the bucket does.
+type Upsell struct {
+	Bucket string `json:"bucket" yaml:"bucket"`
+	Prefix string `json:"prefix,omitempty" yaml:"prefix,omitempty"`
+}
+
+// Table roles the tools look up
+const (
+	TableHands    = "hands"
+	TableStrategy = "strategy"
+)
+
+// EnvFile names the environment variable holding the config file path
+const EnvFile = "ALEXAUTILS_CONFIG"
+
+// Default is used when no configuration file can be found, and matches
+// the values the tools used before they were configurable
+func Default() *Config {
+	return &Config{
+		Region: "us-east-1",
+		Skills: []Skill{
+			{
+				// Every record outside slots/ has always counted as blackjack
+				Name:          "blackjack",
+				Upsell:        &Upsell{Bucket: "garrett-alexa-upsell"},
+				SchemaVersion: 1,
+			},
+			{
+				Name:          "slots",
+				Upsell:        &Upsell{Bucket: "garrett-alexa-upsell", Prefix: "slots/"},
+				SchemaVersion: 1,
+			},
+			{
+				Name:          "threecard",
+				Tables:        map[string]string{TableHands: "ThreeCardHands", TableStrategy: "ThreeCardStrategy"},
+				SchemaVersion: 1,
+			},
+		},
+	}
+}
+
+// SearchPaths lists where Load looks for a configuration file when
+// neither a path nor ALEXAUTILS_CONFIG is given
+func SearchPaths() []string {
+	paths := []string{"alexautils.yaml", "alexautils.yml", "alexautils.json"}
+	if dir, err := os.UserConfigDir(); err == nil {
+		paths = append(paths, filepath.Join(dir, "alexautils", "config.yaml"),
+			filepath.Join(dir, "alexautils", "config.json"))
+	}
+	return paths
+}
+
+// Load reads the configuration from path, or from ALEXAUTILS_CONFIG or
+// the search paths if path is empty, falling back to Default. The
+// environment overrides are applied to the result.
+func Load(path string) (*Config, error) {
+	if path == "" {
+		path = os.Getenv(EnvFile)
+	}
+	if path == "" {
+		for _, p := range SearchPaths() {
+			if _, err := os.Stat(p); err == nil {
+				path = p
+				break
+			}
+		}
+	}
+
+	cfg := Default()
+	if path != "" {
+		var err error
+		if cfg, err = ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.applyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// ReadFile parses a configuration file. Files ending in .json are read
+// as JSON, anything else as YAML. Unknown keys are an error so typos
+// don't silently fall back to defaults.
+func ReadFile(path string) (*Config, error) {
+	dat, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(dat))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(cfg)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(dat))
+		dec.KnownFields(true)
+		err = dec.Decode(cfg)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	cfg.Source = path
+	return cfg, nil
+}
+
+// Skill returns the named skill
+func (c *Config) Skill(name string) (*Skill, error) {
+	for i := range c.Skills {
+		if c.Skills[i].Name == name {
+			return &c.Skills[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no skill named %q in the configuration", name)
+}
+
+// Table returns the name of the skill's table for the given role
+func (s *Skill) Table(role string) (string, error) {
+	if t := s.Tables[role]; t != "" {
+		return t, nil
+	}
+	return "", fmt.Errorf("skill %s has no %q table configured", s.Name, role)
+}
@@ -0,0 +1,212 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	if errs := Default().Validate(); errs != nil {
+		t.Errorf("defaults: %v", errs)
+	}
+	example, err := ReadFile("../alexautils.example.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := example.Validate(); errs != nil {
+		t.Errorf("example: %v", errs)
+	}
+
+	tests := []struct {
+		name   string
+		change func(c *Config)
+		want   []string
+	}{
+		{"no region", func(c *Config) { c.Region = "" }, []string{"region is required"}},
+		{"bad region", func(c *Config) { c.Region = "moon" }, []string{`region "moon" doesn't look like an AWS region`}},
+		{"no skills", func(c *Config) { c.Skills = nil }, []string{"no skills are configured"}},
+		{"no name", func(c *Config) { c.Skills[0].Name = "" }, []string{"skills[0]: name is required"}},
+		{"same name", func(c *Config) { c.Skills[1].Name = "blackjack" }, []string{"skill blackjack: name is used more than once"}},
+		{"bad skill ID", func(c *Config) { c.Skills[0].SkillID = "skill-1" }, []string{`skill blackjack: skillId "skill-1" should start with amzn1.ask.skill.`}},
+		{"bad bucket", func(c *Config) { c.Skills[1].Upsell.Bucket = "Upsell_Bucket" }, []string{`skill slots: upsell bucket "Upsell_Bucket" isn't a valid S3 bucket name`}},
+		{"rooted prefix", func(c *Config) { c.Skills[1].Upsell.Prefix = "/slots/" }, []string{`skill slots: upsell prefix "/slots/" shouldn't start with /`}},
+		{"shared prefix", func(c *Config) { c.Skills[1].Upsell.Prefix = "" }, []string{`skill slots: upsell bucket garrett-alexa-upsell and prefix "" are blackjack's too`}},
+		{"empty role", func(c *Config) { c.Skills[2].Tables[""] = "Hands" }, []string{"skill threecard: table role is empty"}},
+		{"bad table", func(c *Config) { c.Skills[2].Tables[TableHands] = "x" }, []string{`skill threecard: hands table "x" isn't a valid DynamoDB table name`}},
+		{"no schema", func(c *Config) { c.Skills[0].SchemaVersion = 0 }, []string{"skill blackjack: schemaVersion 0 isn't supported (1 to 1)"}},
+		{"new schema", func(c *Config) { c.Skills[1].SchemaVersion = CurrentSchemaVersion + 1 }, []string{"skill slots: schemaVersion 2 isn't supported (1 to 1)"}},
+		{"every problem", func(c *Config) {
+			c.Region = ""
+			c.Skills[0].Name = ""
+			c.Skills[2].Tables[TableStrategy] = "no tables here"
+		}, []string{
+			"region is required",
+			"skills[0]: name is required",
+			`skill threecard: strategy table "no tables here" isn't a valid DynamoDB table name`,
+		}},
+	}
+	for _, test := range tests {
+		c := Default()
+		test.change(c)
+		var got []string
+		for _, err := range c.Validate() {
+			got = append(got, err.Error())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	for name, want := range map[string]string{
+		"threecard":      "ALEXAUTILS_SKILL_THREECARD",
+		"three-card":     "ALEXAUTILS_SKILL_THREE_CARD",
+		"Video Poker 2":  "ALEXAUTILS_SKILL_VIDEO_POKER_2",
+		"blackjack.test": "ALEXAUTILS_SKILL_BLACKJACK_TEST",
+	} {
+		if got := EnvName(name); got != want {
+			t.Errorf("%s: %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	env := map[string]string{
+		"ALEXAUTILS_REGION":                         "eu-west-1",
+		"ALEXAUTILS_ENDPOINT":                       "http://localhost:8000",
+		"ALEXAUTILS_SKILL_BLACKJACK_SKILL_ID":       "amzn1.ask.skill.1",
+		"ALEXAUTILS_SKILL_SLOTS_UPSELL_PREFIX":      "slots/v2/",
+		"ALEXAUTILS_SKILL_THREECARD_TABLE_HANDS":    "ThreeCardHandsTest",
+		"ALEXAUTILS_SKILL_THREECARD_UPSELL_BUCKET":  "threecard-upsell",
+		"ALEXAUTILS_SKILL_THREECARD_TABLE_STRATEGY": "ThreeCardStrategyTest",
+		"ALEXAUTILS_SKILL_THREECARD_SCHEMA_VERSION": "2",
+		"ALEXAUTILS_SKILL_NOSUCH_SKILL_ID":          "amzn1.ask.skill.2",
+	}
+	c := Default()
+	lookup := func(k string) (string, bool) {
+		v, ok := env[k]
+		return v, ok
+	}
+	if err := c.applyEnv(lookup); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Default()
+	want.Region = "eu-west-1"
+	want.Endpoint = "http://localhost:8000"
+	want.Skills[0].SkillID = "amzn1.ask.skill.1"
+	want.Skills[1].Upsell.Prefix = "slots/v2/"
+	want.Skills[2].Upsell = &Upsell{Bucket: "threecard-upsell"}
+	want.Skills[2].Tables = map[string]string{TableHands: "ThreeCardHandsTest", TableStrategy: "ThreeCardStrategyTest"}
+	want.Skills[2].SchemaVersion = 2
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("got %+v\nwant %+v", c, want)
+	}
+
+	// A schema version has to be a number
+	env["ALEXAUTILS_SKILL_THREECARD_SCHEMA_VERSION"] = "v2"
+	err := Default().applyEnv(lookup)
+	if want := `ALEXAUTILS_SKILL_THREECARD_SCHEMA_VERSION: strconv.Atoi: parsing "v2": invalid syntax`; err == nil || err.Error() != want {
+		t.Errorf("bad schema version: %v, want %s", err, want)
+	}
+
+	// A role the tools know can be added to a skill without tables
+	c = &Config{Skills: []Skill{{Name: "slots"}}}
+	err = c.applyEnv(func(k string) (string, bool) {
+		return "SlotsHands", k == "ALEXAUTILS_SKILL_SLOTS_TABLE_HANDS"
+	})
+	if got := c.Skills[0].Tables; err != nil || !reflect.DeepEqual(got, map[string]string{TableHands: "SlotsHands"}) {
+		t.Errorf("tables %v, %v", got, err)
+	}
+}
+
+func writeConfig(t *testing.T, name, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	// Keep the search paths and environment away from any real
+	// configuration; t.Setenv puts each variable back afterwards
+	for _, kv := range os.Environ() {
+		if k, _, _ := strings.Cut(kv, "="); strings.HasPrefix(k, envPrefix) {
+			t.Setenv(k, "")
+			os.Unsetenv(k)
+		}
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv(EnvFile, "")
+
+	yamlFile := writeConfig(t, "test.yaml", "region: eu-west-1\nskills:\n  - name: blackjack\n    tables:\n      hands: Hands\n")
+	jsonFile := writeConfig(t, "test.json", `{"region": "ap-south-1", "skills": [{"name": "slots"}]}`)
+
+	c, err := Load("")
+	if err != nil || c.Source != "" || !reflect.DeepEqual(c, Default()) {
+		t.Errorf("no file: %+v, %v; want the defaults", c, err)
+	}
+
+	t.Setenv(EnvFile, jsonFile)
+	if c, err = Load(""); err != nil || c.Source != jsonFile || c.Region != "ap-south-1" {
+		t.Errorf("%s: %+v, %v", EnvFile, c, err)
+	}
+	// A path given wins over the environment's
+	if c, err = Load(yamlFile); err != nil || c.Source != yamlFile || c.Skills[0].Tables[TableHands] != "Hands" {
+		t.Errorf("path: %+v, %v", c, err)
+	}
+
+	// The environment wins over the file
+	t.Setenv("ALEXAUTILS_REGION", "us-west-2")
+	t.Setenv("ALEXAUTILS_SKILL_BLACKJACK_TABLE_HANDS", "HandsTest")
+	if c, err = Load(yamlFile); err != nil || c.Region != "us-west-2" || c.Skills[0].Tables[TableHands] != "HandsTest" {
+		t.Errorf("environment: %+v, %v", c, err)
+	}
+
+	// A bad override fails the load
+	t.Setenv("ALEXAUTILS_SKILL_BLACKJACK_SCHEMA_VERSION", "one")
+	if _, err = Load(yamlFile); err == nil || !strings.Contains(err.Error(), "ALEXAUTILS_SKILL_BLACKJACK_SCHEMA_VERSION") {
+		t.Errorf("bad schema version: %v", err)
+	}
+	os.Unsetenv("ALEXAUTILS_SKILL_BLACKJACK_SCHEMA_VERSION")
+
+	// The current directory is searched
+	if err = os.WriteFile("alexautils.yaml", []byte("region: us-east-2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvFile, "")
+	os.Unsetenv("ALEXAUTILS_REGION") // t.Setenv restores it
+	if c, err = Load(""); err != nil || c.Source != "alexautils.yaml" || c.Region != "us-east-2" {
+		t.Errorf("search: %+v, %v", c, err)
+	}
+}
+
+func TestReadFileRejectsUnknownKeys(t *testing.T) {
+	for name, data := range map[string]string{
+		"typo.yaml": "region: us-east-1\nskils: []\n",
+		"typo.json": `{"region": "us-east-1", "skills": [{"name": "slots", "bucket": "upsell"}]}`,
+		"bad.yaml":  "region: [\n",
+	} {
+		if _, err := ReadFile(writeConfig(t, name, data)); err == nil || !strings.Contains(err.Error(), name) {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+	if c, err := ReadFile(writeConfig(t, "empty.yaml", "")); err != nil || len(c.Skills) != 0 {
+		t.Errorf("empty file: %+v, %v", c, err)
+	}
+}
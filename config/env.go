@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Environment overrides. The top level values are
+//
+//	ALEXAUTILS_REGION, ALEXAUTILS_PROFILE, ALEXAUTILS_ENDPOINT
+//
+// and each skill's values are keyed by its upper-cased name, with
+// anything other than letters and digits turned into underscores:
+//
+//	ALEXAUTILS_SKILL_<NAME>_SKILL_ID
+//	ALEXAUTILS_SKILL_<NAME>_UPSELL_BUCKET
+//	ALEXAUTILS_SKILL_<NAME>_UPSELL_PREFIX
+//	ALEXAUTILS_SKILL_<NAME>_TABLE_<ROLE>
+//	ALEXAUTILS_SKILL_<NAME>_SCHEMA_VERSION
+const envPrefix = "ALEXAUTILS_"
+
+// EnvName returns the environment variable prefix for a skill
+func EnvName(skill string) string {
+	var sb strings.Builder
+	sb.WriteString(envPrefix + "SKILL_")
+	for _, r := range strings.ToUpper(skill) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	return sb.String()
+}
+
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	if v, ok := lookup(envPrefix + "REGION"); ok {
+		c.Region = v
+	}
+	if v, ok := lookup(envPrefix + "PROFILE"); ok {
+		c.Profile = v
+	}
+	if v, ok := lookup(envPrefix + "ENDPOINT"); ok {
+		c.Endpoint = v
+	}
+
+	for i := range c.Skills {
+		s := &c.Skills[i]
+		prefix := EnvName(s.Name) + "_"
+		if v, ok := lookup(prefix + "SKILL_ID"); ok {
+			s.SkillID = v
+		}
+		if v, ok := lookup(prefix + "UPSELL_BUCKET"); ok {
+			if s.Upsell == nil {
+				s.Upsell = &Upsell{}
+			}
+			s.Upsell.Bucket = v
+		}
+		if v, ok := lookup(prefix + "UPSELL_PREFIX"); ok {
+			if s.Upsell == nil {
+				s.Upsell = &Upsell{}
+			}
+			s.Upsell.Prefix = v
+		}
+		if v, ok := lookup(prefix + "SCHEMA_VERSION"); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("%sSCHEMA_VERSION: %v", prefix, err)
+			}
+			s.SchemaVersion = n
+		}
+
+		// Table roles can be added from the environment too, so check
+		// every role already configured plus the ones the tools know
+		roles := map[string]bool{TableHands: true, TableStrategy: true}
+		for role := range s.Tables {
+			roles[role] = true
+		}
+		names := make([]string, 0, len(roles))
+		for role := range roles {
+			names = append(names, role)
+		}
+		sort.Strings(names)
+		for _, role := range names {
+			if v, ok := lookup(prefix + "TABLE_" + strings.ToUpper(role)); ok {
+				if s.Tables == nil {
+					s.Tables = make(map[string]string)
+				}
+				s.Tables[role] = v
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	bucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	tableName  = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,255}$`)
+	regionName = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+)
+
+const skillIDPrefix = "amzn1.ask.skill."
+
+// Validate checks the configuration and returns every problem found,
+// or nil if there are none
+func (c *Config) Validate() []error {
+	var errs []error
+	add := func(format string, a ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, a...))
+	}
+
+	if c.Region == "" {
+		add("region is required")
+	} else if !regionName.MatchString(c.Region) {
+		add("region %q doesn't look like an AWS region", c.Region)
+	}
+	if len(c.Skills) == 0 {
+		add("no skills are configured")
+	}
+
+	seen := make(map[string]bool)
+	upsells := make(map[Upsell]string)
+	for i, s := range c.Skills {
+		where := fmt.Sprintf("skills[%d]", i)
+		if s.Name == "" {
+			add("%s: name is required", where)
+		} else {
+			where = "skill " + s.Name
+			if seen[s.Name] {
+				add("%s: name is used more than once", where)
+			}
+			seen[s.Name] = true
+		}
+
+		if s.SkillID != "" && !strings.HasPrefix(s.SkillID, skillIDPrefix) {
+			add("%s: skillId %q should start with %s", where, s.SkillID, skillIDPrefix)
+		}
+		if s.Upsell != nil {
+			if !bucketName.MatchString(s.Upsell.Bucket) {
+				add("%s: upsell bucket %q isn't a valid S3 bucket name", where, s.Upsell.Bucket)
+			}
+			if strings.HasPrefix(s.Upsell.Prefix, "/") {
+				add("%s: upsell prefix %q shouldn't start with /", where, s.Upsell.Prefix)
+			}
+			if other, ok := upsells[*s.Upsell]; ok {
+				add("%s: upsell bucket %s and prefix %q are %s's too", where, s.Upsell.Bucket, s.Upsell.Prefix, other)
+			} else {
+				upsells[*s.Upsell] = s.Name
+			}
+		}
+		roles := make([]string, 0, len(s.Tables))
+		for role := range s.Tables {
+			roles = append(roles, role)
+		}
+		sort.Strings(roles)
+		for _, role := range roles {
+			table := s.Tables[role]
+			if role == "" {
+				add("%s: table role is empty", where)
+			}
+			if !tableName.MatchString(table) {
+				add("%s: %s table %q isn't a valid DynamoDB table name", where, role, table)
+			}
+		}
+		if s.SchemaVersion < 1 || s.SchemaVersion > CurrentSchemaVersion {
+			add("%s: schemaVersion %d isn't supported (1 to %d)", where, s.SchemaVersion, CurrentSchemaVersion)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,10 @@
+module github.com/gsdriver/alexautils
+
+go 1.22
+
+require (
+	github.com/aws/aws-sdk-go v1.55.5
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require github.com/jmespath/go-jmespath v0.4.0 // indirect
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/gsdriver/alexautils/config"
+)
+
+// AWSOptions are the flags every AWS-backed command accepts. Anything
+// not given on the command line comes from the configuration file.
+type AWSOptions struct {
+	ConfigFile string
+	Region     string
+	Profile    string
+	Endpoint   string
+
+	cfg *config.Config
+}
+
+// AddAWSFlags registers -config, -region, -profile and -endpoint on fs
+func AddAWSFlags(fs *flag.FlagSet) *AWSOptions {
+	opts := &AWSOptions{}
+	AddConfigFlag(fs, &opts.ConfigFile)
+	fs.StringVar(&opts.Region, "region", "", "AWS region (default from the configuration)")
+	fs.StringVar(&opts.Profile, "profile", "", "AWS shared config profile")
+	fs.StringVar(&opts.Endpoint, "endpoint", "", "override the AWS service endpoint (e.g. DynamoDB Local)")
+	return opts
+}
+
+// Config loads the configuration, applying the command line flags over
+// it. Flags win over the environment, which wins over the file.
+func (o *AWSOptions) Config() (*config.Config, error) {
+	if o.cfg != nil {
+		return o.cfg, nil
+	}
+
+	cfg, err := config.Load(o.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	if o.Region != "" {
+		cfg.Region = o.Region
+	}
+	if o.Profile != "" {
+		cfg.Profile = o.Profile
+	}
+	if o.Endpoint != "" {
+		cfg.Endpoint = o.Endpoint
+	}
+	if errs := cfg.Validate(); errs != nil {
+		return nil, errors.Join(errs...)
+	}
+
+	o.Region, o.Profile, o.Endpoint = cfg.Region, cfg.Profile, cfg.Endpoint
+	o.cfg = cfg
+	return cfg, nil
+}
+
+// Session creates an AWS session from the options
+func (o *AWSOptions) Session() (*session.Session, error) {
+	if _, err := o.Config(); err != nil {
+		return nil, err
+	}
+
+	config := aws.Config{Region: aws.String(o.Region)}
+	if o.Endpoint != "" {
+		config.Endpoint = aws.String(o.Endpoint)
+	}
+	return session.NewSessionWithOptions(session.Options{
+		Config:            config,
+		Profile:           o.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+}
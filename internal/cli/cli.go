@@ -0,0 +1,155 @@
+// Package cli holds the pieces shared by every alexautils subcommand:
+// the command tree, flag parsing, AWS options and exit codes.
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/gsdriver/alexautils/internal/logging"
+)
+
+// Exit codes used by every tool
+const (
+	ExitOK       = 0
+	ExitError    = 1
+	ExitUsage    = 2
+	ExitFailures = 3 // ran to the end but some inputs failed
+)
+
+// Command is a node in the command tree. Leaf commands have a Run
+// function, groups have Subcommands.
+type Command struct {
+	Name        string
+	Summary     string
+	Run         func(args []string) error
+	Subcommands []*Command
+}
+
+// UsageError is returned when a command was invoked incorrectly
+type UsageError struct {
+	Msg string
+}
+
+func (e *UsageError) Error() string {
+	return e.Msg
+}
+
+// Usagef builds a UsageError
+func Usagef(format string, a ...interface{}) error {
+	return &UsageError{Msg: fmt.Sprintf(format, a...)}
+}
+
+// Stderr is where usage and errors are written
+var Stderr io.Writer = os.Stderr
+
+// Main runs the command tree against args (not including the program
+// name) and returns the process exit code
+func Main(root *Command, args []string) int {
+	err := root.dispatch(root.Name, args)
+	var failures *logging.FailuresError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return ExitOK
+	case isUsage(err):
+		fmt.Fprintln(Stderr, "error:", err)
+		return ExitUsage
+	case errors.As(err, &failures):
+		slog.Error(err.Error())
+		return ExitFailures
+	default:
+		slog.Error(err.Error())
+		return ExitError
+	}
+}
+
+func isUsage(err error) bool {
+	var ue *UsageError
+	return errors.As(err, &ue)
+}
+
+func (c *Command) dispatch(path string, args []string) error {
+	if c.Run != nil {
+		return c.Run(args)
+	}
+
+	if len(args) == 0 {
+		c.usage(path)
+		return Usagef("%s needs a subcommand", path)
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		c.usage(path)
+		return flag.ErrHelp
+	}
+	for _, sub := range c.Subcommands {
+		if sub.Name == args[0] {
+			return sub.dispatch(path+" "+sub.Name, args[1:])
+		}
+	}
+	c.usage(path)
+	return Usagef("unknown command %q", path+" "+args[0])
+}
+
+func (c *Command) usage(path string) {
+	fmt.Fprintf(Stderr, "Usage: %s <command> [flags]\n\n", path)
+	if c.Summary != "" {
+		fmt.Fprintf(Stderr, "%s\n\n", c.Summary)
+	}
+	fmt.Fprintln(Stderr, "Commands:")
+	width := 0
+	for _, sub := range c.Subcommands {
+		if len(sub.Name) > width {
+			width = len(sub.Name)
+		}
+	}
+	for _, sub := range c.Subcommands {
+		fmt.Fprintf(Stderr, "  %s%s  %s\n", sub.Name, strings.Repeat(" ", width-len(sub.Name)), sub.Summary)
+	}
+	fmt.Fprintf(Stderr, "\nRun '%s <command> --help' for a command's flags.\n", path)
+}
+
+// NewFlagSet returns a flag set that reports errors instead of exiting
+// and prints usage to Stderr. Every flag set gets -log-level and
+// -log-format, which Parse applies.
+func NewFlagSet(name, summary string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(Stderr)
+	fs.String("log-level", "info", "minimum level to log: debug, info, warn or error")
+	fs.String("log-format", "text", "log output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintf(Stderr, "Usage: %s [flags]\n\n", name)
+		if summary != "" {
+			fmt.Fprintf(Stderr, "%s\n\n", summary)
+		}
+		fmt.Fprintln(Stderr, "Flags:")
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// Parse parses args into fs, turning bad flags into a UsageError.
+// Stray positional arguments are rejected unless allowArgs is set.
+func Parse(fs *flag.FlagSet, args []string, allowArgs bool) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return &UsageError{Msg: err.Error()}
+	}
+	if !allowArgs && fs.NArg() > 0 {
+		return Usagef("%s: unexpected argument %q", fs.Name(), fs.Arg(0))
+	}
+
+	if level, format := fs.Lookup("log-level"), fs.Lookup("log-format"); level != nil && format != nil {
+		if err := logging.Configure(Stderr, level.Value.String(), format.Value.String()); err != nil {
+			return &UsageError{Msg: err.Error()}
+		}
+	}
+	return nil
+}
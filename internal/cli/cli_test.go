@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gsdriver/alexautils/internal/logging"
+)
+
+// captureStderr sends usage and logs to a buffer for the test
+func captureStderr(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	old := Stderr
+	Stderr = &buf
+	if err := logging.Configure(&buf, "info", "text"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		Stderr = old
+		logging.Configure(old, "info", "text")
+	})
+	return &buf
+}
+
+func TestParse(t *testing.T) {
+	captureStderr(t)
+	newFlags := func() (*flag.FlagSet, *int) {
+		fs := NewFlagSet("alexautils test", "Tests.")
+		return fs, fs.Int("n", 1, "a number")
+	}
+
+	fs, n := newFlags()
+	if err := Parse(fs, []string{"-n", "5", "-log-level", "debug"}, false); err != nil || *n != 5 {
+		t.Errorf("good flags: n %d, %v", *n, err)
+	}
+	if fs, _ = newFlags(); Parse(fs, []string{"-n", "5", "extra"}, true) != nil || fs.Arg(0) != "extra" {
+		t.Error("allowed arguments weren't kept")
+	}
+	if fs, _ = newFlags(); !errors.Is(Parse(fs, []string{"-h"}, false), flag.ErrHelp) {
+		t.Error("-h isn't a request for help")
+	}
+
+	for _, test := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-m", "1"}, "flag provided but not defined: -m"},
+		{[]string{"-n", "five"}, `invalid value "five" for flag -n`},
+		{[]string{"-n", "5", "extra"}, `alexautils test: unexpected argument "extra"`},
+		{[]string{"-log-level", "loud"}, `unknown log level "loud"`},
+		{[]string{"-log-format", "xml"}, `unknown log format "xml"`},
+	} {
+		fs, _ := newFlags()
+		err := Parse(fs, test.args, false)
+		if !isUsage(err) || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%v: %v, want a usage error with %q", test.args, err, test.want)
+		}
+	}
+}
+
+func TestMainDispatch(t *testing.T) {
+	var ran []string
+	leaf := func(name string, err error) *Command {
+		return &Command{Name: name, Summary: name + " things", Run: func(args []string) error {
+			ran = append(ran, fmt.Sprint(name, args))
+			return err
+		}}
+	}
+	root := &Command{Name: "alexautils", Subcommands: []*Command{
+		leaf("ok", nil),
+		leaf("usage", Usagef("-x is required")),
+		leaf("failures", fmt.Errorf("reading: %w", &logging.FailuresError{Op: "reading", Processed: 3, Failed: 1})),
+		leaf("broken", errors.New("disk full")),
+		leaf("version", flag.ErrHelp),
+		{Name: "threecard", Summary: "Three card poker", Subcommands: []*Command{
+			leaf("analyze", nil),
+		}},
+	}}
+
+	for _, test := range []struct {
+		args   []string
+		code   int
+		ran    []string
+		stderr string
+	}{
+		{[]string{"ok", "-n", "1"}, ExitOK, []string{"ok[-n 1]"}, ""},
+		{[]string{"threecard", "analyze", "-cards", "5"}, ExitOK, []string{"analyze[-cards 5]"}, ""},
+		{[]string{"usage"}, ExitUsage, []string{"usage[]"}, "error: -x is required"},
+		{[]string{"failures"}, ExitFailures, []string{"failures[]"}, "reading: 1 of 3 inputs failed"},
+		{[]string{"broken"}, ExitError, []string{"broken[]"}, "disk full"},
+		// A command asked for its help from its own flags
+		{[]string{"version", "-h"}, ExitOK, []string{"version[-h]"}, ""},
+		{nil, ExitUsage, nil, "error: alexautils needs a subcommand"},
+		{[]string{"threecard"}, ExitUsage, nil, "error: alexautils threecard needs a subcommand"},
+		{[]string{"threecard", "-h"}, ExitOK, nil, "Usage: alexautils threecard <command> [flags]"},
+		{[]string{"threecard", "help"}, ExitOK, nil, "  analyze  analyze things"},
+		{[]string{"poker"}, ExitUsage, nil, `error: unknown command "alexautils poker"`},
+		{[]string{"threecard", "analyse"}, ExitUsage, nil, `error: unknown command "alexautils threecard analyse"`},
+	} {
+		buf := captureStderr(t)
+		ran = nil
+		if code := Main(root, test.args); code != test.code {
+			t.Errorf("%v: exit %d, want %d", test.args, code, test.code)
+		}
+		if !reflect.DeepEqual(ran, test.ran) {
+			t.Errorf("%v: ran %v, want %v", test.args, ran, test.ran)
+		}
+		if !strings.Contains(buf.String(), test.stderr) {
+			t.Errorf("%v: stderr %q, want %q", test.args, buf.String(), test.stderr)
+		}
+	}
+}
+
+func TestAWSOptionsConfig(t *testing.T) {
+	captureStderr(t)
+	path := filepath.Join(t.TempDir(), "alexautils.yaml")
+	data := "region: eu-west-1\nprofile: file\nendpoint: http://file\nskills:\n  - name: slots\n    schemaVersion: 1\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("ALEXAUTILS_REGION", "us-west-2")
+	t.Setenv("ALEXAUTILS_PROFILE", "env")
+
+	// The flag beats the environment, which beats the file
+	fs := NewFlagSet("alexautils test", "")
+	opts := AddAWSFlags(fs)
+	if err := Parse(fs, []string{"-config", path, "-region", "ap-south-1"}, false); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := opts.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{cfg.Region, cfg.Profile, cfg.Endpoint, opts.Region, opts.Profile, opts.Endpoint}
+	want := []string{"ap-south-1", "env", "http://file", "ap-south-1", "env", "http://file"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("region, profile and endpoint %q, want %q", got, want)
+	}
+
+	// A bad region from any of them fails validation
+	fs = NewFlagSet("alexautils test", "")
+	opts = AddAWSFlags(fs)
+	if err = Parse(fs, []string{"-config", path, "-region", "moon"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = opts.Config(); err == nil || !strings.Contains(err.Error(), `region "moon"`) {
+		t.Errorf("bad region: %v", err)
+	}
+}
+
+// TestMainFailures runs a command that keeps going past bad inputs, the
+// way the S3 and DynamoDB readers do
+func TestMainFailures(t *testing.T) {
+	buf := captureStderr(t)
+	root := &Command{Name: "alexautils", Subcommands: []*Command{
+		{Name: "read", Summary: "Reads things", Run: func(args []string) error {
+			report := logging.NewReport("reading")
+			for _, key := range args {
+				if strings.HasSuffix(key, ".bad") {
+					report.Fail(errors.New("bad JSON"), "key", key)
+					continue
+				}
+				report.Succeeded()
+			}
+			return report.Err()
+		}},
+	}}
+
+	if code := Main(root, []string{"read", "a.json", "b.bad", "c.json"}); code != ExitFailures {
+		t.Errorf("exit %d, want %d", code, ExitFailures)
+	}
+	for _, want := range []string{
+		`level=ERROR msg="reading failed" key=b.bad err="bad JSON"`,
+		`level=WARN msg="reading complete with failures" processed=3 failed=1`,
+		`level=WARN msg=failure op=reading detail="key=b.bad bad JSON"`,
+		`level=ERROR msg="reading: 1 of 3 inputs failed"`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("stderr %q, want %q", buf.String(), want)
+		}
+	}
+
+	buf.Reset()
+	if code := Main(root, []string{"read", "a.json"}); code != ExitOK {
+		t.Errorf("no failures: exit %d, want %d", code, ExitOK)
+	}
+	if want := `level=INFO msg="reading complete" processed=1`; !strings.Contains(buf.String(), want) {
+		t.Errorf("no failures: stderr %q, want %q", buf.String(), want)
+	}
+}
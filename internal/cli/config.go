@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/gsdriver/alexautils/config"
+	"gopkg.in/yaml.v3"
+)
+
+// AddConfigFlag registers -config on fs
+func AddConfigFlag(fs *flag.FlagSet, p *string) {
+	fs.StringVar(p, "config", "", "configuration file (default $"+config.EnvFile+" or alexautils.yaml)")
+}
+
+// ValidateConfig loads the configuration and reports every problem
+// with it, printing the effective configuration when it's valid
+func ValidateConfig(args []string) error {
+	fs := NewFlagSet("alexautils config validate", "Checks the configuration file and environment overrides.")
+	var path string
+	AddConfigFlag(fs, &path)
+	quiet := fs.Bool("q", false, "don't print the effective configuration")
+	if err := Parse(fs, args, false); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		return err
+	}
+	source := cfg.Source
+	if source == "" {
+		source = "built-in defaults"
+	}
+
+	if errs := cfg.Validate(); errs != nil {
+		for _, e := range errs {
+			fmt.Fprintln(Stderr, e)
+		}
+		return fmt.Errorf("%s has %d problem(s)", source, len(errs))
+	}
+
+	fmt.Printf("%s is valid (%d skills)\n", source, len(cfg.Skills))
+	if !*quiet {
+		out, _ := yaml.Marshal(cfg)
+		fmt.Print(string(out))
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Package logging sets up the structured logger every tool writes to
+// and keeps track of which inputs failed so a run can finish with a
+// summary instead of stopping at the first bad record.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// Level is the minimum level logged, shared by every handler
+var Level = new(slog.LevelVar)
+
+// Configure installs the default logger. format is "text" or "json"
+// and level is one of debug, info, warn or error.
+func Configure(w io.Writer, level, format string) error {
+	if err := Level.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("unknown log level %q", level)
+	}
+
+	opts := &slog.HandlerOptions{Level: Level}
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case "text", "":
+		handler = slog.NewTextHandler(w, opts)
+	case "json":
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return fmt.Errorf("unknown log format %q", format)
+	}
+	slog.SetDefault(slog.New(handler))
+	return nil
+}
+
+// maxListed caps how many failures the summary repeats
+const maxListed = 10
+
+// Report counts the inputs an operation processed and logs each one
+// that failed along with its context (S3 key, token, hand...). It's
+// safe to use from multiple goroutines.
+type Report struct {
+	op        string
+	mux       sync.Mutex
+	processed int
+	failed    int
+	listed    []string
+}
+
+// NewReport starts a report for the named operation
+func NewReport(op string) *Report {
+	return &Report{op: op}
+}
+
+// Succeeded records an input that was processed successfully
+func (r *Report) Succeeded() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.processed++
+}
+
+// Fail logs an input that couldn't be processed. args are slog
+// key/value pairs identifying the input.
+func (r *Report) Fail(err error, args ...any) {
+	slog.Error(r.op+" failed", append(args, "err", err)...)
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.processed++
+	r.failed++
+	if len(r.listed) < maxListed {
+		var sb strings.Builder
+		for i := 0; i+1 < len(args); i += 2 {
+			fmt.Fprintf(&sb, "%v=%v ", args[i], args[i+1])
+		}
+		sb.WriteString(err.Error())
+		r.listed = append(r.listed, sb.String())
+	}
+}
+
+// Failed returns how many inputs have failed so far
+func (r *Report) Failed() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.failed
+}
+
+// Err logs the summary and returns a *FailuresError if anything failed
+func (r *Report) Err() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.failed == 0 {
+		slog.Info(r.op+" complete", "processed", r.processed)
+		return nil
+	}
+	slog.Warn(r.op+" complete with failures", "processed", r.processed, "failed", r.failed)
+	for _, f := range r.listed {
+		slog.Warn("failure", "op", r.op, "detail", f)
+	}
+	if r.failed > len(r.listed) {
+		slog.Warn("more failures not listed", "op", r.op, "count", r.failed-len(r.listed))
+	}
+	return &FailuresError{Op: r.op, Processed: r.processed, Failed: r.failed}
+}
+
+// FailuresError reports that some of an operation's inputs failed
+type FailuresError struct {
+	Op        string
+	Processed int
+	Failed    int
+}
+
+func (e *FailuresError) Error() string {
+	return fmt.Sprintf("%s: %d of %d inputs failed", e.Op, e.Failed, e.Processed)
+}
@@ -0,0 +1,103 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// capture sends the default logger to a buffer for the test
+func capture(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Configure(&buf, "info", "text"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { Configure(os.Stderr, "info", "text") })
+	return &buf
+}
+
+func TestConfigure(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Configure(&buf, "warn", "json"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { Configure(os.Stderr, "info", "text") })
+	NewReport("checking").Fail(errors.New("broken"), "hand", "AS-KS-QS")
+	NewReport("checking").Err() // info, so not logged
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("%q: %v", buf.String(), err)
+	}
+	if line["msg"] != "checking failed" || line["hand"] != "AS-KS-QS" || line["err"] != "broken" {
+		t.Errorf("logged %v", line)
+	}
+
+	if err := Configure(&buf, "loud", "text"); err == nil || err.Error() != `unknown log level "loud"` {
+		t.Errorf("bad level: %v", err)
+	}
+	if err := Configure(&buf, "info", "xml"); err == nil || err.Error() != `unknown log format "xml"` {
+		t.Errorf("bad format: %v", err)
+	}
+}
+
+func TestReport(t *testing.T) {
+	buf := capture(t)
+	r := NewReport("loading")
+	r.Succeeded()
+	r.Succeeded()
+	if err := r.Err(); err != nil || r.Failed() != 0 {
+		t.Errorf("no failures: %v, %d failed", err, r.Failed())
+	}
+	if want := `msg="loading complete" processed=2`; !strings.Contains(buf.String(), want) {
+		t.Errorf("logged %q, want %q", buf.String(), want)
+	}
+
+	// From several goroutines, with more failures than get listed
+	buf.Reset()
+	r = NewReport("loading")
+	var wg sync.WaitGroup
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				r.Succeeded()
+				return
+			}
+			r.Fail(fmt.Errorf("bad record %d", i), "key", i)
+		}(i)
+	}
+	wg.Wait()
+	if r.Failed() != 15 {
+		t.Errorf("%d failed, want 15", r.Failed())
+	}
+	err := r.Err()
+	var failures *FailuresError
+	if !errors.As(err, &failures) || *failures != (FailuresError{Op: "loading", Processed: 30, Failed: 15}) {
+		t.Fatalf("got %#v", err)
+	}
+	if err.Error() != "loading: 15 of 30 inputs failed" {
+		t.Errorf("message %q", err)
+	}
+	logged := buf.String()
+	for _, want := range []string{
+		`msg="loading complete with failures" processed=30 failed=15`,
+		`msg="more failures not listed" op=loading count=5`,
+	} {
+		if !strings.Contains(logged, want) {
+			t.Errorf("logged %q, want %q", logged, want)
+		}
+	}
+	if n := strings.Count(logged, "msg=failure "); n != maxListed {
+		t.Errorf("%d failures listed, want %d", n, maxListed)
+	}
+	if n := strings.Count(logged, `msg="loading failed"`); n != 15 {
+		t.Errorf("%d failures logged as they happened, want 15", n)
+	}
+}
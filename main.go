@@ -0,0 +1,47 @@
+// Command alexautils bundles the tools used to manage our Alexa skills
+// into a single binary.
+package main
+
+import (
+	"os"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+	"github.com/gsdriver/alexautils/threecardanalyze"
+	"github.com/gsdriver/alexautils/threecardnames"
+	"github.com/gsdriver/alexautils/upsell"
+)
+
+var root = &cli.Command{
+	Name:    "alexautils",
+	Summary: "Tools to manage Alexa skills.",
+	Subcommands: []*cli.Command{
+		{Name: "config", Summary: "Configuration file tools", Subcommands: []*cli.Command{
+			{Name: "validate", Summary: "Check the configuration and print the effective values", Run: cli.ValidateConfig},
+		}},
+		{Name: "upsell", Summary: "Summarize upsell sessions stored in S3", Run: upsell.Run},
+		{Name: "threecard", Summary: "Three card draw tools", Subcommands: []*cli.Command{
+			{Name: "analyze", Summary: "Compute the best hold for every hand", Run: threecardanalyze.Run},
+			{Name: "advise", Summary: "Show the odds of every hold for a hand given the cards seen", Run: threecardanalyze.Advise},
+			{Name: "equilibrium", Summary: "Solve for player and dealer strategies when both draw", Run: threecardanalyze.SolveEquilibrium},
+			{Name: "simulate", Summary: "Play a strategy against the dealer and report the return", Run: threecardanalyze.Simulate},
+			{Name: "chart", Summary: "Summarize a strategy as an ordered list of rules", Run: threecardanalyze.Chart},
+			{Name: "sidebets", Summary: "Report the house edge, hit frequency and variance of side bets", Run: threecardanalyze.SideBets},
+			{Name: "optimize", Summary: "Search paytable payouts for a target return under optimal play", Run: threecardanalyze.Optimize},
+			{Name: "pack", Summary: "Write a strategy and its rank tables in the compact binary format", Run: threecardanalyze.Pack},
+			{Name: "diff", Summary: "Compare two strategy files hand by hand", Run: threecardanalyze.Diff},
+			{Name: "serve", Summary: "Serve holds, odds and hand ranks over HTTP", Run: threecardanalyze.Serve},
+			{Name: "loadtest", Summary: "Send random requests to the strategy service and report latency", Run: threecardanalyze.LoadTest},
+			{Name: "publish", Summary: "Publish a strategy to DynamoDB and make it live", Run: threecardanalyze.Publish},
+			{Name: "train", Summary: "Practice holds against the best play, focusing on hands you get wrong", Run: threecardanalyze.Train},
+			{Name: "tables", Summary: "Write the ranking and winners tables for a shoe and rules", Run: threecardanalyze.ExportTables},
+			{Name: "names", Summary: "Hand names recorded by the skill", Subcommands: []*cli.Command{
+				{Name: "count", Summary: "Count hand names in the table or a snapshot", Run: threecardnames.Count},
+				{Name: "export", Summary: "Snapshot the hands table to JSON Lines", Run: threecardnames.Export},
+			}},
+		}},
+	},
+}
+
+func main() {
+	os.Exit(cli.Main(root, os.Args[1:]))
+}
@@ -0,0 +1,127 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+)
+
+// Advise is the advise command: the odds of every hold for one hand,
+// drawing from a shoe with the cards already seen taken out
+func Advise(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard advise", "Shows the odds of every hold for a hand given the cards already seen.")
+	handFlag := fs.String("hand", "", "the hand, for example AS-KS-10D (four or five cards for bigger games)")
+	ties := fs.String("ties", string(TieFewestDrawn), "which of several equally good holds to mark: fewest-drawn or highest-card")
+	game := addGameFlags(fs, "seen")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *handFlag == "" {
+		return cli.Usagef("-hand is required")
+	}
+	cards, err := ParseCards(*handFlag)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	if len(cards) != 3 {
+		if dealer.policy != DealerStatic {
+			return cli.Usagef("the dealer only draws in three card hands")
+		}
+		return adviseDraw(cards, game)
+	}
+	policy, err := parseTiePolicy(*ties)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	tables, err := game.tables()
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	a, b, c := Sort3(cards[0], cards[1], cards[2])
+	if tables.Ways(HandIndex(a, b, c)) == 0 {
+		return cli.Usagef("%s can't be dealt from %s", *handFlag, tables.Shoe)
+	}
+	// In key order, so equal holds break the way they do in suggest.json
+	hand, _ := ParseHand(HandKey(a, b, c))
+
+	dealerHolds, err := dealer.holds(tables, func() (*Holds, error) {
+		return NewAnalyzer(tables, StaticField(tables)).BestHolds(), nil
+	})
+	if err != nil {
+		return err
+	}
+	analyzer := NewAnalyzer(tables, fieldFor(tables, dealerHolds))
+	analyzer.Ties = policy
+	odds := analyzer.HandOdds(hand)
+	best := positionsMask(analyzer.bestplay(hand, odds))
+
+	fmt.Printf("Hand:   %s (%s)\n", describeCards(hand[:]), tables.Classify(hand))
+	fmt.Printf("Shoe:   %s, %d cards left, %s, %s dealer\n", tables.Shoe, tables.Shoe.Size(), tables.Rules, dealer.policy)
+	if len(tables.Shoe.Removed) > 0 {
+		fmt.Printf("Seen:   %s\n", describeCards(tables.Shoe.Removed))
+	}
+	printHolds(hand[:], odds[:], best, analyzer.score)
+	return nil
+}
+
+// adviseDraw advises on a hand of more than three cards
+func adviseDraw(cards []Card, game *gameOptions) error {
+	tables, err := game.drawTables(len(cards))
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	hand, err := tables.handFor(cards)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	odds, _ := tables.HoldOdds(hand)
+	best := holdOrderN(len(hand))[0]
+	for _, mask := range holdOrderN(len(hand)) {
+		if odds[mask].Win > odds[best].Win+tieTolerance {
+			best = mask
+		}
+	}
+
+	fmt.Printf("Hand:   %s (%s)\n", describeCards(hand), tables.Classify(hand))
+	fmt.Printf("Deck:   1 deck, %s, static dealer\n", tables.Rules)
+	printHolds(hand, odds, best, func(o Odds) float64 { return o.Win })
+	return nil
+}
+
+// printHolds lists every hold best first, marking the one to play with
+// a * and any as good with a =
+func printHolds(hand []Card, odds []Odds, best uint8, score func(Odds) float64) {
+	masks := make([]uint8, len(odds))
+	for i := range masks {
+		masks[i] = uint8(i)
+	}
+	sort.SliceStable(masks, func(i, j int) bool {
+		return score(odds[masks[i]]) > score(odds[masks[j]])
+	})
+
+	fmt.Println()
+	fmt.Printf("  %-18s %9s %9s %9s\n", "Hold", "Win", "Tie", "Lose")
+	for _, mask := range masks {
+		var held []Card
+		for i, c := range hand {
+			if mask&(1<<i) != 0 {
+				held = append(held, c)
+			}
+		}
+		name := describeCards(held)
+		if name == "" {
+			name = "(draw all)"
+		}
+		marker := " "
+		if mask == best {
+			marker = "*"
+		} else if score(odds[mask]) >= score(odds[best])-tieTolerance {
+			marker = "="
+		}
+		o := odds[mask]
+		fmt.Printf("%s %-18s %8.3f%% %8.3f%% %8.3f%%\n", marker, name, 100*o.Win, 100*o.Tie, 100*o.Lose)
+	}
+}
@@ -1,9 +0,0 @@
-package main
-
-func Ranks() []byte {
-  return []byte(`{"AC-KC-QC":0,"AD-KD-QD":0,"AH-KH-QH":0,"AS-KS-QS":0,"JC-KC-QC":1,"JD-KD-QD":1,"JH-KH-QH":1,"JS-KS-QS":1,"10C-JC-QC":2,"10D-JD-QD":2,"10H-JH-QH":2,"10S-JS-QS":2,"10C-9C-JC":3,"10D-9D-JD":3,"10H-9H-JH":3,"10S-9S-JS":3,"10C-8C-9C":4,"10D-8D-9D":4,"10H-8H-9H":4,"10S-8S-9S":4,"7C-8C-9C":5,"7D-8D-9D":5,"7H-8H-9H":5,"7S-8S-9S":5,"6C-7C-8C":6,"6D-7D-8D":6,"6H-7H-8H":6,"6S-7S-8S":6,"5C-6C-7C":7,"5D-6D-7D":7,"5H-6H-7H":7,"5S-6S-7S":7,"4C-5C-6C":8,"4D-5D-6D":8,"4H-5H-6H":8,"4S-5S-6S":8,"3C-4C-5C":9,"3D-4D-5D":9,"3H-4H-5H":9,"3S-4S-5S":9,"2C-3C-4C":10,"2D-3D-4D":10,"2H-3H-4H":10,"2S-3S-4S":10,"2C-3C-AC":11,"2D-3D-AD":11,"2H-3H-AH":11,"2S-3S-AS":11,"AC-AD-AH":12,"AC-AD-AS":12,"AC-AH-AS":12,"AD-AH-AS":12,"KC-KD-KH":13,"KC-KD-KS":13,"KC-KH-KS":13,"KD-KH-KS":13,"QC-QD-QH":14,"QC-QD-QS":14,"QC-QH-QS":14,"QD-QH-QS":14,"JC-JD-JH":15,"JC-JD-JS":15,"JC-JH-JS":15,"JD-JH-JS":15,"10C-10D-10H":16,"10C-10D-10S":16,"10C-10H-10S":16,"10D-10H-10S":16,"9C-9D-9H":17,"9C-9D-9S":17,"9C-9H-9S":17,"9D-9H-9S":17,"8C-8D-8H":18,"8C-8D-8S":18,"8C-8H-8S":18,"8D-8H-8S":18,"7C-7D-7H":19,"7C-7D-7S":19,"7C-7H-7S":19,"7D-7H-7S":19,"6C-6D-6H":20,"6C-6D-6S":20,"6C-6H-6S":20,"6D-6H-6S":20,"5C-5D-5H":21,"5C-5D-5S":21,"5C-5H-5S":21,"5D-5H-5S":21,"4C-4D-4H":22,"4C-4D-4S":22,"4C-4H-4S":22,"4D-4H-4S":22,"3C-3D-3H":23,"3C-3D-3S":23,"3C-3H-3S":23,"3D-3H-3S":23,"2C-2D-2H":24,"2C-2D-2S":24,"2C-2H-2S":24,"2D-2H-2S":24,"AD-KC-QC":25,"AH-KC-QC":25,"AS-KC-QC":25,"AC-KD-QC":25,"AC-KH-QC":25,"AC-KS-QC":25,"AD-KD-QC":25,"AH-KD-QC":25,"AS-KD-QC":25,"AD-KH-QC":25,"AD-KS-QC":25,"AH-KH-QC":25,"AS-KH-QC":25,"AH-KS-QC":25,"AS-KS-QC":25,"AC-KC-QD":25,"AC-KC-QH":25,"AC-KC-QS":25,"AD-KC-QD":25,"AH-KC-QD":25,"AS-KC-QD":25,"AD-KC-QH":25,"AD-KC-QS":25,"AH-KC-QH":25,"AS-KC-QH":25,"AH-KC-QS":25,"AS-KC-QS":25,"AC-KD-QD":25,"AC-KH-QD":25,"AC-KS-QD":25,"AC-KD-QH":25,"AC-KD-QS":25,"AC-KH-QH":25,"AC-KS-QH":25,"AC-KH-QS":25,"AC-KS-QS":25,"AH-KD-QD":25,"AS-KD-QD":25,"AD-KH-QD":25,"AD-KS-QD":25,"AH-KH-QD":25,"AS-KH-QD":25,"AH-KS-QD":25,"AS-KS-QD":25,"AD-KD-QH":25,"AD-KD-QS":25,"AH-KD-QH":25,"AS-KD-QH":25,"AH-KD-QS":25,"AS-KD-QS":25,"AD-KH-QH":25,"AD-KS-QH":25,"AD-KH-QS":25,"AD-KS-QS":25,"AS-KH-QH":25,"AH-KS-QH":25,"AS-KS-QH":25,"AH-KH-QS":25,"AS-KH-QS":25,"AH-KS-QS":25,"2C-3C-AD":26,"2C-3C-AH":26,"2C-3C-AS":26,"2C-3D-AC":26,"2C-3H-AC":26,"2C-3S-AC":26,"2C-3D-AD":26,"2C-3D-AH":26,"2C-3D-AS":26,"2C-3H-AD":26,"2C-3S-AD":26,"2C-3H-AH":26,"2C-3H-AS":26,"2C-3S-AH":26,"2C-3S-AS":26,"2D-3C-AC":26,"2H-3C-AC":26,"2S-3C-AC":26,"2D-3C-AD":26,"2D-3C-AH":26,"2D-3C-AS":26,"2H-3C-AD":26,"2S-3C-AD":26,"2H-3C-AH":26,"2H-3C-AS":26,"2S-3C-AH":26,"2S-3C-AS":26,"2D-3D-AC":26,"2D-3H-AC":26,"2D-3S-AC":26,"2H-3D-AC":26,"2S-3D-AC":26,"2H-3H-AC":26,"2H-3S-AC":26,"2S-3H-AC":26,"2S-3S-AC":26,"2D-3D-AH":26,"2D-3D-AS":26,"2D-3H-AD":26,"2D-3S-AD":26,"2D-3H-AH":26,"2D-3H-AS":26,"2D-3S-AH":26,"2D-3S-AS":26,"2H-3D-AD":26,"2S-3D-AD":26,"2H-3D-AH":26,"2H-3D-AS":26,"2S-3D-AH":26,"2S-3D-AS":26,"2H-3H-AD":26,"2H-3S-AD":26,"2S-3H-AD":26,"2S-3S-AD":26,"2H-3H-AS":26,"2H-3S-AH":26,"2H-3S-AS":26,"2S-3H-AH":26,"2S-3H-AS":26,"2S-3S-AH":26,"JC-KD-QC":27,"JC-KH-QC":27,"JC-KS-QC":27,"JC-KC-QD":27,"JC-KC-QH":27,"JC-KC-QS":27,"JC-KD-QD":27,"JC-KH-QD":27,"JC-KS-QD":27,"JC-KD-QH":27,"JC-KD-QS":27,"JC-KH-QH":27,"JC-KS-QH":27,"JC-KH-QS":27,"JC-KS-QS":27,"JD-KC-QC":27,"JH-KC-QC":27,"JS-KC-QC":27,"JD-KD-QC":27,"JD-KH-QC":27,"JD-KS-QC":27,"JH-KD-QC":27,"JS-KD-QC":27,"JH-KH-QC":27,"JH-KS-QC":27,"JS-KH-QC":27,"JS-KS-QC":27,"JD-KC-QD":27,"JD-KC-QH":27,"JD-KC-QS":27,"JH-KC-QD":27,"JS-KC-QD":27,"JH-KC-QH":27,"JH-KC-QS":27,"JS-KC-QH":27,"JS-KC-QS":27,"JD-KH-QD":27,"JD-KS-QD":27,"JD-KD-QH":27,"JD-KD-QS":27,"JD-KH-QH":27,"JD-KS-QH":27,"JD-KH-QS":27,"JD-KS-QS":27,"JH-KD-QD":27,"JS-KD-QD":27,"JH-KH-QD":27,"JH-KS-QD":27,"JS-KH-QD":27,"JS-KS-QD":27,"JH-KD-QH":27,"JH-KD-QS":27,"JS-KD-QH":27,"JS-KD-QS":27,"JH-KS-QH":27,"JH-KH-QS":27,"JH-KS-QS":27,"JS-KH-QH":27,"JS-KS-QH":27,"JS-KH-QS":27,"10C-JC-QD":28,"10C-JC-QH":28,"10C-JC-QS":28,"10C-JD-QC":28,"10C-JH-QC":28,"10C-JS-QC":28,"10C-JD-QD":28,"10C-JD-QH":28,"10C-JD-QS":28,"10C-JH-QD":28,"10C-JS-QD":28,"10C-JH-QH":28,"10C-JH-QS":28,"10C-JS-QH":28,"10C-JS-QS":28,"10D-JC-QC":28,"10H-JC-QC":28,"10S-JC-QC":28,"10D-JC-QD":28,"10D-JC-QH":28,"10D-JC-QS":28,"10H-JC-QD":28,"10S-JC-QD":28,"10H-JC-QH":28,"10H-JC-QS":28,"10S-JC-QH":28,"10S-JC-QS":28,"10D-JD-QC":28,"10D-JH-QC":28,"10D-JS-QC":28,"10H-JD-QC":28,"10S-JD-QC":28,"10H-JH-QC":28,"10H-JS-QC":28,"10S-JH-QC":28,"10S-JS-QC":28,"10D-JD-QH":28,"10D-JD-QS":28,"10D-JH-QD":28,"10D-JS-QD":28,"10D-JH-QH":28,"10D-JH-QS":28,"10D-JS-QH":28,"10D-JS-QS":28,"10H-JD-QD":28,"10S-JD-QD":28,"10H-JD-QH":28,"10H-JD-QS":28,"10S-JD-QH":28,"10S-JD-QS":28,"10H-JH-QD":28,"10H-JS-QD":28,"10S-JH-QD":28,"10S-JS-QD":28,"10H-JH-QS":28,"10H-JS-QH":28,"10H-JS-QS":28,"10S-JH-QH":28,"10S-JH-QS":28,"10S-JS-QH":28,"10C-9C-JD":29,"10C-9C-JH":29,"10C-9C-JS":29,"10D-9C-JC":29,"10H-9C-JC":29,"10S-9C-JC":29,"10D-9C-JD":29,"10D-9C-JH":29,"10D-9C-JS":29,"10H-9C-JD":29,"10S-9C-JD":29,"10H-9C-JH":29,"10H-9C-JS":29,"10S-9C-JH":29,"10S-9C-JS":29,"10C-9D-JC":29,"10C-9H-JC":29,"10C-9S-JC":29,"10C-9D-JD":29,"10C-9D-JH":29,"10C-9D-JS":29,"10C-9H-JD":29,"10C-9S-JD":29,"10C-9H-JH":29,"10C-9H-JS":29,"10C-9S-JH":29,"10C-9S-JS":29,"10D-9D-JC":29,"10H-9D-JC":29,"10S-9D-JC":29,"10D-9H-JC":29,"10D-9S-JC":29,"10H-9H-JC":29,"10S-9H-JC":29,"10H-9S-JC":29,"10S-9S-JC":29,"10D-9D-JH":29,"10D-9D-JS":29,"10H-9D-JD":29,"10S-9D-JD":29,"10H-9D-JH":29,"10H-9D-JS":29,"10S-9D-JH":29,"10S-9D-JS":29,"10D-9H-JD":29,"10D-9S-JD":29,"10D-9H-JH":29,"10D-9H-JS":29,"10D-9S-JH":29,"10D-9S-JS":29,"10H-9H-JD":29,"10S-9H-JD":29,"10H-9S-JD":29,"10S-9S-JD":29,"10H-9H-JS":29,"10S-9H-JH":29,"10S-9H-JS":29,"10H-9S-JH":29,"10H-9S-JS":29,"10S-9S-JH":29,"10D-8C-9C":30,"10H-8C-9C":30,"10S-8C-9C":30,"10C-8C-9D":30,"10C-8C-9H":30,"10C-8C-9S":30,"10D-8C-9D":30,"10H-8C-9D":30,"10S-8C-9D":30,"10D-8C-9H":30,"10D-8C-9S":30,"10H-8C-9H":30,"10S-8C-9H":30,"10H-8C-9S":30,"10S-8C-9S":30,"10C-8D-9C":30,"10C-8H-9C":30,"10C-8S-9C":30,"10D-8D-9C":30,"10H-8D-9C":30,"10S-8D-9C":30,"10D-8H-9C":30,"10D-8S-9C":30,"10H-8H-9C":30,"10S-8H-9C":30,"10H-8S-9C":30,"10S-8S-9C":30,"10C-8D-9D":30,"10C-8D-9H":30,"10C-8D-9S":30,"10C-8H-9D":30,"10C-8S-9D":30,"10C-8H-9H":30,"10C-8H-9S":30,"10C-8S-9H":30,"10C-8S-9S":30,"10H-8D-9D":30,"10S-8D-9D":30,"10D-8D-9H":30,"10D-8D-9S":30,"10H-8D-9H":30,"10S-8D-9H":30,"10H-8D-9S":30,"10S-8D-9S":30,"10D-8H-9D":30,"10D-8S-9D":30,"10H-8H-9D":30,"10S-8H-9D":30,"10H-8S-9D":30,"10S-8S-9D":30,"10D-8H-9H":30,"10D-8H-9S":30,"10D-8S-9H":30,"10D-8S-9S":30,"10S-8H-9H":30,"10H-8H-9S":30,"10S-8H-9S":30,"10H-8S-9H":30,"10S-8S-9H":30,"10H-8S-9S":30,"7C-8C-9D":31,"7C-8C-9H":31,"7C-8C-9S":31,"7C-8D-9C":31,"7C-8H-9C":31,"7C-8S-9C":31,"7C-8D-9D":31,"7C-8D-9H":31,"7C-8D-9S":31,"7C-8H-9D":31,"7C-8S-9D":31,"7C-8H-9H":31,"7C-8H-9S":31,"7C-8S-9H":31,"7C-8S-9S":31,"7D-8C-9C":31,"7H-8C-9C":31,"7S-8C-9C":31,"7D-8C-9D":31,"7D-8C-9H":31,"7D-8C-9S":31,"7H-8C-9D":31,"7S-8C-9D":31,"7H-8C-9H":31,"7H-8C-9S":31,"7S-8C-9H":31,"7S-8C-9S":31,"7D-8D-9C":31,"7D-8H-9C":31,"7D-8S-9C":31,"7H-8D-9C":31,"7S-8D-9C":31,"7H-8H-9C":31,"7H-8S-9C":31,"7S-8H-9C":31,"7S-8S-9C":31,"7D-8D-9H":31,"7D-8D-9S":31,"7D-8H-9D":31,"7D-8S-9D":31,"7D-8H-9H":31,"7D-8H-9S":31,"7D-8S-9H":31,"7D-8S-9S":31,"7H-8D-9D":31,"7S-8D-9D":31,"7H-8D-9H":31,"7H-8D-9S":31,"7S-8D-9H":31,"7S-8D-9S":31,"7H-8H-9D":31,"7H-8S-9D":31,"7S-8H-9D":31,"7S-8S-9D":31,"7H-8H-9S":31,"7H-8S-9H":31,"7H-8S-9S":31,"7S-8H-9H":31,"7S-8H-9S":31,"7S-8S-9H":31,"6C-7C-8D":32,"6C-7C-8H":32,"6C-7C-8S":32,"6C-7D-8C":32,"6C-7H-8C":32,"6C-7S-8C":32,"6C-7D-8D":32,"6C-7D-8H":32,"6C-7D-8S":32,"6C-7H-8D":32,"6C-7S-8D":32,"6C-7H-8H":32,"6C-7H-8S":32,"6C-7S-8H":32,"6C-7S-8S":32,"6D-7C-8C":32,"6H-7C-8C":32,"6S-7C-8C":32,"6D-7C-8D":32,"6D-7C-8H":32,"6D-7C-8S":32,"6H-7C-8D":32,"6S-7C-8D":32,"6H-7C-8H":32,"6H-7C-8S":32,"6S-7C-8H":32,"6S-7C-8S":32,"6D-7D-8C":32,"6D-7H-8C":32,"6D-7S-8C":32,"6H-7D-8C":32,"6S-7D-8C":32,"6H-7H-8C":32,"6H-7S-8C":32,"6S-7H-8C":32,"6S-7S-8C":32,"6D-7D-8H":32,"6D-7D-8S":32,"6D-7H-8D":32,"6D-7S-8D":32,"6D-7H-8H":32,"6D-7H-8S":32,"6D-7S-8H":32,"6D-7S-8S":32,"6H-7D-8D":32,"6S-7D-8D":32,"6H-7D-8H":32,"6H-7D-8S":32,"6S-7D-8H":32,"6S-7D-8S":32,"6H-7H-8D":32,"6H-7S-8D":32,"6S-7H-8D":32,"6S-7S-8D":32,"6H-7H-8S":32,"6H-7S-8H":32,"6H-7S-8S":32,"6S-7H-8H":32,"6S-7H-8S":32,"6S-7S-8H":32,"5C-6C-7D":33,"5C-6C-7H":33,"5C-6C-7S":33,"5C-6D-7C":33,"5C-6H-7C":33,"5C-6S-7C":33,"5C-6D-7D":33,"5C-6D-7H":33,"5C-6D-7S":33,"5C-6H-7D":33,"5C-6S-7D":33,"5C-6H-7H":33,"5C-6H-7S":33,"5C-6S-7H":33,"5C-6S-7S":33,"5D-6C-7C":33,"5H-6C-7C":33,"5S-6C-7C":33,"5D-6C-7D":33,"5D-6C-7H":33,"5D-6C-7S":33,"5H-6C-7D":33,"5S-6C-7D":33,"5H-6C-7H":33,"5H-6C-7S":33,"5S-6C-7H":33,"5S-6C-7S":33,"5D-6D-7C":33,"5D-6H-7C":33,"5D-6S-7C":33,"5H-6D-7C":33,"5S-6D-7C":33,"5H-6H-7C":33,"5H-6S-7C":33,"5S-6H-7C":33,"5S-6S-7C":33,"5D-6D-7H":33,"5D-6D-7S":33,"5D-6H-7D":33,"5D-6S-7D":33,"5D-6H-7H":33,"5D-6H-7S":33,"5D-6S-7H":33,"5D-6S-7S":33,"5H-6D-7D":33,"5S-6D-7D":33,"5H-6D-7H":33,"5H-6D-7S":33,"5S-6D-7H":33,"5S-6D-7S":33,"5H-6H-7D":33,"5H-6S-7D":33,"5S-6H-7D":33,"5S-6S-7D":33,"5H-6H-7S":33,"5H-6S-7H":33,"5H-6S-7S":33,"5S-6H-7H":33,"5S-6H-7S":33,"5S-6S-7H":33,"4C-5C-6D":34,"4C-5C-6H":34,"4C-5C-6S":34,"4C-5D-6C":34,"4C-5H-6C":34,"4C-5S-6C":34,"4C-5D-6D":34,"4C-5D-6H":34,"4C-5D-6S":34,"4C-5H-6D":34,"4C-5S-6D":34,"4C-5H-6H":34,"4C-5H-6S":34,"4C-5S-6H":34,"4C-5S-6S":34,"4D-5C-6C":34,"4H-5C-6C":34,"4S-5C-6C":34,"4D-5C-6D":34,"4D-5C-6H":34,"4D-5C-6S":34,"4H-5C-6D":34,"4S-5C-6D":34,"4H-5C-6H":34,"4H-5C-6S":34,"4S-5C-6H":34,"4S-5C-6S":34,"4D-5D-6C":34,"4D-5H-6C":34,"4D-5S-6C":34,"4H-5D-6C":34,"4S-5D-6C":34,"4H-5H-6C":34,"4H-5S-6C":34,"4S-5H-6C":34,"4S-5S-6C":34,"4D-5D-6H":34,"4D-5D-6S":34,"4D-5H-6D":34,"4D-5S-6D":34,"4D-5H-6H":34,"4D-5H-6S":34,"4D-5S-6H":34,"4D-5S-6S":34,"4H-5D-6D":34,"4S-5D-6D":34,"4H-5D-6H":34,"4H-5D-6S":34,"4S-5D-6H":34,"4S-5D-6S":34,"4H-5H-6D":34,"4H-5S-6D":34,"4S-5H-6D":34,"4S-5S-6D":34,"4H-5H-6S":34,"4H-5S-6H":34,"4H-5S-6S":34,"4S-5H-6H":34,"4S-5H-6S":34,"4S-5S-6H":34,"3C-4C-5D":35,"3C-4C-5H":35,"3C-4C-5S":35,"3C-4D-5C":35,"3C-4H-5C":35,"3C-4S-5C":35,"3C-4D-5D":35,"3C-4D-5H":35,"3C-4D-5S":35,"3C-4H-5D":35,"3C-4S-5D":35,"3C-4H-5H":35,"3C-4H-5S":35,"3C-4S-5H":35,"3C-4S-5S":35,"3D-4C-5C":35,"3H-4C-5C":35,"3S-4C-5C":35,"3D-4C-5D":35,"3D-4C-5H":35,"3D-4C-5S":35,"3H-4C-5D":35,"3S-4C-5D":35,"3H-4C-5H":35,"3H-4C-5S":35,"3S-4C-5H":35,"3S-4C-5S":35,"3D-4D-5C":35,"3D-4H-5C":35,"3D-4S-5C":35,"3H-4D-5C":35,"3S-4D-5C":35,"3H-4H-5C":35,"3H-4S-5C":35,"3S-4H-5C":35,"3S-4S-5C":35,"3D-4D-5H":35,"3D-4D-5S":35,"3D-4H-5D":35,"3D-4S-5D":35,"3D-4H-5H":35,"3D-4H-5S":35,"3D-4S-5H":35,"3D-4S-5S":35,"3H-4D-5D":35,"3S-4D-5D":35,"3H-4D-5H":35,"3H-4D-5S":35,"3S-4D-5H":35,"3S-4D-5S":35,"3H-4H-5D":35,"3H-4S-5D":35,"3S-4H-5D":35,"3S-4S-5D":35,"3H-4H-5S":35,"3H-4S-5H":35,"3H-4S-5S":35,"3S-4H-5H":35,"3S-4H-5S":35,"3S-4S-5H":35,"2C-3C-4D":36,"2C-3C-4H":36,"2C-3C-4S":36,"2C-3D-4C":36,"2C-3H-4C":36,"2C-3S-4C":36,"2C-3D-4D":36,"2C-3D-4H":36,"2C-3D-4S":36,"2C-3H-4D":36,"2C-3S-4D":36,"2C-3H-4H":36,"2C-3H-4S":36,"2C-3S-4H":36,"2C-3S-4S":36,"2D-3C-4C":36,"2H-3C-4C":36,"2S-3C-4C":36,"2D-3C-4D":36,"2D-3C-4H":36,"2D-3C-4S":36,"2H-3C-4D":36,"2S-3C-4D":36,"2H-3C-4H":36,"2H-3C-4S":36,"2S-3C-4H":36,"2S-3C-4S":36,"2D-3D-4C":36,"2D-3H-4C":36,"2D-3S-4C":36,"2H-3D-4C":36,"2S-3D-4C":36,"2H-3H-4C":36,"2H-3S-4C":36,"2S-3H-4C":36,"2S-3S-4C":36,"2D-3D-4H":36,"2D-3D-4S":36,"2D-3H-4D":36,"2D-3S-4D":36,"2D-3H-4H":36,"2D-3H-4S":36,"2D-3S-4H":36,"2D-3S-4S":36,"2H-3D-4D":36,"2S-3D-4D":36,"2H-3D-4H":36,"2H-3D-4S":36,"2S-3D-4H":36,"2S-3D-4S":36,"2H-3H-4D":36,"2H-3S-4D":36,"2S-3H-4D":36,"2S-3S-4D":36,"2H-3H-4S":36,"2H-3S-4H":36,"2H-3S-4S":36,"2S-3H-4H":36,"2S-3H-4S":36,"2S-3S-4H":36,"AC-JC-KC":37,"AD-JD-KD":37,"AH-JH-KH":37,"AS-JS-KS":37,"10C-AC-KC":38,"10D-AD-KD":38,"10H-AH-KH":38,"10S-AS-KS":38,"9C-AC-KC":39,"9D-AD-KD":39,"9H-AH-KH":39,"9S-AS-KS":39,"8C-AC-KC":40,"8D-AD-KD":40,"8H-AH-KH":40,"8S-AS-KS":40,"7C-AC-KC":41,"7D-AD-KD":41,"7H-AH-KH":41,"7S-AS-KS":41,"6C-AC-KC":42,"6D-AD-KD":42,"6H-AH-KH":42,"6S-AS-KS":42,"5C-AC-KC":43,"5D-AD-KD":43,"5H-AH-KH":43,"5S-AS-KS":43,"4C-AC-KC":44,"4D-AD-KD":44,"4H-AH-KH":44,"4S-AS-KS":44,"3C-AC-KC":45,"3D-AD-KD":45,"3H-AH-KH":45,"3S-AS-KS":45,"2C-AC-KC":46,"2D-AD-KD":46,"2H-AH-KH":46,"2S-AS-KS":46,"AC-JC-QC":47,"AD-JD-QD":47,"AH-JH-QH":47,"AS-JS-QS":47,"10C-AC-QC":48,"10D-AD-QD":48,"10H-AH-QH":48,"10S-AS-QS":48,"9C-AC-QC":49,"9D-AD-QD":49,"9H-AH-QH":49,"9S-AS-QS":49,"8C-AC-QC":50,"8D-AD-QD":50,"8H-AH-QH":50,"8S-AS-QS":50,"7C-AC-QC":51,"7D-AD-QD":51,"7H-AH-QH":51,"7S-AS-QS":51,"6C-AC-QC":52,"6D-AD-QD":52,"6H-AH-QH":52,"6S-AS-QS":52,"5C-AC-QC":53,"5D-AD-QD":53,"5H-AH-QH":53,"5S-AS-QS":53,"4C-AC-QC":54,"4D-AD-QD":54,"4H-AH-QH":54,"4S-AS-QS":54,"3C-AC-QC":55,"3D-AD-QD":55,"3H-AH-QH":55,"3S-AS-QS":55,"2C-AC-QC":56,"2D-AD-QD":56,"2H-AH-QH":56,"2S-AS-QS":56,"10C-AC-JC":57,"10D-AD-JD":57,"10H-AH-JH":57,"10S-AS-JS":57,"9C-AC-JC":58,"9D-AD-JD":58,"9H-AH-JH":58,"9S-AS-JS":58,"8C-AC-JC":59,"8D-AD-JD":59,"8H-AH-JH":59,"8S-AS-JS":59,"7C-AC-JC":60,"7D-AD-JD":60,"7H-AH-JH":60,"7S-AS-JS":60,"6C-AC-JC":61,"6D-AD-JD":61,"6H-AH-JH":61,"6S-AS-JS":61,"5C-AC-JC":62,"5D-AD-JD":62,"5H-AH-JH":62,"5S-AS-JS":62,"4C-AC-JC":63,"4D-AD-JD":63,"4H-AH-JH":63,"4S-AS-JS":63,"3C-AC-JC":64,"3D-AD-JD":64,"3H-AH-JH":64,"3S-AS-JS":64,"2C-AC-JC":65,"2D-AD-JD":65,"2H-AH-JH":65,"2S-AS-JS":65,"10C-9C-AC":66,"10D-9D-AD":66,"10H-9H-AH":66,"10S-9S-AS":66,"10C-8C-AC":67,"10D-8D-AD":67,"10H-8H-AH":67,"10S-8S-AS":67,"10C-7C-AC":68,"10D-7D-AD":68,"10H-7H-AH":68,"10S-7S-AS":68,"10C-6C-AC":69,"10D-6D-AD":69,"10H-6H-AH":69,"10S-6S-AS":69,"10C-5C-AC":70,"10D-5D-AD":70,"10H-5H-AH":70,"10S-5S-AS":70,"10C-4C-AC":71,"10D-4D-AD":71,"10H-4H-AH":71,"10S-4S-AS":71,"10C-3C-AC":72,"10D-3D-AD":72,"10H-3H-AH":72,"10S-3S-AS":72,"10C-2C-AC":73,"10D-2D-AD":73,"10H-2H-AH":73,"10S-2S-AS":73,"8C-9C-AC":74,"8D-9D-AD":74,"8H-9H-AH":74,"8S-9S-AS":74,"7C-9C-AC":75,"7D-9D-AD":75,"7H-9H-AH":75,"7S-9S-AS":75,"6C-9C-AC":76,"6D-9D-AD":76,"6H-9H-AH":76,"6S-9S-AS":76,"5C-9C-AC":77,"5D-9D-AD":77,"5H-9H-AH":77,"5S-9S-AS":77,"4C-9C-AC":78,"4D-9D-AD":78,"4H-9H-AH":78,"4S-9S-AS":78,"3C-9C-AC":79,"3D-9D-AD":79,"3H-9H-AH":79,"3S-9S-AS":79,"2C-9C-AC":80,"2D-9D-AD":80,"2H-9H-AH":80,"2S-9S-AS":80,"7C-8C-AC":81,"7D-8D-AD":81,"7H-8H-AH":81,"7S-8S-AS":81,"6C-8C-AC":82,"6D-8D-AD":82,"6H-8H-AH":82,"6S-8S-AS":82,"5C-8C-AC":83,"5D-8D-AD":83,"5H-8H-AH":83,"5S-8S-AS":83,"4C-8C-AC":84,"4D-8D-AD":84,"4H-8H-AH":84,"4S-8S-AS":84,"3C-8C-AC":85,"3D-8D-AD":85,"3H-8H-AH":85,"3S-8S-AS":85,"2C-8C-AC":86,"2D-8D-AD":86,"2H-8H-AH":86,"2S-8S-AS":86,"6C-7C-AC":87,"6D-7D-AD":87,"6H-7H-AH":87,"6S-7S-AS":87,"5C-7C-AC":88,"5D-7D-AD":88,"5H-7H-AH":88,"5S-7S-AS":88,"4C-7C-AC":89,"4D-7D-AD":89,"4H-7H-AH":89,"4S-7S-AS":89,"3C-7C-AC":90,"3D-7D-AD":90,"3H-7H-AH":90,"3S-7S-AS":90,"2C-7C-AC":91,"2D-7D-AD":91,"2H-7H-AH":91,"2S-7S-AS":91,"5C-6C-AC":92,"5D-6D-AD":92,"5H-6H-AH":92,"5S-6S-AS":92,"4C-6C-AC":93,"4D-6D-AD":93,"4H-6H-AH":93,"4S-6S-AS":93,"3C-6C-AC":94,"3D-6D-AD":94,"3H-6H-AH":94,"3S-6S-AS":94,"2C-6C-AC":95,"2D-6D-AD":95,"2H-6H-AH":95,"2S-6S-AS":95,"4C-5C-AC":96,"4D-5D-AD":96,"4H-5H-AH":96,"4S-5S-AS":96,"3C-5C-AC":97,"3D-5D-AD":97,"3H-5H-AH":97,"3S-5S-AS":97,"2C-5C-AC":98,"2D-5D-AD":98,"2H-5H-AH":98,"2S-5S-AS":98,"3C-4C-AC":99,"3D-4D-AD":99,"3H-4H-AH":99,"3S-4S-AS":99,"2C-4C-AC":100,"2D-4D-AD":100,"2H-4H-AH":100,"2S-4S-AS":100,"10C-KC-QC":101,"10D-KD-QD":101,"10H-KH-QH":101,"10S-KS-QS":101,"9C-KC-QC":102,"9D-KD-QD":102,"9H-KH-QH":102,"9S-KS-QS":102,"8C-KC-QC":103,"8D-KD-QD":103,"8H-KH-QH":103,"8S-KS-QS":103,"7C-KC-QC":104,"7D-KD-QD":104,"7H-KH-QH":104,"7S-KS-QS":104,"6C-KC-QC":105,"6D-KD-QD":105,"6H-KH-QH":105,"6S-KS-QS":105,"5C-KC-QC":106,"5D-KD-QD":106,"5H-KH-QH":106,"5S-KS-QS":106,"4C-KC-QC":107,"4D-KD-QD":107,"4H-KH-QH":107,"4S-KS-QS":107,"3C-KC-QC":108,"3D-KD-QD":108,"3H-KH-QH":108,"3S-KS-QS":108,"2C-KC-QC":109,"2D-KD-QD":109,"2H-KH-QH":109,"2S-KS-QS":109,"10C-JC-KC":110,"10D-JD-KD":110,"10H-JH-KH":110,"10S-JS-KS":110,"9C-JC-KC":111,"9D-JD-KD":111,"9H-JH-KH":111,"9S-JS-KS":111,"8C-JC-KC":112,"8D-JD-KD":112,"8H-JH-KH":112,"8S-JS-KS":112,"7C-JC-KC":113,"7D-JD-KD":113,"7H-JH-KH":113,"7S-JS-KS":113,"6C-JC-KC":114,"6D-JD-KD":114,"6H-JH-KH":114,"6S-JS-KS":114,"5C-JC-KC":115,"5D-JD-KD":115,"5H-JH-KH":115,"5S-JS-KS":115,"4C-JC-KC":116,"4D-JD-KD":116,"4H-JH-KH":116,"4S-JS-KS":116,"3C-JC-KC":117,"3D-JD-KD":117,"3H-JH-KH":117,"3S-JS-KS":117,"2C-JC-KC":118,"2D-JD-KD":118,"2H-JH-KH":118,"2S-JS-KS":118,"10C-9C-KC":119,"10D-9D-KD":119,"10H-9H-KH":119,"10S-9S-KS":119,"10C-8C-KC":120,"10D-8D-KD":120,"10H-8H-KH":120,"10S-8S-KS":120,"10C-7C-KC":121,"10D-7D-KD":121,"10H-7H-KH":121,"10S-7S-KS":121,"10C-6C-KC":122,"10D-6D-KD":122,"10H-6H-KH":122,"10S-6S-KS":122,"10C-5C-KC":123,"10D-5D-KD":123,"10H-5H-KH":123,"10S-5S-KS":123,"10C-4C-KC":124,"10D-4D-KD":124,"10H-4H-KH":124,"10S-4S-KS":124,"10C-3C-KC":125,"10D-3D-KD":125,"10H-3H-KH":125,"10S-3S-KS":125,"10C-2C-KC":126,"10D-2D-KD":126,"10H-2H-KH":126,"10S-2S-KS":126,"8C-9C-KC":127,"8D-9D-KD":127,"8H-9H-KH":127,"8S-9S-KS":127,"7C-9C-KC":128,"7D-9D-KD":128,"7H-9H-KH":128,"7S-9S-KS":128,"6C-9C-KC":129,"6D-9D-KD":129,"6H-9H-KH":129,"6S-9S-KS":129,"5C-9C-KC":130,"5D-9D-KD":130,"5H-9H-KH":130,"5S-9S-KS":130,"4C-9C-KC":131,"4D-9D-KD":131,"4H-9H-KH":131,"4S-9S-KS":131,"3C-9C-KC":132,"3D-9D-KD":132,"3H-9H-KH":132,"3S-9S-KS":132,"2C-9C-KC":133,"2D-9D-KD":133,"2H-9H-KH":133,"2S-9S-KS":133,"7C-8C-KC":134,"7D-8D-KD":134,"7H-8H-KH":134,"7S-8S-KS":134,"6C-8C-KC":135,"6D-8D-KD":135,"6H-8H-KH":135,"6S-8S-KS":135,"5C-8C-KC":136,"5D-8D-KD":136,"5H-8H-KH":136,"5S-8S-KS":136,"4C-8C-KC":137,"4D-8D-KD":137,"4H-8H-KH":137,"4S-8S-KS":137,"3C-8C-KC":138,"3D-8D-KD":138,"3H-8H-KH":138,"3S-8S-KS":138,"2C-8C-KC":139,"2D-8D-KD":139,"2H-8H-KH":139,"2S-8S-KS":139,"6C-7C-KC":140,"6D-7D-KD":140,"6H-7H-KH":140,"6S-7S-KS":140,"5C-7C-KC":141,"5D-7D-KD":141,"5H-7H-KH":141,"5S-7S-KS":141,"4C-7C-KC":142,"4D-7D-KD":142,"4H-7H-KH":142,"4S-7S-KS":142,"3C-7C-KC":143,"3D-7D-KD":143,"3H-7H-KH":143,"3S-7S-KS":143,"2C-7C-KC":144,"2D-7D-KD":144,"2H-7H-KH":144,"2S-7S-KS":144,"5C-6C-KC":145,"5D-6D-KD":145,"5H-6H-KH":145,"5S-6S-KS":145,"4C-6C-KC":146,"4D-6D-KD":146,"4H-6H-KH":146,"4S-6S-KS":146,"3C-6C-KC":147,"3D-6D-KD":147,"3H-6H-KH":147,"3S-6S-KS":147,"2C-6C-KC":148,"2D-6D-KD":148,"2H-6H-KH":148,"2S-6S-KS":148,"4C-5C-KC":149,"4D-5D-KD":149,"4H-5H-KH":149,"4S-5S-KS":149,"3C-5C-KC":150,"3D-5D-KD":150,"3H-5H-KH":150,"3S-5S-KS":150,"2C-5C-KC":151,"2D-5D-KD":151,"2H-5H-KH":151,"2S-5S-KS":151,"3C-4C-KC":152,"3D-4D-KD":152,"3H-4H-KH":152,"3S-4S-KS":152,"2C-4C-KC":153,"2D-4D-KD":153,"2H-4H-KH":153,"2S-4S-KS":153,"2C-3C-KC":154,"2D-3D-KD":154,"2H-3H-KH":154,"2S-3S-KS":154,"9C-JC-QC":155,"9D-JD-QD":155,"9H-JH-QH":155,"9S-JS-QS":155,"8C-JC-QC":156,"8D-JD-QD":156,"8H-JH-QH":156,"8S-JS-QS":156,"7C-JC-QC":157,"7D-JD-QD":157,"7H-JH-QH":157,"7S-JS-QS":157,"6C-JC-QC":158,"6D-JD-QD":158,"6H-JH-QH":158,"6S-JS-QS":158,"5C-JC-QC":159,"5D-JD-QD":159,"5H-JH-QH":159,"5S-JS-QS":159,"4C-JC-QC":160,"4D-JD-QD":160,"4H-JH-QH":160,"4S-JS-QS":160,"3C-JC-QC":161,"3D-JD-QD":161,"3H-JH-QH":161,"3S-JS-QS":161,"2C-JC-QC":162,"2D-JD-QD":162,"2H-JH-QH":162,"2S-JS-QS":162,"10C-9C-QC":163,"10D-9D-QD":163,"10H-9H-QH":163,"10S-9S-QS":163,"10C-8C-QC":164,"10D-8D-QD":164,"10H-8H-QH":164,"10S-8S-QS":164,"10C-7C-QC":165,"10D-7D-QD":165,"10H-7H-QH":165,"10S-7S-QS":165,"10C-6C-QC":166,"10D-6D-QD":166,"10H-6H-QH":166,"10S-6S-QS":166,"10C-5C-QC":167,"10D-5D-QD":167,"10H-5H-QH":167,"10S-5S-QS":167,"10C-4C-QC":168,"10D-4D-QD":168,"10H-4H-QH":168,"10S-4S-QS":168,"10C-3C-QC":169,"10D-3D-QD":169,"10H-3H-QH":169,"10S-3S-QS":169,"10C-2C-QC":170,"10D-2D-QD":170,"10H-2H-QH":170,"10S-2S-QS":170,"8C-9C-QC":171,"8D-9D-QD":171,"8H-9H-QH":171,"8S-9S-QS":171,"7C-9C-QC":172,"7D-9D-QD":172,"7H-9H-QH":172,"7S-9S-QS":172,"6C-9C-QC":173,"6D-9D-QD":173,"6H-9H-QH":173,"6S-9S-QS":173,"5C-9C-QC":174,"5D-9D-QD":174,"5H-9H-QH":174,"5S-9S-QS":174,"4C-9C-QC":175,"4D-9D-QD":175,"4H-9H-QH":175,"4S-9S-QS":175,"3C-9C-QC":176,"3D-9D-QD":176,"3H-9H-QH":176,"3S-9S-QS":176,"2C-9C-QC":177,"2D-9D-QD":177,"2H-9H-QH":177,"2S-9S-QS":177,"7C-8C-QC":178,"7D-8D-QD":178,"7H-8H-QH":178,"7S-8S-QS":178,"6C-8C-QC":179,"6D-8D-QD":179,"6H-8H-QH":179,"6S-8S-QS":179,"5C-8C-QC":180,"5D-8D-QD":180,"5H-8H-QH":180,"5S-8S-QS":180,"4C-8C-QC":181,"4D-8D-QD":181,"4H-8H-QH":181,"4S-8S-QS":181,"3C-8C-QC":182,"3D-8D-QD":182,"3H-8H-QH":182,"3S-8S-QS":182,"2C-8C-QC":183,"2D-8D-QD":183,"2H-8H-QH":183,"2S-8S-QS":183,"6C-7C-QC":184,"6D-7D-QD":184,"6H-7H-QH":184,"6S-7S-QS":184,"5C-7C-QC":185,"5D-7D-QD":185,"5H-7H-QH":185,"5S-7S-QS":185,"4C-7C-QC":186,"4D-7D-QD":186,"4H-7H-QH":186,"4S-7S-QS":186,"3C-7C-QC":187,"3D-7D-QD":187,"3H-7H-QH":187,"3S-7S-QS":187,"2C-7C-QC":188,"2D-7D-QD":188,"2H-7H-QH":188,"2S-7S-QS":188,"5C-6C-QC":189,"5D-6D-QD":189,"5H-6H-QH":189,"5S-6S-QS":189,"4C-6C-QC":190,"4D-6D-QD":190,"4H-6H-QH":190,"4S-6S-QS":190,"3C-6C-QC":191,"3D-6D-QD":191,"3H-6H-QH":191,"3S-6S-QS":191,"2C-6C-QC":192,"2D-6D-QD":192,"2H-6H-QH":192,"2S-6S-QS":192,"4C-5C-QC":193,"4D-5D-QD":193,"4H-5H-QH":193,"4S-5S-QS":193,"3C-5C-QC":194,"3D-5D-QD":194,"3H-5H-QH":194,"3S-5S-QS":194,"2C-5C-QC":195,"2D-5D-QD":195,"2H-5H-QH":195,"2S-5S-QS":195,"3C-4C-QC":196,"3D-4D-QD":196,"3H-4H-QH":196,"3S-4S-QS":196,"2C-4C-QC":197,"2D-4D-QD":197,"2H-4H-QH":197,"2S-4S-QS":197,"2C-3C-QC":198,"2D-3D-QD":198,"2H-3H-QH":198,"2S-3S-QS":198,"10C-8C-JC":199,"10D-8D-JD":199,"10H-8H-JH":199,"10S-8S-JS":199,"10C-7C-JC":200,"10D-7D-JD":200,"10H-7H-JH":200,"10S-7S-JS":200,"10C-6C-JC":201,"10D-6D-JD":201,"10H-6H-JH":201,"10S-6S-JS":201,"10C-5C-JC":202,"10D-5D-JD":202,"10H-5H-JH":202,"10S-5S-JS":202,"10C-4C-JC":203,"10D-4D-JD":203,"10H-4H-JH":203,"10S-4S-JS":203,"10C-3C-JC":204,"10D-3D-JD":204,"10H-3H-JH":204,"10S-3S-JS":204,"10C-2C-JC":205,"10D-2D-JD":205,"10H-2H-JH":205,"10S-2S-JS":205,"8C-9C-JC":206,"8D-9D-JD":206,"8H-9H-JH":206,"8S-9S-JS":206,"7C-9C-JC":207,"7D-9D-JD":207,"7H-9H-JH":207,"7S-9S-JS":207,"6C-9C-JC":208,"6D-9D-JD":208,"6H-9H-JH":208,"6S-9S-JS":208,"5C-9C-JC":209,"5D-9D-JD":209,"5H-9H-JH":209,"5S-9S-JS":209,"4C-9C-JC":210,"4D-9D-JD":210,"4H-9H-JH":210,"4S-9S-JS":210,"3C-9C-JC":211,"3D-9D-JD":211,"3H-9H-JH":211,"3S-9S-JS":211,"2C-9C-JC":212,"2D-9D-JD":212,"2H-9H-JH":212,"2S-9S-JS":212,"7C-8C-JC":213,"7D-8D-JD":213,"7H-8H-JH":213,"7S-8S-JS":213,"6C-8C-JC":214,"6D-8D-JD":214,"6H-8H-JH":214,"6S-8S-JS":214,"5C-8C-JC":215,"5D-8D-JD":215,"5H-8H-JH":215,"5S-8S-JS":215,"4C-8C-JC":216,"4D-8D-JD":216,"4H-8H-JH":216,"4S-8S-JS":216,"3C-8C-JC":217,"3D-8D-JD":217,"3H-8H-JH":217,"3S-8S-JS":217,"2C-8C-JC":218,"2D-8D-JD":218,"2H-8H-JH":218,"2S-8S-JS":218,"6C-7C-JC":219,"6D-7D-JD":219,"6H-7H-JH":219,"6S-7S-JS":219,"5C-7C-JC":220,"5D-7D-JD":220,"5H-7H-JH":220,"5S-7S-JS":220,"4C-7C-JC":221,"4D-7D-JD":221,"4H-7H-JH":221,"4S-7S-JS":221,"3C-7C-JC":222,"3D-7D-JD":222,"3H-7H-JH":222,"3S-7S-JS":222,"2C-7C-JC":223,"2D-7D-JD":223,"2H-7H-JH":223,"2S-7S-JS":223,"5C-6C-JC":224,"5D-6D-JD":224,"5H-6H-JH":224,"5S-6S-JS":224,"4C-6C-JC":225,"4D-6D-JD":225,"4H-6H-JH":225,"4S-6S-JS":225,"3C-6C-JC":226,"3D-6D-JD":226,"3H-6H-JH":226,"3S-6S-JS":226,"2C-6C-JC":227,"2D-6D-JD":227,"2H-6H-JH":227,"2S-6S-JS":227,"4C-5C-JC":228,"4D-5D-JD":228,"4H-5H-JH":228,"4S-5S-JS":228,"3C-5C-JC":229,"3D-5D-JD":229,"3H-5H-JH":229,"3S-5S-JS":229,"2C-5C-JC":230,"2D-5D-JD":230,"2H-5H-JH":230,"2S-5S-JS":230,"3C-4C-JC":231,"3D-4D-JD":231,"3H-4H-JH":231,"3S-4S-JS":231,"2C-4C-JC":232,"2D-4D-JD":232,"2H-4H-JH":232,"2S-4S-JS":232,"2C-3C-JC":233,"2D-3D-JD":233,"2H-3H-JH":233,"2S-3S-JS":233,"10C-7C-9C":234,"10D-7D-9D":234,"10H-7H-9H":234,"10S-7S-9S":234,"10C-6C-9C":235,"10D-6D-9D":235,"10H-6H-9H":235,"10S-6S-9S":235,"10C-5C-9C":236,"10D-5D-9D":236,"10H-5H-9H":236,"10S-5S-9S":236,"10C-4C-9C":237,"10D-4D-9D":237,"10H-4H-9H":237,"10S-4S-9S":237,"10C-3C-9C":238,"10D-3D-9D":238,"10H-3H-9H":238,"10S-3S-9S":238,"10C-2C-9C":239,"10D-2D-9D":239,"10H-2H-9H":239,"10S-2S-9S":239,"10C-7C-8C":240,"10D-7D-8D":240,"10H-7H-8H":240,"10S-7S-8S":240,"10C-6C-8C":241,"10D-6D-8D":241,"10H-6H-8H":241,"10S-6S-8S":241,"10C-5C-8C":242,"10D-5D-8D":242,"10H-5H-8H":242,"10S-5S-8S":242,"10C-4C-8C":243,"10D-4D-8D":243,"10H-4H-8H":243,"10S-4S-8S":243,"10C-3C-8C":244,"10D-3D-8D":244,"10H-3H-8H":244,"10S-3S-8S":244,"10C-2C-8C":245,"10D-2D-8D":245,"10H-2H-8H":245,"10S-2S-8S":245,"10C-6C-7C":246,"10D-6D-7D":246,"10H-6H-7H":246,"10S-6S-7S":246,"10C-5C-7C":247,"10D-5D-7D":247,"10H-5H-7H":247,"10S-5S-7S":247,"10C-4C-7C":248,"10D-4D-7D":248,"10H-4H-7H":248,"10S-4S-7S":248,"10C-3C-7C":249,"10D-3D-7D":249,"10H-3H-7H":249,"10S-3S-7S":249,"10C-2C-7C":250,"10D-2D-7D":250,"10H-2H-7H":250,"10S-2S-7S":250,"10C-5C-6C":251,"10D-5D-6D":251,"10H-5H-6H":251,"10S-5S-6S":251,"10C-4C-6C":252,"10D-4D-6D":252,"10H-4H-6H":252,"10S-4S-6S":252,"10C-3C-6C":253,"10D-3D-6D":253,"10H-3H-6H":253,"10S-3S-6S":253,"10C-2C-6C":254,"10D-2D-6D":254,"10H-2H-6H":254,"10S-2S-6S":254,"10C-4C-5C":255,"10D-4D-5D":255,"10H-4H-5H":255,"10S-4S-5S":255,"10C-3C-5C":256,"10D-3D-5D":256,"10H-3H-5H":256,"10S-3S-5S":256,"10C-2C-5C":257,"10D-2D-5D":257,"10H-2H-5H":257,"10S-2S-5S":257,"10C-3C-4C":258,"10D-3D-4D":258,"10H-3H-4H":258,"10S-3S-4S":258,"10C-2C-4C":259,"10D-2D-4D":259,"10H-2H-4H":259,"10S-2S-4S":259,"10C-2C-3C":260,"10D-2D-3D":260,"10H-2H-3H":260,"10S-2S-3S":260,"6C-8C-9C":261,"6D-8D-9D":261,"6H-8H-9H":261,"6S-8S-9S":261,"5C-8C-9C":262,"5D-8D-9D":262,"5H-8H-9H":262,"5S-8S-9S":262,"4C-8C-9C":263,"4D-8D-9D":263,"4H-8H-9H":263,"4S-8S-9S":263,"3C-8C-9C":264,"3D-8D-9D":264,"3H-8H-9H":264,"3S-8S-9S":264,"2C-8C-9C":265,"2D-8D-9D":265,"2H-8H-9H":265,"2S-8S-9S":265,"6C-7C-9C":266,"6D-7D-9D":266,"6H-7H-9H":266,"6S-7S-9S":266,"5C-7C-9C":267,"5D-7D-9D":267,"5H-7H-9H":267,"5S-7S-9S":267,"4C-7C-9C":268,"4D-7D-9D":268,"4H-7H-9H":268,"4S-7S-9S":268,"3C-7C-9C":269,"3D-7D-9D":269,"3H-7H-9H":269,"3S-7S-9S":269,"2C-7C-9C":270,"2D-7D-9D":270,"2H-7H-9H":270,"2S-7S-9S":270,"5C-6C-9C":271,"5D-6D-9D":271,"5H-6H-9H":271,"5S-6S-9S":271,"4C-6C-9C":272,"4D-6D-9D":272,"4H-6H-9H":272,"4S-6S-9S":272,"3C-6C-9C":273,"3D-6D-9D":273,"3H-6H-9H":273,"3S-6S-9S":273,"2C-6C-9C":274,"2D-6D-9D":274,"2H-6H-9H":274,"2S-6S-9S":274,"4C-5C-9C":275,"4D-5D-9D":275,"4H-5H-9H":275,"4S-5S-9S":275,"3C-5C-9C":276,"3D-5D-9D":276,"3H-5H-9H":276,"3S-5S-9S":276,"2C-5C-9C":277,"2D-5D-9D":277,"2H-5H-9H":277,"2S-5S-9S":277,"3C-4C-9C":278,"3D-4D-9D":278,"3H-4H-9H":278,"3S-4S-9S":278,"2C-4C-9C":279,"2D-4D-9D":279,"2H-4H-9H":279,"2S-4S-9S":279,"2C-3C-9C":280,"2D-3D-9D":280,"2H-3H-9H":280,"2S-3S-9S":280,"5C-7C-8C":281,"5D-7D-8D":281,"5H-7H-8H":281,"5S-7S-8S":281,"4C-7C-8C":282,"4D-7D-8D":282,"4H-7H-8H":282,"4S-7S-8S":282,"3C-7C-8C":283,"3D-7D-8D":283,"3H-7H-8H":283,"3S-7S-8S":283,"2C-7C-8C":284,"2D-7D-8D":284,"2H-7H-8H":284,"2S-7S-8S":284,"5C-6C-8C":285,"5D-6D-8D":285,"5H-6H-8H":285,"5S-6S-8S":285,"4C-6C-8C":286,"4D-6D-8D":286,"4H-6H-8H":286,"4S-6S-8S":286,"3C-6C-8C":287,"3D-6D-8D":287,"3H-6H-8H":287,"3S-6S-8S":287,"2C-6C-8C":288,"2D-6D-8D":288,"2H-6H-8H":288,"2S-6S-8S":288,"4C-5C-8C":289,"4D-5D-8D":289,"4H-5H-8H":289,"4S-5S-8S":289,"3C-5C-8C":290,"3D-5D-8D":290,"3H-5H-8H":290,"3S-5S-8S":290,"2C-5C-8C":291,"2D-5D-8D":291,"2H-5H-8H":291,"2S-5S-8S":291,"3C-4C-8C":292,"3D-4D-8D":292,"3H-4H-8H":292,"3S-4S-8S":292,"2C-4C-8C":293,"2D-4D-8D":293,"2H-4H-8H":293,"2S-4S-8S":293,"2C-3C-8C":294,"2D-3D-8D":294,"2H-3H-8H":294,"2S-3S-8S":294,"4C-6C-7C":295,"4D-6D-7D":295,"4H-6H-7H":295,"4S-6S-7S":295,"3C-6C-7C":296,"3D-6D-7D":296,"3H-6H-7H":296,"3S-6S-7S":296,"2C-6C-7C":297,"2D-6D-7D":297,"2H-6H-7H":297,"2S-6S-7S":297,"4C-5C-7C":298,"4D-5D-7D":298,"4H-5H-7H":298,"4S-5S-7S":298,"3C-5C-7C":299,"3D-5D-7D":299,"3H-5H-7H":299,"3S-5S-7S":299,"2C-5C-7C":300,"2D-5D-7D":300,"2H-5H-7H":300,"2S-5S-7S":300,"3C-4C-7C":301,"3D-4D-7D":301,"3H-4H-7H":301,"3S-4S-7S":301,"2C-4C-7C":302,"2D-4D-7D":302,"2H-4H-7H":302,"2S-4S-7S":302,"2C-3C-7C":303,"2D-3D-7D":303,"2H-3H-7H":303,"2S-3S-7S":303,"3C-5C-6C":304,"3D-5D-6D":304,"3H-5H-6H":304,"3S-5S-6S":304,"2C-5C-6C":305,"2D-5D-6D":305,"2H-5H-6H":305,"2S-5S-6S":305,"3C-4C-6C":306,"3D-4D-6D":306,"3H-4H-6H":306,"3S-4S-6S":306,"2C-4C-6C":307,"2D-4D-6D":307,"2H-4H-6H":307,"2S-4S-6S":307,"2C-3C-6C":308,"2D-3D-6D":308,"2H-3H-6H":308,"2S-3S-6S":308,"2C-4C-5C":309,"2D-4D-5D":309,"2H-4H-5H":309,"2S-4S-5S":309,"2C-3C-5C":310,"2D-3D-5D":310,"2H-3H-5H":310,"2S-3S-5S":310,"AC-AD-KC":311,"AC-AH-KC":311,"AC-AS-KC":311,"AD-AH-KC":311,"AD-AS-KC":311,"AH-AS-KC":311,"AC-AD-KD":311,"AC-AH-KD":311,"AC-AS-KD":311,"AC-AD-KH":311,"AC-AD-KS":311,"AC-AH-KH":311,"AC-AS-KH":311,"AC-AH-KS":311,"AC-AS-KS":311,"AD-AH-KD":311,"AD-AS-KD":311,"AH-AS-KD":311,"AD-AH-KH":311,"AD-AS-KH":311,"AD-AH-KS":311,"AD-AS-KS":311,"AH-AS-KH":311,"AH-AS-KS":311,"AC-AD-QC":312,"AC-AH-QC":312,"AC-AS-QC":312,"AD-AH-QC":312,"AD-AS-QC":312,"AH-AS-QC":312,"AC-AD-QD":312,"AC-AH-QD":312,"AC-AS-QD":312,"AC-AD-QH":312,"AC-AD-QS":312,"AC-AH-QH":312,"AC-AS-QH":312,"AC-AH-QS":312,"AC-AS-QS":312,"AD-AH-QD":312,"AD-AS-QD":312,"AH-AS-QD":312,"AD-AH-QH":312,"AD-AS-QH":312,"AD-AH-QS":312,"AD-AS-QS":312,"AH-AS-QH":312,"AH-AS-QS":312,"AC-AD-JC":313,"AC-AH-JC":313,"AC-AS-JC":313,"AD-AH-JC":313,"AD-AS-JC":313,"AH-AS-JC":313,"AC-AD-JD":313,"AC-AH-JD":313,"AC-AS-JD":313,"AC-AD-JH":313,"AC-AD-JS":313,"AC-AH-JH":313,"AC-AS-JH":313,"AC-AH-JS":313,"AC-AS-JS":313,"AD-AH-JD":313,"AD-AS-JD":313,"AH-AS-JD":313,"AD-AH-JH":313,"AD-AS-JH":313,"AD-AH-JS":313,"AD-AS-JS":313,"AH-AS-JH":313,"AH-AS-JS":313,"10C-AC-AD":314,"10C-AC-AH":314,"10C-AC-AS":314,"10C-AD-AH":314,"10C-AD-AS":314,"10C-AH-AS":314,"10D-AC-AD":314,"10D-AC-AH":314,"10D-AC-AS":314,"10H-AC-AD":314,"10S-AC-AD":314,"10H-AC-AH":314,"10H-AC-AS":314,"10S-AC-AH":314,"10S-AC-AS":314,"10D-AD-AH":314,"10D-AD-AS":314,"10D-AH-AS":314,"10H-AD-AH":314,"10H-AD-AS":314,"10S-AD-AH":314,"10S-AD-AS":314,"10H-AH-AS":314,"10S-AH-AS":314,"9C-AC-AD":315,"9C-AC-AH":315,"9C-AC-AS":315,"9C-AD-AH":315,"9C-AD-AS":315,"9C-AH-AS":315,"9D-AC-AD":315,"9D-AC-AH":315,"9D-AC-AS":315,"9H-AC-AD":315,"9S-AC-AD":315,"9H-AC-AH":315,"9H-AC-AS":315,"9S-AC-AH":315,"9S-AC-AS":315,"9D-AD-AH":315,"9D-AD-AS":315,"9D-AH-AS":315,"9H-AD-AH":315,"9H-AD-AS":315,"9S-AD-AH":315,"9S-AD-AS":315,"9H-AH-AS":315,"9S-AH-AS":315,"8C-AC-AD":316,"8C-AC-AH":316,"8C-AC-AS":316,"8C-AD-AH":316,"8C-AD-AS":316,"8C-AH-AS":316,"8D-AC-AD":316,"8D-AC-AH":316,"8D-AC-AS":316,"8H-AC-AD":316,"8S-AC-AD":316,"8H-AC-AH":316,"8H-AC-AS":316,"8S-AC-AH":316,"8S-AC-AS":316,"8D-AD-AH":316,"8D-AD-AS":316,"8D-AH-AS":316,"8H-AD-AH":316,"8H-AD-AS":316,"8S-AD-AH":316,"8S-AD-AS":316,"8H-AH-AS":316,"8S-AH-AS":316,"7C-AC-AD":317,"7C-AC-AH":317,"7C-AC-AS":317,"7C-AD-AH":317,"7C-AD-AS":317,"7C-AH-AS":317,"7D-AC-AD":317,"7D-AC-AH":317,"7D-AC-AS":317,"7H-AC-AD":317,"7S-AC-AD":317,"7H-AC-AH":317,"7H-AC-AS":317,"7S-AC-AH":317,"7S-AC-AS":317,"7D-AD-AH":317,"7D-AD-AS":317,"7D-AH-AS":317,"7H-AD-AH":317,"7H-AD-AS":317,"7S-AD-AH":317,"7S-AD-AS":317,"7H-AH-AS":317,"7S-AH-AS":317,"6C-AC-AD":318,"6C-AC-AH":318,"6C-AC-AS":318,"6C-AD-AH":318,"6C-AD-AS":318,"6C-AH-AS":318,"6D-AC-AD":318,"6D-AC-AH":318,"6D-AC-AS":318,"6H-AC-AD":318,"6S-AC-AD":318,"6H-AC-AH":318,"6H-AC-AS":318,"6S-AC-AH":318,"6S-AC-AS":318,"6D-AD-AH":318,"6D-AD-AS":318,"6D-AH-AS":318,"6H-AD-AH":318,"6H-AD-AS":318,"6S-AD-AH":318,"6S-AD-AS":318,"6H-AH-AS":318,"6S-AH-AS":318,"5C-AC-AD":319,"5C-AC-AH":319,"5C-AC-AS":319,"5C-AD-AH":319,"5C-AD-AS":319,"5C-AH-AS":319,"5D-AC-AD":319,"5D-AC-AH":319,"5D-AC-AS":319,"5H-AC-AD":319,"5S-AC-AD":319,"5H-AC-AH":319,"5H-AC-AS":319,"5S-AC-AH":319,"5S-AC-AS":319,"5D-AD-AH":319,"5D-AD-AS":319,"5D-AH-AS":319,"5H-AD-AH":319,"5H-AD-AS":319,"5S-AD-AH":319,"5S-AD-AS":319,"5H-AH-AS":319,"5S-AH-AS":319,"4C-AC-AD":320,"4C-AC-AH":320,"4C-AC-AS":320,"4C-AD-AH":320,"4C-AD-AS":320,"4C-AH-AS":320,"4D-AC-AD":320,"4D-AC-AH":320,"4D-AC-AS":320,"4H-AC-AD":320,"4S-AC-AD":320,"4H-AC-AH":320,"4H-AC-AS":320,"4S-AC-AH":320,"4S-AC-AS":320,"4D-AD-AH":320,"4D-AD-AS":320,"4D-AH-AS":320,"4H-AD-AH":320,"4H-AD-AS":320,"4S-AD-AH":320,"4S-AD-AS":320,"4H-AH-AS":320,"4S-AH-AS":320,"3C-AC-AD":321,"3C-AC-AH":321,"3C-AC-AS":321,"3C-AD-AH":321,"3C-AD-AS":321,"3C-AH-AS":321,"3D-AC-AD":321,"3D-AC-AH":321,"3D-AC-AS":321,"3H-AC-AD":321,"3S-AC-AD":321,"3H-AC-AH":321,"3H-AC-AS":321,"3S-AC-AH":321,"3S-AC-AS":321,"3D-AD-AH":321,"3D-AD-AS":321,"3D-AH-AS":321,"3H-AD-AH":321,"3H-AD-AS":321,"3S-AD-AH":321,"3S-AD-AS":321,"3H-AH-AS":321,"3S-AH-AS":321,"2C-AC-AD":322,"2C-AC-AH":322,"2C-AC-AS":322,"2C-AD-AH":322,"2C-AD-AS":322,"2C-AH-AS":322,"2D-AC-AD":322,"2D-AC-AH":322,"2D-AC-AS":322,"2H-AC-AD":322,"2S-AC-AD":322,"2H-AC-AH":322,"2H-AC-AS":322,"2S-AC-AH":322,"2S-AC-AS":322,"2D-AD-AH":322,"2D-AD-AS":322,"2D-AH-AS":322,"2H-AD-AH":322,"2H-AD-AS":322,"2S-AD-AH":322,"2S-AD-AS":322,"2H-AH-AS":322,"2S-AH-AS":322,"AC-KC-KD":323,"AC-KC-KH":323,"AC-KC-KS":323,"AD-KC-KD":323,"AH-KC-KD":323,"AS-KC-KD":323,"AD-KC-KH":323,"AD-KC-KS":323,"AH-KC-KH":323,"AS-KC-KH":323,"AH-KC-KS":323,"AS-KC-KS":323,"AC-KD-KH":323,"AC-KD-KS":323,"AC-KH-KS":323,"AD-KD-KH":323,"AD-KD-KS":323,"AH-KD-KH":323,"AS-KD-KH":323,"AH-KD-KS":323,"AS-KD-KS":323,"AD-KH-KS":323,"AH-KH-KS":323,"AS-KH-KS":323,"KC-KD-QC":324,"KC-KH-QC":324,"KC-KS-QC":324,"KD-KH-QC":324,"KD-KS-QC":324,"KH-KS-QC":324,"KC-KD-QD":324,"KC-KH-QD":324,"KC-KS-QD":324,"KC-KD-QH":324,"KC-KD-QS":324,"KC-KH-QH":324,"KC-KS-QH":324,"KC-KH-QS":324,"KC-KS-QS":324,"KD-KH-QD":324,"KD-KS-QD":324,"KH-KS-QD":324,"KD-KH-QH":324,"KD-KS-QH":324,"KD-KH-QS":324,"KD-KS-QS":324,"KH-KS-QH":324,"KH-KS-QS":324,"JC-KC-KD":325,"JC-KC-KH":325,"JC-KC-KS":325,"JC-KD-KH":325,"JC-KD-KS":325,"JC-KH-KS":325,"JD-KC-KD":325,"JD-KC-KH":325,"JD-KC-KS":325,"JH-KC-KD":325,"JS-KC-KD":325,"JH-KC-KH":325,"JH-KC-KS":325,"JS-KC-KH":325,"JS-KC-KS":325,"JD-KD-KH":325,"JD-KD-KS":325,"JD-KH-KS":325,"JH-KD-KH":325,"JH-KD-KS":325,"JS-KD-KH":325,"JS-KD-KS":325,"JH-KH-KS":325,"JS-KH-KS":325,"10C-KC-KD":326,"10C-KC-KH":326,"10C-KC-KS":326,"10C-KD-KH":326,"10C-KD-KS":326,"10C-KH-KS":326,"10D-KC-KD":326,"10D-KC-KH":326,"10D-KC-KS":326,"10H-KC-KD":326,"10S-KC-KD":326,"10H-KC-KH":326,"10H-KC-KS":326,"10S-KC-KH":326,"10S-KC-KS":326,"10D-KD-KH":326,"10D-KD-KS":326,"10D-KH-KS":326,"10H-KD-KH":326,"10H-KD-KS":326,"10S-KD-KH":326,"10S-KD-KS":326,"10H-KH-KS":326,"10S-KH-KS":326,"9C-KC-KD":327,"9C-KC-KH":327,"9C-KC-KS":327,"9C-KD-KH":327,"9C-KD-KS":327,"9C-KH-KS":327,"9D-KC-KD":327,"9D-KC-KH":327,"9D-KC-KS":327,"9H-KC-KD":327,"9S-KC-KD":327,"9H-KC-KH":327,"9H-KC-KS":327,"9S-KC-KH":327,"9S-KC-KS":327,"9D-KD-KH":327,"9D-KD-KS":327,"9D-KH-KS":327,"9H-KD-KH":327,"9H-KD-KS":327,"9S-KD-KH":327,"9S-KD-KS":327,"9H-KH-KS":327,"9S-KH-KS":327,"8C-KC-KD":328,"8C-KC-KH":328,"8C-KC-KS":328,"8C-KD-KH":328,"8C-KD-KS":328,"8C-KH-KS":328,"8D-KC-KD":328,"8D-KC-KH":328,"8D-KC-KS":328,"8H-KC-KD":328,"8S-KC-KD":328,"8H-KC-KH":328,"8H-KC-KS":328,"8S-KC-KH":328,"8S-KC-KS":328,"8D-KD-KH":328,"8D-KD-KS":328,"8D-KH-KS":328,"8H-KD-KH":328,"8H-KD-KS":328,"8S-KD-KH":328,"8S-KD-KS":328,"8H-KH-KS":328,"8S-KH-KS":328,"7C-KC-KD":329,"7C-KC-KH":329,"7C-KC-KS":329,"7C-KD-KH":329,"7C-KD-KS":329,"7C-KH-KS":329,"7D-KC-KD":329,"7D-KC-KH":329,"7D-KC-KS":329,"7H-KC-KD":329,"7S-KC-KD":329,"7H-KC-KH":329,"7H-KC-KS":329,"7S-KC-KH":329,"7S-KC-KS":329,"7D-KD-KH":329,"7D-KD-KS":329,"7D-KH-KS":329,"7H-KD-KH":329,"7H-KD-KS":329,"7S-KD-KH":329,"7S-KD-KS":329,"7H-KH-KS":329,"7S-KH-KS":329,"6C-KC-KD":330,"6C-KC-KH":330,"6C-KC-KS":330,"6C-KD-KH":330,"6C-KD-KS":330,"6C-KH-KS":330,"6D-KC-KD":330,"6D-KC-KH":330,"6D-KC-KS":330,"6H-KC-KD":330,"6S-KC-KD":330,"6H-KC-KH":330,"6H-KC-KS":330,"6S-KC-KH":330,"6S-KC-KS":330,"6D-KD-KH":330,"6D-KD-KS":330,"6D-KH-KS":330,"6H-KD-KH":330,"6H-KD-KS":330,"6S-KD-KH":330,"6S-KD-KS":330,"6H-KH-KS":330,"6S-KH-KS":330,"5C-KC-KD":331,"5C-KC-KH":331,"5C-KC-KS":331,"5C-KD-KH":331,"5C-KD-KS":331,"5C-KH-KS":331,"5D-KC-KD":331,"5D-KC-KH":331,"5D-KC-KS":331,"5H-KC-KD":331,"5S-KC-KD":331,"5H-KC-KH":331,"5H-KC-KS":331,"5S-KC-KH":331,"5S-KC-KS":331,"5D-KD-KH":331,"5D-KD-KS":331,"5D-KH-KS":331,"5H-KD-KH":331,"5H-KD-KS":331,"5S-KD-KH":331,"5S-KD-KS":331,"5H-KH-KS":331,"5S-KH-KS":331,"4C-KC-KD":332,"4C-KC-KH":332,"4C-KC-KS":332,"4C-KD-KH":332,"4C-KD-KS":332,"4C-KH-KS":332,"4D-KC-KD":332,"4D-KC-KH":332,"4D-KC-KS":332,"4H-KC-KD":332,"4S-KC-KD":332,"4H-KC-KH":332,"4H-KC-KS":332,"4S-KC-KH":332,"4S-KC-KS":332,"4D-KD-KH":332,"4D-KD-KS":332,"4D-KH-KS":332,"4H-KD-KH":332,"4H-KD-KS":332,"4S-KD-KH":332,"4S-KD-KS":332,"4H-KH-KS":332,"4S-KH-KS":332,"3C-KC-KD":333,"3C-KC-KH":333,"3C-KC-KS":333,"3C-KD-KH":333,"3C-KD-KS":333,"3C-KH-KS":333,"3D-KC-KD":333,"3D-KC-KH":333,"3D-KC-KS":333,"3H-KC-KD":333,"3S-KC-KD":333,"3H-KC-KH":333,"3H-KC-KS":333,"3S-KC-KH":333,"3S-KC-KS":333,"3D-KD-KH":333,"3D-KD-KS":333,"3D-KH-KS":333,"3H-KD-KH":333,"3H-KD-KS":333,"3S-KD-KH":333,"3S-KD-KS":333,"3H-KH-KS":333,"3S-KH-KS":333,"2C-KC-KD":334,"2C-KC-KH":334,"2C-KC-KS":334,"2C-KD-KH":334,"2C-KD-KS":334,"2C-KH-KS":334,"2D-KC-KD":334,"2D-KC-KH":334,"2D-KC-KS":334,"2H-KC-KD":334,"2S-KC-KD":334,"2H-KC-KH":334,"2H-KC-KS":334,"2S-KC-KH":334,"2S-KC-KS":334,"2D-KD-KH":334,"2D-KD-KS":334,"2D-KH-KS":334,"2H-KD-KH":334,"2H-KD-KS":334,"2S-KD-KH":334,"2S-KD-KS":334,"2H-KH-KS":334,"2S-KH-KS":334,"AC-QC-QD":335,"AC-QC-QH":335,"AC-QC-QS":335,"AD-QC-QD":335,"AH-QC-QD":335,"AS-QC-QD":335,"AD-QC-QH":335,"AD-QC-QS":335,"AH-QC-QH":335,"AS-QC-QH":335,"AH-QC-QS":335,"AS-QC-QS":335,"AC-QD-QH":335,"AC-QD-QS":335,"AC-QH-QS":335,"AD-QD-QH":335,"AD-QD-QS":335,"AH-QD-QH":335,"AS-QD-QH":335,"AH-QD-QS":335,"AS-QD-QS":335,"AD-QH-QS":335,"AH-QH-QS":335,"AS-QH-QS":335,"KC-QC-QD":336,"KC-QC-QH":336,"KC-QC-QS":336,"KD-QC-QD":336,"KH-QC-QD":336,"KS-QC-QD":336,"KD-QC-QH":336,"KD-QC-QS":336,"KH-QC-QH":336,"KS-QC-QH":336,"KH-QC-QS":336,"KS-QC-QS":336,"KC-QD-QH":336,"KC-QD-QS":336,"KC-QH-QS":336,"KD-QD-QH":336,"KD-QD-QS":336,"KH-QD-QH":336,"KS-QD-QH":336,"KH-QD-QS":336,"KS-QD-QS":336,"KD-QH-QS":336,"KH-QH-QS":336,"KS-QH-QS":336,"JC-QC-QD":337,"JC-QC-QH":337,"JC-QC-QS":337,"JC-QD-QH":337,"JC-QD-QS":337,"JC-QH-QS":337,"JD-QC-QD":337,"JD-QC-QH":337,"JD-QC-QS":337,"JH-QC-QD":337,"JS-QC-QD":337,"JH-QC-QH":337,"JH-QC-QS":337,"JS-QC-QH":337,"JS-QC-QS":337,"JD-QD-QH":337,"JD-QD-QS":337,"JD-QH-QS":337,"JH-QD-QH":337,"JH-QD-QS":337,"JS-QD-QH":337,"JS-QD-QS":337,"JH-QH-QS":337,"JS-QH-QS":337,"10C-QC-QD":338,"10C-QC-QH":338,"10C-QC-QS":338,"10C-QD-QH":338,"10C-QD-QS":338,"10C-QH-QS":338,"10D-QC-QD":338,"10D-QC-QH":338,"10D-QC-QS":338,"10H-QC-QD":338,"10S-QC-QD":338,"10H-QC-QH":338,"10H-QC-QS":338,"10S-QC-QH":338,"10S-QC-QS":338,"10D-QD-QH":338,"10D-QD-QS":338,"10D-QH-QS":338,"10H-QD-QH":338,"10H-QD-QS":338,"10S-QD-QH":338,"10S-QD-QS":338,"10H-QH-QS":338,"10S-QH-QS":338,"9C-QC-QD":339,"9C-QC-QH":339,"9C-QC-QS":339,"9C-QD-QH":339,"9C-QD-QS":339,"9C-QH-QS":339,"9D-QC-QD":339,"9D-QC-QH":339,"9D-QC-QS":339,"9H-QC-QD":339,"9S-QC-QD":339,"9H-QC-QH":339,"9H-QC-QS":339,"9S-QC-QH":339,"9S-QC-QS":339,"9D-QD-QH":339,"9D-QD-QS":339,"9D-QH-QS":339,"9H-QD-QH":339,"9H-QD-QS":339,"9S-QD-QH":339,"9S-QD-QS":339,"9H-QH-QS":339,"9S-QH-QS":339,"8C-QC-QD":340,"8C-QC-QH":340,"8C-QC-QS":340,"8C-QD-QH":340,"8C-QD-QS":340,"8C-QH-QS":340,"8D-QC-QD":340,"8D-QC-QH":340,"8D-QC-QS":340,"8H-QC-QD":340,"8S-QC-QD":340,"8H-QC-QH":340,"8H-QC-QS":340,"8S-QC-QH":340,"8S-QC-QS":340,"8D-QD-QH":340,"8D-QD-QS":340,"8D-QH-QS":340,"8H-QD-QH":340,"8H-QD-QS":340,"8S-QD-QH":340,"8S-QD-QS":340,"8H-QH-QS":340,"8S-QH-QS":340,"7C-QC-QD":341,"7C-QC-QH":341,"7C-QC-QS":341,"7C-QD-QH":341,"7C-QD-QS":341,"7C-QH-QS":341,"7D-QC-QD":341,"7D-QC-QH":341,"7D-QC-QS":341,"7H-QC-QD":341,"7S-QC-QD":341,"7H-QC-QH":341,"7H-QC-QS":341,"7S-QC-QH":341,"7S-QC-QS":341,"7D-QD-QH":341,"7D-QD-QS":341,"7D-QH-QS":341,"7H-QD-QH":341,"7H-QD-QS":341,"7S-QD-QH":341,"7S-QD-QS":341,"7H-QH-QS":341,"7S-QH-QS":341,"6C-QC-QD":342,"6C-QC-QH":342,"6C-QC-QS":342,"6C-QD-QH":342,"6C-QD-QS":342,"6C-QH-QS":342,"6D-QC-QD":342,"6D-QC-QH":342,"6D-QC-QS":342,"6H-QC-QD":342,"6S-QC-QD":342,"6H-QC-QH":342,"6H-QC-QS":342,"6S-QC-QH":342,"6S-QC-QS":342,"6D-QD-QH":342,"6D-QD-QS":342,"6D-QH-QS":342,"6H-QD-QH":342,"6H-QD-QS":342,"6S-QD-QH":342,"6S-QD-QS":342,"6H-QH-QS":342,"6S-QH-QS":342,"5C-QC-QD":343,"5C-QC-QH":343,"5C-QC-QS":343,"5C-QD-QH":343,"5C-QD-QS":343,"5C-QH-QS":343,"5D-QC-QD":343,"5D-QC-QH":343,"5D-QC-QS":343,"5H-QC-QD":343,"5S-QC-QD":343,"5H-QC-QH":343,"5H-QC-QS":343,"5S-QC-QH":343,"5S-QC-QS":343,"5D-QD-QH":343,"5D-QD-QS":343,"5D-QH-QS":343,"5H-QD-QH":343,"5H-QD-QS":343,"5S-QD-QH":343,"5S-QD-QS":343,"5H-QH-QS":343,"5S-QH-QS":343,"4C-QC-QD":344,"4C-QC-QH":344,"4C-QC-QS":344,"4C-QD-QH":344,"4C-QD-QS":344,"4C-QH-QS":344,"4D-QC-QD":344,"4D-QC-QH":344,"4D-QC-QS":344,"4H-QC-QD":344,"4S-QC-QD":344,"4H-QC-QH":344,"4H-QC-QS":344,"4S-QC-QH":344,"4S-QC-QS":344,"4D-QD-QH":344,"4D-QD-QS":344,"4D-QH-QS":344,"4H-QD-QH":344,"4H-QD-QS":344,"4S-QD-QH":344,"4S-QD-QS":344,"4H-QH-QS":344,"4S-QH-QS":344,"3C-QC-QD":345,"3C-QC-QH":345,"3C-QC-QS":345,"3C-QD-QH":345,"3C-QD-QS":345,"3C-QH-QS":345,"3D-QC-QD":345,"3D-QC-QH":345,"3D-QC-QS":345,"3H-QC-QD":345,"3S-QC-QD":345,"3H-QC-QH":345,"3H-QC-QS":345,"3S-QC-QH":345,"3S-QC-QS":345,"3D-QD-QH":345,"3D-QD-QS":345,"3D-QH-QS":345,"3H-QD-QH":345,"3H-QD-QS":345,"3S-QD-QH":345,"3S-QD-QS":345,"3H-QH-QS":345,"3S-QH-QS":345,"2C-QC-QD":346,"2C-QC-QH":346,"2C-QC-QS":346,"2C-QD-QH":346,"2C-QD-QS":346,"2C-QH-QS":346,"2D-QC-QD":346,"2D-QC-QH":346,"2D-QC-QS":346,"2H-QC-QD":346,"2S-QC-QD":346,"2H-QC-QH":346,"2H-QC-QS":346,"2S-QC-QH":346,"2S-QC-QS":346,"2D-QD-QH":346,"2D-QD-QS":346,"2D-QH-QS":346,"2H-QD-QH":346,"2H-QD-QS":346,"2S-QD-QH":346,"2S-QD-QS":346,"2H-QH-QS":346,"2S-QH-QS":346,"AC-JC-JD":347,"AC-JC-JH":347,"AC-JC-JS":347,"AD-JC-JD":347,"AH-JC-JD":347,"AS-JC-JD":347,"AD-JC-JH":347,"AD-JC-JS":347,"AH-JC-JH":347,"AS-JC-JH":347,"AH-JC-JS":347,"AS-JC-JS":347,"AC-JD-JH":347,"AC-JD-JS":347,"AC-JH-JS":347,"AD-JD-JH":347,"AD-JD-JS":347,"AH-JD-JH":347,"AS-JD-JH":347,"AH-JD-JS":347,"AS-JD-JS":347,"AD-JH-JS":347,"AH-JH-JS":347,"AS-JH-JS":347,"JC-JD-KC":348,"JC-JH-KC":348,"JC-JS-KC":348,"JC-JD-KD":348,"JC-JD-KH":348,"JC-JD-KS":348,"JC-JH-KD":348,"JC-JS-KD":348,"JC-JH-KH":348,"JC-JH-KS":348,"JC-JS-KH":348,"JC-JS-KS":348,"JD-JH-KC":348,"JD-JS-KC":348,"JH-JS-KC":348,"JD-JH-KD":348,"JD-JS-KD":348,"JD-JH-KH":348,"JD-JH-KS":348,"JD-JS-KH":348,"JD-JS-KS":348,"JH-JS-KD":348,"JH-JS-KH":348,"JH-JS-KS":348,"JC-JD-QC":349,"JC-JH-QC":349,"JC-JS-QC":349,"JC-JD-QD":349,"JC-JD-QH":349,"JC-JD-QS":349,"JC-JH-QD":349,"JC-JS-QD":349,"JC-JH-QH":349,"JC-JH-QS":349,"JC-JS-QH":349,"JC-JS-QS":349,"JD-JH-QC":349,"JD-JS-QC":349,"JH-JS-QC":349,"JD-JH-QD":349,"JD-JS-QD":349,"JD-JH-QH":349,"JD-JH-QS":349,"JD-JS-QH":349,"JD-JS-QS":349,"JH-JS-QD":349,"JH-JS-QH":349,"JH-JS-QS":349,"10C-JC-JD":350,"10C-JC-JH":350,"10C-JC-JS":350,"10C-JD-JH":350,"10C-JD-JS":350,"10C-JH-JS":350,"10D-JC-JD":350,"10D-JC-JH":350,"10D-JC-JS":350,"10H-JC-JD":350,"10S-JC-JD":350,"10H-JC-JH":350,"10H-JC-JS":350,"10S-JC-JH":350,"10S-JC-JS":350,"10D-JD-JH":350,"10D-JD-JS":350,"10D-JH-JS":350,"10H-JD-JH":350,"10H-JD-JS":350,"10S-JD-JH":350,"10S-JD-JS":350,"10H-JH-JS":350,"10S-JH-JS":350,"9C-JC-JD":351,"9C-JC-JH":351,"9C-JC-JS":351,"9C-JD-JH":351,"9C-JD-JS":351,"9C-JH-JS":351,"9D-JC-JD":351,"9D-JC-JH":351,"9D-JC-JS":351,"9H-JC-JD":351,"9S-JC-JD":351,"9H-JC-JH":351,"9H-JC-JS":351,"9S-JC-JH":351,"9S-JC-JS":351,"9D-JD-JH":351,"9D-JD-JS":351,"9D-JH-JS":351,"9H-JD-JH":351,"9H-JD-JS":351,"9S-JD-JH":351,"9S-JD-JS":351,"9H-JH-JS":351,"9S-JH-JS":351,"8C-JC-JD":352,"8C-JC-JH":352,"8C-JC-JS":352,"8C-JD-JH":352,"8C-JD-JS":352,"8C-JH-JS":352,"8D-JC-JD":352,"8D-JC-JH":352,"8D-JC-JS":352,"8H-JC-JD":352,"8S-JC-JD":352,"8H-JC-JH":352,"8H-JC-JS":352,"8S-JC-JH":352,"8S-JC-JS":352,"8D-JD-JH":352,"8D-JD-JS":352,"8D-JH-JS":352,"8H-JD-JH":352,"8H-JD-JS":352,"8S-JD-JH":352,"8S-JD-JS":352,"8H-JH-JS":352,"8S-JH-JS":352,"7C-JC-JD":353,"7C-JC-JH":353,"7C-JC-JS":353,"7C-JD-JH":353,"7C-JD-JS":353,"7C-JH-JS":353,"7D-JC-JD":353,"7D-JC-JH":353,"7D-JC-JS":353,"7H-JC-JD":353,"7S-JC-JD":353,"7H-JC-JH":353,"7H-JC-JS":353,"7S-JC-JH":353,"7S-JC-JS":353,"7D-JD-JH":353,"7D-JD-JS":353,"7D-JH-JS":353,"7H-JD-JH":353,"7H-JD-JS":353,"7S-JD-JH":353,"7S-JD-JS":353,"7H-JH-JS":353,"7S-JH-JS":353,"6C-JC-JD":354,"6C-JC-JH":354,"6C-JC-JS":354,"6C-JD-JH":354,"6C-JD-JS":354,"6C-JH-JS":354,"6D-JC-JD":354,"6D-JC-JH":354,"6D-JC-JS":354,"6H-JC-JD":354,"6S-JC-JD":354,"6H-JC-JH":354,"6H-JC-JS":354,"6S-JC-JH":354,"6S-JC-JS":354,"6D-JD-JH":354,"6D-JD-JS":354,"6D-JH-JS":354,"6H-JD-JH":354,"6H-JD-JS":354,"6S-JD-JH":354,"6S-JD-JS":354,"6H-JH-JS":354,"6S-JH-JS":354,"5C-JC-JD":355,"5C-JC-JH":355,"5C-JC-JS":355,"5C-JD-JH":355,"5C-JD-JS":355,"5C-JH-JS":355,"5D-JC-JD":355,"5D-JC-JH":355,"5D-JC-JS":355,"5H-JC-JD":355,"5S-JC-JD":355,"5H-JC-JH":355,"5H-JC-JS":355,"5S-JC-JH":355,"5S-JC-JS":355,"5D-JD-JH":355,"5D-JD-JS":355,"5D-JH-JS":355,"5H-JD-JH":355,"5H-JD-JS":355,"5S-JD-JH":355,"5S-JD-JS":355,"5H-JH-JS":355,"5S-JH-JS":355,"4C-JC-JD":356,"4C-JC-JH":356,"4C-JC-JS":356,"4C-JD-JH":356,"4C-JD-JS":356,"4C-JH-JS":356,"4D-JC-JD":356,"4D-JC-JH":356,"4D-JC-JS":356,"4H-JC-JD":356,"4S-JC-JD":356,"4H-JC-JH":356,"4H-JC-JS":356,"4S-JC-JH":356,"4S-JC-JS":356,"4D-JD-JH":356,"4D-JD-JS":356,"4D-JH-JS":356,"4H-JD-JH":356,"4H-JD-JS":356,"4S-JD-JH":356,"4S-JD-JS":356,"4H-JH-JS":356,"4S-JH-JS":356,"3C-JC-JD":357,"3C-JC-JH":357,"3C-JC-JS":357,"3C-JD-JH":357,"3C-JD-JS":357,"3C-JH-JS":357,"3D-JC-JD":357,"3D-JC-JH":357,"3D-JC-JS":357,"3H-JC-JD":357,"3S-JC-JD":357,"3H-JC-JH":357,"3H-JC-JS":357,"3S-JC-JH":357,"3S-JC-JS":357,"3D-JD-JH":357,"3D-JD-JS":357,"3D-JH-JS":357,"3H-JD-JH":357,"3H-JD-JS":357,"3S-JD-JH":357,"3S-JD-JS":357,"3H-JH-JS":357,"3S-JH-JS":357,"2C-JC-JD":358,"2C-JC-JH":358,"2C-JC-JS":358,"2C-JD-JH":358,"2C-JD-JS":358,"2C-JH-JS":358,"2D-JC-JD":358,"2D-JC-JH":358,"2D-JC-JS":358,"2H-JC-JD":358,"2S-JC-JD":358,"2H-JC-JH":358,"2H-JC-JS":358,"2S-JC-JH":358,"2S-JC-JS":358,"2D-JD-JH":358,"2D-JD-JS":358,"2D-JH-JS":358,"2H-JD-JH":358,"2H-JD-JS":358,"2S-JD-JH":358,"2S-JD-JS":358,"2H-JH-JS":358,"2S-JH-JS":358,"10C-10D-AC":359,"10C-10H-AC":359,"10C-10S-AC":359,"10C-10D-AD":359,"10C-10D-AH":359,"10C-10D-AS":359,"10C-10H-AD":359,"10C-10S-AD":359,"10C-10H-AH":359,"10C-10H-AS":359,"10C-10S-AH":359,"10C-10S-AS":359,"10D-10H-AC":359,"10D-10S-AC":359,"10H-10S-AC":359,"10D-10H-AD":359,"10D-10S-AD":359,"10D-10H-AH":359,"10D-10H-AS":359,"10D-10S-AH":359,"10D-10S-AS":359,"10H-10S-AD":359,"10H-10S-AH":359,"10H-10S-AS":359,"10C-10D-KC":360,"10C-10H-KC":360,"10C-10S-KC":360,"10C-10D-KD":360,"10C-10D-KH":360,"10C-10D-KS":360,"10C-10H-KD":360,"10C-10S-KD":360,"10C-10H-KH":360,"10C-10H-KS":360,"10C-10S-KH":360,"10C-10S-KS":360,"10D-10H-KC":360,"10D-10S-KC":360,"10H-10S-KC":360,"10D-10H-KD":360,"10D-10S-KD":360,"10D-10H-KH":360,"10D-10H-KS":360,"10D-10S-KH":360,"10D-10S-KS":360,"10H-10S-KD":360,"10H-10S-KH":360,"10H-10S-KS":360,"10C-10D-QC":361,"10C-10H-QC":361,"10C-10S-QC":361,"10C-10D-QD":361,"10C-10D-QH":361,"10C-10D-QS":361,"10C-10H-QD":361,"10C-10S-QD":361,"10C-10H-QH":361,"10C-10H-QS":361,"10C-10S-QH":361,"10C-10S-QS":361,"10D-10H-QC":361,"10D-10S-QC":361,"10H-10S-QC":361,"10D-10H-QD":361,"10D-10S-QD":361,"10D-10H-QH":361,"10D-10H-QS":361,"10D-10S-QH":361,"10D-10S-QS":361,"10H-10S-QD":361,"10H-10S-QH":361,"10H-10S-QS":361,"10C-10D-JC":362,"10C-10H-JC":362,"10C-10S-JC":362,"10C-10D-JD":362,"10C-10D-JH":362,"10C-10D-JS":362,"10C-10H-JD":362,"10C-10S-JD":362,"10C-10H-JH":362,"10C-10H-JS":362,"10C-10S-JH":362,"10C-10S-JS":362,"10D-10H-JC":362,"10D-10S-JC":362,"10H-10S-JC":362,"10D-10H-JD":362,"10D-10S-JD":362,"10D-10H-JH":362,"10D-10H-JS":362,"10D-10S-JH":362,"10D-10S-JS":362,"10H-10S-JD":362,"10H-10S-JH":362,"10H-10S-JS":362,"10C-10D-9C":363,"10C-10H-9C":363,"10C-10S-9C":363,"10D-10H-9C":363,"10D-10S-9C":363,"10H-10S-9C":363,"10C-10D-9D":363,"10C-10H-9D":363,"10C-10S-9D":363,"10C-10D-9H":363,"10C-10D-9S":363,"10C-10H-9H":363,"10C-10S-9H":363,"10C-10H-9S":363,"10C-10S-9S":363,"10D-10H-9D":363,"10D-10S-9D":363,"10H-10S-9D":363,"10D-10H-9H":363,"10D-10S-9H":363,"10D-10H-9S":363,"10D-10S-9S":363,"10H-10S-9H":363,"10H-10S-9S":363,"10C-10D-8C":364,"10C-10H-8C":364,"10C-10S-8C":364,"10D-10H-8C":364,"10D-10S-8C":364,"10H-10S-8C":364,"10C-10D-8D":364,"10C-10H-8D":364,"10C-10S-8D":364,"10C-10D-8H":364,"10C-10D-8S":364,"10C-10H-8H":364,"10C-10S-8H":364,"10C-10H-8S":364,"10C-10S-8S":364,"10D-10H-8D":364,"10D-10S-8D":364,"10H-10S-8D":364,"10D-10H-8H":364,"10D-10S-8H":364,"10D-10H-8S":364,"10D-10S-8S":364,"10H-10S-8H":364,"10H-10S-8S":364,"10C-10D-7C":365,"10C-10H-7C":365,"10C-10S-7C":365,"10D-10H-7C":365,"10D-10S-7C":365,"10H-10S-7C":365,"10C-10D-7D":365,"10C-10H-7D":365,"10C-10S-7D":365,"10C-10D-7H":365,"10C-10D-7S":365,"10C-10H-7H":365,"10C-10S-7H":365,"10C-10H-7S":365,"10C-10S-7S":365,"10D-10H-7D":365,"10D-10S-7D":365,"10H-10S-7D":365,"10D-10H-7H":365,"10D-10S-7H":365,"10D-10H-7S":365,"10D-10S-7S":365,"10H-10S-7H":365,"10H-10S-7S":365,"10C-10D-6C":366,"10C-10H-6C":366,"10C-10S-6C":366,"10D-10H-6C":366,"10D-10S-6C":366,"10H-10S-6C":366,"10C-10D-6D":366,"10C-10H-6D":366,"10C-10S-6D":366,"10C-10D-6H":366,"10C-10D-6S":366,"10C-10H-6H":366,"10C-10S-6H":366,"10C-10H-6S":366,"10C-10S-6S":366,"10D-10H-6D":366,"10D-10S-6D":366,"10H-10S-6D":366,"10D-10H-6H":366,"10D-10S-6H":366,"10D-10H-6S":366,"10D-10S-6S":366,"10H-10S-6H":366,"10H-10S-6S":366,"10C-10D-5C":367,"10C-10H-5C":367,"10C-10S-5C":367,"10D-10H-5C":367,"10D-10S-5C":367,"10H-10S-5C":367,"10C-10D-5D":367,"10C-10H-5D":367,"10C-10S-5D":367,"10C-10D-5H":367,"10C-10D-5S":367,"10C-10H-5H":367,"10C-10S-5H":367,"10C-10H-5S":367,"10C-10S-5S":367,"10D-10H-5D":367,"10D-10S-5D":367,"10H-10S-5D":367,"10D-10H-5H":367,"10D-10S-5H":367,"10D-10H-5S":367,"10D-10S-5S":367,"10H-10S-5H":367,"10H-10S-5S":367,"10C-10D-4C":368,"10C-10H-4C":368,"10C-10S-4C":368,"10D-10H-4C":368,"10D-10S-4C":368,"10H-10S-4C":368,"10C-10D-4D":368,"10C-10H-4D":368,"10C-10S-4D":368,"10C-10D-4H":368,"10C-10D-4S":368,"10C-10H-4H":368,"10C-10S-4H":368,"10C-10H-4S":368,"10C-10S-4S":368,"10D-10H-4D":368,"10D-10S-4D":368,"10H-10S-4D":368,"10D-10H-4H":368,"10D-10S-4H":368,"10D-10H-4S":368,"10D-10S-4S":368,"10H-10S-4H":368,"10H-10S-4S":368,"10C-10D-3C":369,"10C-10H-3C":369,"10C-10S-3C":369,"10D-10H-3C":369,"10D-10S-3C":369,"10H-10S-3C":369,"10C-10D-3D":369,"10C-10H-3D":369,"10C-10S-3D":369,"10C-10D-3H":369,"10C-10D-3S":369,"10C-10H-3H":369,"10C-10S-3H":369,"10C-10H-3S":369,"10C-10S-3S":369,"10D-10H-3D":369,"10D-10S-3D":369,"10H-10S-3D":369,"10D-10H-3H":369,"10D-10S-3H":369,"10D-10H-3S":369,"10D-10S-3S":369,"10H-10S-3H":369,"10H-10S-3S":369,"10C-10D-2C":370,"10C-10H-2C":370,"10C-10S-2C":370,"10D-10H-2C":370,"10D-10S-2C":370,"10H-10S-2C":370,"10C-10D-2D":370,"10C-10H-2D":370,"10C-10S-2D":370,"10C-10D-2H":370,"10C-10D-2S":370,"10C-10H-2H":370,"10C-10S-2H":370,"10C-10H-2S":370,"10C-10S-2S":370,"10D-10H-2D":370,"10D-10S-2D":370,"10H-10S-2D":370,"10D-10H-2H":370,"10D-10S-2H":370,"10D-10H-2S":370,"10D-10S-2S":370,"10H-10S-2H":370,"10H-10S-2S":370,"9C-9D-AC":371,"9C-9H-AC":371,"9C-9S-AC":371,"9C-9D-AD":371,"9C-9D-AH":371,"9C-9D-AS":371,"9C-9H-AD":371,"9C-9S-AD":371,"9C-9H-AH":371,"9C-9H-AS":371,"9C-9S-AH":371,"9C-9S-AS":371,"9D-9H-AC":371,"9D-9S-AC":371,"9H-9S-AC":371,"9D-9H-AD":371,"9D-9S-AD":371,"9D-9H-AH":371,"9D-9H-AS":371,"9D-9S-AH":371,"9D-9S-AS":371,"9H-9S-AD":371,"9H-9S-AH":371,"9H-9S-AS":371,"9C-9D-KC":372,"9C-9H-KC":372,"9C-9S-KC":372,"9C-9D-KD":372,"9C-9D-KH":372,"9C-9D-KS":372,"9C-9H-KD":372,"9C-9S-KD":372,"9C-9H-KH":372,"9C-9H-KS":372,"9C-9S-KH":372,"9C-9S-KS":372,"9D-9H-KC":372,"9D-9S-KC":372,"9H-9S-KC":372,"9D-9H-KD":372,"9D-9S-KD":372,"9D-9H-KH":372,"9D-9H-KS":372,"9D-9S-KH":372,"9D-9S-KS":372,"9H-9S-KD":372,"9H-9S-KH":372,"9H-9S-KS":372,"9C-9D-QC":373,"9C-9H-QC":373,"9C-9S-QC":373,"9C-9D-QD":373,"9C-9D-QH":373,"9C-9D-QS":373,"9C-9H-QD":373,"9C-9S-QD":373,"9C-9H-QH":373,"9C-9H-QS":373,"9C-9S-QH":373,"9C-9S-QS":373,"9D-9H-QC":373,"9D-9S-QC":373,"9H-9S-QC":373,"9D-9H-QD":373,"9D-9S-QD":373,"9D-9H-QH":373,"9D-9H-QS":373,"9D-9S-QH":373,"9D-9S-QS":373,"9H-9S-QD":373,"9H-9S-QH":373,"9H-9S-QS":373,"9C-9D-JC":374,"9C-9H-JC":374,"9C-9S-JC":374,"9C-9D-JD":374,"9C-9D-JH":374,"9C-9D-JS":374,"9C-9H-JD":374,"9C-9S-JD":374,"9C-9H-JH":374,"9C-9H-JS":374,"9C-9S-JH":374,"9C-9S-JS":374,"9D-9H-JC":374,"9D-9S-JC":374,"9H-9S-JC":374,"9D-9H-JD":374,"9D-9S-JD":374,"9D-9H-JH":374,"9D-9H-JS":374,"9D-9S-JH":374,"9D-9S-JS":374,"9H-9S-JD":374,"9H-9S-JH":374,"9H-9S-JS":374,"10C-9C-9D":375,"10C-9C-9H":375,"10C-9C-9S":375,"10D-9C-9D":375,"10H-9C-9D":375,"10S-9C-9D":375,"10D-9C-9H":375,"10D-9C-9S":375,"10H-9C-9H":375,"10S-9C-9H":375,"10H-9C-9S":375,"10S-9C-9S":375,"10C-9D-9H":375,"10C-9D-9S":375,"10C-9H-9S":375,"10D-9D-9H":375,"10D-9D-9S":375,"10H-9D-9H":375,"10S-9D-9H":375,"10H-9D-9S":375,"10S-9D-9S":375,"10D-9H-9S":375,"10H-9H-9S":375,"10S-9H-9S":375,"8C-9C-9D":376,"8C-9C-9H":376,"8C-9C-9S":376,"8C-9D-9H":376,"8C-9D-9S":376,"8C-9H-9S":376,"8D-9C-9D":376,"8D-9C-9H":376,"8D-9C-9S":376,"8H-9C-9D":376,"8S-9C-9D":376,"8H-9C-9H":376,"8H-9C-9S":376,"8S-9C-9H":376,"8S-9C-9S":376,"8D-9D-9H":376,"8D-9D-9S":376,"8D-9H-9S":376,"8H-9D-9H":376,"8H-9D-9S":376,"8S-9D-9H":376,"8S-9D-9S":376,"8H-9H-9S":376,"8S-9H-9S":376,"7C-9C-9D":377,"7C-9C-9H":377,"7C-9C-9S":377,"7C-9D-9H":377,"7C-9D-9S":377,"7C-9H-9S":377,"7D-9C-9D":377,"7D-9C-9H":377,"7D-9C-9S":377,"7H-9C-9D":377,"7S-9C-9D":377,"7H-9C-9H":377,"7H-9C-9S":377,"7S-9C-9H":377,"7S-9C-9S":377,"7D-9D-9H":377,"7D-9D-9S":377,"7D-9H-9S":377,"7H-9D-9H":377,"7H-9D-9S":377,"7S-9D-9H":377,"7S-9D-9S":377,"7H-9H-9S":377,"7S-9H-9S":377,"6C-9C-9D":378,"6C-9C-9H":378,"6C-9C-9S":378,"6C-9D-9H":378,"6C-9D-9S":378,"6C-9H-9S":378,"6D-9C-9D":378,"6D-9C-9H":378,"6D-9C-9S":378,"6H-9C-9D":378,"6S-9C-9D":378,"6H-9C-9H":378,"6H-9C-9S":378,"6S-9C-9H":378,"6S-9C-9S":378,"6D-9D-9H":378,"6D-9D-9S":378,"6D-9H-9S":378,"6H-9D-9H":378,"6H-9D-9S":378,"6S-9D-9H":378,"6S-9D-9S":378,"6H-9H-9S":378,"6S-9H-9S":378,"5C-9C-9D":379,"5C-9C-9H":379,"5C-9C-9S":379,"5C-9D-9H":379,"5C-9D-9S":379,"5C-9H-9S":379,"5D-9C-9D":379,"5D-9C-9H":379,"5D-9C-9S":379,"5H-9C-9D":379,"5S-9C-9D":379,"5H-9C-9H":379,"5H-9C-9S":379,"5S-9C-9H":379,"5S-9C-9S":379,"5D-9D-9H":379,"5D-9D-9S":379,"5D-9H-9S":379,"5H-9D-9H":379,"5H-9D-9S":379,"5S-9D-9H":379,"5S-9D-9S":379,"5H-9H-9S":379,"5S-9H-9S":379,"4C-9C-9D":380,"4C-9C-9H":380,"4C-9C-9S":380,"4C-9D-9H":380,"4C-9D-9S":380,"4C-9H-9S":380,"4D-9C-9D":380,"4D-9C-9H":380,"4D-9C-9S":380,"4H-9C-9D":380,"4S-9C-9D":380,"4H-9C-9H":380,"4H-9C-9S":380,"4S-9C-9H":380,"4S-9C-9S":380,"4D-9D-9H":380,"4D-9D-9S":380,"4D-9H-9S":380,"4H-9D-9H":380,"4H-9D-9S":380,"4S-9D-9H":380,"4S-9D-9S":380,"4H-9H-9S":380,"4S-9H-9S":380,"3C-9C-9D":381,"3C-9C-9H":381,"3C-9C-9S":381,"3C-9D-9H":381,"3C-9D-9S":381,"3C-9H-9S":381,"3D-9C-9D":381,"3D-9C-9H":381,"3D-9C-9S":381,"3H-9C-9D":381,"3S-9C-9D":381,"3H-9C-9H":381,"3H-9C-9S":381,"3S-9C-9H":381,"3S-9C-9S":381,"3D-9D-9H":381,"3D-9D-9S":381,"3D-9H-9S":381,"3H-9D-9H":381,"3H-9D-9S":381,"3S-9D-9H":381,"3S-9D-9S":381,"3H-9H-9S":381,"3S-9H-9S":381,"2C-9C-9D":382,"2C-9C-9H":382,"2C-9C-9S":382,"2C-9D-9H":382,"2C-9D-9S":382,"2C-9H-9S":382,"2D-9C-9D":382,"2D-9C-9H":382,"2D-9C-9S":382,"2H-9C-9D":382,"2S-9C-9D":382,"2H-9C-9H":382,"2H-9C-9S":382,"2S-9C-9H":382,"2S-9C-9S":382,"2D-9D-9H":382,"2D-9D-9S":382,"2D-9H-9S":382,"2H-9D-9H":382,"2H-9D-9S":382,"2S-9D-9H":382,"2S-9D-9S":382,"2H-9H-9S":382,"2S-9H-9S":382,"8C-8D-AC":383,"8C-8H-AC":383,"8C-8S-AC":383,"8C-8D-AD":383,"8C-8D-AH":383,"8C-8D-AS":383,"8C-8H-AD":383,"8C-8S-AD":383,"8C-8H-AH":383,"8C-8H-AS":383,"8C-8S-AH":383,"8C-8S-AS":383,"8D-8H-AC":383,"8D-8S-AC":383,"8H-8S-AC":383,"8D-8H-AD":383,"8D-8S-AD":383,"8D-8H-AH":383,"8D-8H-AS":383,"8D-8S-AH":383,"8D-8S-AS":383,"8H-8S-AD":383,"8H-8S-AH":383,"8H-8S-AS":383,"8C-8D-KC":384,"8C-8H-KC":384,"8C-8S-KC":384,"8C-8D-KD":384,"8C-8D-KH":384,"8C-8D-KS":384,"8C-8H-KD":384,"8C-8S-KD":384,"8C-8H-KH":384,"8C-8H-KS":384,"8C-8S-KH":384,"8C-8S-KS":384,"8D-8H-KC":384,"8D-8S-KC":384,"8H-8S-KC":384,"8D-8H-KD":384,"8D-8S-KD":384,"8D-8H-KH":384,"8D-8H-KS":384,"8D-8S-KH":384,"8D-8S-KS":384,"8H-8S-KD":384,"8H-8S-KH":384,"8H-8S-KS":384,"8C-8D-QC":385,"8C-8H-QC":385,"8C-8S-QC":385,"8C-8D-QD":385,"8C-8D-QH":385,"8C-8D-QS":385,"8C-8H-QD":385,"8C-8S-QD":385,"8C-8H-QH":385,"8C-8H-QS":385,"8C-8S-QH":385,"8C-8S-QS":385,"8D-8H-QC":385,"8D-8S-QC":385,"8H-8S-QC":385,"8D-8H-QD":385,"8D-8S-QD":385,"8D-8H-QH":385,"8D-8H-QS":385,"8D-8S-QH":385,"8D-8S-QS":385,"8H-8S-QD":385,"8H-8S-QH":385,"8H-8S-QS":385,"8C-8D-JC":386,"8C-8H-JC":386,"8C-8S-JC":386,"8C-8D-JD":386,"8C-8D-JH":386,"8C-8D-JS":386,"8C-8H-JD":386,"8C-8S-JD":386,"8C-8H-JH":386,"8C-8H-JS":386,"8C-8S-JH":386,"8C-8S-JS":386,"8D-8H-JC":386,"8D-8S-JC":386,"8H-8S-JC":386,"8D-8H-JD":386,"8D-8S-JD":386,"8D-8H-JH":386,"8D-8H-JS":386,"8D-8S-JH":386,"8D-8S-JS":386,"8H-8S-JD":386,"8H-8S-JH":386,"8H-8S-JS":386,"10C-8C-8D":387,"10C-8C-8H":387,"10C-8C-8S":387,"10D-8C-8D":387,"10H-8C-8D":387,"10S-8C-8D":387,"10D-8C-8H":387,"10D-8C-8S":387,"10H-8C-8H":387,"10S-8C-8H":387,"10H-8C-8S":387,"10S-8C-8S":387,"10C-8D-8H":387,"10C-8D-8S":387,"10C-8H-8S":387,"10D-8D-8H":387,"10D-8D-8S":387,"10H-8D-8H":387,"10S-8D-8H":387,"10H-8D-8S":387,"10S-8D-8S":387,"10D-8H-8S":387,"10H-8H-8S":387,"10S-8H-8S":387,"8C-8D-9C":388,"8C-8H-9C":388,"8C-8S-9C":388,"8C-8D-9D":388,"8C-8D-9H":388,"8C-8D-9S":388,"8C-8H-9D":388,"8C-8S-9D":388,"8C-8H-9H":388,"8C-8H-9S":388,"8C-8S-9H":388,"8C-8S-9S":388,"8D-8H-9C":388,"8D-8S-9C":388,"8H-8S-9C":388,"8D-8H-9D":388,"8D-8S-9D":388,"8D-8H-9H":388,"8D-8H-9S":388,"8D-8S-9H":388,"8D-8S-9S":388,"8H-8S-9D":388,"8H-8S-9H":388,"8H-8S-9S":388,"7C-8C-8D":389,"7C-8C-8H":389,"7C-8C-8S":389,"7C-8D-8H":389,"7C-8D-8S":389,"7C-8H-8S":389,"7D-8C-8D":389,"7D-8C-8H":389,"7D-8C-8S":389,"7H-8C-8D":389,"7S-8C-8D":389,"7H-8C-8H":389,"7H-8C-8S":389,"7S-8C-8H":389,"7S-8C-8S":389,"7D-8D-8H":389,"7D-8D-8S":389,"7D-8H-8S":389,"7H-8D-8H":389,"7H-8D-8S":389,"7S-8D-8H":389,"7S-8D-8S":389,"7H-8H-8S":389,"7S-8H-8S":389,"6C-8C-8D":390,"6C-8C-8H":390,"6C-8C-8S":390,"6C-8D-8H":390,"6C-8D-8S":390,"6C-8H-8S":390,"6D-8C-8D":390,"6D-8C-8H":390,"6D-8C-8S":390,"6H-8C-8D":390,"6S-8C-8D":390,"6H-8C-8H":390,"6H-8C-8S":390,"6S-8C-8H":390,"6S-8C-8S":390,"6D-8D-8H":390,"6D-8D-8S":390,"6D-8H-8S":390,"6H-8D-8H":390,"6H-8D-8S":390,"6S-8D-8H":390,"6S-8D-8S":390,"6H-8H-8S":390,"6S-8H-8S":390,"5C-8C-8D":391,"5C-8C-8H":391,"5C-8C-8S":391,"5C-8D-8H":391,"5C-8D-8S":391,"5C-8H-8S":391,"5D-8C-8D":391,"5D-8C-8H":391,"5D-8C-8S":391,"5H-8C-8D":391,"5S-8C-8D":391,"5H-8C-8H":391,"5H-8C-8S":391,"5S-8C-8H":391,"5S-8C-8S":391,"5D-8D-8H":391,"5D-8D-8S":391,"5D-8H-8S":391,"5H-8D-8H":391,"5H-8D-8S":391,"5S-8D-8H":391,"5S-8D-8S":391,"5H-8H-8S":391,"5S-8H-8S":391,"4C-8C-8D":392,"4C-8C-8H":392,"4C-8C-8S":392,"4C-8D-8H":392,"4C-8D-8S":392,"4C-8H-8S":392,"4D-8C-8D":392,"4D-8C-8H":392,"4D-8C-8S":392,"4H-8C-8D":392,"4S-8C-8D":392,"4H-8C-8H":392,"4H-8C-8S":392,"4S-8C-8H":392,"4S-8C-8S":392,"4D-8D-8H":392,"4D-8D-8S":392,"4D-8H-8S":392,"4H-8D-8H":392,"4H-8D-8S":392,"4S-8D-8H":392,"4S-8D-8S":392,"4H-8H-8S":392,"4S-8H-8S":392,"3C-8C-8D":393,"3C-8C-8H":393,"3C-8C-8S":393,"3C-8D-8H":393,"3C-8D-8S":393,"3C-8H-8S":393,"3D-8C-8D":393,"3D-8C-8H":393,"3D-8C-8S":393,"3H-8C-8D":393,"3S-8C-8D":393,"3H-8C-8H":393,"3H-8C-8S":393,"3S-8C-8H":393,"3S-8C-8S":393,"3D-8D-8H":393,"3D-8D-8S":393,"3D-8H-8S":393,"3H-8D-8H":393,"3H-8D-8S":393,"3S-8D-8H":393,"3S-8D-8S":393,"3H-8H-8S":393,"3S-8H-8S":393,"2C-8C-8D":394,"2C-8C-8H":394,"2C-8C-8S":394,"2C-8D-8H":394,"2C-8D-8S":394,"2C-8H-8S":394,"2D-8C-8D":394,"2D-8C-8H":394,"2D-8C-8S":394,"2H-8C-8D":394,"2S-8C-8D":394,"2H-8C-8H":394,"2H-8C-8S":394,"2S-8C-8H":394,"2S-8C-8S":394,"2D-8D-8H":394,"2D-8D-8S":394,"2D-8H-8S":394,"2H-8D-8H":394,"2H-8D-8S":394,"2S-8D-8H":394,"2S-8D-8S":394,"2H-8H-8S":394,"2S-8H-8S":394,"7C-7D-AC":395,"7C-7H-AC":395,"7C-7S-AC":395,"7C-7D-AD":395,"7C-7D-AH":395,"7C-7D-AS":395,"7C-7H-AD":395,"7C-7S-AD":395,"7C-7H-AH":395,"7C-7H-AS":395,"7C-7S-AH":395,"7C-7S-AS":395,"7D-7H-AC":395,"7D-7S-AC":395,"7H-7S-AC":395,"7D-7H-AD":395,"7D-7S-AD":395,"7D-7H-AH":395,"7D-7H-AS":395,"7D-7S-AH":395,"7D-7S-AS":395,"7H-7S-AD":395,"7H-7S-AH":395,"7H-7S-AS":395,"7C-7D-KC":396,"7C-7H-KC":396,"7C-7S-KC":396,"7C-7D-KD":396,"7C-7D-KH":396,"7C-7D-KS":396,"7C-7H-KD":396,"7C-7S-KD":396,"7C-7H-KH":396,"7C-7H-KS":396,"7C-7S-KH":396,"7C-7S-KS":396,"7D-7H-KC":396,"7D-7S-KC":396,"7H-7S-KC":396,"7D-7H-KD":396,"7D-7S-KD":396,"7D-7H-KH":396,"7D-7H-KS":396,"7D-7S-KH":396,"7D-7S-KS":396,"7H-7S-KD":396,"7H-7S-KH":396,"7H-7S-KS":396,"7C-7D-QC":397,"7C-7H-QC":397,"7C-7S-QC":397,"7C-7D-QD":397,"7C-7D-QH":397,"7C-7D-QS":397,"7C-7H-QD":397,"7C-7S-QD":397,"7C-7H-QH":397,"7C-7H-QS":397,"7C-7S-QH":397,"7C-7S-QS":397,"7D-7H-QC":397,"7D-7S-QC":397,"7H-7S-QC":397,"7D-7H-QD":397,"7D-7S-QD":397,"7D-7H-QH":397,"7D-7H-QS":397,"7D-7S-QH":397,"7D-7S-QS":397,"7H-7S-QD":397,"7H-7S-QH":397,"7H-7S-QS":397,"7C-7D-JC":398,"7C-7H-JC":398,"7C-7S-JC":398,"7C-7D-JD":398,"7C-7D-JH":398,"7C-7D-JS":398,"7C-7H-JD":398,"7C-7S-JD":398,"7C-7H-JH":398,"7C-7H-JS":398,"7C-7S-JH":398,"7C-7S-JS":398,"7D-7H-JC":398,"7D-7S-JC":398,"7H-7S-JC":398,"7D-7H-JD":398,"7D-7S-JD":398,"7D-7H-JH":398,"7D-7H-JS":398,"7D-7S-JH":398,"7D-7S-JS":398,"7H-7S-JD":398,"7H-7S-JH":398,"7H-7S-JS":398,"10C-7C-7D":399,"10C-7C-7H":399,"10C-7C-7S":399,"10D-7C-7D":399,"10H-7C-7D":399,"10S-7C-7D":399,"10D-7C-7H":399,"10D-7C-7S":399,"10H-7C-7H":399,"10S-7C-7H":399,"10H-7C-7S":399,"10S-7C-7S":399,"10C-7D-7H":399,"10C-7D-7S":399,"10C-7H-7S":399,"10D-7D-7H":399,"10D-7D-7S":399,"10H-7D-7H":399,"10S-7D-7H":399,"10H-7D-7S":399,"10S-7D-7S":399,"10D-7H-7S":399,"10H-7H-7S":399,"10S-7H-7S":399,"7C-7D-9C":400,"7C-7H-9C":400,"7C-7S-9C":400,"7C-7D-9D":400,"7C-7D-9H":400,"7C-7D-9S":400,"7C-7H-9D":400,"7C-7S-9D":400,"7C-7H-9H":400,"7C-7H-9S":400,"7C-7S-9H":400,"7C-7S-9S":400,"7D-7H-9C":400,"7D-7S-9C":400,"7H-7S-9C":400,"7D-7H-9D":400,"7D-7S-9D":400,"7D-7H-9H":400,"7D-7H-9S":400,"7D-7S-9H":400,"7D-7S-9S":400,"7H-7S-9D":400,"7H-7S-9H":400,"7H-7S-9S":400,"7C-7D-8C":401,"7C-7H-8C":401,"7C-7S-8C":401,"7C-7D-8D":401,"7C-7D-8H":401,"7C-7D-8S":401,"7C-7H-8D":401,"7C-7S-8D":401,"7C-7H-8H":401,"7C-7H-8S":401,"7C-7S-8H":401,"7C-7S-8S":401,"7D-7H-8C":401,"7D-7S-8C":401,"7H-7S-8C":401,"7D-7H-8D":401,"7D-7S-8D":401,"7D-7H-8H":401,"7D-7H-8S":401,"7D-7S-8H":401,"7D-7S-8S":401,"7H-7S-8D":401,"7H-7S-8H":401,"7H-7S-8S":401,"6C-7C-7D":402,"6C-7C-7H":402,"6C-7C-7S":402,"6C-7D-7H":402,"6C-7D-7S":402,"6C-7H-7S":402,"6D-7C-7D":402,"6D-7C-7H":402,"6D-7C-7S":402,"6H-7C-7D":402,"6S-7C-7D":402,"6H-7C-7H":402,"6H-7C-7S":402,"6S-7C-7H":402,"6S-7C-7S":402,"6D-7D-7H":402,"6D-7D-7S":402,"6D-7H-7S":402,"6H-7D-7H":402,"6H-7D-7S":402,"6S-7D-7H":402,"6S-7D-7S":402,"6H-7H-7S":402,"6S-7H-7S":402,"5C-7C-7D":403,"5C-7C-7H":403,"5C-7C-7S":403,"5C-7D-7H":403,"5C-7D-7S":403,"5C-7H-7S":403,"5D-7C-7D":403,"5D-7C-7H":403,"5D-7C-7S":403,"5H-7C-7D":403,"5S-7C-7D":403,"5H-7C-7H":403,"5H-7C-7S":403,"5S-7C-7H":403,"5S-7C-7S":403,"5D-7D-7H":403,"5D-7D-7S":403,"5D-7H-7S":403,"5H-7D-7H":403,"5H-7D-7S":403,"5S-7D-7H":403,"5S-7D-7S":403,"5H-7H-7S":403,"5S-7H-7S":403,"4C-7C-7D":404,"4C-7C-7H":404,"4C-7C-7S":404,"4C-7D-7H":404,"4C-7D-7S":404,"4C-7H-7S":404,"4D-7C-7D":404,"4D-7C-7H":404,"4D-7C-7S":404,"4H-7C-7D":404,"4S-7C-7D":404,"4H-7C-7H":404,"4H-7C-7S":404,"4S-7C-7H":404,"4S-7C-7S":404,"4D-7D-7H":404,"4D-7D-7S":404,"4D-7H-7S":404,"4H-7D-7H":404,"4H-7D-7S":404,"4S-7D-7H":404,"4S-7D-7S":404,"4H-7H-7S":404,"4S-7H-7S":404,"3C-7C-7D":405,"3C-7C-7H":405,"3C-7C-7S":405,"3C-7D-7H":405,"3C-7D-7S":405,"3C-7H-7S":405,"3D-7C-7D":405,"3D-7C-7H":405,"3D-7C-7S":405,"3H-7C-7D":405,"3S-7C-7D":405,"3H-7C-7H":405,"3H-7C-7S":405,"3S-7C-7H":405,"3S-7C-7S":405,"3D-7D-7H":405,"3D-7D-7S":405,"3D-7H-7S":405,"3H-7D-7H":405,"3H-7D-7S":405,"3S-7D-7H":405,"3S-7D-7S":405,"3H-7H-7S":405,"3S-7H-7S":405,"2C-7C-7D":406,"2C-7C-7H":406,"2C-7C-7S":406,"2C-7D-7H":406,"2C-7D-7S":406,"2C-7H-7S":406,"2D-7C-7D":406,"2D-7C-7H":406,"2D-7C-7S":406,"2H-7C-7D":406,"2S-7C-7D":406,"2H-7C-7H":406,"2H-7C-7S":406,"2S-7C-7H":406,"2S-7C-7S":406,"2D-7D-7H":406,"2D-7D-7S":406,"2D-7H-7S":406,"2H-7D-7H":406,"2H-7D-7S":406,"2S-7D-7H":406,"2S-7D-7S":406,"2H-7H-7S":406,"2S-7H-7S":406,"6C-6D-AC":407,"6C-6H-AC":407,"6C-6S-AC":407,"6C-6D-AD":407,"6C-6D-AH":407,"6C-6D-AS":407,"6C-6H-AD":407,"6C-6S-AD":407,"6C-6H-AH":407,"6C-6H-AS":407,"6C-6S-AH":407,"6C-6S-AS":407,"6D-6H-AC":407,"6D-6S-AC":407,"6H-6S-AC":407,"6D-6H-AD":407,"6D-6S-AD":407,"6D-6H-AH":407,"6D-6H-AS":407,"6D-6S-AH":407,"6D-6S-AS":407,"6H-6S-AD":407,"6H-6S-AH":407,"6H-6S-AS":407,"6C-6D-KC":408,"6C-6H-KC":408,"6C-6S-KC":408,"6C-6D-KD":408,"6C-6D-KH":408,"6C-6D-KS":408,"6C-6H-KD":408,"6C-6S-KD":408,"6C-6H-KH":408,"6C-6H-KS":408,"6C-6S-KH":408,"6C-6S-KS":408,"6D-6H-KC":408,"6D-6S-KC":408,"6H-6S-KC":408,"6D-6H-KD":408,"6D-6S-KD":408,"6D-6H-KH":408,"6D-6H-KS":408,"6D-6S-KH":408,"6D-6S-KS":408,"6H-6S-KD":408,"6H-6S-KH":408,"6H-6S-KS":408,"6C-6D-QC":409,"6C-6H-QC":409,"6C-6S-QC":409,"6C-6D-QD":409,"6C-6D-QH":409,"6C-6D-QS":409,"6C-6H-QD":409,"6C-6S-QD":409,"6C-6H-QH":409,"6C-6H-QS":409,"6C-6S-QH":409,"6C-6S-QS":409,"6D-6H-QC":409,"6D-6S-QC":409,"6H-6S-QC":409,"6D-6H-QD":409,"6D-6S-QD":409,"6D-6H-QH":409,"6D-6H-QS":409,"6D-6S-QH":409,"6D-6S-QS":409,"6H-6S-QD":409,"6H-6S-QH":409,"6H-6S-QS":409,"6C-6D-JC":410,"6C-6H-JC":410,"6C-6S-JC":410,"6C-6D-JD":410,"6C-6D-JH":410,"6C-6D-JS":410,"6C-6H-JD":410,"6C-6S-JD":410,"6C-6H-JH":410,"6C-6H-JS":410,"6C-6S-JH":410,"6C-6S-JS":410,"6D-6H-JC":410,"6D-6S-JC":410,"6H-6S-JC":410,"6D-6H-JD":410,"6D-6S-JD":410,"6D-6H-JH":410,"6D-6H-JS":410,"6D-6S-JH":410,"6D-6S-JS":410,"6H-6S-JD":410,"6H-6S-JH":410,"6H-6S-JS":410,"10C-6C-6D":411,"10C-6C-6H":411,"10C-6C-6S":411,"10D-6C-6D":411,"10H-6C-6D":411,"10S-6C-6D":411,"10D-6C-6H":411,"10D-6C-6S":411,"10H-6C-6H":411,"10S-6C-6H":411,"10H-6C-6S":411,"10S-6C-6S":411,"10C-6D-6H":411,"10C-6D-6S":411,"10C-6H-6S":411,"10D-6D-6H":411,"10D-6D-6S":411,"10H-6D-6H":411,"10S-6D-6H":411,"10H-6D-6S":411,"10S-6D-6S":411,"10D-6H-6S":411,"10H-6H-6S":411,"10S-6H-6S":411,"6C-6D-9C":412,"6C-6H-9C":412,"6C-6S-9C":412,"6C-6D-9D":412,"6C-6D-9H":412,"6C-6D-9S":412,"6C-6H-9D":412,"6C-6S-9D":412,"6C-6H-9H":412,"6C-6H-9S":412,"6C-6S-9H":412,"6C-6S-9S":412,"6D-6H-9C":412,"6D-6S-9C":412,"6H-6S-9C":412,"6D-6H-9D":412,"6D-6S-9D":412,"6D-6H-9H":412,"6D-6H-9S":412,"6D-6S-9H":412,"6D-6S-9S":412,"6H-6S-9D":412,"6H-6S-9H":412,"6H-6S-9S":412,"6C-6D-8C":413,"6C-6H-8C":413,"6C-6S-8C":413,"6C-6D-8D":413,"6C-6D-8H":413,"6C-6D-8S":413,"6C-6H-8D":413,"6C-6S-8D":413,"6C-6H-8H":413,"6C-6H-8S":413,"6C-6S-8H":413,"6C-6S-8S":413,"6D-6H-8C":413,"6D-6S-8C":413,"6H-6S-8C":413,"6D-6H-8D":413,"6D-6S-8D":413,"6D-6H-8H":413,"6D-6H-8S":413,"6D-6S-8H":413,"6D-6S-8S":413,"6H-6S-8D":413,"6H-6S-8H":413,"6H-6S-8S":413,"6C-6D-7C":414,"6C-6H-7C":414,"6C-6S-7C":414,"6C-6D-7D":414,"6C-6D-7H":414,"6C-6D-7S":414,"6C-6H-7D":414,"6C-6S-7D":414,"6C-6H-7H":414,"6C-6H-7S":414,"6C-6S-7H":414,"6C-6S-7S":414,"6D-6H-7C":414,"6D-6S-7C":414,"6H-6S-7C":414,"6D-6H-7D":414,"6D-6S-7D":414,"6D-6H-7H":414,"6D-6H-7S":414,"6D-6S-7H":414,"6D-6S-7S":414,"6H-6S-7D":414,"6H-6S-7H":414,"6H-6S-7S":414,"5C-6C-6D":415,"5C-6C-6H":415,"5C-6C-6S":415,"5C-6D-6H":415,"5C-6D-6S":415,"5C-6H-6S":415,"5D-6C-6D":415,"5D-6C-6H":415,"5D-6C-6S":415,"5H-6C-6D":415,"5S-6C-6D":415,"5H-6C-6H":415,"5H-6C-6S":415,"5S-6C-6H":415,"5S-6C-6S":415,"5D-6D-6H":415,"5D-6D-6S":415,"5D-6H-6S":415,"5H-6D-6H":415,"5H-6D-6S":415,"5S-6D-6H":415,"5S-6D-6S":415,"5H-6H-6S":415,"5S-6H-6S":415,"4C-6C-6D":416,"4C-6C-6H":416,"4C-6C-6S":416,"4C-6D-6H":416,"4C-6D-6S":416,"4C-6H-6S":416,"4D-6C-6D":416,"4D-6C-6H":416,"4D-6C-6S":416,"4H-6C-6D":416,"4S-6C-6D":416,"4H-6C-6H":416,"4H-6C-6S":416,"4S-6C-6H":416,"4S-6C-6S":416,"4D-6D-6H":416,"4D-6D-6S":416,"4D-6H-6S":416,"4H-6D-6H":416,"4H-6D-6S":416,"4S-6D-6H":416,"4S-6D-6S":416,"4H-6H-6S":416,"4S-6H-6S":416,"3C-6C-6D":417,"3C-6C-6H":417,"3C-6C-6S":417,"3C-6D-6H":417,"3C-6D-6S":417,"3C-6H-6S":417,"3D-6C-6D":417,"3D-6C-6H":417,"3D-6C-6S":417,"3H-6C-6D":417,"3S-6C-6D":417,"3H-6C-6H":417,"3H-6C-6S":417,"3S-6C-6H":417,"3S-6C-6S":417,"3D-6D-6H":417,"3D-6D-6S":417,"3D-6H-6S":417,"3H-6D-6H":417,"3H-6D-6S":417,"3S-6D-6H":417,"3S-6D-6S":417,"3H-6H-6S":417,"3S-6H-6S":417,"2C-6C-6D":418,"2C-6C-6H":418,"2C-6C-6S":418,"2C-6D-6H":418,"2C-6D-6S":418,"2C-6H-6S":418,"2D-6C-6D":418,"2D-6C-6H":418,"2D-6C-6S":418,"2H-6C-6D":418,"2S-6C-6D":418,"2H-6C-6H":418,"2H-6C-6S":418,"2S-6C-6H":418,"2S-6C-6S":418,"2D-6D-6H":418,"2D-6D-6S":418,"2D-6H-6S":418,"2H-6D-6H":418,"2H-6D-6S":418,"2S-6D-6H":418,"2S-6D-6S":418,"2H-6H-6S":418,"2S-6H-6S":418,"5C-5D-AC":419,"5C-5H-AC":419,"5C-5S-AC":419,"5C-5D-AD":419,"5C-5D-AH":419,"5C-5D-AS":419,"5C-5H-AD":419,"5C-5S-AD":419,"5C-5H-AH":419,"5C-5H-AS":419,"5C-5S-AH":419,"5C-5S-AS":419,"5D-5H-AC":419,"5D-5S-AC":419,"5H-5S-AC":419,"5D-5H-AD":419,"5D-5S-AD":419,"5D-5H-AH":419,"5D-5H-AS":419,"5D-5S-AH":419,"5D-5S-AS":419,"5H-5S-AD":419,"5H-5S-AH":419,"5H-5S-AS":419,"5C-5D-KC":420,"5C-5H-KC":420,"5C-5S-KC":420,"5C-5D-KD":420,"5C-5D-KH":420,"5C-5D-KS":420,"5C-5H-KD":420,"5C-5S-KD":420,"5C-5H-KH":420,"5C-5H-KS":420,"5C-5S-KH":420,"5C-5S-KS":420,"5D-5H-KC":420,"5D-5S-KC":420,"5H-5S-KC":420,"5D-5H-KD":420,"5D-5S-KD":420,"5D-5H-KH":420,"5D-5H-KS":420,"5D-5S-KH":420,"5D-5S-KS":420,"5H-5S-KD":420,"5H-5S-KH":420,"5H-5S-KS":420,"5C-5D-QC":421,"5C-5H-QC":421,"5C-5S-QC":421,"5C-5D-QD":421,"5C-5D-QH":421,"5C-5D-QS":421,"5C-5H-QD":421,"5C-5S-QD":421,"5C-5H-QH":421,"5C-5H-QS":421,"5C-5S-QH":421,"5C-5S-QS":421,"5D-5H-QC":421,"5D-5S-QC":421,"5H-5S-QC":421,"5D-5H-QD":421,"5D-5S-QD":421,"5D-5H-QH":421,"5D-5H-QS":421,"5D-5S-QH":421,"5D-5S-QS":421,"5H-5S-QD":421,"5H-5S-QH":421,"5H-5S-QS":421,"5C-5D-JC":422,"5C-5H-JC":422,"5C-5S-JC":422,"5C-5D-JD":422,"5C-5D-JH":422,"5C-5D-JS":422,"5C-5H-JD":422,"5C-5S-JD":422,"5C-5H-JH":422,"5C-5H-JS":422,"5C-5S-JH":422,"5C-5S-JS":422,"5D-5H-JC":422,"5D-5S-JC":422,"5H-5S-JC":422,"5D-5H-JD":422,"5D-5S-JD":422,"5D-5H-JH":422,"5D-5H-JS":422,"5D-5S-JH":422,"5D-5S-JS":422,"5H-5S-JD":422,"5H-5S-JH":422,"5H-5S-JS":422,"10C-5C-5D":423,"10C-5C-5H":423,"10C-5C-5S":423,"10D-5C-5D":423,"10H-5C-5D":423,"10S-5C-5D":423,"10D-5C-5H":423,"10D-5C-5S":423,"10H-5C-5H":423,"10S-5C-5H":423,"10H-5C-5S":423,"10S-5C-5S":423,"10C-5D-5H":423,"10C-5D-5S":423,"10C-5H-5S":423,"10D-5D-5H":423,"10D-5D-5S":423,"10H-5D-5H":423,"10S-5D-5H":423,"10H-5D-5S":423,"10S-5D-5S":423,"10D-5H-5S":423,"10H-5H-5S":423,"10S-5H-5S":423,"5C-5D-9C":424,"5C-5H-9C":424,"5C-5S-9C":424,"5C-5D-9D":424,"5C-5D-9H":424,"5C-5D-9S":424,"5C-5H-9D":424,"5C-5S-9D":424,"5C-5H-9H":424,"5C-5H-9S":424,"5C-5S-9H":424,"5C-5S-9S":424,"5D-5H-9C":424,"5D-5S-9C":424,"5H-5S-9C":424,"5D-5H-9D":424,"5D-5S-9D":424,"5D-5H-9H":424,"5D-5H-9S":424,"5D-5S-9H":424,"5D-5S-9S":424,"5H-5S-9D":424,"5H-5S-9H":424,"5H-5S-9S":424,"5C-5D-8C":425,"5C-5H-8C":425,"5C-5S-8C":425,"5C-5D-8D":425,"5C-5D-8H":425,"5C-5D-8S":425,"5C-5H-8D":425,"5C-5S-8D":425,"5C-5H-8H":425,"5C-5H-8S":425,"5C-5S-8H":425,"5C-5S-8S":425,"5D-5H-8C":425,"5D-5S-8C":425,"5H-5S-8C":425,"5D-5H-8D":425,"5D-5S-8D":425,"5D-5H-8H":425,"5D-5H-8S":425,"5D-5S-8H":425,"5D-5S-8S":425,"5H-5S-8D":425,"5H-5S-8H":425,"5H-5S-8S":425,"5C-5D-7C":426,"5C-5H-7C":426,"5C-5S-7C":426,"5C-5D-7D":426,"5C-5D-7H":426,"5C-5D-7S":426,"5C-5H-7D":426,"5C-5S-7D":426,"5C-5H-7H":426,"5C-5H-7S":426,"5C-5S-7H":426,"5C-5S-7S":426,"5D-5H-7C":426,"5D-5S-7C":426,"5H-5S-7C":426,"5D-5H-7D":426,"5D-5S-7D":426,"5D-5H-7H":426,"5D-5H-7S":426,"5D-5S-7H":426,"5D-5S-7S":426,"5H-5S-7D":426,"5H-5S-7H":426,"5H-5S-7S":426,"5C-5D-6C":427,"5C-5H-6C":427,"5C-5S-6C":427,"5C-5D-6D":427,"5C-5D-6H":427,"5C-5D-6S":427,"5C-5H-6D":427,"5C-5S-6D":427,"5C-5H-6H":427,"5C-5H-6S":427,"5C-5S-6H":427,"5C-5S-6S":427,"5D-5H-6C":427,"5D-5S-6C":427,"5H-5S-6C":427,"5D-5H-6D":427,"5D-5S-6D":427,"5D-5H-6H":427,"5D-5H-6S":427,"5D-5S-6H":427,"5D-5S-6S":427,"5H-5S-6D":427,"5H-5S-6H":427,"5H-5S-6S":427,"4C-5C-5D":428,"4C-5C-5H":428,"4C-5C-5S":428,"4C-5D-5H":428,"4C-5D-5S":428,"4C-5H-5S":428,"4D-5C-5D":428,"4D-5C-5H":428,"4D-5C-5S":428,"4H-5C-5D":428,"4S-5C-5D":428,"4H-5C-5H":428,"4H-5C-5S":428,"4S-5C-5H":428,"4S-5C-5S":428,"4D-5D-5H":428,"4D-5D-5S":428,"4D-5H-5S":428,"4H-5D-5H":428,"4H-5D-5S":428,"4S-5D-5H":428,"4S-5D-5S":428,"4H-5H-5S":428,"4S-5H-5S":428,"3C-5C-5D":429,"3C-5C-5H":429,"3C-5C-5S":429,"3C-5D-5H":429,"3C-5D-5S":429,"3C-5H-5S":429,"3D-5C-5D":429,"3D-5C-5H":429,"3D-5C-5S":429,"3H-5C-5D":429,"3S-5C-5D":429,"3H-5C-5H":429,"3H-5C-5S":429,"3S-5C-5H":429,"3S-5C-5S":429,"3D-5D-5H":429,"3D-5D-5S":429,"3D-5H-5S":429,"3H-5D-5H":429,"3H-5D-5S":429,"3S-5D-5H":429,"3S-5D-5S":429,"3H-5H-5S":429,"3S-5H-5S":429,"2C-5C-5D":430,"2C-5C-5H":430,"2C-5C-5S":430,"2C-5D-5H":430,"2C-5D-5S":430,"2C-5H-5S":430,"2D-5C-5D":430,"2D-5C-5H":430,"2D-5C-5S":430,"2H-5C-5D":430,"2S-5C-5D":430,"2H-5C-5H":430,"2H-5C-5S":430,"2S-5C-5H":430,"2S-5C-5S":430,"2D-5D-5H":430,"2D-5D-5S":430,"2D-5H-5S":430,"2H-5D-5H":430,"2H-5D-5S":430,"2S-5D-5H":430,"2S-5D-5S":430,"2H-5H-5S":430,"2S-5H-5S":430,"4C-4D-AC":431,"4C-4H-AC":431,"4C-4S-AC":431,"4C-4D-AD":431,"4C-4D-AH":431,"4C-4D-AS":431,"4C-4H-AD":431,"4C-4S-AD":431,"4C-4H-AH":431,"4C-4H-AS":431,"4C-4S-AH":431,"4C-4S-AS":431,"4D-4H-AC":431,"4D-4S-AC":431,"4H-4S-AC":431,"4D-4H-AD":431,"4D-4S-AD":431,"4D-4H-AH":431,"4D-4H-AS":431,"4D-4S-AH":431,"4D-4S-AS":431,"4H-4S-AD":431,"4H-4S-AH":431,"4H-4S-AS":431,"4C-4D-KC":432,"4C-4H-KC":432,"4C-4S-KC":432,"4C-4D-KD":432,"4C-4D-KH":432,"4C-4D-KS":432,"4C-4H-KD":432,"4C-4S-KD":432,"4C-4H-KH":432,"4C-4H-KS":432,"4C-4S-KH":432,"4C-4S-KS":432,"4D-4H-KC":432,"4D-4S-KC":432,"4H-4S-KC":432,"4D-4H-KD":432,"4D-4S-KD":432,"4D-4H-KH":432,"4D-4H-KS":432,"4D-4S-KH":432,"4D-4S-KS":432,"4H-4S-KD":432,"4H-4S-KH":432,"4H-4S-KS":432,"4C-4D-QC":433,"4C-4H-QC":433,"4C-4S-QC":433,"4C-4D-QD":433,"4C-4D-QH":433,"4C-4D-QS":433,"4C-4H-QD":433,"4C-4S-QD":433,"4C-4H-QH":433,"4C-4H-QS":433,"4C-4S-QH":433,"4C-4S-QS":433,"4D-4H-QC":433,"4D-4S-QC":433,"4H-4S-QC":433,"4D-4H-QD":433,"4D-4S-QD":433,"4D-4H-QH":433,"4D-4H-QS":433,"4D-4S-QH":433,"4D-4S-QS":433,"4H-4S-QD":433,"4H-4S-QH":433,"4H-4S-QS":433,"4C-4D-JC":434,"4C-4H-JC":434,"4C-4S-JC":434,"4C-4D-JD":434,"4C-4D-JH":434,"4C-4D-JS":434,"4C-4H-JD":434,"4C-4S-JD":434,"4C-4H-JH":434,"4C-4H-JS":434,"4C-4S-JH":434,"4C-4S-JS":434,"4D-4H-JC":434,"4D-4S-JC":434,"4H-4S-JC":434,"4D-4H-JD":434,"4D-4S-JD":434,"4D-4H-JH":434,"4D-4H-JS":434,"4D-4S-JH":434,"4D-4S-JS":434,"4H-4S-JD":434,"4H-4S-JH":434,"4H-4S-JS":434,"10C-4C-4D":435,"10C-4C-4H":435,"10C-4C-4S":435,"10D-4C-4D":435,"10H-4C-4D":435,"10S-4C-4D":435,"10D-4C-4H":435,"10D-4C-4S":435,"10H-4C-4H":435,"10S-4C-4H":435,"10H-4C-4S":435,"10S-4C-4S":435,"10C-4D-4H":435,"10C-4D-4S":435,"10C-4H-4S":435,"10D-4D-4H":435,"10D-4D-4S":435,"10H-4D-4H":435,"10S-4D-4H":435,"10H-4D-4S":435,"10S-4D-4S":435,"10D-4H-4S":435,"10H-4H-4S":435,"10S-4H-4S":435,"4C-4D-9C":436,"4C-4H-9C":436,"4C-4S-9C":436,"4C-4D-9D":436,"4C-4D-9H":436,"4C-4D-9S":436,"4C-4H-9D":436,"4C-4S-9D":436,"4C-4H-9H":436,"4C-4H-9S":436,"4C-4S-9H":436,"4C-4S-9S":436,"4D-4H-9C":436,"4D-4S-9C":436,"4H-4S-9C":436,"4D-4H-9D":436,"4D-4S-9D":436,"4D-4H-9H":436,"4D-4H-9S":436,"4D-4S-9H":436,"4D-4S-9S":436,"4H-4S-9D":436,"4H-4S-9H":436,"4H-4S-9S":436,"4C-4D-8C":437,"4C-4H-8C":437,"4C-4S-8C":437,"4C-4D-8D":437,"4C-4D-8H":437,"4C-4D-8S":437,"4C-4H-8D":437,"4C-4S-8D":437,"4C-4H-8H":437,"4C-4H-8S":437,"4C-4S-8H":437,"4C-4S-8S":437,"4D-4H-8C":437,"4D-4S-8C":437,"4H-4S-8C":437,"4D-4H-8D":437,"4D-4S-8D":437,"4D-4H-8H":437,"4D-4H-8S":437,"4D-4S-8H":437,"4D-4S-8S":437,"4H-4S-8D":437,"4H-4S-8H":437,"4H-4S-8S":437,"4C-4D-7C":438,"4C-4H-7C":438,"4C-4S-7C":438,"4C-4D-7D":438,"4C-4D-7H":438,"4C-4D-7S":438,"4C-4H-7D":438,"4C-4S-7D":438,"4C-4H-7H":438,"4C-4H-7S":438,"4C-4S-7H":438,"4C-4S-7S":438,"4D-4H-7C":438,"4D-4S-7C":438,"4H-4S-7C":438,"4D-4H-7D":438,"4D-4S-7D":438,"4D-4H-7H":438,"4D-4H-7S":438,"4D-4S-7H":438,"4D-4S-7S":438,"4H-4S-7D":438,"4H-4S-7H":438,"4H-4S-7S":438,"4C-4D-6C":439,"4C-4H-6C":439,"4C-4S-6C":439,"4C-4D-6D":439,"4C-4D-6H":439,"4C-4D-6S":439,"4C-4H-6D":439,"4C-4S-6D":439,"4C-4H-6H":439,"4C-4H-6S":439,"4C-4S-6H":439,"4C-4S-6S":439,"4D-4H-6C":439,"4D-4S-6C":439,"4H-4S-6C":439,"4D-4H-6D":439,"4D-4S-6D":439,"4D-4H-6H":439,"4D-4H-6S":439,"4D-4S-6H":439,"4D-4S-6S":439,"4H-4S-6D":439,"4H-4S-6H":439,"4H-4S-6S":439,"4C-4D-5C":440,"4C-4H-5C":440,"4C-4S-5C":440,"4C-4D-5D":440,"4C-4D-5H":440,"4C-4D-5S":440,"4C-4H-5D":440,"4C-4S-5D":440,"4C-4H-5H":440,"4C-4H-5S":440,"4C-4S-5H":440,"4C-4S-5S":440,"4D-4H-5C":440,"4D-4S-5C":440,"4H-4S-5C":440,"4D-4H-5D":440,"4D-4S-5D":440,"4D-4H-5H":440,"4D-4H-5S":440,"4D-4S-5H":440,"4D-4S-5S":440,"4H-4S-5D":440,"4H-4S-5H":440,"4H-4S-5S":440,"3C-4C-4D":441,"3C-4C-4H":441,"3C-4C-4S":441,"3C-4D-4H":441,"3C-4D-4S":441,"3C-4H-4S":441,"3D-4C-4D":441,"3D-4C-4H":441,"3D-4C-4S":441,"3H-4C-4D":441,"3S-4C-4D":441,"3H-4C-4H":441,"3H-4C-4S":441,"3S-4C-4H":441,"3S-4C-4S":441,"3D-4D-4H":441,"3D-4D-4S":441,"3D-4H-4S":441,"3H-4D-4H":441,"3H-4D-4S":441,"3S-4D-4H":441,"3S-4D-4S":441,"3H-4H-4S":441,"3S-4H-4S":441,"2C-4C-4D":442,"2C-4C-4H":442,"2C-4C-4S":442,"2C-4D-4H":442,"2C-4D-4S":442,"2C-4H-4S":442,"2D-4C-4D":442,"2D-4C-4H":442,"2D-4C-4S":442,"2H-4C-4D":442,"2S-4C-4D":442,"2H-4C-4H":442,"2H-4C-4S":442,"2S-4C-4H":442,"2S-4C-4S":442,"2D-4D-4H":442,"2D-4D-4S":442,"2D-4H-4S":442,"2H-4D-4H":442,"2H-4D-4S":442,"2S-4D-4H":442,"2S-4D-4S":442,"2H-4H-4S":442,"2S-4H-4S":442,"3C-3D-AC":443,"3C-3H-AC":443,"3C-3S-AC":443,"3C-3D-AD":443,"3C-3D-AH":443,"3C-3D-AS":443,"3C-3H-AD":443,"3C-3S-AD":443,"3C-3H-AH":443,"3C-3H-AS":443,"3C-3S-AH":443,"3C-3S-AS":443,"3D-3H-AC":443,"3D-3S-AC":443,"3H-3S-AC":443,"3D-3H-AD":443,"3D-3S-AD":443,"3D-3H-AH":443,"3D-3H-AS":443,"3D-3S-AH":443,"3D-3S-AS":443,"3H-3S-AD":443,"3H-3S-AH":443,"3H-3S-AS":443,"3C-3D-KC":444,"3C-3H-KC":444,"3C-3S-KC":444,"3C-3D-KD":444,"3C-3D-KH":444,"3C-3D-KS":444,"3C-3H-KD":444,"3C-3S-KD":444,"3C-3H-KH":444,"3C-3H-KS":444,"3C-3S-KH":444,"3C-3S-KS":444,"3D-3H-KC":444,"3D-3S-KC":444,"3H-3S-KC":444,"3D-3H-KD":444,"3D-3S-KD":444,"3D-3H-KH":444,"3D-3H-KS":444,"3D-3S-KH":444,"3D-3S-KS":444,"3H-3S-KD":444,"3H-3S-KH":444,"3H-3S-KS":444,"3C-3D-QC":445,"3C-3H-QC":445,"3C-3S-QC":445,"3C-3D-QD":445,"3C-3D-QH":445,"3C-3D-QS":445,"3C-3H-QD":445,"3C-3S-QD":445,"3C-3H-QH":445,"3C-3H-QS":445,"3C-3S-QH":445,"3C-3S-QS":445,"3D-3H-QC":445,"3D-3S-QC":445,"3H-3S-QC":445,"3D-3H-QD":445,"3D-3S-QD":445,"3D-3H-QH":445,"3D-3H-QS":445,"3D-3S-QH":445,"3D-3S-QS":445,"3H-3S-QD":445,"3H-3S-QH":445,"3H-3S-QS":445,"3C-3D-JC":446,"3C-3H-JC":446,"3C-3S-JC":446,"3C-3D-JD":446,"3C-3D-JH":446,"3C-3D-JS":446,"3C-3H-JD":446,"3C-3S-JD":446,"3C-3H-JH":446,"3C-3H-JS":446,"3C-3S-JH":446,"3C-3S-JS":446,"3D-3H-JC":446,"3D-3S-JC":446,"3H-3S-JC":446,"3D-3H-JD":446,"3D-3S-JD":446,"3D-3H-JH":446,"3D-3H-JS":446,"3D-3S-JH":446,"3D-3S-JS":446,"3H-3S-JD":446,"3H-3S-JH":446,"3H-3S-JS":446,"10C-3C-3D":447,"10C-3C-3H":447,"10C-3C-3S":447,"10D-3C-3D":447,"10H-3C-3D":447,"10S-3C-3D":447,"10D-3C-3H":447,"10D-3C-3S":447,"10H-3C-3H":447,"10S-3C-3H":447,"10H-3C-3S":447,"10S-3C-3S":447,"10C-3D-3H":447,"10C-3D-3S":447,"10C-3H-3S":447,"10D-3D-3H":447,"10D-3D-3S":447,"10H-3D-3H":447,"10S-3D-3H":447,"10H-3D-3S":447,"10S-3D-3S":447,"10D-3H-3S":447,"10H-3H-3S":447,"10S-3H-3S":447,"3C-3D-9C":448,"3C-3H-9C":448,"3C-3S-9C":448,"3C-3D-9D":448,"3C-3D-9H":448,"3C-3D-9S":448,"3C-3H-9D":448,"3C-3S-9D":448,"3C-3H-9H":448,"3C-3H-9S":448,"3C-3S-9H":448,"3C-3S-9S":448,"3D-3H-9C":448,"3D-3S-9C":448,"3H-3S-9C":448,"3D-3H-9D":448,"3D-3S-9D":448,"3D-3H-9H":448,"3D-3H-9S":448,"3D-3S-9H":448,"3D-3S-9S":448,"3H-3S-9D":448,"3H-3S-9H":448,"3H-3S-9S":448,"3C-3D-8C":449,"3C-3H-8C":449,"3C-3S-8C":449,"3C-3D-8D":449,"3C-3D-8H":449,"3C-3D-8S":449,"3C-3H-8D":449,"3C-3S-8D":449,"3C-3H-8H":449,"3C-3H-8S":449,"3C-3S-8H":449,"3C-3S-8S":449,"3D-3H-8C":449,"3D-3S-8C":449,"3H-3S-8C":449,"3D-3H-8D":449,"3D-3S-8D":449,"3D-3H-8H":449,"3D-3H-8S":449,"3D-3S-8H":449,"3D-3S-8S":449,"3H-3S-8D":449,"3H-3S-8H":449,"3H-3S-8S":449,"3C-3D-7C":450,"3C-3H-7C":450,"3C-3S-7C":450,"3C-3D-7D":450,"3C-3D-7H":450,"3C-3D-7S":450,"3C-3H-7D":450,"3C-3S-7D":450,"3C-3H-7H":450,"3C-3H-7S":450,"3C-3S-7H":450,"3C-3S-7S":450,"3D-3H-7C":450,"3D-3S-7C":450,"3H-3S-7C":450,"3D-3H-7D":450,"3D-3S-7D":450,"3D-3H-7H":450,"3D-3H-7S":450,"3D-3S-7H":450,"3D-3S-7S":450,"3H-3S-7D":450,"3H-3S-7H":450,"3H-3S-7S":450,"3C-3D-6C":451,"3C-3H-6C":451,"3C-3S-6C":451,"3C-3D-6D":451,"3C-3D-6H":451,"3C-3D-6S":451,"3C-3H-6D":451,"3C-3S-6D":451,"3C-3H-6H":451,"3C-3H-6S":451,"3C-3S-6H":451,"3C-3S-6S":451,"3D-3H-6C":451,"3D-3S-6C":451,"3H-3S-6C":451,"3D-3H-6D":451,"3D-3S-6D":451,"3D-3H-6H":451,"3D-3H-6S":451,"3D-3S-6H":451,"3D-3S-6S":451,"3H-3S-6D":451,"3H-3S-6H":451,"3H-3S-6S":451,"3C-3D-5C":452,"3C-3H-5C":452,"3C-3S-5C":452,"3C-3D-5D":452,"3C-3D-5H":452,"3C-3D-5S":452,"3C-3H-5D":452,"3C-3S-5D":452,"3C-3H-5H":452,"3C-3H-5S":452,"3C-3S-5H":452,"3C-3S-5S":452,"3D-3H-5C":452,"3D-3S-5C":452,"3H-3S-5C":452,"3D-3H-5D":452,"3D-3S-5D":452,"3D-3H-5H":452,"3D-3H-5S":452,"3D-3S-5H":452,"3D-3S-5S":452,"3H-3S-5D":452,"3H-3S-5H":452,"3H-3S-5S":452,"3C-3D-4C":453,"3C-3H-4C":453,"3C-3S-4C":453,"3C-3D-4D":453,"3C-3D-4H":453,"3C-3D-4S":453,"3C-3H-4D":453,"3C-3S-4D":453,"3C-3H-4H":453,"3C-3H-4S":453,"3C-3S-4H":453,"3C-3S-4S":453,"3D-3H-4C":453,"3D-3S-4C":453,"3H-3S-4C":453,"3D-3H-4D":453,"3D-3S-4D":453,"3D-3H-4H":453,"3D-3H-4S":453,"3D-3S-4H":453,"3D-3S-4S":453,"3H-3S-4D":453,"3H-3S-4H":453,"3H-3S-4S":453,"2C-3C-3D":454,"2C-3C-3H":454,"2C-3C-3S":454,"2C-3D-3H":454,"2C-3D-3S":454,"2C-3H-3S":454,"2D-3C-3D":454,"2D-3C-3H":454,"2D-3C-3S":454,"2H-3C-3D":454,"2S-3C-3D":454,"2H-3C-3H":454,"2H-3C-3S":454,"2S-3C-3H":454,"2S-3C-3S":454,"2D-3D-3H":454,"2D-3D-3S":454,"2D-3H-3S":454,"2H-3D-3H":454,"2H-3D-3S":454,"2S-3D-3H":454,"2S-3D-3S":454,"2H-3H-3S":454,"2S-3H-3S":454,"2C-2D-AC":455,"2C-2H-AC":455,"2C-2S-AC":455,"2C-2D-AD":455,"2C-2D-AH":455,"2C-2D-AS":455,"2C-2H-AD":455,"2C-2S-AD":455,"2C-2H-AH":455,"2C-2H-AS":455,"2C-2S-AH":455,"2C-2S-AS":455,"2D-2H-AC":455,"2D-2S-AC":455,"2H-2S-AC":455,"2D-2H-AD":455,"2D-2S-AD":455,"2D-2H-AH":455,"2D-2H-AS":455,"2D-2S-AH":455,"2D-2S-AS":455,"2H-2S-AD":455,"2H-2S-AH":455,"2H-2S-AS":455,"2C-2D-KC":456,"2C-2H-KC":456,"2C-2S-KC":456,"2C-2D-KD":456,"2C-2D-KH":456,"2C-2D-KS":456,"2C-2H-KD":456,"2C-2S-KD":456,"2C-2H-KH":456,"2C-2H-KS":456,"2C-2S-KH":456,"2C-2S-KS":456,"2D-2H-KC":456,"2D-2S-KC":456,"2H-2S-KC":456,"2D-2H-KD":456,"2D-2S-KD":456,"2D-2H-KH":456,"2D-2H-KS":456,"2D-2S-KH":456,"2D-2S-KS":456,"2H-2S-KD":456,"2H-2S-KH":456,"2H-2S-KS":456,"2C-2D-QC":457,"2C-2H-QC":457,"2C-2S-QC":457,"2C-2D-QD":457,"2C-2D-QH":457,"2C-2D-QS":457,"2C-2H-QD":457,"2C-2S-QD":457,"2C-2H-QH":457,"2C-2H-QS":457,"2C-2S-QH":457,"2C-2S-QS":457,"2D-2H-QC":457,"2D-2S-QC":457,"2H-2S-QC":457,"2D-2H-QD":457,"2D-2S-QD":457,"2D-2H-QH":457,"2D-2H-QS":457,"2D-2S-QH":457,"2D-2S-QS":457,"2H-2S-QD":457,"2H-2S-QH":457,"2H-2S-QS":457,"2C-2D-JC":458,"2C-2H-JC":458,"2C-2S-JC":458,"2C-2D-JD":458,"2C-2D-JH":458,"2C-2D-JS":458,"2C-2H-JD":458,"2C-2S-JD":458,"2C-2H-JH":458,"2C-2H-JS":458,"2C-2S-JH":458,"2C-2S-JS":458,"2D-2H-JC":458,"2D-2S-JC":458,"2H-2S-JC":458,"2D-2H-JD":458,"2D-2S-JD":458,"2D-2H-JH":458,"2D-2H-JS":458,"2D-2S-JH":458,"2D-2S-JS":458,"2H-2S-JD":458,"2H-2S-JH":458,"2H-2S-JS":458,"10C-2C-2D":459,"10C-2C-2H":459,"10C-2C-2S":459,"10D-2C-2D":459,"10H-2C-2D":459,"10S-2C-2D":459,"10D-2C-2H":459,"10D-2C-2S":459,"10H-2C-2H":459,"10S-2C-2H":459,"10H-2C-2S":459,"10S-2C-2S":459,"10C-2D-2H":459,"10C-2D-2S":459,"10C-2H-2S":459,"10D-2D-2H":459,"10D-2D-2S":459,"10H-2D-2H":459,"10S-2D-2H":459,"10H-2D-2S":459,"10S-2D-2S":459,"10D-2H-2S":459,"10H-2H-2S":459,"10S-2H-2S":459,"2C-2D-9C":460,"2C-2H-9C":460,"2C-2S-9C":460,"2C-2D-9D":460,"2C-2D-9H":460,"2C-2D-9S":460,"2C-2H-9D":460,"2C-2S-9D":460,"2C-2H-9H":460,"2C-2H-9S":460,"2C-2S-9H":460,"2C-2S-9S":460,"2D-2H-9C":460,"2D-2S-9C":460,"2H-2S-9C":460,"2D-2H-9D":460,"2D-2S-9D":460,"2D-2H-9H":460,"2D-2H-9S":460,"2D-2S-9H":460,"2D-2S-9S":460,"2H-2S-9D":460,"2H-2S-9H":460,"2H-2S-9S":460,"2C-2D-8C":461,"2C-2H-8C":461,"2C-2S-8C":461,"2C-2D-8D":461,"2C-2D-8H":461,"2C-2D-8S":461,"2C-2H-8D":461,"2C-2S-8D":461,"2C-2H-8H":461,"2C-2H-8S":461,"2C-2S-8H":461,"2C-2S-8S":461,"2D-2H-8C":461,"2D-2S-8C":461,"2H-2S-8C":461,"2D-2H-8D":461,"2D-2S-8D":461,"2D-2H-8H":461,"2D-2H-8S":461,"2D-2S-8H":461,"2D-2S-8S":461,"2H-2S-8D":461,"2H-2S-8H":461,"2H-2S-8S":461,"2C-2D-7C":462,"2C-2H-7C":462,"2C-2S-7C":462,"2C-2D-7D":462,"2C-2D-7H":462,"2C-2D-7S":462,"2C-2H-7D":462,"2C-2S-7D":462,"2C-2H-7H":462,"2C-2H-7S":462,"2C-2S-7H":462,"2C-2S-7S":462,"2D-2H-7C":462,"2D-2S-7C":462,"2H-2S-7C":462,"2D-2H-7D":462,"2D-2S-7D":462,"2D-2H-7H":462,"2D-2H-7S":462,"2D-2S-7H":462,"2D-2S-7S":462,"2H-2S-7D":462,"2H-2S-7H":462,"2H-2S-7S":462,"2C-2D-6C":463,"2C-2H-6C":463,"2C-2S-6C":463,"2C-2D-6D":463,"2C-2D-6H":463,"2C-2D-6S":463,"2C-2H-6D":463,"2C-2S-6D":463,"2C-2H-6H":463,"2C-2H-6S":463,"2C-2S-6H":463,"2C-2S-6S":463,"2D-2H-6C":463,"2D-2S-6C":463,"2H-2S-6C":463,"2D-2H-6D":463,"2D-2S-6D":463,"2D-2H-6H":463,"2D-2H-6S":463,"2D-2S-6H":463,"2D-2S-6S":463,"2H-2S-6D":463,"2H-2S-6H":463,"2H-2S-6S":463,"2C-2D-5C":464,"2C-2H-5C":464,"2C-2S-5C":464,"2C-2D-5D":464,"2C-2D-5H":464,"2C-2D-5S":464,"2C-2H-5D":464,"2C-2S-5D":464,"2C-2H-5H":464,"2C-2H-5S":464,"2C-2S-5H":464,"2C-2S-5S":464,"2D-2H-5C":464,"2D-2S-5C":464,"2H-2S-5C":464,"2D-2H-5D":464,"2D-2S-5D":464,"2D-2H-5H":464,"2D-2H-5S":464,"2D-2S-5H":464,"2D-2S-5S":464,"2H-2S-5D":464,"2H-2S-5H":464,"2H-2S-5S":464,"2C-2D-4C":465,"2C-2H-4C":465,"2C-2S-4C":465,"2C-2D-4D":465,"2C-2D-4H":465,"2C-2D-4S":465,"2C-2H-4D":465,"2C-2S-4D":465,"2C-2H-4H":465,"2C-2H-4S":465,"2C-2S-4H":465,"2C-2S-4S":465,"2D-2H-4C":465,"2D-2S-4C":465,"2H-2S-4C":465,"2D-2H-4D":465,"2D-2S-4D":465,"2D-2H-4H":465,"2D-2H-4S":465,"2D-2S-4H":465,"2D-2S-4S":465,"2H-2S-4D":465,"2H-2S-4H":465,"2H-2S-4S":465,"2C-2D-3C":466,"2C-2H-3C":466,"2C-2S-3C":466,"2C-2D-3D":466,"2C-2D-3H":466,"2C-2D-3S":466,"2C-2H-3D":466,"2C-2S-3D":466,"2C-2H-3H":466,"2C-2H-3S":466,"2C-2S-3H":466,"2C-2S-3S":466,"2D-2H-3C":466,"2D-2S-3C":466,"2H-2S-3C":466,"2D-2H-3D":466,"2D-2S-3D":466,"2D-2H-3H":466,"2D-2H-3S":466,"2D-2S-3H":466,"2D-2S-3S":466,"2H-2S-3D":466,"2H-2S-3H":466,"2H-2S-3S":466,"AD-JC-KC":467,"AH-JC-KC":467,"AS-JC-KC":467,"AC-JC-KD":467,"AC-JC-KH":467,"AC-JC-KS":467,"AD-JC-KD":467,"AH-JC-KD":467,"AS-JC-KD":467,"AD-JC-KH":467,"AD-JC-KS":467,"AH-JC-KH":467,"AS-JC-KH":467,"AH-JC-KS":467,"AS-JC-KS":467,"AC-JD-KC":467,"AC-JH-KC":467,"AC-JS-KC":467,"AD-JD-KC":467,"AH-JD-KC":467,"AS-JD-KC":467,"AD-JH-KC":467,"AD-JS-KC":467,"AH-JH-KC":467,"AS-JH-KC":467,"AH-JS-KC":467,"AS-JS-KC":467,"AC-JD-KD":467,"AC-JD-KH":467,"AC-JD-KS":467,"AC-JH-KD":467,"AC-JS-KD":467,"AC-JH-KH":467,"AC-JH-KS":467,"AC-JS-KH":467,"AC-JS-KS":467,"AH-JD-KD":467,"AS-JD-KD":467,"AD-JD-KH":467,"AD-JD-KS":467,"AH-JD-KH":467,"AS-JD-KH":467,"AH-JD-KS":467,"AS-JD-KS":467,"AD-JH-KD":467,"AD-JS-KD":467,"AH-JH-KD":467,"AS-JH-KD":467,"AH-JS-KD":467,"AS-JS-KD":467,"AD-JH-KH":467,"AD-JH-KS":467,"AD-JS-KH":467,"AD-JS-KS":467,"AS-JH-KH":467,"AH-JH-KS":467,"AS-JH-KS":467,"AH-JS-KH":467,"AS-JS-KH":467,"AH-JS-KS":467,"10C-AD-KC":468,"10C-AH-KC":468,"10C-AS-KC":468,"10C-AC-KD":468,"10C-AC-KH":468,"10C-AC-KS":468,"10C-AD-KD":468,"10C-AH-KD":468,"10C-AS-KD":468,"10C-AD-KH":468,"10C-AD-KS":468,"10C-AH-KH":468,"10C-AS-KH":468,"10C-AH-KS":468,"10C-AS-KS":468,"10D-AC-KC":468,"10H-AC-KC":468,"10S-AC-KC":468,"10D-AD-KC":468,"10D-AH-KC":468,"10D-AS-KC":468,"10H-AD-KC":468,"10S-AD-KC":468,"10H-AH-KC":468,"10H-AS-KC":468,"10S-AH-KC":468,"10S-AS-KC":468,"10D-AC-KD":468,"10D-AC-KH":468,"10D-AC-KS":468,"10H-AC-KD":468,"10S-AC-KD":468,"10H-AC-KH":468,"10H-AC-KS":468,"10S-AC-KH":468,"10S-AC-KS":468,"10D-AH-KD":468,"10D-AS-KD":468,"10D-AD-KH":468,"10D-AD-KS":468,"10D-AH-KH":468,"10D-AS-KH":468,"10D-AH-KS":468,"10D-AS-KS":468,"10H-AD-KD":468,"10S-AD-KD":468,"10H-AH-KD":468,"10H-AS-KD":468,"10S-AH-KD":468,"10S-AS-KD":468,"10H-AD-KH":468,"10H-AD-KS":468,"10S-AD-KH":468,"10S-AD-KS":468,"10H-AS-KH":468,"10H-AH-KS":468,"10H-AS-KS":468,"10S-AH-KH":468,"10S-AS-KH":468,"10S-AH-KS":468,"9C-AD-KC":469,"9C-AH-KC":469,"9C-AS-KC":469,"9C-AC-KD":469,"9C-AC-KH":469,"9C-AC-KS":469,"9C-AD-KD":469,"9C-AH-KD":469,"9C-AS-KD":469,"9C-AD-KH":469,"9C-AD-KS":469,"9C-AH-KH":469,"9C-AS-KH":469,"9C-AH-KS":469,"9C-AS-KS":469,"9D-AC-KC":469,"9H-AC-KC":469,"9S-AC-KC":469,"9D-AD-KC":469,"9D-AH-KC":469,"9D-AS-KC":469,"9H-AD-KC":469,"9S-AD-KC":469,"9H-AH-KC":469,"9H-AS-KC":469,"9S-AH-KC":469,"9S-AS-KC":469,"9D-AC-KD":469,"9D-AC-KH":469,"9D-AC-KS":469,"9H-AC-KD":469,"9S-AC-KD":469,"9H-AC-KH":469,"9H-AC-KS":469,"9S-AC-KH":469,"9S-AC-KS":469,"9D-AH-KD":469,"9D-AS-KD":469,"9D-AD-KH":469,"9D-AD-KS":469,"9D-AH-KH":469,"9D-AS-KH":469,"9D-AH-KS":469,"9D-AS-KS":469,"9H-AD-KD":469,"9S-AD-KD":469,"9H-AH-KD":469,"9H-AS-KD":469,"9S-AH-KD":469,"9S-AS-KD":469,"9H-AD-KH":469,"9H-AD-KS":469,"9S-AD-KH":469,"9S-AD-KS":469,"9H-AS-KH":469,"9H-AH-KS":469,"9H-AS-KS":469,"9S-AH-KH":469,"9S-AS-KH":469,"9S-AH-KS":469,"8C-AD-KC":470,"8C-AH-KC":470,"8C-AS-KC":470,"8C-AC-KD":470,"8C-AC-KH":470,"8C-AC-KS":470,"8C-AD-KD":470,"8C-AH-KD":470,"8C-AS-KD":470,"8C-AD-KH":470,"8C-AD-KS":470,"8C-AH-KH":470,"8C-AS-KH":470,"8C-AH-KS":470,"8C-AS-KS":470,"8D-AC-KC":470,"8H-AC-KC":470,"8S-AC-KC":470,"8D-AD-KC":470,"8D-AH-KC":470,"8D-AS-KC":470,"8H-AD-KC":470,"8S-AD-KC":470,"8H-AH-KC":470,"8H-AS-KC":470,"8S-AH-KC":470,"8S-AS-KC":470,"8D-AC-KD":470,"8D-AC-KH":470,"8D-AC-KS":470,"8H-AC-KD":470,"8S-AC-KD":470,"8H-AC-KH":470,"8H-AC-KS":470,"8S-AC-KH":470,"8S-AC-KS":470,"8D-AH-KD":470,"8D-AS-KD":470,"8D-AD-KH":470,"8D-AD-KS":470,"8D-AH-KH":470,"8D-AS-KH":470,"8D-AH-KS":470,"8D-AS-KS":470,"8H-AD-KD":470,"8S-AD-KD":470,"8H-AH-KD":470,"8H-AS-KD":470,"8S-AH-KD":470,"8S-AS-KD":470,"8H-AD-KH":470,"8H-AD-KS":470,"8S-AD-KH":470,"8S-AD-KS":470,"8H-AS-KH":470,"8H-AH-KS":470,"8H-AS-KS":470,"8S-AH-KH":470,"8S-AS-KH":470,"8S-AH-KS":470,"7C-AD-KC":471,"7C-AH-KC":471,"7C-AS-KC":471,"7C-AC-KD":471,"7C-AC-KH":471,"7C-AC-KS":471,"7C-AD-KD":471,"7C-AH-KD":471,"7C-AS-KD":471,"7C-AD-KH":471,"7C-AD-KS":471,"7C-AH-KH":471,"7C-AS-KH":471,"7C-AH-KS":471,"7C-AS-KS":471,"7D-AC-KC":471,"7H-AC-KC":471,"7S-AC-KC":471,"7D-AD-KC":471,"7D-AH-KC":471,"7D-AS-KC":471,"7H-AD-KC":471,"7S-AD-KC":471,"7H-AH-KC":471,"7H-AS-KC":471,"7S-AH-KC":471,"7S-AS-KC":471,"7D-AC-KD":471,"7D-AC-KH":471,"7D-AC-KS":471,"7H-AC-KD":471,"7S-AC-KD":471,"7H-AC-KH":471,"7H-AC-KS":471,"7S-AC-KH":471,"7S-AC-KS":471,"7D-AH-KD":471,"7D-AS-KD":471,"7D-AD-KH":471,"7D-AD-KS":471,"7D-AH-KH":471,"7D-AS-KH":471,"7D-AH-KS":471,"7D-AS-KS":471,"7H-AD-KD":471,"7S-AD-KD":471,"7H-AH-KD":471,"7H-AS-KD":471,"7S-AH-KD":471,"7S-AS-KD":471,"7H-AD-KH":471,"7H-AD-KS":471,"7S-AD-KH":471,"7S-AD-KS":471,"7H-AS-KH":471,"7H-AH-KS":471,"7H-AS-KS":471,"7S-AH-KH":471,"7S-AS-KH":471,"7S-AH-KS":471,"6C-AD-KC":472,"6C-AH-KC":472,"6C-AS-KC":472,"6C-AC-KD":472,"6C-AC-KH":472,"6C-AC-KS":472,"6C-AD-KD":472,"6C-AH-KD":472,"6C-AS-KD":472,"6C-AD-KH":472,"6C-AD-KS":472,"6C-AH-KH":472,"6C-AS-KH":472,"6C-AH-KS":472,"6C-AS-KS":472,"6D-AC-KC":472,"6H-AC-KC":472,"6S-AC-KC":472,"6D-AD-KC":472,"6D-AH-KC":472,"6D-AS-KC":472,"6H-AD-KC":472,"6S-AD-KC":472,"6H-AH-KC":472,"6H-AS-KC":472,"6S-AH-KC":472,"6S-AS-KC":472,"6D-AC-KD":472,"6D-AC-KH":472,"6D-AC-KS":472,"6H-AC-KD":472,"6S-AC-KD":472,"6H-AC-KH":472,"6H-AC-KS":472,"6S-AC-KH":472,"6S-AC-KS":472,"6D-AH-KD":472,"6D-AS-KD":472,"6D-AD-KH":472,"6D-AD-KS":472,"6D-AH-KH":472,"6D-AS-KH":472,"6D-AH-KS":472,"6D-AS-KS":472,"6H-AD-KD":472,"6S-AD-KD":472,"6H-AH-KD":472,"6H-AS-KD":472,"6S-AH-KD":472,"6S-AS-KD":472,"6H-AD-KH":472,"6H-AD-KS":472,"6S-AD-KH":472,"6S-AD-KS":472,"6H-AS-KH":472,"6H-AH-KS":472,"6H-AS-KS":472,"6S-AH-KH":472,"6S-AS-KH":472,"6S-AH-KS":472,"5C-AD-KC":473,"5C-AH-KC":473,"5C-AS-KC":473,"5C-AC-KD":473,"5C-AC-KH":473,"5C-AC-KS":473,"5C-AD-KD":473,"5C-AH-KD":473,"5C-AS-KD":473,"5C-AD-KH":473,"5C-AD-KS":473,"5C-AH-KH":473,"5C-AS-KH":473,"5C-AH-KS":473,"5C-AS-KS":473,"5D-AC-KC":473,"5H-AC-KC":473,"5S-AC-KC":473,"5D-AD-KC":473,"5D-AH-KC":473,"5D-AS-KC":473,"5H-AD-KC":473,"5S-AD-KC":473,"5H-AH-KC":473,"5H-AS-KC":473,"5S-AH-KC":473,"5S-AS-KC":473,"5D-AC-KD":473,"5D-AC-KH":473,"5D-AC-KS":473,"5H-AC-KD":473,"5S-AC-KD":473,"5H-AC-KH":473,"5H-AC-KS":473,"5S-AC-KH":473,"5S-AC-KS":473,"5D-AH-KD":473,"5D-AS-KD":473,"5D-AD-KH":473,"5D-AD-KS":473,"5D-AH-KH":473,"5D-AS-KH":473,"5D-AH-KS":473,"5D-AS-KS":473,"5H-AD-KD":473,"5S-AD-KD":473,"5H-AH-KD":473,"5H-AS-KD":473,"5S-AH-KD":473,"5S-AS-KD":473,"5H-AD-KH":473,"5H-AD-KS":473,"5S-AD-KH":473,"5S-AD-KS":473,"5H-AS-KH":473,"5H-AH-KS":473,"5H-AS-KS":473,"5S-AH-KH":473,"5S-AS-KH":473,"5S-AH-KS":473,"4C-AD-KC":474,"4C-AH-KC":474,"4C-AS-KC":474,"4C-AC-KD":474,"4C-AC-KH":474,"4C-AC-KS":474,"4C-AD-KD":474,"4C-AH-KD":474,"4C-AS-KD":474,"4C-AD-KH":474,"4C-AD-KS":474,"4C-AH-KH":474,"4C-AS-KH":474,"4C-AH-KS":474,"4C-AS-KS":474,"4D-AC-KC":474,"4H-AC-KC":474,"4S-AC-KC":474,"4D-AD-KC":474,"4D-AH-KC":474,"4D-AS-KC":474,"4H-AD-KC":474,"4S-AD-KC":474,"4H-AH-KC":474,"4H-AS-KC":474,"4S-AH-KC":474,"4S-AS-KC":474,"4D-AC-KD":474,"4D-AC-KH":474,"4D-AC-KS":474,"4H-AC-KD":474,"4S-AC-KD":474,"4H-AC-KH":474,"4H-AC-KS":474,"4S-AC-KH":474,"4S-AC-KS":474,"4D-AH-KD":474,"4D-AS-KD":474,"4D-AD-KH":474,"4D-AD-KS":474,"4D-AH-KH":474,"4D-AS-KH":474,"4D-AH-KS":474,"4D-AS-KS":474,"4H-AD-KD":474,"4S-AD-KD":474,"4H-AH-KD":474,"4H-AS-KD":474,"4S-AH-KD":474,"4S-AS-KD":474,"4H-AD-KH":474,"4H-AD-KS":474,"4S-AD-KH":474,"4S-AD-KS":474,"4H-AS-KH":474,"4H-AH-KS":474,"4H-AS-KS":474,"4S-AH-KH":474,"4S-AS-KH":474,"4S-AH-KS":474,"3C-AD-KC":475,"3C-AH-KC":475,"3C-AS-KC":475,"3C-AC-KD":475,"3C-AC-KH":475,"3C-AC-KS":475,"3C-AD-KD":475,"3C-AH-KD":475,"3C-AS-KD":475,"3C-AD-KH":475,"3C-AD-KS":475,"3C-AH-KH":475,"3C-AS-KH":475,"3C-AH-KS":475,"3C-AS-KS":475,"3D-AC-KC":475,"3H-AC-KC":475,"3S-AC-KC":475,"3D-AD-KC":475,"3D-AH-KC":475,"3D-AS-KC":475,"3H-AD-KC":475,"3S-AD-KC":475,"3H-AH-KC":475,"3H-AS-KC":475,"3S-AH-KC":475,"3S-AS-KC":475,"3D-AC-KD":475,"3D-AC-KH":475,"3D-AC-KS":475,"3H-AC-KD":475,"3S-AC-KD":475,"3H-AC-KH":475,"3H-AC-KS":475,"3S-AC-KH":475,"3S-AC-KS":475,"3D-AH-KD":475,"3D-AS-KD":475,"3D-AD-KH":475,"3D-AD-KS":475,"3D-AH-KH":475,"3D-AS-KH":475,"3D-AH-KS":475,"3D-AS-KS":475,"3H-AD-KD":475,"3S-AD-KD":475,"3H-AH-KD":475,"3H-AS-KD":475,"3S-AH-KD":475,"3S-AS-KD":475,"3H-AD-KH":475,"3H-AD-KS":475,"3S-AD-KH":475,"3S-AD-KS":475,"3H-AS-KH":475,"3H-AH-KS":475,"3H-AS-KS":475,"3S-AH-KH":475,"3S-AS-KH":475,"3S-AH-KS":475,"2C-AD-KC":476,"2C-AH-KC":476,"2C-AS-KC":476,"2C-AC-KD":476,"2C-AC-KH":476,"2C-AC-KS":476,"2C-AD-KD":476,"2C-AH-KD":476,"2C-AS-KD":476,"2C-AD-KH":476,"2C-AD-KS":476,"2C-AH-KH":476,"2C-AS-KH":476,"2C-AH-KS":476,"2C-AS-KS":476,"2D-AC-KC":476,"2H-AC-KC":476,"2S-AC-KC":476,"2D-AD-KC":476,"2D-AH-KC":476,"2D-AS-KC":476,"2H-AD-KC":476,"2S-AD-KC":476,"2H-AH-KC":476,"2H-AS-KC":476,"2S-AH-KC":476,"2S-AS-KC":476,"2D-AC-KD":476,"2D-AC-KH":476,"2D-AC-KS":476,"2H-AC-KD":476,"2S-AC-KD":476,"2H-AC-KH":476,"2H-AC-KS":476,"2S-AC-KH":476,"2S-AC-KS":476,"2D-AH-KD":476,"2D-AS-KD":476,"2D-AD-KH":476,"2D-AD-KS":476,"2D-AH-KH":476,"2D-AS-KH":476,"2D-AH-KS":476,"2D-AS-KS":476,"2H-AD-KD":476,"2S-AD-KD":476,"2H-AH-KD":476,"2H-AS-KD":476,"2S-AH-KD":476,"2S-AS-KD":476,"2H-AD-KH":476,"2H-AD-KS":476,"2S-AD-KH":476,"2S-AD-KS":476,"2H-AS-KH":476,"2H-AH-KS":476,"2H-AS-KS":476,"2S-AH-KH":476,"2S-AS-KH":476,"2S-AH-KS":476,"AD-JC-QC":477,"AH-JC-QC":477,"AS-JC-QC":477,"AC-JC-QD":477,"AC-JC-QH":477,"AC-JC-QS":477,"AD-JC-QD":477,"AH-JC-QD":477,"AS-JC-QD":477,"AD-JC-QH":477,"AD-JC-QS":477,"AH-JC-QH":477,"AS-JC-QH":477,"AH-JC-QS":477,"AS-JC-QS":477,"AC-JD-QC":477,"AC-JH-QC":477,"AC-JS-QC":477,"AD-JD-QC":477,"AH-JD-QC":477,"AS-JD-QC":477,"AD-JH-QC":477,"AD-JS-QC":477,"AH-JH-QC":477,"AS-JH-QC":477,"AH-JS-QC":477,"AS-JS-QC":477,"AC-JD-QD":477,"AC-JD-QH":477,"AC-JD-QS":477,"AC-JH-QD":477,"AC-JS-QD":477,"AC-JH-QH":477,"AC-JH-QS":477,"AC-JS-QH":477,"AC-JS-QS":477,"AH-JD-QD":477,"AS-JD-QD":477,"AD-JD-QH":477,"AD-JD-QS":477,"AH-JD-QH":477,"AS-JD-QH":477,"AH-JD-QS":477,"AS-JD-QS":477,"AD-JH-QD":477,"AD-JS-QD":477,"AH-JH-QD":477,"AS-JH-QD":477,"AH-JS-QD":477,"AS-JS-QD":477,"AD-JH-QH":477,"AD-JH-QS":477,"AD-JS-QH":477,"AD-JS-QS":477,"AS-JH-QH":477,"AH-JH-QS":477,"AS-JH-QS":477,"AH-JS-QH":477,"AS-JS-QH":477,"AH-JS-QS":477,"10C-AD-QC":478,"10C-AH-QC":478,"10C-AS-QC":478,"10C-AC-QD":478,"10C-AC-QH":478,"10C-AC-QS":478,"10C-AD-QD":478,"10C-AH-QD":478,"10C-AS-QD":478,"10C-AD-QH":478,"10C-AD-QS":478,"10C-AH-QH":478,"10C-AS-QH":478,"10C-AH-QS":478,"10C-AS-QS":478,"10D-AC-QC":478,"10H-AC-QC":478,"10S-AC-QC":478,"10D-AD-QC":478,"10D-AH-QC":478,"10D-AS-QC":478,"10H-AD-QC":478,"10S-AD-QC":478,"10H-AH-QC":478,"10H-AS-QC":478,"10S-AH-QC":478,"10S-AS-QC":478,"10D-AC-QD":478,"10D-AC-QH":478,"10D-AC-QS":478,"10H-AC-QD":478,"10S-AC-QD":478,"10H-AC-QH":478,"10H-AC-QS":478,"10S-AC-QH":478,"10S-AC-QS":478,"10D-AH-QD":478,"10D-AS-QD":478,"10D-AD-QH":478,"10D-AD-QS":478,"10D-AH-QH":478,"10D-AS-QH":478,"10D-AH-QS":478,"10D-AS-QS":478,"10H-AD-QD":478,"10S-AD-QD":478,"10H-AH-QD":478,"10H-AS-QD":478,"10S-AH-QD":478,"10S-AS-QD":478,"10H-AD-QH":478,"10H-AD-QS":478,"10S-AD-QH":478,"10S-AD-QS":478,"10H-AS-QH":478,"10H-AH-QS":478,"10H-AS-QS":478,"10S-AH-QH":478,"10S-AS-QH":478,"10S-AH-QS":478,"9C-AD-QC":479,"9C-AH-QC":479,"9C-AS-QC":479,"9C-AC-QD":479,"9C-AC-QH":479,"9C-AC-QS":479,"9C-AD-QD":479,"9C-AH-QD":479,"9C-AS-QD":479,"9C-AD-QH":479,"9C-AD-QS":479,"9C-AH-QH":479,"9C-AS-QH":479,"9C-AH-QS":479,"9C-AS-QS":479,"9D-AC-QC":479,"9H-AC-QC":479,"9S-AC-QC":479,"9D-AD-QC":479,"9D-AH-QC":479,"9D-AS-QC":479,"9H-AD-QC":479,"9S-AD-QC":479,"9H-AH-QC":479,"9H-AS-QC":479,"9S-AH-QC":479,"9S-AS-QC":479,"9D-AC-QD":479,"9D-AC-QH":479,"9D-AC-QS":479,"9H-AC-QD":479,"9S-AC-QD":479,"9H-AC-QH":479,"9H-AC-QS":479,"9S-AC-QH":479,"9S-AC-QS":479,"9D-AH-QD":479,"9D-AS-QD":479,"9D-AD-QH":479,"9D-AD-QS":479,"9D-AH-QH":479,"9D-AS-QH":479,"9D-AH-QS":479,"9D-AS-QS":479,"9H-AD-QD":479,"9S-AD-QD":479,"9H-AH-QD":479,"9H-AS-QD":479,"9S-AH-QD":479,"9S-AS-QD":479,"9H-AD-QH":479,"9H-AD-QS":479,"9S-AD-QH":479,"9S-AD-QS":479,"9H-AS-QH":479,"9H-AH-QS":479,"9H-AS-QS":479,"9S-AH-QH":479,"9S-AS-QH":479,"9S-AH-QS":479,"8C-AD-QC":480,"8C-AH-QC":480,"8C-AS-QC":480,"8C-AC-QD":480,"8C-AC-QH":480,"8C-AC-QS":480,"8C-AD-QD":480,"8C-AH-QD":480,"8C-AS-QD":480,"8C-AD-QH":480,"8C-AD-QS":480,"8C-AH-QH":480,"8C-AS-QH":480,"8C-AH-QS":480,"8C-AS-QS":480,"8D-AC-QC":480,"8H-AC-QC":480,"8S-AC-QC":480,"8D-AD-QC":480,"8D-AH-QC":480,"8D-AS-QC":480,"8H-AD-QC":480,"8S-AD-QC":480,"8H-AH-QC":480,"8H-AS-QC":480,"8S-AH-QC":480,"8S-AS-QC":480,"8D-AC-QD":480,"8D-AC-QH":480,"8D-AC-QS":480,"8H-AC-QD":480,"8S-AC-QD":480,"8H-AC-QH":480,"8H-AC-QS":480,"8S-AC-QH":480,"8S-AC-QS":480,"8D-AH-QD":480,"8D-AS-QD":480,"8D-AD-QH":480,"8D-AD-QS":480,"8D-AH-QH":480,"8D-AS-QH":480,"8D-AH-QS":480,"8D-AS-QS":480,"8H-AD-QD":480,"8S-AD-QD":480,"8H-AH-QD":480,"8H-AS-QD":480,"8S-AH-QD":480,"8S-AS-QD":480,"8H-AD-QH":480,"8H-AD-QS":480,"8S-AD-QH":480,"8S-AD-QS":480,"8H-AS-QH":480,"8H-AH-QS":480,"8H-AS-QS":480,"8S-AH-QH":480,"8S-AS-QH":480,"8S-AH-QS":480,"7C-AD-QC":481,"7C-AH-QC":481,"7C-AS-QC":481,"7C-AC-QD":481,"7C-AC-QH":481,"7C-AC-QS":481,"7C-AD-QD":481,"7C-AH-QD":481,"7C-AS-QD":481,"7C-AD-QH":481,"7C-AD-QS":481,"7C-AH-QH":481,"7C-AS-QH":481,"7C-AH-QS":481,"7C-AS-QS":481,"7D-AC-QC":481,"7H-AC-QC":481,"7S-AC-QC":481,"7D-AD-QC":481,"7D-AH-QC":481,"7D-AS-QC":481,"7H-AD-QC":481,"7S-AD-QC":481,"7H-AH-QC":481,"7H-AS-QC":481,"7S-AH-QC":481,"7S-AS-QC":481,"7D-AC-QD":481,"7D-AC-QH":481,"7D-AC-QS":481,"7H-AC-QD":481,"7S-AC-QD":481,"7H-AC-QH":481,"7H-AC-QS":481,"7S-AC-QH":481,"7S-AC-QS":481,"7D-AH-QD":481,"7D-AS-QD":481,"7D-AD-QH":481,"7D-AD-QS":481,"7D-AH-QH":481,"7D-AS-QH":481,"7D-AH-QS":481,"7D-AS-QS":481,"7H-AD-QD":481,"7S-AD-QD":481,"7H-AH-QD":481,"7H-AS-QD":481,"7S-AH-QD":481,"7S-AS-QD":481,"7H-AD-QH":481,"7H-AD-QS":481,"7S-AD-QH":481,"7S-AD-QS":481,"7H-AS-QH":481,"7H-AH-QS":481,"7H-AS-QS":481,"7S-AH-QH":481,"7S-AS-QH":481,"7S-AH-QS":481,"6C-AD-QC":482,"6C-AH-QC":482,"6C-AS-QC":482,"6C-AC-QD":482,"6C-AC-QH":482,"6C-AC-QS":482,"6C-AD-QD":482,"6C-AH-QD":482,"6C-AS-QD":482,"6C-AD-QH":482,"6C-AD-QS":482,"6C-AH-QH":482,"6C-AS-QH":482,"6C-AH-QS":482,"6C-AS-QS":482,"6D-AC-QC":482,"6H-AC-QC":482,"6S-AC-QC":482,"6D-AD-QC":482,"6D-AH-QC":482,"6D-AS-QC":482,"6H-AD-QC":482,"6S-AD-QC":482,"6H-AH-QC":482,"6H-AS-QC":482,"6S-AH-QC":482,"6S-AS-QC":482,"6D-AC-QD":482,"6D-AC-QH":482,"6D-AC-QS":482,"6H-AC-QD":482,"6S-AC-QD":482,"6H-AC-QH":482,"6H-AC-QS":482,"6S-AC-QH":482,"6S-AC-QS":482,"6D-AH-QD":482,"6D-AS-QD":482,"6D-AD-QH":482,"6D-AD-QS":482,"6D-AH-QH":482,"6D-AS-QH":482,"6D-AH-QS":482,"6D-AS-QS":482,"6H-AD-QD":482,"6S-AD-QD":482,"6H-AH-QD":482,"6H-AS-QD":482,"6S-AH-QD":482,"6S-AS-QD":482,"6H-AD-QH":482,"6H-AD-QS":482,"6S-AD-QH":482,"6S-AD-QS":482,"6H-AS-QH":482,"6H-AH-QS":482,"6H-AS-QS":482,"6S-AH-QH":482,"6S-AS-QH":482,"6S-AH-QS":482,"5C-AD-QC":483,"5C-AH-QC":483,"5C-AS-QC":483,"5C-AC-QD":483,"5C-AC-QH":483,"5C-AC-QS":483,"5C-AD-QD":483,"5C-AH-QD":483,"5C-AS-QD":483,"5C-AD-QH":483,"5C-AD-QS":483,"5C-AH-QH":483,"5C-AS-QH":483,"5C-AH-QS":483,"5C-AS-QS":483,"5D-AC-QC":483,"5H-AC-QC":483,"5S-AC-QC":483,"5D-AD-QC":483,"5D-AH-QC":483,"5D-AS-QC":483,"5H-AD-QC":483,"5S-AD-QC":483,"5H-AH-QC":483,"5H-AS-QC":483,"5S-AH-QC":483,"5S-AS-QC":483,"5D-AC-QD":483,"5D-AC-QH":483,"5D-AC-QS":483,"5H-AC-QD":483,"5S-AC-QD":483,"5H-AC-QH":483,"5H-AC-QS":483,"5S-AC-QH":483,"5S-AC-QS":483,"5D-AH-QD":483,"5D-AS-QD":483,"5D-AD-QH":483,"5D-AD-QS":483,"5D-AH-QH":483,"5D-AS-QH":483,"5D-AH-QS":483,"5D-AS-QS":483,"5H-AD-QD":483,"5S-AD-QD":483,"5H-AH-QD":483,"5H-AS-QD":483,"5S-AH-QD":483,"5S-AS-QD":483,"5H-AD-QH":483,"5H-AD-QS":483,"5S-AD-QH":483,"5S-AD-QS":483,"5H-AS-QH":483,"5H-AH-QS":483,"5H-AS-QS":483,"5S-AH-QH":483,"5S-AS-QH":483,"5S-AH-QS":483,"4C-AD-QC":484,"4C-AH-QC":484,"4C-AS-QC":484,"4C-AC-QD":484,"4C-AC-QH":484,"4C-AC-QS":484,"4C-AD-QD":484,"4C-AH-QD":484,"4C-AS-QD":484,"4C-AD-QH":484,"4C-AD-QS":484,"4C-AH-QH":484,"4C-AS-QH":484,"4C-AH-QS":484,"4C-AS-QS":484,"4D-AC-QC":484,"4H-AC-QC":484,"4S-AC-QC":484,"4D-AD-QC":484,"4D-AH-QC":484,"4D-AS-QC":484,"4H-AD-QC":484,"4S-AD-QC":484,"4H-AH-QC":484,"4H-AS-QC":484,"4S-AH-QC":484,"4S-AS-QC":484,"4D-AC-QD":484,"4D-AC-QH":484,"4D-AC-QS":484,"4H-AC-QD":484,"4S-AC-QD":484,"4H-AC-QH":484,"4H-AC-QS":484,"4S-AC-QH":484,"4S-AC-QS":484,"4D-AH-QD":484,"4D-AS-QD":484,"4D-AD-QH":484,"4D-AD-QS":484,"4D-AH-QH":484,"4D-AS-QH":484,"4D-AH-QS":484,"4D-AS-QS":484,"4H-AD-QD":484,"4S-AD-QD":484,"4H-AH-QD":484,"4H-AS-QD":484,"4S-AH-QD":484,"4S-AS-QD":484,"4H-AD-QH":484,"4H-AD-QS":484,"4S-AD-QH":484,"4S-AD-QS":484,"4H-AS-QH":484,"4H-AH-QS":484,"4H-AS-QS":484,"4S-AH-QH":484,"4S-AS-QH":484,"4S-AH-QS":484,"3C-AD-QC":485,"3C-AH-QC":485,"3C-AS-QC":485,"3C-AC-QD":485,"3C-AC-QH":485,"3C-AC-QS":485,"3C-AD-QD":485,"3C-AH-QD":485,"3C-AS-QD":485,"3C-AD-QH":485,"3C-AD-QS":485,"3C-AH-QH":485,"3C-AS-QH":485,"3C-AH-QS":485,"3C-AS-QS":485,"3D-AC-QC":485,"3H-AC-QC":485,"3S-AC-QC":485,"3D-AD-QC":485,"3D-AH-QC":485,"3D-AS-QC":485,"3H-AD-QC":485,"3S-AD-QC":485,"3H-AH-QC":485,"3H-AS-QC":485,"3S-AH-QC":485,"3S-AS-QC":485,"3D-AC-QD":485,"3D-AC-QH":485,"3D-AC-QS":485,"3H-AC-QD":485,"3S-AC-QD":485,"3H-AC-QH":485,"3H-AC-QS":485,"3S-AC-QH":485,"3S-AC-QS":485,"3D-AH-QD":485,"3D-AS-QD":485,"3D-AD-QH":485,"3D-AD-QS":485,"3D-AH-QH":485,"3D-AS-QH":485,"3D-AH-QS":485,"3D-AS-QS":485,"3H-AD-QD":485,"3S-AD-QD":485,"3H-AH-QD":485,"3H-AS-QD":485,"3S-AH-QD":485,"3S-AS-QD":485,"3H-AD-QH":485,"3H-AD-QS":485,"3S-AD-QH":485,"3S-AD-QS":485,"3H-AS-QH":485,"3H-AH-QS":485,"3H-AS-QS":485,"3S-AH-QH":485,"3S-AS-QH":485,"3S-AH-QS":485,"2C-AD-QC":486,"2C-AH-QC":486,"2C-AS-QC":486,"2C-AC-QD":486,"2C-AC-QH":486,"2C-AC-QS":486,"2C-AD-QD":486,"2C-AH-QD":486,"2C-AS-QD":486,"2C-AD-QH":486,"2C-AD-QS":486,"2C-AH-QH":486,"2C-AS-QH":486,"2C-AH-QS":486,"2C-AS-QS":486,"2D-AC-QC":486,"2H-AC-QC":486,"2S-AC-QC":486,"2D-AD-QC":486,"2D-AH-QC":486,"2D-AS-QC":486,"2H-AD-QC":486,"2S-AD-QC":486,"2H-AH-QC":486,"2H-AS-QC":486,"2S-AH-QC":486,"2S-AS-QC":486,"2D-AC-QD":486,"2D-AC-QH":486,"2D-AC-QS":486,"2H-AC-QD":486,"2S-AC-QD":486,"2H-AC-QH":486,"2H-AC-QS":486,"2S-AC-QH":486,"2S-AC-QS":486,"2D-AH-QD":486,"2D-AS-QD":486,"2D-AD-QH":486,"2D-AD-QS":486,"2D-AH-QH":486,"2D-AS-QH":486,"2D-AH-QS":486,"2D-AS-QS":486,"2H-AD-QD":486,"2S-AD-QD":486,"2H-AH-QD":486,"2H-AS-QD":486,"2S-AH-QD":486,"2S-AS-QD":486,"2H-AD-QH":486,"2H-AD-QS":486,"2S-AD-QH":486,"2S-AD-QS":486,"2H-AS-QH":486,"2H-AH-QS":486,"2H-AS-QS":486,"2S-AH-QH":486,"2S-AS-QH":486,"2S-AH-QS":486,"10C-AD-JC":487,"10C-AH-JC":487,"10C-AS-JC":487,"10C-AC-JD":487,"10C-AC-JH":487,"10C-AC-JS":487,"10C-AD-JD":487,"10C-AH-JD":487,"10C-AS-JD":487,"10C-AD-JH":487,"10C-AD-JS":487,"10C-AH-JH":487,"10C-AS-JH":487,"10C-AH-JS":487,"10C-AS-JS":487,"10D-AC-JC":487,"10H-AC-JC":487,"10S-AC-JC":487,"10D-AD-JC":487,"10D-AH-JC":487,"10D-AS-JC":487,"10H-AD-JC":487,"10S-AD-JC":487,"10H-AH-JC":487,"10H-AS-JC":487,"10S-AH-JC":487,"10S-AS-JC":487,"10D-AC-JD":487,"10D-AC-JH":487,"10D-AC-JS":487,"10H-AC-JD":487,"10S-AC-JD":487,"10H-AC-JH":487,"10H-AC-JS":487,"10S-AC-JH":487,"10S-AC-JS":487,"10D-AH-JD":487,"10D-AS-JD":487,"10D-AD-JH":487,"10D-AD-JS":487,"10D-AH-JH":487,"10D-AS-JH":487,"10D-AH-JS":487,"10D-AS-JS":487,"10H-AD-JD":487,"10S-AD-JD":487,"10H-AH-JD":487,"10H-AS-JD":487,"10S-AH-JD":487,"10S-AS-JD":487,"10H-AD-JH":487,"10H-AD-JS":487,"10S-AD-JH":487,"10S-AD-JS":487,"10H-AS-JH":487,"10H-AH-JS":487,"10H-AS-JS":487,"10S-AH-JH":487,"10S-AS-JH":487,"10S-AH-JS":487,"9C-AD-JC":488,"9C-AH-JC":488,"9C-AS-JC":488,"9C-AC-JD":488,"9C-AC-JH":488,"9C-AC-JS":488,"9C-AD-JD":488,"9C-AH-JD":488,"9C-AS-JD":488,"9C-AD-JH":488,"9C-AD-JS":488,"9C-AH-JH":488,"9C-AS-JH":488,"9C-AH-JS":488,"9C-AS-JS":488,"9D-AC-JC":488,"9H-AC-JC":488,"9S-AC-JC":488,"9D-AD-JC":488,"9D-AH-JC":488,"9D-AS-JC":488,"9H-AD-JC":488,"9S-AD-JC":488,"9H-AH-JC":488,"9H-AS-JC":488,"9S-AH-JC":488,"9S-AS-JC":488,"9D-AC-JD":488,"9D-AC-JH":488,"9D-AC-JS":488,"9H-AC-JD":488,"9S-AC-JD":488,"9H-AC-JH":488,"9H-AC-JS":488,"9S-AC-JH":488,"9S-AC-JS":488,"9D-AH-JD":488,"9D-AS-JD":488,"9D-AD-JH":488,"9D-AD-JS":488,"9D-AH-JH":488,"9D-AS-JH":488,"9D-AH-JS":488,"9D-AS-JS":488,"9H-AD-JD":488,"9S-AD-JD":488,"9H-AH-JD":488,"9H-AS-JD":488,"9S-AH-JD":488,"9S-AS-JD":488,"9H-AD-JH":488,"9H-AD-JS":488,"9S-AD-JH":488,"9S-AD-JS":488,"9H-AS-JH":488,"9H-AH-JS":488,"9H-AS-JS":488,"9S-AH-JH":488,"9S-AS-JH":488,"9S-AH-JS":488,"8C-AD-JC":489,"8C-AH-JC":489,"8C-AS-JC":489,"8C-AC-JD":489,"8C-AC-JH":489,"8C-AC-JS":489,"8C-AD-JD":489,"8C-AH-JD":489,"8C-AS-JD":489,"8C-AD-JH":489,"8C-AD-JS":489,"8C-AH-JH":489,"8C-AS-JH":489,"8C-AH-JS":489,"8C-AS-JS":489,"8D-AC-JC":489,"8H-AC-JC":489,"8S-AC-JC":489,"8D-AD-JC":489,"8D-AH-JC":489,"8D-AS-JC":489,"8H-AD-JC":489,"8S-AD-JC":489,"8H-AH-JC":489,"8H-AS-JC":489,"8S-AH-JC":489,"8S-AS-JC":489,"8D-AC-JD":489,"8D-AC-JH":489,"8D-AC-JS":489,"8H-AC-JD":489,"8S-AC-JD":489,"8H-AC-JH":489,"8H-AC-JS":489,"8S-AC-JH":489,"8S-AC-JS":489,"8D-AH-JD":489,"8D-AS-JD":489,"8D-AD-JH":489,"8D-AD-JS":489,"8D-AH-JH":489,"8D-AS-JH":489,"8D-AH-JS":489,"8D-AS-JS":489,"8H-AD-JD":489,"8S-AD-JD":489,"8H-AH-JD":489,"8H-AS-JD":489,"8S-AH-JD":489,"8S-AS-JD":489,"8H-AD-JH":489,"8H-AD-JS":489,"8S-AD-JH":489,"8S-AD-JS":489,"8H-AS-JH":489,"8H-AH-JS":489,"8H-AS-JS":489,"8S-AH-JH":489,"8S-AS-JH":489,"8S-AH-JS":489,"7C-AD-JC":490,"7C-AH-JC":490,"7C-AS-JC":490,"7C-AC-JD":490,"7C-AC-JH":490,"7C-AC-JS":490,"7C-AD-JD":490,"7C-AH-JD":490,"7C-AS-JD":490,"7C-AD-JH":490,"7C-AD-JS":490,"7C-AH-JH":490,"7C-AS-JH":490,"7C-AH-JS":490,"7C-AS-JS":490,"7D-AC-JC":490,"7H-AC-JC":490,"7S-AC-JC":490,"7D-AD-JC":490,"7D-AH-JC":490,"7D-AS-JC":490,"7H-AD-JC":490,"7S-AD-JC":490,"7H-AH-JC":490,"7H-AS-JC":490,"7S-AH-JC":490,"7S-AS-JC":490,"7D-AC-JD":490,"7D-AC-JH":490,"7D-AC-JS":490,"7H-AC-JD":490,"7S-AC-JD":490,"7H-AC-JH":490,"7H-AC-JS":490,"7S-AC-JH":490,"7S-AC-JS":490,"7D-AH-JD":490,"7D-AS-JD":490,"7D-AD-JH":490,"7D-AD-JS":490,"7D-AH-JH":490,"7D-AS-JH":490,"7D-AH-JS":490,"7D-AS-JS":490,"7H-AD-JD":490,"7S-AD-JD":490,"7H-AH-JD":490,"7H-AS-JD":490,"7S-AH-JD":490,"7S-AS-JD":490,"7H-AD-JH":490,"7H-AD-JS":490,"7S-AD-JH":490,"7S-AD-JS":490,"7H-AS-JH":490,"7H-AH-JS":490,"7H-AS-JS":490,"7S-AH-JH":490,"7S-AS-JH":490,"7S-AH-JS":490,"6C-AD-JC":491,"6C-AH-JC":491,"6C-AS-JC":491,"6C-AC-JD":491,"6C-AC-JH":491,"6C-AC-JS":491,"6C-AD-JD":491,"6C-AH-JD":491,"6C-AS-JD":491,"6C-AD-JH":491,"6C-AD-JS":491,"6C-AH-JH":491,"6C-AS-JH":491,"6C-AH-JS":491,"6C-AS-JS":491,"6D-AC-JC":491,"6H-AC-JC":491,"6S-AC-JC":491,"6D-AD-JC":491,"6D-AH-JC":491,"6D-AS-JC":491,"6H-AD-JC":491,"6S-AD-JC":491,"6H-AH-JC":491,"6H-AS-JC":491,"6S-AH-JC":491,"6S-AS-JC":491,"6D-AC-JD":491,"6D-AC-JH":491,"6D-AC-JS":491,"6H-AC-JD":491,"6S-AC-JD":491,"6H-AC-JH":491,"6H-AC-JS":491,"6S-AC-JH":491,"6S-AC-JS":491,"6D-AH-JD":491,"6D-AS-JD":491,"6D-AD-JH":491,"6D-AD-JS":491,"6D-AH-JH":491,"6D-AS-JH":491,"6D-AH-JS":491,"6D-AS-JS":491,"6H-AD-JD":491,"6S-AD-JD":491,"6H-AH-JD":491,"6H-AS-JD":491,"6S-AH-JD":491,"6S-AS-JD":491,"6H-AD-JH":491,"6H-AD-JS":491,"6S-AD-JH":491,"6S-AD-JS":491,"6H-AS-JH":491,"6H-AH-JS":491,"6H-AS-JS":491,"6S-AH-JH":491,"6S-AS-JH":491,"6S-AH-JS":491,"5C-AD-JC":492,"5C-AH-JC":492,"5C-AS-JC":492,"5C-AC-JD":492,"5C-AC-JH":492,"5C-AC-JS":492,"5C-AD-JD":492,"5C-AH-JD":492,"5C-AS-JD":492,"5C-AD-JH":492,"5C-AD-JS":492,"5C-AH-JH":492,"5C-AS-JH":492,"5C-AH-JS":492,"5C-AS-JS":492,"5D-AC-JC":492,"5H-AC-JC":492,"5S-AC-JC":492,"5D-AD-JC":492,"5D-AH-JC":492,"5D-AS-JC":492,"5H-AD-JC":492,"5S-AD-JC":492,"5H-AH-JC":492,"5H-AS-JC":492,"5S-AH-JC":492,"5S-AS-JC":492,"5D-AC-JD":492,"5D-AC-JH":492,"5D-AC-JS":492,"5H-AC-JD":492,"5S-AC-JD":492,"5H-AC-JH":492,"5H-AC-JS":492,"5S-AC-JH":492,"5S-AC-JS":492,"5D-AH-JD":492,"5D-AS-JD":492,"5D-AD-JH":492,"5D-AD-JS":492,"5D-AH-JH":492,"5D-AS-JH":492,"5D-AH-JS":492,"5D-AS-JS":492,"5H-AD-JD":492,"5S-AD-JD":492,"5H-AH-JD":492,"5H-AS-JD":492,"5S-AH-JD":492,"5S-AS-JD":492,"5H-AD-JH":492,"5H-AD-JS":492,"5S-AD-JH":492,"5S-AD-JS":492,"5H-AS-JH":492,"5H-AH-JS":492,"5H-AS-JS":492,"5S-AH-JH":492,"5S-AS-JH":492,"5S-AH-JS":492,"4C-AD-JC":493,"4C-AH-JC":493,"4C-AS-JC":493,"4C-AC-JD":493,"4C-AC-JH":493,"4C-AC-JS":493,"4C-AD-JD":493,"4C-AH-JD":493,"4C-AS-JD":493,"4C-AD-JH":493,"4C-AD-JS":493,"4C-AH-JH":493,"4C-AS-JH":493,"4C-AH-JS":493,"4C-AS-JS":493,"4D-AC-JC":493,"4H-AC-JC":493,"4S-AC-JC":493,"4D-AD-JC":493,"4D-AH-JC":493,"4D-AS-JC":493,"4H-AD-JC":493,"4S-AD-JC":493,"4H-AH-JC":493,"4H-AS-JC":493,"4S-AH-JC":493,"4S-AS-JC":493,"4D-AC-JD":493,"4D-AC-JH":493,"4D-AC-JS":493,"4H-AC-JD":493,"4S-AC-JD":493,"4H-AC-JH":493,"4H-AC-JS":493,"4S-AC-JH":493,"4S-AC-JS":493,"4D-AH-JD":493,"4D-AS-JD":493,"4D-AD-JH":493,"4D-AD-JS":493,"4D-AH-JH":493,"4D-AS-JH":493,"4D-AH-JS":493,"4D-AS-JS":493,"4H-AD-JD":493,"4S-AD-JD":493,"4H-AH-JD":493,"4H-AS-JD":493,"4S-AH-JD":493,"4S-AS-JD":493,"4H-AD-JH":493,"4H-AD-JS":493,"4S-AD-JH":493,"4S-AD-JS":493,"4H-AS-JH":493,"4H-AH-JS":493,"4H-AS-JS":493,"4S-AH-JH":493,"4S-AS-JH":493,"4S-AH-JS":493,"3C-AD-JC":494,"3C-AH-JC":494,"3C-AS-JC":494,"3C-AC-JD":494,"3C-AC-JH":494,"3C-AC-JS":494,"3C-AD-JD":494,"3C-AH-JD":494,"3C-AS-JD":494,"3C-AD-JH":494,"3C-AD-JS":494,"3C-AH-JH":494,"3C-AS-JH":494,"3C-AH-JS":494,"3C-AS-JS":494,"3D-AC-JC":494,"3H-AC-JC":494,"3S-AC-JC":494,"3D-AD-JC":494,"3D-AH-JC":494,"3D-AS-JC":494,"3H-AD-JC":494,"3S-AD-JC":494,"3H-AH-JC":494,"3H-AS-JC":494,"3S-AH-JC":494,"3S-AS-JC":494,"3D-AC-JD":494,"3D-AC-JH":494,"3D-AC-JS":494,"3H-AC-JD":494,"3S-AC-JD":494,"3H-AC-JH":494,"3H-AC-JS":494,"3S-AC-JH":494,"3S-AC-JS":494,"3D-AH-JD":494,"3D-AS-JD":494,"3D-AD-JH":494,"3D-AD-JS":494,"3D-AH-JH":494,"3D-AS-JH":494,"3D-AH-JS":494,"3D-AS-JS":494,"3H-AD-JD":494,"3S-AD-JD":494,"3H-AH-JD":494,"3H-AS-JD":494,"3S-AH-JD":494,"3S-AS-JD":494,"3H-AD-JH":494,"3H-AD-JS":494,"3S-AD-JH":494,"3S-AD-JS":494,"3H-AS-JH":494,"3H-AH-JS":494,"3H-AS-JS":494,"3S-AH-JH":494,"3S-AS-JH":494,"3S-AH-JS":494,"2C-AD-JC":495,"2C-AH-JC":495,"2C-AS-JC":495,"2C-AC-JD":495,"2C-AC-JH":495,"2C-AC-JS":495,"2C-AD-JD":495,"2C-AH-JD":495,"2C-AS-JD":495,"2C-AD-JH":495,"2C-AD-JS":495,"2C-AH-JH":495,"2C-AS-JH":495,"2C-AH-JS":495,"2C-AS-JS":495,"2D-AC-JC":495,"2H-AC-JC":495,"2S-AC-JC":495,"2D-AD-JC":495,"2D-AH-JC":495,"2D-AS-JC":495,"2H-AD-JC":495,"2S-AD-JC":495,"2H-AH-JC":495,"2H-AS-JC":495,"2S-AH-JC":495,"2S-AS-JC":495,"2D-AC-JD":495,"2D-AC-JH":495,"2D-AC-JS":495,"2H-AC-JD":495,"2S-AC-JD":495,"2H-AC-JH":495,"2H-AC-JS":495,"2S-AC-JH":495,"2S-AC-JS":495,"2D-AH-JD":495,"2D-AS-JD":495,"2D-AD-JH":495,"2D-AD-JS":495,"2D-AH-JH":495,"2D-AS-JH":495,"2D-AH-JS":495,"2D-AS-JS":495,"2H-AD-JD":495,"2S-AD-JD":495,"2H-AH-JD":495,"2H-AS-JD":495,"2S-AH-JD":495,"2S-AS-JD":495,"2H-AD-JH":495,"2H-AD-JS":495,"2S-AD-JH":495,"2S-AD-JS":495,"2H-AS-JH":495,"2H-AH-JS":495,"2H-AS-JS":495,"2S-AH-JH":495,"2S-AS-JH":495,"2S-AH-JS":495,"10C-9C-AD":496,"10C-9C-AH":496,"10C-9C-AS":496,"10D-9C-AC":496,"10H-9C-AC":496,"10S-9C-AC":496,"10D-9C-AD":496,"10D-9C-AH":496,"10D-9C-AS":496,"10H-9C-AD":496,"10S-9C-AD":496,"10H-9C-AH":496,"10H-9C-AS":496,"10S-9C-AH":496,"10S-9C-AS":496,"10C-9D-AC":496,"10C-9H-AC":496,"10C-9S-AC":496,"10C-9D-AD":496,"10C-9D-AH":496,"10C-9D-AS":496,"10C-9H-AD":496,"10C-9S-AD":496,"10C-9H-AH":496,"10C-9H-AS":496,"10C-9S-AH":496,"10C-9S-AS":496,"10D-9D-AC":496,"10H-9D-AC":496,"10S-9D-AC":496,"10D-9H-AC":496,"10D-9S-AC":496,"10H-9H-AC":496,"10S-9H-AC":496,"10H-9S-AC":496,"10S-9S-AC":496,"10D-9D-AH":496,"10D-9D-AS":496,"10H-9D-AD":496,"10S-9D-AD":496,"10H-9D-AH":496,"10H-9D-AS":496,"10S-9D-AH":496,"10S-9D-AS":496,"10D-9H-AD":496,"10D-9S-AD":496,"10D-9H-AH":496,"10D-9H-AS":496,"10D-9S-AH":496,"10D-9S-AS":496,"10H-9H-AD":496,"10S-9H-AD":496,"10H-9S-AD":496,"10S-9S-AD":496,"10H-9H-AS":496,"10S-9H-AH":496,"10S-9H-AS":496,"10H-9S-AH":496,"10H-9S-AS":496,"10S-9S-AH":496,"10C-8C-AD":497,"10C-8C-AH":497,"10C-8C-AS":497,"10D-8C-AC":497,"10H-8C-AC":497,"10S-8C-AC":497,"10D-8C-AD":497,"10D-8C-AH":497,"10D-8C-AS":497,"10H-8C-AD":497,"10S-8C-AD":497,"10H-8C-AH":497,"10H-8C-AS":497,"10S-8C-AH":497,"10S-8C-AS":497,"10C-8D-AC":497,"10C-8H-AC":497,"10C-8S-AC":497,"10C-8D-AD":497,"10C-8D-AH":497,"10C-8D-AS":497,"10C-8H-AD":497,"10C-8S-AD":497,"10C-8H-AH":497,"10C-8H-AS":497,"10C-8S-AH":497,"10C-8S-AS":497,"10D-8D-AC":497,"10H-8D-AC":497,"10S-8D-AC":497,"10D-8H-AC":497,"10D-8S-AC":497,"10H-8H-AC":497,"10S-8H-AC":497,"10H-8S-AC":497,"10S-8S-AC":497,"10D-8D-AH":497,"10D-8D-AS":497,"10H-8D-AD":497,"10S-8D-AD":497,"10H-8D-AH":497,"10H-8D-AS":497,"10S-8D-AH":497,"10S-8D-AS":497,"10D-8H-AD":497,"10D-8S-AD":497,"10D-8H-AH":497,"10D-8H-AS":497,"10D-8S-AH":497,"10D-8S-AS":497,"10H-8H-AD":497,"10S-8H-AD":497,"10H-8S-AD":497,"10S-8S-AD":497,"10H-8H-AS":497,"10S-8H-AH":497,"10S-8H-AS":497,"10H-8S-AH":497,"10H-8S-AS":497,"10S-8S-AH":497,"10C-7C-AD":498,"10C-7C-AH":498,"10C-7C-AS":498,"10D-7C-AC":498,"10H-7C-AC":498,"10S-7C-AC":498,"10D-7C-AD":498,"10D-7C-AH":498,"10D-7C-AS":498,"10H-7C-AD":498,"10S-7C-AD":498,"10H-7C-AH":498,"10H-7C-AS":498,"10S-7C-AH":498,"10S-7C-AS":498,"10C-7D-AC":498,"10C-7H-AC":498,"10C-7S-AC":498,"10C-7D-AD":498,"10C-7D-AH":498,"10C-7D-AS":498,"10C-7H-AD":498,"10C-7S-AD":498,"10C-7H-AH":498,"10C-7H-AS":498,"10C-7S-AH":498,"10C-7S-AS":498,"10D-7D-AC":498,"10H-7D-AC":498,"10S-7D-AC":498,"10D-7H-AC":498,"10D-7S-AC":498,"10H-7H-AC":498,"10S-7H-AC":498,"10H-7S-AC":498,"10S-7S-AC":498,"10D-7D-AH":498,"10D-7D-AS":498,"10H-7D-AD":498,"10S-7D-AD":498,"10H-7D-AH":498,"10H-7D-AS":498,"10S-7D-AH":498,"10S-7D-AS":498,"10D-7H-AD":498,"10D-7S-AD":498,"10D-7H-AH":498,"10D-7H-AS":498,"10D-7S-AH":498,"10D-7S-AS":498,"10H-7H-AD":498,"10S-7H-AD":498,"10H-7S-AD":498,"10S-7S-AD":498,"10H-7H-AS":498,"10S-7H-AH":498,"10S-7H-AS":498,"10H-7S-AH":498,"10H-7S-AS":498,"10S-7S-AH":498,"10C-6C-AD":499,"10C-6C-AH":499,"10C-6C-AS":499,"10D-6C-AC":499,"10H-6C-AC":499,"10S-6C-AC":499,"10D-6C-AD":499,"10D-6C-AH":499,"10D-6C-AS":499,"10H-6C-AD":499,"10S-6C-AD":499,"10H-6C-AH":499,"10H-6C-AS":499,"10S-6C-AH":499,"10S-6C-AS":499,"10C-6D-AC":499,"10C-6H-AC":499,"10C-6S-AC":499,"10C-6D-AD":499,"10C-6D-AH":499,"10C-6D-AS":499,"10C-6H-AD":499,"10C-6S-AD":499,"10C-6H-AH":499,"10C-6H-AS":499,"10C-6S-AH":499,"10C-6S-AS":499,"10D-6D-AC":499,"10H-6D-AC":499,"10S-6D-AC":499,"10D-6H-AC":499,"10D-6S-AC":499,"10H-6H-AC":499,"10S-6H-AC":499,"10H-6S-AC":499,"10S-6S-AC":499,"10D-6D-AH":499,"10D-6D-AS":499,"10H-6D-AD":499,"10S-6D-AD":499,"10H-6D-AH":499,"10H-6D-AS":499,"10S-6D-AH":499,"10S-6D-AS":499,"10D-6H-AD":499,"10D-6S-AD":499,"10D-6H-AH":499,"10D-6H-AS":499,"10D-6S-AH":499,"10D-6S-AS":499,"10H-6H-AD":499,"10S-6H-AD":499,"10H-6S-AD":499,"10S-6S-AD":499,"10H-6H-AS":499,"10S-6H-AH":499,"10S-6H-AS":499,"10H-6S-AH":499,"10H-6S-AS":499,"10S-6S-AH":499,"10C-5C-AD":500,"10C-5C-AH":500,"10C-5C-AS":500,"10D-5C-AC":500,"10H-5C-AC":500,"10S-5C-AC":500,"10D-5C-AD":500,"10D-5C-AH":500,"10D-5C-AS":500,"10H-5C-AD":500,"10S-5C-AD":500,"10H-5C-AH":500,"10H-5C-AS":500,"10S-5C-AH":500,"10S-5C-AS":500,"10C-5D-AC":500,"10C-5H-AC":500,"10C-5S-AC":500,"10C-5D-AD":500,"10C-5D-AH":500,"10C-5D-AS":500,"10C-5H-AD":500,"10C-5S-AD":500,"10C-5H-AH":500,"10C-5H-AS":500,"10C-5S-AH":500,"10C-5S-AS":500,"10D-5D-AC":500,"10H-5D-AC":500,"10S-5D-AC":500,"10D-5H-AC":500,"10D-5S-AC":500,"10H-5H-AC":500,"10S-5H-AC":500,"10H-5S-AC":500,"10S-5S-AC":500,"10D-5D-AH":500,"10D-5D-AS":500,"10H-5D-AD":500,"10S-5D-AD":500,"10H-5D-AH":500,"10H-5D-AS":500,"10S-5D-AH":500,"10S-5D-AS":500,"10D-5H-AD":500,"10D-5S-AD":500,"10D-5H-AH":500,"10D-5H-AS":500,"10D-5S-AH":500,"10D-5S-AS":500,"10H-5H-AD":500,"10S-5H-AD":500,"10H-5S-AD":500,"10S-5S-AD":500,"10H-5H-AS":500,"10S-5H-AH":500,"10S-5H-AS":500,"10H-5S-AH":500,"10H-5S-AS":500,"10S-5S-AH":500,"10C-4C-AD":501,"10C-4C-AH":501,"10C-4C-AS":501,"10D-4C-AC":501,"10H-4C-AC":501,"10S-4C-AC":501,"10D-4C-AD":501,"10D-4C-AH":501,"10D-4C-AS":501,"10H-4C-AD":501,"10S-4C-AD":501,"10H-4C-AH":501,"10H-4C-AS":501,"10S-4C-AH":501,"10S-4C-AS":501,"10C-4D-AC":501,"10C-4H-AC":501,"10C-4S-AC":501,"10C-4D-AD":501,"10C-4D-AH":501,"10C-4D-AS":501,"10C-4H-AD":501,"10C-4S-AD":501,"10C-4H-AH":501,"10C-4H-AS":501,"10C-4S-AH":501,"10C-4S-AS":501,"10D-4D-AC":501,"10H-4D-AC":501,"10S-4D-AC":501,"10D-4H-AC":501,"10D-4S-AC":501,"10H-4H-AC":501,"10S-4H-AC":501,"10H-4S-AC":501,"10S-4S-AC":501,"10D-4D-AH":501,"10D-4D-AS":501,"10H-4D-AD":501,"10S-4D-AD":501,"10H-4D-AH":501,"10H-4D-AS":501,"10S-4D-AH":501,"10S-4D-AS":501,"10D-4H-AD":501,"10D-4S-AD":501,"10D-4H-AH":501,"10D-4H-AS":501,"10D-4S-AH":501,"10D-4S-AS":501,"10H-4H-AD":501,"10S-4H-AD":501,"10H-4S-AD":501,"10S-4S-AD":501,"10H-4H-AS":501,"10S-4H-AH":501,"10S-4H-AS":501,"10H-4S-AH":501,"10H-4S-AS":501,"10S-4S-AH":501,"10C-3C-AD":502,"10C-3C-AH":502,"10C-3C-AS":502,"10D-3C-AC":502,"10H-3C-AC":502,"10S-3C-AC":502,"10D-3C-AD":502,"10D-3C-AH":502,"10D-3C-AS":502,"10H-3C-AD":502,"10S-3C-AD":502,"10H-3C-AH":502,"10H-3C-AS":502,"10S-3C-AH":502,"10S-3C-AS":502,"10C-3D-AC":502,"10C-3H-AC":502,"10C-3S-AC":502,"10C-3D-AD":502,"10C-3D-AH":502,"10C-3D-AS":502,"10C-3H-AD":502,"10C-3S-AD":502,"10C-3H-AH":502,"10C-3H-AS":502,"10C-3S-AH":502,"10C-3S-AS":502,"10D-3D-AC":502,"10H-3D-AC":502,"10S-3D-AC":502,"10D-3H-AC":502,"10D-3S-AC":502,"10H-3H-AC":502,"10S-3H-AC":502,"10H-3S-AC":502,"10S-3S-AC":502,"10D-3D-AH":502,"10D-3D-AS":502,"10H-3D-AD":502,"10S-3D-AD":502,"10H-3D-AH":502,"10H-3D-AS":502,"10S-3D-AH":502,"10S-3D-AS":502,"10D-3H-AD":502,"10D-3S-AD":502,"10D-3H-AH":502,"10D-3H-AS":502,"10D-3S-AH":502,"10D-3S-AS":502,"10H-3H-AD":502,"10S-3H-AD":502,"10H-3S-AD":502,"10S-3S-AD":502,"10H-3H-AS":502,"10S-3H-AH":502,"10S-3H-AS":502,"10H-3S-AH":502,"10H-3S-AS":502,"10S-3S-AH":502,"10C-2C-AD":503,"10C-2C-AH":503,"10C-2C-AS":503,"10D-2C-AC":503,"10H-2C-AC":503,"10S-2C-AC":503,"10D-2C-AD":503,"10D-2C-AH":503,"10D-2C-AS":503,"10H-2C-AD":503,"10S-2C-AD":503,"10H-2C-AH":503,"10H-2C-AS":503,"10S-2C-AH":503,"10S-2C-AS":503,"10C-2D-AC":503,"10C-2H-AC":503,"10C-2S-AC":503,"10C-2D-AD":503,"10C-2D-AH":503,"10C-2D-AS":503,"10C-2H-AD":503,"10C-2S-AD":503,"10C-2H-AH":503,"10C-2H-AS":503,"10C-2S-AH":503,"10C-2S-AS":503,"10D-2D-AC":503,"10H-2D-AC":503,"10S-2D-AC":503,"10D-2H-AC":503,"10D-2S-AC":503,"10H-2H-AC":503,"10S-2H-AC":503,"10H-2S-AC":503,"10S-2S-AC":503,"10D-2D-AH":503,"10D-2D-AS":503,"10H-2D-AD":503,"10S-2D-AD":503,"10H-2D-AH":503,"10H-2D-AS":503,"10S-2D-AH":503,"10S-2D-AS":503,"10D-2H-AD":503,"10D-2S-AD":503,"10D-2H-AH":503,"10D-2H-AS":503,"10D-2S-AH":503,"10D-2S-AS":503,"10H-2H-AD":503,"10S-2H-AD":503,"10H-2S-AD":503,"10S-2S-AD":503,"10H-2H-AS":503,"10S-2H-AH":503,"10S-2H-AS":503,"10H-2S-AH":503,"10H-2S-AS":503,"10S-2S-AH":503,"8C-9C-AD":504,"8C-9C-AH":504,"8C-9C-AS":504,"8C-9D-AC":504,"8C-9H-AC":504,"8C-9S-AC":504,"8C-9D-AD":504,"8C-9D-AH":504,"8C-9D-AS":504,"8C-9H-AD":504,"8C-9S-AD":504,"8C-9H-AH":504,"8C-9H-AS":504,"8C-9S-AH":504,"8C-9S-AS":504,"8D-9C-AC":504,"8H-9C-AC":504,"8S-9C-AC":504,"8D-9C-AD":504,"8D-9C-AH":504,"8D-9C-AS":504,"8H-9C-AD":504,"8S-9C-AD":504,"8H-9C-AH":504,"8H-9C-AS":504,"8S-9C-AH":504,"8S-9C-AS":504,"8D-9D-AC":504,"8D-9H-AC":504,"8D-9S-AC":504,"8H-9D-AC":504,"8S-9D-AC":504,"8H-9H-AC":504,"8H-9S-AC":504,"8S-9H-AC":504,"8S-9S-AC":504,"8D-9D-AH":504,"8D-9D-AS":504,"8D-9H-AD":504,"8D-9S-AD":504,"8D-9H-AH":504,"8D-9H-AS":504,"8D-9S-AH":504,"8D-9S-AS":504,"8H-9D-AD":504,"8S-9D-AD":504,"8H-9D-AH":504,"8H-9D-AS":504,"8S-9D-AH":504,"8S-9D-AS":504,"8H-9H-AD":504,"8H-9S-AD":504,"8S-9H-AD":504,"8S-9S-AD":504,"8H-9H-AS":504,"8H-9S-AH":504,"8H-9S-AS":504,"8S-9H-AH":504,"8S-9H-AS":504,"8S-9S-AH":504,"7C-9C-AD":505,"7C-9C-AH":505,"7C-9C-AS":505,"7C-9D-AC":505,"7C-9H-AC":505,"7C-9S-AC":505,"7C-9D-AD":505,"7C-9D-AH":505,"7C-9D-AS":505,"7C-9H-AD":505,"7C-9S-AD":505,"7C-9H-AH":505,"7C-9H-AS":505,"7C-9S-AH":505,"7C-9S-AS":505,"7D-9C-AC":505,"7H-9C-AC":505,"7S-9C-AC":505,"7D-9C-AD":505,"7D-9C-AH":505,"7D-9C-AS":505,"7H-9C-AD":505,"7S-9C-AD":505,"7H-9C-AH":505,"7H-9C-AS":505,"7S-9C-AH":505,"7S-9C-AS":505,"7D-9D-AC":505,"7D-9H-AC":505,"7D-9S-AC":505,"7H-9D-AC":505,"7S-9D-AC":505,"7H-9H-AC":505,"7H-9S-AC":505,"7S-9H-AC":505,"7S-9S-AC":505,"7D-9D-AH":505,"7D-9D-AS":505,"7D-9H-AD":505,"7D-9S-AD":505,"7D-9H-AH":505,"7D-9H-AS":505,"7D-9S-AH":505,"7D-9S-AS":505,"7H-9D-AD":505,"7S-9D-AD":505,"7H-9D-AH":505,"7H-9D-AS":505,"7S-9D-AH":505,"7S-9D-AS":505,"7H-9H-AD":505,"7H-9S-AD":505,"7S-9H-AD":505,"7S-9S-AD":505,"7H-9H-AS":505,"7H-9S-AH":505,"7H-9S-AS":505,"7S-9H-AH":505,"7S-9H-AS":505,"7S-9S-AH":505,"6C-9C-AD":506,"6C-9C-AH":506,"6C-9C-AS":506,"6C-9D-AC":506,"6C-9H-AC":506,"6C-9S-AC":506,"6C-9D-AD":506,"6C-9D-AH":506,"6C-9D-AS":506,"6C-9H-AD":506,"6C-9S-AD":506,"6C-9H-AH":506,"6C-9H-AS":506,"6C-9S-AH":506,"6C-9S-AS":506,"6D-9C-AC":506,"6H-9C-AC":506,"6S-9C-AC":506,"6D-9C-AD":506,"6D-9C-AH":506,"6D-9C-AS":506,"6H-9C-AD":506,"6S-9C-AD":506,"6H-9C-AH":506,"6H-9C-AS":506,"6S-9C-AH":506,"6S-9C-AS":506,"6D-9D-AC":506,"6D-9H-AC":506,"6D-9S-AC":506,"6H-9D-AC":506,"6S-9D-AC":506,"6H-9H-AC":506,"6H-9S-AC":506,"6S-9H-AC":506,"6S-9S-AC":506,"6D-9D-AH":506,"6D-9D-AS":506,"6D-9H-AD":506,"6D-9S-AD":506,"6D-9H-AH":506,"6D-9H-AS":506,"6D-9S-AH":506,"6D-9S-AS":506,"6H-9D-AD":506,"6S-9D-AD":506,"6H-9D-AH":506,"6H-9D-AS":506,"6S-9D-AH":506,"6S-9D-AS":506,"6H-9H-AD":506,"6H-9S-AD":506,"6S-9H-AD":506,"6S-9S-AD":506,"6H-9H-AS":506,"6H-9S-AH":506,"6H-9S-AS":506,"6S-9H-AH":506,"6S-9H-AS":506,"6S-9S-AH":506,"5C-9C-AD":507,"5C-9C-AH":507,"5C-9C-AS":507,"5C-9D-AC":507,"5C-9H-AC":507,"5C-9S-AC":507,"5C-9D-AD":507,"5C-9D-AH":507,"5C-9D-AS":507,"5C-9H-AD":507,"5C-9S-AD":507,"5C-9H-AH":507,"5C-9H-AS":507,"5C-9S-AH":507,"5C-9S-AS":507,"5D-9C-AC":507,"5H-9C-AC":507,"5S-9C-AC":507,"5D-9C-AD":507,"5D-9C-AH":507,"5D-9C-AS":507,"5H-9C-AD":507,"5S-9C-AD":507,"5H-9C-AH":507,"5H-9C-AS":507,"5S-9C-AH":507,"5S-9C-AS":507,"5D-9D-AC":507,"5D-9H-AC":507,"5D-9S-AC":507,"5H-9D-AC":507,"5S-9D-AC":507,"5H-9H-AC":507,"5H-9S-AC":507,"5S-9H-AC":507,"5S-9S-AC":507,"5D-9D-AH":507,"5D-9D-AS":507,"5D-9H-AD":507,"5D-9S-AD":507,"5D-9H-AH":507,"5D-9H-AS":507,"5D-9S-AH":507,"5D-9S-AS":507,"5H-9D-AD":507,"5S-9D-AD":507,"5H-9D-AH":507,"5H-9D-AS":507,"5S-9D-AH":507,"5S-9D-AS":507,"5H-9H-AD":507,"5H-9S-AD":507,"5S-9H-AD":507,"5S-9S-AD":507,"5H-9H-AS":507,"5H-9S-AH":507,"5H-9S-AS":507,"5S-9H-AH":507,"5S-9H-AS":507,"5S-9S-AH":507,"4C-9C-AD":508,"4C-9C-AH":508,"4C-9C-AS":508,"4C-9D-AC":508,"4C-9H-AC":508,"4C-9S-AC":508,"4C-9D-AD":508,"4C-9D-AH":508,"4C-9D-AS":508,"4C-9H-AD":508,"4C-9S-AD":508,"4C-9H-AH":508,"4C-9H-AS":508,"4C-9S-AH":508,"4C-9S-AS":508,"4D-9C-AC":508,"4H-9C-AC":508,"4S-9C-AC":508,"4D-9C-AD":508,"4D-9C-AH":508,"4D-9C-AS":508,"4H-9C-AD":508,"4S-9C-AD":508,"4H-9C-AH":508,"4H-9C-AS":508,"4S-9C-AH":508,"4S-9C-AS":508,"4D-9D-AC":508,"4D-9H-AC":508,"4D-9S-AC":508,"4H-9D-AC":508,"4S-9D-AC":508,"4H-9H-AC":508,"4H-9S-AC":508,"4S-9H-AC":508,"4S-9S-AC":508,"4D-9D-AH":508,"4D-9D-AS":508,"4D-9H-AD":508,"4D-9S-AD":508,"4D-9H-AH":508,"4D-9H-AS":508,"4D-9S-AH":508,"4D-9S-AS":508,"4H-9D-AD":508,"4S-9D-AD":508,"4H-9D-AH":508,"4H-9D-AS":508,"4S-9D-AH":508,"4S-9D-AS":508,"4H-9H-AD":508,"4H-9S-AD":508,"4S-9H-AD":508,"4S-9S-AD":508,"4H-9H-AS":508,"4H-9S-AH":508,"4H-9S-AS":508,"4S-9H-AH":508,"4S-9H-AS":508,"4S-9S-AH":508,"3C-9C-AD":509,"3C-9C-AH":509,"3C-9C-AS":509,"3C-9D-AC":509,"3C-9H-AC":509,"3C-9S-AC":509,"3C-9D-AD":509,"3C-9D-AH":509,"3C-9D-AS":509,"3C-9H-AD":509,"3C-9S-AD":509,"3C-9H-AH":509,"3C-9H-AS":509,"3C-9S-AH":509,"3C-9S-AS":509,"3D-9C-AC":509,"3H-9C-AC":509,"3S-9C-AC":509,"3D-9C-AD":509,"3D-9C-AH":509,"3D-9C-AS":509,"3H-9C-AD":509,"3S-9C-AD":509,"3H-9C-AH":509,"3H-9C-AS":509,"3S-9C-AH":509,"3S-9C-AS":509,"3D-9D-AC":509,"3D-9H-AC":509,"3D-9S-AC":509,"3H-9D-AC":509,"3S-9D-AC":509,"3H-9H-AC":509,"3H-9S-AC":509,"3S-9H-AC":509,"3S-9S-AC":509,"3D-9D-AH":509,"3D-9D-AS":509,"3D-9H-AD":509,"3D-9S-AD":509,"3D-9H-AH":509,"3D-9H-AS":509,"3D-9S-AH":509,"3D-9S-AS":509,"3H-9D-AD":509,"3S-9D-AD":509,"3H-9D-AH":509,"3H-9D-AS":509,"3S-9D-AH":509,"3S-9D-AS":509,"3H-9H-AD":509,"3H-9S-AD":509,"3S-9H-AD":509,"3S-9S-AD":509,"3H-9H-AS":509,"3H-9S-AH":509,"3H-9S-AS":509,"3S-9H-AH":509,"3S-9H-AS":509,"3S-9S-AH":509,"2C-9C-AD":510,"2C-9C-AH":510,"2C-9C-AS":510,"2C-9D-AC":510,"2C-9H-AC":510,"2C-9S-AC":510,"2C-9D-AD":510,"2C-9D-AH":510,"2C-9D-AS":510,"2C-9H-AD":510,"2C-9S-AD":510,"2C-9H-AH":510,"2C-9H-AS":510,"2C-9S-AH":510,"2C-9S-AS":510,"2D-9C-AC":510,"2H-9C-AC":510,"2S-9C-AC":510,"2D-9C-AD":510,"2D-9C-AH":510,"2D-9C-AS":510,"2H-9C-AD":510,"2S-9C-AD":510,"2H-9C-AH":510,"2H-9C-AS":510,"2S-9C-AH":510,"2S-9C-AS":510,"2D-9D-AC":510,"2D-9H-AC":510,"2D-9S-AC":510,"2H-9D-AC":510,"2S-9D-AC":510,"2H-9H-AC":510,"2H-9S-AC":510,"2S-9H-AC":510,"2S-9S-AC":510,"2D-9D-AH":510,"2D-9D-AS":510,"2D-9H-AD":510,"2D-9S-AD":510,"2D-9H-AH":510,"2D-9H-AS":510,"2D-9S-AH":510,"2D-9S-AS":510,"2H-9D-AD":510,"2S-9D-AD":510,"2H-9D-AH":510,"2H-9D-AS":510,"2S-9D-AH":510,"2S-9D-AS":510,"2H-9H-AD":510,"2H-9S-AD":510,"2S-9H-AD":510,"2S-9S-AD":510,"2H-9H-AS":510,"2H-9S-AH":510,"2H-9S-AS":510,"2S-9H-AH":510,"2S-9H-AS":510,"2S-9S-AH":510,"7C-8C-AD":511,"7C-8C-AH":511,"7C-8C-AS":511,"7C-8D-AC":511,"7C-8H-AC":511,"7C-8S-AC":511,"7C-8D-AD":511,"7C-8D-AH":511,"7C-8D-AS":511,"7C-8H-AD":511,"7C-8S-AD":511,"7C-8H-AH":511,"7C-8H-AS":511,"7C-8S-AH":511,"7C-8S-AS":511,"7D-8C-AC":511,"7H-8C-AC":511,"7S-8C-AC":511,"7D-8C-AD":511,"7D-8C-AH":511,"7D-8C-AS":511,"7H-8C-AD":511,"7S-8C-AD":511,"7H-8C-AH":511,"7H-8C-AS":511,"7S-8C-AH":511,"7S-8C-AS":511,"7D-8D-AC":511,"7D-8H-AC":511,"7D-8S-AC":511,"7H-8D-AC":511,"7S-8D-AC":511,"7H-8H-AC":511,"7H-8S-AC":511,"7S-8H-AC":511,"7S-8S-AC":511,"7D-8D-AH":511,"7D-8D-AS":511,"7D-8H-AD":511,"7D-8S-AD":511,"7D-8H-AH":511,"7D-8H-AS":511,"7D-8S-AH":511,"7D-8S-AS":511,"7H-8D-AD":511,"7S-8D-AD":511,"7H-8D-AH":511,"7H-8D-AS":511,"7S-8D-AH":511,"7S-8D-AS":511,"7H-8H-AD":511,"7H-8S-AD":511,"7S-8H-AD":511,"7S-8S-AD":511,"7H-8H-AS":511,"7H-8S-AH":511,"7H-8S-AS":511,"7S-8H-AH":511,"7S-8H-AS":511,"7S-8S-AH":511,"6C-8C-AD":512,"6C-8C-AH":512,"6C-8C-AS":512,"6C-8D-AC":512,"6C-8H-AC":512,"6C-8S-AC":512,"6C-8D-AD":512,"6C-8D-AH":512,"6C-8D-AS":512,"6C-8H-AD":512,"6C-8S-AD":512,"6C-8H-AH":512,"6C-8H-AS":512,"6C-8S-AH":512,"6C-8S-AS":512,"6D-8C-AC":512,"6H-8C-AC":512,"6S-8C-AC":512,"6D-8C-AD":512,"6D-8C-AH":512,"6D-8C-AS":512,"6H-8C-AD":512,"6S-8C-AD":512,"6H-8C-AH":512,"6H-8C-AS":512,"6S-8C-AH":512,"6S-8C-AS":512,"6D-8D-AC":512,"6D-8H-AC":512,"6D-8S-AC":512,"6H-8D-AC":512,"6S-8D-AC":512,"6H-8H-AC":512,"6H-8S-AC":512,"6S-8H-AC":512,"6S-8S-AC":512,"6D-8D-AH":512,"6D-8D-AS":512,"6D-8H-AD":512,"6D-8S-AD":512,"6D-8H-AH":512,"6D-8H-AS":512,"6D-8S-AH":512,"6D-8S-AS":512,"6H-8D-AD":512,"6S-8D-AD":512,"6H-8D-AH":512,"6H-8D-AS":512,"6S-8D-AH":512,"6S-8D-AS":512,"6H-8H-AD":512,"6H-8S-AD":512,"6S-8H-AD":512,"6S-8S-AD":512,"6H-8H-AS":512,"6H-8S-AH":512,"6H-8S-AS":512,"6S-8H-AH":512,"6S-8H-AS":512,"6S-8S-AH":512,"5C-8C-AD":513,"5C-8C-AH":513,"5C-8C-AS":513,"5C-8D-AC":513,"5C-8H-AC":513,"5C-8S-AC":513,"5C-8D-AD":513,"5C-8D-AH":513,"5C-8D-AS":513,"5C-8H-AD":513,"5C-8S-AD":513,"5C-8H-AH":513,"5C-8H-AS":513,"5C-8S-AH":513,"5C-8S-AS":513,"5D-8C-AC":513,"5H-8C-AC":513,"5S-8C-AC":513,"5D-8C-AD":513,"5D-8C-AH":513,"5D-8C-AS":513,"5H-8C-AD":513,"5S-8C-AD":513,"5H-8C-AH":513,"5H-8C-AS":513,"5S-8C-AH":513,"5S-8C-AS":513,"5D-8D-AC":513,"5D-8H-AC":513,"5D-8S-AC":513,"5H-8D-AC":513,"5S-8D-AC":513,"5H-8H-AC":513,"5H-8S-AC":513,"5S-8H-AC":513,"5S-8S-AC":513,"5D-8D-AH":513,"5D-8D-AS":513,"5D-8H-AD":513,"5D-8S-AD":513,"5D-8H-AH":513,"5D-8H-AS":513,"5D-8S-AH":513,"5D-8S-AS":513,"5H-8D-AD":513,"5S-8D-AD":513,"5H-8D-AH":513,"5H-8D-AS":513,"5S-8D-AH":513,"5S-8D-AS":513,"5H-8H-AD":513,"5H-8S-AD":513,"5S-8H-AD":513,"5S-8S-AD":513,"5H-8H-AS":513,"5H-8S-AH":513,"5H-8S-AS":513,"5S-8H-AH":513,"5S-8H-AS":513,"5S-8S-AH":513,"4C-8C-AD":514,"4C-8C-AH":514,"4C-8C-AS":514,"4C-8D-AC":514,"4C-8H-AC":514,"4C-8S-AC":514,"4C-8D-AD":514,"4C-8D-AH":514,"4C-8D-AS":514,"4C-8H-AD":514,"4C-8S-AD":514,"4C-8H-AH":514,"4C-8H-AS":514,"4C-8S-AH":514,"4C-8S-AS":514,"4D-8C-AC":514,"4H-8C-AC":514,"4S-8C-AC":514,"4D-8C-AD":514,"4D-8C-AH":514,"4D-8C-AS":514,"4H-8C-AD":514,"4S-8C-AD":514,"4H-8C-AH":514,"4H-8C-AS":514,"4S-8C-AH":514,"4S-8C-AS":514,"4D-8D-AC":514,"4D-8H-AC":514,"4D-8S-AC":514,"4H-8D-AC":514,"4S-8D-AC":514,"4H-8H-AC":514,"4H-8S-AC":514,"4S-8H-AC":514,"4S-8S-AC":514,"4D-8D-AH":514,"4D-8D-AS":514,"4D-8H-AD":514,"4D-8S-AD":514,"4D-8H-AH":514,"4D-8H-AS":514,"4D-8S-AH":514,"4D-8S-AS":514,"4H-8D-AD":514,"4S-8D-AD":514,"4H-8D-AH":514,"4H-8D-AS":514,"4S-8D-AH":514,"4S-8D-AS":514,"4H-8H-AD":514,"4H-8S-AD":514,"4S-8H-AD":514,"4S-8S-AD":514,"4H-8H-AS":514,"4H-8S-AH":514,"4H-8S-AS":514,"4S-8H-AH":514,"4S-8H-AS":514,"4S-8S-AH":514,"3C-8C-AD":515,"3C-8C-AH":515,"3C-8C-AS":515,"3C-8D-AC":515,"3C-8H-AC":515,"3C-8S-AC":515,"3C-8D-AD":515,"3C-8D-AH":515,"3C-8D-AS":515,"3C-8H-AD":515,"3C-8S-AD":515,"3C-8H-AH":515,"3C-8H-AS":515,"3C-8S-AH":515,"3C-8S-AS":515,"3D-8C-AC":515,"3H-8C-AC":515,"3S-8C-AC":515,"3D-8C-AD":515,"3D-8C-AH":515,"3D-8C-AS":515,"3H-8C-AD":515,"3S-8C-AD":515,"3H-8C-AH":515,"3H-8C-AS":515,"3S-8C-AH":515,"3S-8C-AS":515,"3D-8D-AC":515,"3D-8H-AC":515,"3D-8S-AC":515,"3H-8D-AC":515,"3S-8D-AC":515,"3H-8H-AC":515,"3H-8S-AC":515,"3S-8H-AC":515,"3S-8S-AC":515,"3D-8D-AH":515,"3D-8D-AS":515,"3D-8H-AD":515,"3D-8S-AD":515,"3D-8H-AH":515,"3D-8H-AS":515,"3D-8S-AH":515,"3D-8S-AS":515,"3H-8D-AD":515,"3S-8D-AD":515,"3H-8D-AH":515,"3H-8D-AS":515,"3S-8D-AH":515,"3S-8D-AS":515,"3H-8H-AD":515,"3H-8S-AD":515,"3S-8H-AD":515,"3S-8S-AD":515,"3H-8H-AS":515,"3H-8S-AH":515,"3H-8S-AS":515,"3S-8H-AH":515,"3S-8H-AS":515,"3S-8S-AH":515,"2C-8C-AD":516,"2C-8C-AH":516,"2C-8C-AS":516,"2C-8D-AC":516,"2C-8H-AC":516,"2C-8S-AC":516,"2C-8D-AD":516,"2C-8D-AH":516,"2C-8D-AS":516,"2C-8H-AD":516,"2C-8S-AD":516,"2C-8H-AH":516,"2C-8H-AS":516,"2C-8S-AH":516,"2C-8S-AS":516,"2D-8C-AC":516,"2H-8C-AC":516,"2S-8C-AC":516,"2D-8C-AD":516,"2D-8C-AH":516,"2D-8C-AS":516,"2H-8C-AD":516,"2S-8C-AD":516,"2H-8C-AH":516,"2H-8C-AS":516,"2S-8C-AH":516,"2S-8C-AS":516,"2D-8D-AC":516,"2D-8H-AC":516,"2D-8S-AC":516,"2H-8D-AC":516,"2S-8D-AC":516,"2H-8H-AC":516,"2H-8S-AC":516,"2S-8H-AC":516,"2S-8S-AC":516,"2D-8D-AH":516,"2D-8D-AS":516,"2D-8H-AD":516,"2D-8S-AD":516,"2D-8H-AH":516,"2D-8H-AS":516,"2D-8S-AH":516,"2D-8S-AS":516,"2H-8D-AD":516,"2S-8D-AD":516,"2H-8D-AH":516,"2H-8D-AS":516,"2S-8D-AH":516,"2S-8D-AS":516,"2H-8H-AD":516,"2H-8S-AD":516,"2S-8H-AD":516,"2S-8S-AD":516,"2H-8H-AS":516,"2H-8S-AH":516,"2H-8S-AS":516,"2S-8H-AH":516,"2S-8H-AS":516,"2S-8S-AH":516,"6C-7C-AD":517,"6C-7C-AH":517,"6C-7C-AS":517,"6C-7D-AC":517,"6C-7H-AC":517,"6C-7S-AC":517,"6C-7D-AD":517,"6C-7D-AH":517,"6C-7D-AS":517,"6C-7H-AD":517,"6C-7S-AD":517,"6C-7H-AH":517,"6C-7H-AS":517,"6C-7S-AH":517,"6C-7S-AS":517,"6D-7C-AC":517,"6H-7C-AC":517,"6S-7C-AC":517,"6D-7C-AD":517,"6D-7C-AH":517,"6D-7C-AS":517,"6H-7C-AD":517,"6S-7C-AD":517,"6H-7C-AH":517,"6H-7C-AS":517,"6S-7C-AH":517,"6S-7C-AS":517,"6D-7D-AC":517,"6D-7H-AC":517,"6D-7S-AC":517,"6H-7D-AC":517,"6S-7D-AC":517,"6H-7H-AC":517,"6H-7S-AC":517,"6S-7H-AC":517,"6S-7S-AC":517,"6D-7D-AH":517,"6D-7D-AS":517,"6D-7H-AD":517,"6D-7S-AD":517,"6D-7H-AH":517,"6D-7H-AS":517,"6D-7S-AH":517,"6D-7S-AS":517,"6H-7D-AD":517,"6S-7D-AD":517,"6H-7D-AH":517,"6H-7D-AS":517,"6S-7D-AH":517,"6S-7D-AS":517,"6H-7H-AD":517,"6H-7S-AD":517,"6S-7H-AD":517,"6S-7S-AD":517,"6H-7H-AS":517,"6H-7S-AH":517,"6H-7S-AS":517,"6S-7H-AH":517,"6S-7H-AS":517,"6S-7S-AH":517,"5C-7C-AD":518,"5C-7C-AH":518,"5C-7C-AS":518,"5C-7D-AC":518,"5C-7H-AC":518,"5C-7S-AC":518,"5C-7D-AD":518,"5C-7D-AH":518,"5C-7D-AS":518,"5C-7H-AD":518,"5C-7S-AD":518,"5C-7H-AH":518,"5C-7H-AS":518,"5C-7S-AH":518,"5C-7S-AS":518,"5D-7C-AC":518,"5H-7C-AC":518,"5S-7C-AC":518,"5D-7C-AD":518,"5D-7C-AH":518,"5D-7C-AS":518,"5H-7C-AD":518,"5S-7C-AD":518,"5H-7C-AH":518,"5H-7C-AS":518,"5S-7C-AH":518,"5S-7C-AS":518,"5D-7D-AC":518,"5D-7H-AC":518,"5D-7S-AC":518,"5H-7D-AC":518,"5S-7D-AC":518,"5H-7H-AC":518,"5H-7S-AC":518,"5S-7H-AC":518,"5S-7S-AC":518,"5D-7D-AH":518,"5D-7D-AS":518,"5D-7H-AD":518,"5D-7S-AD":518,"5D-7H-AH":518,"5D-7H-AS":518,"5D-7S-AH":518,"5D-7S-AS":518,"5H-7D-AD":518,"5S-7D-AD":518,"5H-7D-AH":518,"5H-7D-AS":518,"5S-7D-AH":518,"5S-7D-AS":518,"5H-7H-AD":518,"5H-7S-AD":518,"5S-7H-AD":518,"5S-7S-AD":518,"5H-7H-AS":518,"5H-7S-AH":518,"5H-7S-AS":518,"5S-7H-AH":518,"5S-7H-AS":518,"5S-7S-AH":518,"4C-7C-AD":519,"4C-7C-AH":519,"4C-7C-AS":519,"4C-7D-AC":519,"4C-7H-AC":519,"4C-7S-AC":519,"4C-7D-AD":519,"4C-7D-AH":519,"4C-7D-AS":519,"4C-7H-AD":519,"4C-7S-AD":519,"4C-7H-AH":519,"4C-7H-AS":519,"4C-7S-AH":519,"4C-7S-AS":519,"4D-7C-AC":519,"4H-7C-AC":519,"4S-7C-AC":519,"4D-7C-AD":519,"4D-7C-AH":519,"4D-7C-AS":519,"4H-7C-AD":519,"4S-7C-AD":519,"4H-7C-AH":519,"4H-7C-AS":519,"4S-7C-AH":519,"4S-7C-AS":519,"4D-7D-AC":519,"4D-7H-AC":519,"4D-7S-AC":519,"4H-7D-AC":519,"4S-7D-AC":519,"4H-7H-AC":519,"4H-7S-AC":519,"4S-7H-AC":519,"4S-7S-AC":519,"4D-7D-AH":519,"4D-7D-AS":519,"4D-7H-AD":519,"4D-7S-AD":519,"4D-7H-AH":519,"4D-7H-AS":519,"4D-7S-AH":519,"4D-7S-AS":519,"4H-7D-AD":519,"4S-7D-AD":519,"4H-7D-AH":519,"4H-7D-AS":519,"4S-7D-AH":519,"4S-7D-AS":519,"4H-7H-AD":519,"4H-7S-AD":519,"4S-7H-AD":519,"4S-7S-AD":519,"4H-7H-AS":519,"4H-7S-AH":519,"4H-7S-AS":519,"4S-7H-AH":519,"4S-7H-AS":519,"4S-7S-AH":519,"3C-7C-AD":520,"3C-7C-AH":520,"3C-7C-AS":520,"3C-7D-AC":520,"3C-7H-AC":520,"3C-7S-AC":520,"3C-7D-AD":520,"3C-7D-AH":520,"3C-7D-AS":520,"3C-7H-AD":520,"3C-7S-AD":520,"3C-7H-AH":520,"3C-7H-AS":520,"3C-7S-AH":520,"3C-7S-AS":520,"3D-7C-AC":520,"3H-7C-AC":520,"3S-7C-AC":520,"3D-7C-AD":520,"3D-7C-AH":520,"3D-7C-AS":520,"3H-7C-AD":520,"3S-7C-AD":520,"3H-7C-AH":520,"3H-7C-AS":520,"3S-7C-AH":520,"3S-7C-AS":520,"3D-7D-AC":520,"3D-7H-AC":520,"3D-7S-AC":520,"3H-7D-AC":520,"3S-7D-AC":520,"3H-7H-AC":520,"3H-7S-AC":520,"3S-7H-AC":520,"3S-7S-AC":520,"3D-7D-AH":520,"3D-7D-AS":520,"3D-7H-AD":520,"3D-7S-AD":520,"3D-7H-AH":520,"3D-7H-AS":520,"3D-7S-AH":520,"3D-7S-AS":520,"3H-7D-AD":520,"3S-7D-AD":520,"3H-7D-AH":520,"3H-7D-AS":520,"3S-7D-AH":520,"3S-7D-AS":520,"3H-7H-AD":520,"3H-7S-AD":520,"3S-7H-AD":520,"3S-7S-AD":520,"3H-7H-AS":520,"3H-7S-AH":520,"3H-7S-AS":520,"3S-7H-AH":520,"3S-7H-AS":520,"3S-7S-AH":520,"2C-7C-AD":521,"2C-7C-AH":521,"2C-7C-AS":521,"2C-7D-AC":521,"2C-7H-AC":521,"2C-7S-AC":521,"2C-7D-AD":521,"2C-7D-AH":521,"2C-7D-AS":521,"2C-7H-AD":521,"2C-7S-AD":521,"2C-7H-AH":521,"2C-7H-AS":521,"2C-7S-AH":521,"2C-7S-AS":521,"2D-7C-AC":521,"2H-7C-AC":521,"2S-7C-AC":521,"2D-7C-AD":521,"2D-7C-AH":521,"2D-7C-AS":521,"2H-7C-AD":521,"2S-7C-AD":521,"2H-7C-AH":521,"2H-7C-AS":521,"2S-7C-AH":521,"2S-7C-AS":521,"2D-7D-AC":521,"2D-7H-AC":521,"2D-7S-AC":521,"2H-7D-AC":521,"2S-7D-AC":521,"2H-7H-AC":521,"2H-7S-AC":521,"2S-7H-AC":521,"2S-7S-AC":521,"2D-7D-AH":521,"2D-7D-AS":521,"2D-7H-AD":521,"2D-7S-AD":521,"2D-7H-AH":521,"2D-7H-AS":521,"2D-7S-AH":521,"2D-7S-AS":521,"2H-7D-AD":521,"2S-7D-AD":521,"2H-7D-AH":521,"2H-7D-AS":521,"2S-7D-AH":521,"2S-7D-AS":521,"2H-7H-AD":521,"2H-7S-AD":521,"2S-7H-AD":521,"2S-7S-AD":521,"2H-7H-AS":521,"2H-7S-AH":521,"2H-7S-AS":521,"2S-7H-AH":521,"2S-7H-AS":521,"2S-7S-AH":521,"5C-6C-AD":522,"5C-6C-AH":522,"5C-6C-AS":522,"5C-6D-AC":522,"5C-6H-AC":522,"5C-6S-AC":522,"5C-6D-AD":522,"5C-6D-AH":522,"5C-6D-AS":522,"5C-6H-AD":522,"5C-6S-AD":522,"5C-6H-AH":522,"5C-6H-AS":522,"5C-6S-AH":522,"5C-6S-AS":522,"5D-6C-AC":522,"5H-6C-AC":522,"5S-6C-AC":522,"5D-6C-AD":522,"5D-6C-AH":522,"5D-6C-AS":522,"5H-6C-AD":522,"5S-6C-AD":522,"5H-6C-AH":522,"5H-6C-AS":522,"5S-6C-AH":522,"5S-6C-AS":522,"5D-6D-AC":522,"5D-6H-AC":522,"5D-6S-AC":522,"5H-6D-AC":522,"5S-6D-AC":522,"5H-6H-AC":522,"5H-6S-AC":522,"5S-6H-AC":522,"5S-6S-AC":522,"5D-6D-AH":522,"5D-6D-AS":522,"5D-6H-AD":522,"5D-6S-AD":522,"5D-6H-AH":522,"5D-6H-AS":522,"5D-6S-AH":522,"5D-6S-AS":522,"5H-6D-AD":522,"5S-6D-AD":522,"5H-6D-AH":522,"5H-6D-AS":522,"5S-6D-AH":522,"5S-6D-AS":522,"5H-6H-AD":522,"5H-6S-AD":522,"5S-6H-AD":522,"5S-6S-AD":522,"5H-6H-AS":522,"5H-6S-AH":522,"5H-6S-AS":522,"5S-6H-AH":522,"5S-6H-AS":522,"5S-6S-AH":522,"4C-6C-AD":523,"4C-6C-AH":523,"4C-6C-AS":523,"4C-6D-AC":523,"4C-6H-AC":523,"4C-6S-AC":523,"4C-6D-AD":523,"4C-6D-AH":523,"4C-6D-AS":523,"4C-6H-AD":523,"4C-6S-AD":523,"4C-6H-AH":523,"4C-6H-AS":523,"4C-6S-AH":523,"4C-6S-AS":523,"4D-6C-AC":523,"4H-6C-AC":523,"4S-6C-AC":523,"4D-6C-AD":523,"4D-6C-AH":523,"4D-6C-AS":523,"4H-6C-AD":523,"4S-6C-AD":523,"4H-6C-AH":523,"4H-6C-AS":523,"4S-6C-AH":523,"4S-6C-AS":523,"4D-6D-AC":523,"4D-6H-AC":523,"4D-6S-AC":523,"4H-6D-AC":523,"4S-6D-AC":523,"4H-6H-AC":523,"4H-6S-AC":523,"4S-6H-AC":523,"4S-6S-AC":523,"4D-6D-AH":523,"4D-6D-AS":523,"4D-6H-AD":523,"4D-6S-AD":523,"4D-6H-AH":523,"4D-6H-AS":523,"4D-6S-AH":523,"4D-6S-AS":523,"4H-6D-AD":523,"4S-6D-AD":523,"4H-6D-AH":523,"4H-6D-AS":523,"4S-6D-AH":523,"4S-6D-AS":523,"4H-6H-AD":523,"4H-6S-AD":523,"4S-6H-AD":523,"4S-6S-AD":523,"4H-6H-AS":523,"4H-6S-AH":523,"4H-6S-AS":523,"4S-6H-AH":523,"4S-6H-AS":523,"4S-6S-AH":523,"3C-6C-AD":524,"3C-6C-AH":524,"3C-6C-AS":524,"3C-6D-AC":524,"3C-6H-AC":524,"3C-6S-AC":524,"3C-6D-AD":524,"3C-6D-AH":524,"3C-6D-AS":524,"3C-6H-AD":524,"3C-6S-AD":524,"3C-6H-AH":524,"3C-6H-AS":524,"3C-6S-AH":524,"3C-6S-AS":524,"3D-6C-AC":524,"3H-6C-AC":524,"3S-6C-AC":524,"3D-6C-AD":524,"3D-6C-AH":524,"3D-6C-AS":524,"3H-6C-AD":524,"3S-6C-AD":524,"3H-6C-AH":524,"3H-6C-AS":524,"3S-6C-AH":524,"3S-6C-AS":524,"3D-6D-AC":524,"3D-6H-AC":524,"3D-6S-AC":524,"3H-6D-AC":524,"3S-6D-AC":524,"3H-6H-AC":524,"3H-6S-AC":524,"3S-6H-AC":524,"3S-6S-AC":524,"3D-6D-AH":524,"3D-6D-AS":524,"3D-6H-AD":524,"3D-6S-AD":524,"3D-6H-AH":524,"3D-6H-AS":524,"3D-6S-AH":524,"3D-6S-AS":524,"3H-6D-AD":524,"3S-6D-AD":524,"3H-6D-AH":524,"3H-6D-AS":524,"3S-6D-AH":524,"3S-6D-AS":524,"3H-6H-AD":524,"3H-6S-AD":524,"3S-6H-AD":524,"3S-6S-AD":524,"3H-6H-AS":524,"3H-6S-AH":524,"3H-6S-AS":524,"3S-6H-AH":524,"3S-6H-AS":524,"3S-6S-AH":524,"2C-6C-AD":525,"2C-6C-AH":525,"2C-6C-AS":525,"2C-6D-AC":525,"2C-6H-AC":525,"2C-6S-AC":525,"2C-6D-AD":525,"2C-6D-AH":525,"2C-6D-AS":525,"2C-6H-AD":525,"2C-6S-AD":525,"2C-6H-AH":525,"2C-6H-AS":525,"2C-6S-AH":525,"2C-6S-AS":525,"2D-6C-AC":525,"2H-6C-AC":525,"2S-6C-AC":525,"2D-6C-AD":525,"2D-6C-AH":525,"2D-6C-AS":525,"2H-6C-AD":525,"2S-6C-AD":525,"2H-6C-AH":525,"2H-6C-AS":525,"2S-6C-AH":525,"2S-6C-AS":525,"2D-6D-AC":525,"2D-6H-AC":525,"2D-6S-AC":525,"2H-6D-AC":525,"2S-6D-AC":525,"2H-6H-AC":525,"2H-6S-AC":525,"2S-6H-AC":525,"2S-6S-AC":525,"2D-6D-AH":525,"2D-6D-AS":525,"2D-6H-AD":525,"2D-6S-AD":525,"2D-6H-AH":525,"2D-6H-AS":525,"2D-6S-AH":525,"2D-6S-AS":525,"2H-6D-AD":525,"2S-6D-AD":525,"2H-6D-AH":525,"2H-6D-AS":525,"2S-6D-AH":525,"2S-6D-AS":525,"2H-6H-AD":525,"2H-6S-AD":525,"2S-6H-AD":525,"2S-6S-AD":525,"2H-6H-AS":525,"2H-6S-AH":525,"2H-6S-AS":525,"2S-6H-AH":525,"2S-6H-AS":525,"2S-6S-AH":525,"4C-5C-AD":526,"4C-5C-AH":526,"4C-5C-AS":526,"4C-5D-AC":526,"4C-5H-AC":526,"4C-5S-AC":526,"4C-5D-AD":526,"4C-5D-AH":526,"4C-5D-AS":526,"4C-5H-AD":526,"4C-5S-AD":526,"4C-5H-AH":526,"4C-5H-AS":526,"4C-5S-AH":526,"4C-5S-AS":526,"4D-5C-AC":526,"4H-5C-AC":526,"4S-5C-AC":526,"4D-5C-AD":526,"4D-5C-AH":526,"4D-5C-AS":526,"4H-5C-AD":526,"4S-5C-AD":526,"4H-5C-AH":526,"4H-5C-AS":526,"4S-5C-AH":526,"4S-5C-AS":526,"4D-5D-AC":526,"4D-5H-AC":526,"4D-5S-AC":526,"4H-5D-AC":526,"4S-5D-AC":526,"4H-5H-AC":526,"4H-5S-AC":526,"4S-5H-AC":526,"4S-5S-AC":526,"4D-5D-AH":526,"4D-5D-AS":526,"4D-5H-AD":526,"4D-5S-AD":526,"4D-5H-AH":526,"4D-5H-AS":526,"4D-5S-AH":526,"4D-5S-AS":526,"4H-5D-AD":526,"4S-5D-AD":526,"4H-5D-AH":526,"4H-5D-AS":526,"4S-5D-AH":526,"4S-5D-AS":526,"4H-5H-AD":526,"4H-5S-AD":526,"4S-5H-AD":526,"4S-5S-AD":526,"4H-5H-AS":526,"4H-5S-AH":526,"4H-5S-AS":526,"4S-5H-AH":526,"4S-5H-AS":526,"4S-5S-AH":526,"3C-5C-AD":527,"3C-5C-AH":527,"3C-5C-AS":527,"3C-5D-AC":527,"3C-5H-AC":527,"3C-5S-AC":527,"3C-5D-AD":527,"3C-5D-AH":527,"3C-5D-AS":527,"3C-5H-AD":527,"3C-5S-AD":527,"3C-5H-AH":527,"3C-5H-AS":527,"3C-5S-AH":527,"3C-5S-AS":527,"3D-5C-AC":527,"3H-5C-AC":527,"3S-5C-AC":527,"3D-5C-AD":527,"3D-5C-AH":527,"3D-5C-AS":527,"3H-5C-AD":527,"3S-5C-AD":527,"3H-5C-AH":527,"3H-5C-AS":527,"3S-5C-AH":527,"3S-5C-AS":527,"3D-5D-AC":527,"3D-5H-AC":527,"3D-5S-AC":527,"3H-5D-AC":527,"3S-5D-AC":527,"3H-5H-AC":527,"3H-5S-AC":527,"3S-5H-AC":527,"3S-5S-AC":527,"3D-5D-AH":527,"3D-5D-AS":527,"3D-5H-AD":527,"3D-5S-AD":527,"3D-5H-AH":527,"3D-5H-AS":527,"3D-5S-AH":527,"3D-5S-AS":527,"3H-5D-AD":527,"3S-5D-AD":527,"3H-5D-AH":527,"3H-5D-AS":527,"3S-5D-AH":527,"3S-5D-AS":527,"3H-5H-AD":527,"3H-5S-AD":527,"3S-5H-AD":527,"3S-5S-AD":527,"3H-5H-AS":527,"3H-5S-AH":527,"3H-5S-AS":527,"3S-5H-AH":527,"3S-5H-AS":527,"3S-5S-AH":527,"2C-5C-AD":528,"2C-5C-AH":528,"2C-5C-AS":528,"2C-5D-AC":528,"2C-5H-AC":528,"2C-5S-AC":528,"2C-5D-AD":528,"2C-5D-AH":528,"2C-5D-AS":528,"2C-5H-AD":528,"2C-5S-AD":528,"2C-5H-AH":528,"2C-5H-AS":528,"2C-5S-AH":528,"2C-5S-AS":528,"2D-5C-AC":528,"2H-5C-AC":528,"2S-5C-AC":528,"2D-5C-AD":528,"2D-5C-AH":528,"2D-5C-AS":528,"2H-5C-AD":528,"2S-5C-AD":528,"2H-5C-AH":528,"2H-5C-AS":528,"2S-5C-AH":528,"2S-5C-AS":528,"2D-5D-AC":528,"2D-5H-AC":528,"2D-5S-AC":528,"2H-5D-AC":528,"2S-5D-AC":528,"2H-5H-AC":528,"2H-5S-AC":528,"2S-5H-AC":528,"2S-5S-AC":528,"2D-5D-AH":528,"2D-5D-AS":528,"2D-5H-AD":528,"2D-5S-AD":528,"2D-5H-AH":528,"2D-5H-AS":528,"2D-5S-AH":528,"2D-5S-AS":528,"2H-5D-AD":528,"2S-5D-AD":528,"2H-5D-AH":528,"2H-5D-AS":528,"2S-5D-AH":528,"2S-5D-AS":528,"2H-5H-AD":528,"2H-5S-AD":528,"2S-5H-AD":528,"2S-5S-AD":528,"2H-5H-AS":528,"2H-5S-AH":528,"2H-5S-AS":528,"2S-5H-AH":528,"2S-5H-AS":528,"2S-5S-AH":528,"3C-4C-AD":529,"3C-4C-AH":529,"3C-4C-AS":529,"3C-4D-AC":529,"3C-4H-AC":529,"3C-4S-AC":529,"3C-4D-AD":529,"3C-4D-AH":529,"3C-4D-AS":529,"3C-4H-AD":529,"3C-4S-AD":529,"3C-4H-AH":529,"3C-4H-AS":529,"3C-4S-AH":529,"3C-4S-AS":529,"3D-4C-AC":529,"3H-4C-AC":529,"3S-4C-AC":529,"3D-4C-AD":529,"3D-4C-AH":529,"3D-4C-AS":529,"3H-4C-AD":529,"3S-4C-AD":529,"3H-4C-AH":529,"3H-4C-AS":529,"3S-4C-AH":529,"3S-4C-AS":529,"3D-4D-AC":529,"3D-4H-AC":529,"3D-4S-AC":529,"3H-4D-AC":529,"3S-4D-AC":529,"3H-4H-AC":529,"3H-4S-AC":529,"3S-4H-AC":529,"3S-4S-AC":529,"3D-4D-AH":529,"3D-4D-AS":529,"3D-4H-AD":529,"3D-4S-AD":529,"3D-4H-AH":529,"3D-4H-AS":529,"3D-4S-AH":529,"3D-4S-AS":529,"3H-4D-AD":529,"3S-4D-AD":529,"3H-4D-AH":529,"3H-4D-AS":529,"3S-4D-AH":529,"3S-4D-AS":529,"3H-4H-AD":529,"3H-4S-AD":529,"3S-4H-AD":529,"3S-4S-AD":529,"3H-4H-AS":529,"3H-4S-AH":529,"3H-4S-AS":529,"3S-4H-AH":529,"3S-4H-AS":529,"3S-4S-AH":529,"2C-4C-AD":530,"2C-4C-AH":530,"2C-4C-AS":530,"2C-4D-AC":530,"2C-4H-AC":530,"2C-4S-AC":530,"2C-4D-AD":530,"2C-4D-AH":530,"2C-4D-AS":530,"2C-4H-AD":530,"2C-4S-AD":530,"2C-4H-AH":530,"2C-4H-AS":530,"2C-4S-AH":530,"2C-4S-AS":530,"2D-4C-AC":530,"2H-4C-AC":530,"2S-4C-AC":530,"2D-4C-AD":530,"2D-4C-AH":530,"2D-4C-AS":530,"2H-4C-AD":530,"2S-4C-AD":530,"2H-4C-AH":530,"2H-4C-AS":530,"2S-4C-AH":530,"2S-4C-AS":530,"2D-4D-AC":530,"2D-4H-AC":530,"2D-4S-AC":530,"2H-4D-AC":530,"2S-4D-AC":530,"2H-4H-AC":530,"2H-4S-AC":530,"2S-4H-AC":530,"2S-4S-AC":530,"2D-4D-AH":530,"2D-4D-AS":530,"2D-4H-AD":530,"2D-4S-AD":530,"2D-4H-AH":530,"2D-4H-AS":530,"2D-4S-AH":530,"2D-4S-AS":530,"2H-4D-AD":530,"2S-4D-AD":530,"2H-4D-AH":530,"2H-4D-AS":530,"2S-4D-AH":530,"2S-4D-AS":530,"2H-4H-AD":530,"2H-4S-AD":530,"2S-4H-AD":530,"2S-4S-AD":530,"2H-4H-AS":530,"2H-4S-AH":530,"2H-4S-AS":530,"2S-4H-AH":530,"2S-4H-AS":530,"2S-4S-AH":530,"10C-KD-QC":531,"10C-KH-QC":531,"10C-KS-QC":531,"10C-KC-QD":531,"10C-KC-QH":531,"10C-KC-QS":531,"10C-KD-QD":531,"10C-KH-QD":531,"10C-KS-QD":531,"10C-KD-QH":531,"10C-KD-QS":531,"10C-KH-QH":531,"10C-KS-QH":531,"10C-KH-QS":531,"10C-KS-QS":531,"10D-KC-QC":531,"10H-KC-QC":531,"10S-KC-QC":531,"10D-KD-QC":531,"10D-KH-QC":531,"10D-KS-QC":531,"10H-KD-QC":531,"10S-KD-QC":531,"10H-KH-QC":531,"10H-KS-QC":531,"10S-KH-QC":531,"10S-KS-QC":531,"10D-KC-QD":531,"10D-KC-QH":531,"10D-KC-QS":531,"10H-KC-QD":531,"10S-KC-QD":531,"10H-KC-QH":531,"10H-KC-QS":531,"10S-KC-QH":531,"10S-KC-QS":531,"10D-KH-QD":531,"10D-KS-QD":531,"10D-KD-QH":531,"10D-KD-QS":531,"10D-KH-QH":531,"10D-KS-QH":531,"10D-KH-QS":531,"10D-KS-QS":531,"10H-KD-QD":531,"10S-KD-QD":531,"10H-KH-QD":531,"10H-KS-QD":531,"10S-KH-QD":531,"10S-KS-QD":531,"10H-KD-QH":531,"10H-KD-QS":531,"10S-KD-QH":531,"10S-KD-QS":531,"10H-KS-QH":531,"10H-KH-QS":531,"10H-KS-QS":531,"10S-KH-QH":531,"10S-KS-QH":531,"10S-KH-QS":531,"9C-KD-QC":532,"9C-KH-QC":532,"9C-KS-QC":532,"9C-KC-QD":532,"9C-KC-QH":532,"9C-KC-QS":532,"9C-KD-QD":532,"9C-KH-QD":532,"9C-KS-QD":532,"9C-KD-QH":532,"9C-KD-QS":532,"9C-KH-QH":532,"9C-KS-QH":532,"9C-KH-QS":532,"9C-KS-QS":532,"9D-KC-QC":532,"9H-KC-QC":532,"9S-KC-QC":532,"9D-KD-QC":532,"9D-KH-QC":532,"9D-KS-QC":532,"9H-KD-QC":532,"9S-KD-QC":532,"9H-KH-QC":532,"9H-KS-QC":532,"9S-KH-QC":532,"9S-KS-QC":532,"9D-KC-QD":532,"9D-KC-QH":532,"9D-KC-QS":532,"9H-KC-QD":532,"9S-KC-QD":532,"9H-KC-QH":532,"9H-KC-QS":532,"9S-KC-QH":532,"9S-KC-QS":532,"9D-KH-QD":532,"9D-KS-QD":532,"9D-KD-QH":532,"9D-KD-QS":532,"9D-KH-QH":532,"9D-KS-QH":532,"9D-KH-QS":532,"9D-KS-QS":532,"9H-KD-QD":532,"9S-KD-QD":532,"9H-KH-QD":532,"9H-KS-QD":532,"9S-KH-QD":532,"9S-KS-QD":532,"9H-KD-QH":532,"9H-KD-QS":532,"9S-KD-QH":532,"9S-KD-QS":532,"9H-KS-QH":532,"9H-KH-QS":532,"9H-KS-QS":532,"9S-KH-QH":532,"9S-KS-QH":532,"9S-KH-QS":532,"8C-KD-QC":533,"8C-KH-QC":533,"8C-KS-QC":533,"8C-KC-QD":533,"8C-KC-QH":533,"8C-KC-QS":533,"8C-KD-QD":533,"8C-KH-QD":533,"8C-KS-QD":533,"8C-KD-QH":533,"8C-KD-QS":533,"8C-KH-QH":533,"8C-KS-QH":533,"8C-KH-QS":533,"8C-KS-QS":533,"8D-KC-QC":533,"8H-KC-QC":533,"8S-KC-QC":533,"8D-KD-QC":533,"8D-KH-QC":533,"8D-KS-QC":533,"8H-KD-QC":533,"8S-KD-QC":533,"8H-KH-QC":533,"8H-KS-QC":533,"8S-KH-QC":533,"8S-KS-QC":533,"8D-KC-QD":533,"8D-KC-QH":533,"8D-KC-QS":533,"8H-KC-QD":533,"8S-KC-QD":533,"8H-KC-QH":533,"8H-KC-QS":533,"8S-KC-QH":533,"8S-KC-QS":533,"8D-KH-QD":533,"8D-KS-QD":533,"8D-KD-QH":533,"8D-KD-QS":533,"8D-KH-QH":533,"8D-KS-QH":533,"8D-KH-QS":533,"8D-KS-QS":533,"8H-KD-QD":533,"8S-KD-QD":533,"8H-KH-QD":533,"8H-KS-QD":533,"8S-KH-QD":533,"8S-KS-QD":533,"8H-KD-QH":533,"8H-KD-QS":533,"8S-KD-QH":533,"8S-KD-QS":533,"8H-KS-QH":533,"8H-KH-QS":533,"8H-KS-QS":533,"8S-KH-QH":533,"8S-KS-QH":533,"8S-KH-QS":533,"7C-KD-QC":534,"7C-KH-QC":534,"7C-KS-QC":534,"7C-KC-QD":534,"7C-KC-QH":534,"7C-KC-QS":534,"7C-KD-QD":534,"7C-KH-QD":534,"7C-KS-QD":534,"7C-KD-QH":534,"7C-KD-QS":534,"7C-KH-QH":534,"7C-KS-QH":534,"7C-KH-QS":534,"7C-KS-QS":534,"7D-KC-QC":534,"7H-KC-QC":534,"7S-KC-QC":534,"7D-KD-QC":534,"7D-KH-QC":534,"7D-KS-QC":534,"7H-KD-QC":534,"7S-KD-QC":534,"7H-KH-QC":534,"7H-KS-QC":534,"7S-KH-QC":534,"7S-KS-QC":534,"7D-KC-QD":534,"7D-KC-QH":534,"7D-KC-QS":534,"7H-KC-QD":534,"7S-KC-QD":534,"7H-KC-QH":534,"7H-KC-QS":534,"7S-KC-QH":534,"7S-KC-QS":534,"7D-KH-QD":534,"7D-KS-QD":534,"7D-KD-QH":534,"7D-KD-QS":534,"7D-KH-QH":534,"7D-KS-QH":534,"7D-KH-QS":534,"7D-KS-QS":534,"7H-KD-QD":534,"7S-KD-QD":534,"7H-KH-QD":534,"7H-KS-QD":534,"7S-KH-QD":534,"7S-KS-QD":534,"7H-KD-QH":534,"7H-KD-QS":534,"7S-KD-QH":534,"7S-KD-QS":534,"7H-KS-QH":534,"7H-KH-QS":534,"7H-KS-QS":534,"7S-KH-QH":534,"7S-KS-QH":534,"7S-KH-QS":534,"6C-KD-QC":535,"6C-KH-QC":535,"6C-KS-QC":535,"6C-KC-QD":535,"6C-KC-QH":535,"6C-KC-QS":535,"6C-KD-QD":535,"6C-KH-QD":535,"6C-KS-QD":535,"6C-KD-QH":535,"6C-KD-QS":535,"6C-KH-QH":535,"6C-KS-QH":535,"6C-KH-QS":535,"6C-KS-QS":535,"6D-KC-QC":535,"6H-KC-QC":535,"6S-KC-QC":535,"6D-KD-QC":535,"6D-KH-QC":535,"6D-KS-QC":535,"6H-KD-QC":535,"6S-KD-QC":535,"6H-KH-QC":535,"6H-KS-QC":535,"6S-KH-QC":535,"6S-KS-QC":535,"6D-KC-QD":535,"6D-KC-QH":535,"6D-KC-QS":535,"6H-KC-QD":535,"6S-KC-QD":535,"6H-KC-QH":535,"6H-KC-QS":535,"6S-KC-QH":535,"6S-KC-QS":535,"6D-KH-QD":535,"6D-KS-QD":535,"6D-KD-QH":535,"6D-KD-QS":535,"6D-KH-QH":535,"6D-KS-QH":535,"6D-KH-QS":535,"6D-KS-QS":535,"6H-KD-QD":535,"6S-KD-QD":535,"6H-KH-QD":535,"6H-KS-QD":535,"6S-KH-QD":535,"6S-KS-QD":535,"6H-KD-QH":535,"6H-KD-QS":535,"6S-KD-QH":535,"6S-KD-QS":535,"6H-KS-QH":535,"6H-KH-QS":535,"6H-KS-QS":535,"6S-KH-QH":535,"6S-KS-QH":535,"6S-KH-QS":535,"5C-KD-QC":536,"5C-KH-QC":536,"5C-KS-QC":536,"5C-KC-QD":536,"5C-KC-QH":536,"5C-KC-QS":536,"5C-KD-QD":536,"5C-KH-QD":536,"5C-KS-QD":536,"5C-KD-QH":536,"5C-KD-QS":536,"5C-KH-QH":536,"5C-KS-QH":536,"5C-KH-QS":536,"5C-KS-QS":536,"5D-KC-QC":536,"5H-KC-QC":536,"5S-KC-QC":536,"5D-KD-QC":536,"5D-KH-QC":536,"5D-KS-QC":536,"5H-KD-QC":536,"5S-KD-QC":536,"5H-KH-QC":536,"5H-KS-QC":536,"5S-KH-QC":536,"5S-KS-QC":536,"5D-KC-QD":536,"5D-KC-QH":536,"5D-KC-QS":536,"5H-KC-QD":536,"5S-KC-QD":536,"5H-KC-QH":536,"5H-KC-QS":536,"5S-KC-QH":536,"5S-KC-QS":536,"5D-KH-QD":536,"5D-KS-QD":536,"5D-KD-QH":536,"5D-KD-QS":536,"5D-KH-QH":536,"5D-KS-QH":536,"5D-KH-QS":536,"5D-KS-QS":536,"5H-KD-QD":536,"5S-KD-QD":536,"5H-KH-QD":536,"5H-KS-QD":536,"5S-KH-QD":536,"5S-KS-QD":536,"5H-KD-QH":536,"5H-KD-QS":536,"5S-KD-QH":536,"5S-KD-QS":536,"5H-KS-QH":536,"5H-KH-QS":536,"5H-KS-QS":536,"5S-KH-QH":536,"5S-KS-QH":536,"5S-KH-QS":536,"4C-KD-QC":537,"4C-KH-QC":537,"4C-KS-QC":537,"4C-KC-QD":537,"4C-KC-QH":537,"4C-KC-QS":537,"4C-KD-QD":537,"4C-KH-QD":537,"4C-KS-QD":537,"4C-KD-QH":537,"4C-KD-QS":537,"4C-KH-QH":537,"4C-KS-QH":537,"4C-KH-QS":537,"4C-KS-QS":537,"4D-KC-QC":537,"4H-KC-QC":537,"4S-KC-QC":537,"4D-KD-QC":537,"4D-KH-QC":537,"4D-KS-QC":537,"4H-KD-QC":537,"4S-KD-QC":537,"4H-KH-QC":537,"4H-KS-QC":537,"4S-KH-QC":537,"4S-KS-QC":537,"4D-KC-QD":537,"4D-KC-QH":537,"4D-KC-QS":537,"4H-KC-QD":537,"4S-KC-QD":537,"4H-KC-QH":537,"4H-KC-QS":537,"4S-KC-QH":537,"4S-KC-QS":537,"4D-KH-QD":537,"4D-KS-QD":537,"4D-KD-QH":537,"4D-KD-QS":537,"4D-KH-QH":537,"4D-KS-QH":537,"4D-KH-QS":537,"4D-KS-QS":537,"4H-KD-QD":537,"4S-KD-QD":537,"4H-KH-QD":537,"4H-KS-QD":537,"4S-KH-QD":537,"4S-KS-QD":537,"4H-KD-QH":537,"4H-KD-QS":537,"4S-KD-QH":537,"4S-KD-QS":537,"4H-KS-QH":537,"4H-KH-QS":537,"4H-KS-QS":537,"4S-KH-QH":537,"4S-KS-QH":537,"4S-KH-QS":537,"3C-KD-QC":538,"3C-KH-QC":538,"3C-KS-QC":538,"3C-KC-QD":538,"3C-KC-QH":538,"3C-KC-QS":538,"3C-KD-QD":538,"3C-KH-QD":538,"3C-KS-QD":538,"3C-KD-QH":538,"3C-KD-QS":538,"3C-KH-QH":538,"3C-KS-QH":538,"3C-KH-QS":538,"3C-KS-QS":538,"3D-KC-QC":538,"3H-KC-QC":538,"3S-KC-QC":538,"3D-KD-QC":538,"3D-KH-QC":538,"3D-KS-QC":538,"3H-KD-QC":538,"3S-KD-QC":538,"3H-KH-QC":538,"3H-KS-QC":538,"3S-KH-QC":538,"3S-KS-QC":538,"3D-KC-QD":538,"3D-KC-QH":538,"3D-KC-QS":538,"3H-KC-QD":538,"3S-KC-QD":538,"3H-KC-QH":538,"3H-KC-QS":538,"3S-KC-QH":538,"3S-KC-QS":538,"3D-KH-QD":538,"3D-KS-QD":538,"3D-KD-QH":538,"3D-KD-QS":538,"3D-KH-QH":538,"3D-KS-QH":538,"3D-KH-QS":538,"3D-KS-QS":538,"3H-KD-QD":538,"3S-KD-QD":538,"3H-KH-QD":538,"3H-KS-QD":538,"3S-KH-QD":538,"3S-KS-QD":538,"3H-KD-QH":538,"3H-KD-QS":538,"3S-KD-QH":538,"3S-KD-QS":538,"3H-KS-QH":538,"3H-KH-QS":538,"3H-KS-QS":538,"3S-KH-QH":538,"3S-KS-QH":538,"3S-KH-QS":538,"2C-KD-QC":539,"2C-KH-QC":539,"2C-KS-QC":539,"2C-KC-QD":539,"2C-KC-QH":539,"2C-KC-QS":539,"2C-KD-QD":539,"2C-KH-QD":539,"2C-KS-QD":539,"2C-KD-QH":539,"2C-KD-QS":539,"2C-KH-QH":539,"2C-KS-QH":539,"2C-KH-QS":539,"2C-KS-QS":539,"2D-KC-QC":539,"2H-KC-QC":539,"2S-KC-QC":539,"2D-KD-QC":539,"2D-KH-QC":539,"2D-KS-QC":539,"2H-KD-QC":539,"2S-KD-QC":539,"2H-KH-QC":539,"2H-KS-QC":539,"2S-KH-QC":539,"2S-KS-QC":539,"2D-KC-QD":539,"2D-KC-QH":539,"2D-KC-QS":539,"2H-KC-QD":539,"2S-KC-QD":539,"2H-KC-QH":539,"2H-KC-QS":539,"2S-KC-QH":539,"2S-KC-QS":539,"2D-KH-QD":539,"2D-KS-QD":539,"2D-KD-QH":539,"2D-KD-QS":539,"2D-KH-QH":539,"2D-KS-QH":539,"2D-KH-QS":539,"2D-KS-QS":539,"2H-KD-QD":539,"2S-KD-QD":539,"2H-KH-QD":539,"2H-KS-QD":539,"2S-KH-QD":539,"2S-KS-QD":539,"2H-KD-QH":539,"2H-KD-QS":539,"2S-KD-QH":539,"2S-KD-QS":539,"2H-KS-QH":539,"2H-KH-QS":539,"2H-KS-QS":539,"2S-KH-QH":539,"2S-KS-QH":539,"2S-KH-QS":539,"10C-JC-KD":540,"10C-JC-KH":540,"10C-JC-KS":540,"10C-JD-KC":540,"10C-JH-KC":540,"10C-JS-KC":540,"10C-JD-KD":540,"10C-JD-KH":540,"10C-JD-KS":540,"10C-JH-KD":540,"10C-JS-KD":540,"10C-JH-KH":540,"10C-JH-KS":540,"10C-JS-KH":540,"10C-JS-KS":540,"10D-JC-KC":540,"10H-JC-KC":540,"10S-JC-KC":540,"10D-JC-KD":540,"10D-JC-KH":540,"10D-JC-KS":540,"10H-JC-KD":540,"10S-JC-KD":540,"10H-JC-KH":540,"10H-JC-KS":540,"10S-JC-KH":540,"10S-JC-KS":540,"10D-JD-KC":540,"10D-JH-KC":540,"10D-JS-KC":540,"10H-JD-KC":540,"10S-JD-KC":540,"10H-JH-KC":540,"10H-JS-KC":540,"10S-JH-KC":540,"10S-JS-KC":540,"10D-JD-KH":540,"10D-JD-KS":540,"10D-JH-KD":540,"10D-JS-KD":540,"10D-JH-KH":540,"10D-JH-KS":540,"10D-JS-KH":540,"10D-JS-KS":540,"10H-JD-KD":540,"10S-JD-KD":540,"10H-JD-KH":540,"10H-JD-KS":540,"10S-JD-KH":540,"10S-JD-KS":540,"10H-JH-KD":540,"10H-JS-KD":540,"10S-JH-KD":540,"10S-JS-KD":540,"10H-JH-KS":540,"10H-JS-KH":540,"10H-JS-KS":540,"10S-JH-KH":540,"10S-JH-KS":540,"10S-JS-KH":540,"9C-JC-KD":541,"9C-JC-KH":541,"9C-JC-KS":541,"9C-JD-KC":541,"9C-JH-KC":541,"9C-JS-KC":541,"9C-JD-KD":541,"9C-JD-KH":541,"9C-JD-KS":541,"9C-JH-KD":541,"9C-JS-KD":541,"9C-JH-KH":541,"9C-JH-KS":541,"9C-JS-KH":541,"9C-JS-KS":541,"9D-JC-KC":541,"9H-JC-KC":541,"9S-JC-KC":541,"9D-JC-KD":541,"9D-JC-KH":541,"9D-JC-KS":541,"9H-JC-KD":541,"9S-JC-KD":541,"9H-JC-KH":541,"9H-JC-KS":541,"9S-JC-KH":541,"9S-JC-KS":541,"9D-JD-KC":541,"9D-JH-KC":541,"9D-JS-KC":541,"9H-JD-KC":541,"9S-JD-KC":541,"9H-JH-KC":541,"9H-JS-KC":541,"9S-JH-KC":541,"9S-JS-KC":541,"9D-JD-KH":541,"9D-JD-KS":541,"9D-JH-KD":541,"9D-JS-KD":541,"9D-JH-KH":541,"9D-JH-KS":541,"9D-JS-KH":541,"9D-JS-KS":541,"9H-JD-KD":541,"9S-JD-KD":541,"9H-JD-KH":541,"9H-JD-KS":541,"9S-JD-KH":541,"9S-JD-KS":541,"9H-JH-KD":541,"9H-JS-KD":541,"9S-JH-KD":541,"9S-JS-KD":541,"9H-JH-KS":541,"9H-JS-KH":541,"9H-JS-KS":541,"9S-JH-KH":541,"9S-JH-KS":541,"9S-JS-KH":541,"8C-JC-KD":542,"8C-JC-KH":542,"8C-JC-KS":542,"8C-JD-KC":542,"8C-JH-KC":542,"8C-JS-KC":542,"8C-JD-KD":542,"8C-JD-KH":542,"8C-JD-KS":542,"8C-JH-KD":542,"8C-JS-KD":542,"8C-JH-KH":542,"8C-JH-KS":542,"8C-JS-KH":542,"8C-JS-KS":542,"8D-JC-KC":542,"8H-JC-KC":542,"8S-JC-KC":542,"8D-JC-KD":542,"8D-JC-KH":542,"8D-JC-KS":542,"8H-JC-KD":542,"8S-JC-KD":542,"8H-JC-KH":542,"8H-JC-KS":542,"8S-JC-KH":542,"8S-JC-KS":542,"8D-JD-KC":542,"8D-JH-KC":542,"8D-JS-KC":542,"8H-JD-KC":542,"8S-JD-KC":542,"8H-JH-KC":542,"8H-JS-KC":542,"8S-JH-KC":542,"8S-JS-KC":542,"8D-JD-KH":542,"8D-JD-KS":542,"8D-JH-KD":542,"8D-JS-KD":542,"8D-JH-KH":542,"8D-JH-KS":542,"8D-JS-KH":542,"8D-JS-KS":542,"8H-JD-KD":542,"8S-JD-KD":542,"8H-JD-KH":542,"8H-JD-KS":542,"8S-JD-KH":542,"8S-JD-KS":542,"8H-JH-KD":542,"8H-JS-KD":542,"8S-JH-KD":542,"8S-JS-KD":542,"8H-JH-KS":542,"8H-JS-KH":542,"8H-JS-KS":542,"8S-JH-KH":542,"8S-JH-KS":542,"8S-JS-KH":542,"7C-JC-KD":543,"7C-JC-KH":543,"7C-JC-KS":543,"7C-JD-KC":543,"7C-JH-KC":543,"7C-JS-KC":543,"7C-JD-KD":543,"7C-JD-KH":543,"7C-JD-KS":543,"7C-JH-KD":543,"7C-JS-KD":543,"7C-JH-KH":543,"7C-JH-KS":543,"7C-JS-KH":543,"7C-JS-KS":543,"7D-JC-KC":543,"7H-JC-KC":543,"7S-JC-KC":543,"7D-JC-KD":543,"7D-JC-KH":543,"7D-JC-KS":543,"7H-JC-KD":543,"7S-JC-KD":543,"7H-JC-KH":543,"7H-JC-KS":543,"7S-JC-KH":543,"7S-JC-KS":543,"7D-JD-KC":543,"7D-JH-KC":543,"7D-JS-KC":543,"7H-JD-KC":543,"7S-JD-KC":543,"7H-JH-KC":543,"7H-JS-KC":543,"7S-JH-KC":543,"7S-JS-KC":543,"7D-JD-KH":543,"7D-JD-KS":543,"7D-JH-KD":543,"7D-JS-KD":543,"7D-JH-KH":543,"7D-JH-KS":543,"7D-JS-KH":543,"7D-JS-KS":543,"7H-JD-KD":543,"7S-JD-KD":543,"7H-JD-KH":543,"7H-JD-KS":543,"7S-JD-KH":543,"7S-JD-KS":543,"7H-JH-KD":543,"7H-JS-KD":543,"7S-JH-KD":543,"7S-JS-KD":543,"7H-JH-KS":543,"7H-JS-KH":543,"7H-JS-KS":543,"7S-JH-KH":543,"7S-JH-KS":543,"7S-JS-KH":543,"6C-JC-KD":544,"6C-JC-KH":544,"6C-JC-KS":544,"6C-JD-KC":544,"6C-JH-KC":544,"6C-JS-KC":544,"6C-JD-KD":544,"6C-JD-KH":544,"6C-JD-KS":544,"6C-JH-KD":544,"6C-JS-KD":544,"6C-JH-KH":544,"6C-JH-KS":544,"6C-JS-KH":544,"6C-JS-KS":544,"6D-JC-KC":544,"6H-JC-KC":544,"6S-JC-KC":544,"6D-JC-KD":544,"6D-JC-KH":544,"6D-JC-KS":544,"6H-JC-KD":544,"6S-JC-KD":544,"6H-JC-KH":544,"6H-JC-KS":544,"6S-JC-KH":544,"6S-JC-KS":544,"6D-JD-KC":544,"6D-JH-KC":544,"6D-JS-KC":544,"6H-JD-KC":544,"6S-JD-KC":544,"6H-JH-KC":544,"6H-JS-KC":544,"6S-JH-KC":544,"6S-JS-KC":544,"6D-JD-KH":544,"6D-JD-KS":544,"6D-JH-KD":544,"6D-JS-KD":544,"6D-JH-KH":544,"6D-JH-KS":544,"6D-JS-KH":544,"6D-JS-KS":544,"6H-JD-KD":544,"6S-JD-KD":544,"6H-JD-KH":544,"6H-JD-KS":544,"6S-JD-KH":544,"6S-JD-KS":544,"6H-JH-KD":544,"6H-JS-KD":544,"6S-JH-KD":544,"6S-JS-KD":544,"6H-JH-KS":544,"6H-JS-KH":544,"6H-JS-KS":544,"6S-JH-KH":544,"6S-JH-KS":544,"6S-JS-KH":544,"5C-JC-KD":545,"5C-JC-KH":545,"5C-JC-KS":545,"5C-JD-KC":545,"5C-JH-KC":545,"5C-JS-KC":545,"5C-JD-KD":545,"5C-JD-KH":545,"5C-JD-KS":545,"5C-JH-KD":545,"5C-JS-KD":545,"5C-JH-KH":545,"5C-JH-KS":545,"5C-JS-KH":545,"5C-JS-KS":545,"5D-JC-KC":545,"5H-JC-KC":545,"5S-JC-KC":545,"5D-JC-KD":545,"5D-JC-KH":545,"5D-JC-KS":545,"5H-JC-KD":545,"5S-JC-KD":545,"5H-JC-KH":545,"5H-JC-KS":545,"5S-JC-KH":545,"5S-JC-KS":545,"5D-JD-KC":545,"5D-JH-KC":545,"5D-JS-KC":545,"5H-JD-KC":545,"5S-JD-KC":545,"5H-JH-KC":545,"5H-JS-KC":545,"5S-JH-KC":545,"5S-JS-KC":545,"5D-JD-KH":545,"5D-JD-KS":545,"5D-JH-KD":545,"5D-JS-KD":545,"5D-JH-KH":545,"5D-JH-KS":545,"5D-JS-KH":545,"5D-JS-KS":545,"5H-JD-KD":545,"5S-JD-KD":545,"5H-JD-KH":545,"5H-JD-KS":545,"5S-JD-KH":545,"5S-JD-KS":545,"5H-JH-KD":545,"5H-JS-KD":545,"5S-JH-KD":545,"5S-JS-KD":545,"5H-JH-KS":545,"5H-JS-KH":545,"5H-JS-KS":545,"5S-JH-KH":545,"5S-JH-KS":545,"5S-JS-KH":545,"4C-JC-KD":546,"4C-JC-KH":546,"4C-JC-KS":546,"4C-JD-KC":546,"4C-JH-KC":546,"4C-JS-KC":546,"4C-JD-KD":546,"4C-JD-KH":546,"4C-JD-KS":546,"4C-JH-KD":546,"4C-JS-KD":546,"4C-JH-KH":546,"4C-JH-KS":546,"4C-JS-KH":546,"4C-JS-KS":546,"4D-JC-KC":546,"4H-JC-KC":546,"4S-JC-KC":546,"4D-JC-KD":546,"4D-JC-KH":546,"4D-JC-KS":546,"4H-JC-KD":546,"4S-JC-KD":546,"4H-JC-KH":546,"4H-JC-KS":546,"4S-JC-KH":546,"4S-JC-KS":546,"4D-JD-KC":546,"4D-JH-KC":546,"4D-JS-KC":546,"4H-JD-KC":546,"4S-JD-KC":546,"4H-JH-KC":546,"4H-JS-KC":546,"4S-JH-KC":546,"4S-JS-KC":546,"4D-JD-KH":546,"4D-JD-KS":546,"4D-JH-KD":546,"4D-JS-KD":546,"4D-JH-KH":546,"4D-JH-KS":546,"4D-JS-KH":546,"4D-JS-KS":546,"4H-JD-KD":546,"4S-JD-KD":546,"4H-JD-KH":546,"4H-JD-KS":546,"4S-JD-KH":546,"4S-JD-KS":546,"4H-JH-KD":546,"4H-JS-KD":546,"4S-JH-KD":546,"4S-JS-KD":546,"4H-JH-KS":546,"4H-JS-KH":546,"4H-JS-KS":546,"4S-JH-KH":546,"4S-JH-KS":546,"4S-JS-KH":546,"3C-JC-KD":547,"3C-JC-KH":547,"3C-JC-KS":547,"3C-JD-KC":547,"3C-JH-KC":547,"3C-JS-KC":547,"3C-JD-KD":547,"3C-JD-KH":547,"3C-JD-KS":547,"3C-JH-KD":547,"3C-JS-KD":547,"3C-JH-KH":547,"3C-JH-KS":547,"3C-JS-KH":547,"3C-JS-KS":547,"3D-JC-KC":547,"3H-JC-KC":547,"3S-JC-KC":547,"3D-JC-KD":547,"3D-JC-KH":547,"3D-JC-KS":547,"3H-JC-KD":547,"3S-JC-KD":547,"3H-JC-KH":547,"3H-JC-KS":547,"3S-JC-KH":547,"3S-JC-KS":547,"3D-JD-KC":547,"3D-JH-KC":547,"3D-JS-KC":547,"3H-JD-KC":547,"3S-JD-KC":547,"3H-JH-KC":547,"3H-JS-KC":547,"3S-JH-KC":547,"3S-JS-KC":547,"3D-JD-KH":547,"3D-JD-KS":547,"3D-JH-KD":547,"3D-JS-KD":547,"3D-JH-KH":547,"3D-JH-KS":547,"3D-JS-KH":547,"3D-JS-KS":547,"3H-JD-KD":547,"3S-JD-KD":547,"3H-JD-KH":547,"3H-JD-KS":547,"3S-JD-KH":547,"3S-JD-KS":547,"3H-JH-KD":547,"3H-JS-KD":547,"3S-JH-KD":547,"3S-JS-KD":547,"3H-JH-KS":547,"3H-JS-KH":547,"3H-JS-KS":547,"3S-JH-KH":547,"3S-JH-KS":547,"3S-JS-KH":547,"2C-JC-KD":548,"2C-JC-KH":548,"2C-JC-KS":548,"2C-JD-KC":548,"2C-JH-KC":548,"2C-JS-KC":548,"2C-JD-KD":548,"2C-JD-KH":548,"2C-JD-KS":548,"2C-JH-KD":548,"2C-JS-KD":548,"2C-JH-KH":548,"2C-JH-KS":548,"2C-JS-KH":548,"2C-JS-KS":548,"2D-JC-KC":548,"2H-JC-KC":548,"2S-JC-KC":548,"2D-JC-KD":548,"2D-JC-KH":548,"2D-JC-KS":548,"2H-JC-KD":548,"2S-JC-KD":548,"2H-JC-KH":548,"2H-JC-KS":548,"2S-JC-KH":548,"2S-JC-KS":548,"2D-JD-KC":548,"2D-JH-KC":548,"2D-JS-KC":548,"2H-JD-KC":548,"2S-JD-KC":548,"2H-JH-KC":548,"2H-JS-KC":548,"2S-JH-KC":548,"2S-JS-KC":548,"2D-JD-KH":548,"2D-JD-KS":548,"2D-JH-KD":548,"2D-JS-KD":548,"2D-JH-KH":548,"2D-JH-KS":548,"2D-JS-KH":548,"2D-JS-KS":548,"2H-JD-KD":548,"2S-JD-KD":548,"2H-JD-KH":548,"2H-JD-KS":548,"2S-JD-KH":548,"2S-JD-KS":548,"2H-JH-KD":548,"2H-JS-KD":548,"2S-JH-KD":548,"2S-JS-KD":548,"2H-JH-KS":548,"2H-JS-KH":548,"2H-JS-KS":548,"2S-JH-KH":548,"2S-JH-KS":548,"2S-JS-KH":548,"10C-9C-KD":549,"10C-9C-KH":549,"10C-9C-KS":549,"10D-9C-KC":549,"10H-9C-KC":549,"10S-9C-KC":549,"10D-9C-KD":549,"10D-9C-KH":549,"10D-9C-KS":549,"10H-9C-KD":549,"10S-9C-KD":549,"10H-9C-KH":549,"10H-9C-KS":549,"10S-9C-KH":549,"10S-9C-KS":549,"10C-9D-KC":549,"10C-9H-KC":549,"10C-9S-KC":549,"10C-9D-KD":549,"10C-9D-KH":549,"10C-9D-KS":549,"10C-9H-KD":549,"10C-9S-KD":549,"10C-9H-KH":549,"10C-9H-KS":549,"10C-9S-KH":549,"10C-9S-KS":549,"10D-9D-KC":549,"10H-9D-KC":549,"10S-9D-KC":549,"10D-9H-KC":549,"10D-9S-KC":549,"10H-9H-KC":549,"10S-9H-KC":549,"10H-9S-KC":549,"10S-9S-KC":549,"10D-9D-KH":549,"10D-9D-KS":549,"10H-9D-KD":549,"10S-9D-KD":549,"10H-9D-KH":549,"10H-9D-KS":549,"10S-9D-KH":549,"10S-9D-KS":549,"10D-9H-KD":549,"10D-9S-KD":549,"10D-9H-KH":549,"10D-9H-KS":549,"10D-9S-KH":549,"10D-9S-KS":549,"10H-9H-KD":549,"10S-9H-KD":549,"10H-9S-KD":549,"10S-9S-KD":549,"10H-9H-KS":549,"10S-9H-KH":549,"10S-9H-KS":549,"10H-9S-KH":549,"10H-9S-KS":549,"10S-9S-KH":549,"10C-8C-KD":550,"10C-8C-KH":550,"10C-8C-KS":550,"10D-8C-KC":550,"10H-8C-KC":550,"10S-8C-KC":550,"10D-8C-KD":550,"10D-8C-KH":550,"10D-8C-KS":550,"10H-8C-KD":550,"10S-8C-KD":550,"10H-8C-KH":550,"10H-8C-KS":550,"10S-8C-KH":550,"10S-8C-KS":550,"10C-8D-KC":550,"10C-8H-KC":550,"10C-8S-KC":550,"10C-8D-KD":550,"10C-8D-KH":550,"10C-8D-KS":550,"10C-8H-KD":550,"10C-8S-KD":550,"10C-8H-KH":550,"10C-8H-KS":550,"10C-8S-KH":550,"10C-8S-KS":550,"10D-8D-KC":550,"10H-8D-KC":550,"10S-8D-KC":550,"10D-8H-KC":550,"10D-8S-KC":550,"10H-8H-KC":550,"10S-8H-KC":550,"10H-8S-KC":550,"10S-8S-KC":550,"10D-8D-KH":550,"10D-8D-KS":550,"10H-8D-KD":550,"10S-8D-KD":550,"10H-8D-KH":550,"10H-8D-KS":550,"10S-8D-KH":550,"10S-8D-KS":550,"10D-8H-KD":550,"10D-8S-KD":550,"10D-8H-KH":550,"10D-8H-KS":550,"10D-8S-KH":550,"10D-8S-KS":550,"10H-8H-KD":550,"10S-8H-KD":550,"10H-8S-KD":550,"10S-8S-KD":550,"10H-8H-KS":550,"10S-8H-KH":550,"10S-8H-KS":550,"10H-8S-KH":550,"10H-8S-KS":550,"10S-8S-KH":550,"10C-7C-KD":551,"10C-7C-KH":551,"10C-7C-KS":551,"10D-7C-KC":551,"10H-7C-KC":551,"10S-7C-KC":551,"10D-7C-KD":551,"10D-7C-KH":551,"10D-7C-KS":551,"10H-7C-KD":551,"10S-7C-KD":551,"10H-7C-KH":551,"10H-7C-KS":551,"10S-7C-KH":551,"10S-7C-KS":551,"10C-7D-KC":551,"10C-7H-KC":551,"10C-7S-KC":551,"10C-7D-KD":551,"10C-7D-KH":551,"10C-7D-KS":551,"10C-7H-KD":551,"10C-7S-KD":551,"10C-7H-KH":551,"10C-7H-KS":551,"10C-7S-KH":551,"10C-7S-KS":551,"10D-7D-KC":551,"10H-7D-KC":551,"10S-7D-KC":551,"10D-7H-KC":551,"10D-7S-KC":551,"10H-7H-KC":551,"10S-7H-KC":551,"10H-7S-KC":551,"10S-7S-KC":551,"10D-7D-KH":551,"10D-7D-KS":551,"10H-7D-KD":551,"10S-7D-KD":551,"10H-7D-KH":551,"10H-7D-KS":551,"10S-7D-KH":551,"10S-7D-KS":551,"10D-7H-KD":551,"10D-7S-KD":551,"10D-7H-KH":551,"10D-7H-KS":551,"10D-7S-KH":551,"10D-7S-KS":551,"10H-7H-KD":551,"10S-7H-KD":551,"10H-7S-KD":551,"10S-7S-KD":551,"10H-7H-KS":551,"10S-7H-KH":551,"10S-7H-KS":551,"10H-7S-KH":551,"10H-7S-KS":551,"10S-7S-KH":551,"10C-6C-KD":552,"10C-6C-KH":552,"10C-6C-KS":552,"10D-6C-KC":552,"10H-6C-KC":552,"10S-6C-KC":552,"10D-6C-KD":552,"10D-6C-KH":552,"10D-6C-KS":552,"10H-6C-KD":552,"10S-6C-KD":552,"10H-6C-KH":552,"10H-6C-KS":552,"10S-6C-KH":552,"10S-6C-KS":552,"10C-6D-KC":552,"10C-6H-KC":552,"10C-6S-KC":552,"10C-6D-KD":552,"10C-6D-KH":552,"10C-6D-KS":552,"10C-6H-KD":552,"10C-6S-KD":552,"10C-6H-KH":552,"10C-6H-KS":552,"10C-6S-KH":552,"10C-6S-KS":552,"10D-6D-KC":552,"10H-6D-KC":552,"10S-6D-KC":552,"10D-6H-KC":552,"10D-6S-KC":552,"10H-6H-KC":552,"10S-6H-KC":552,"10H-6S-KC":552,"10S-6S-KC":552,"10D-6D-KH":552,"10D-6D-KS":552,"10H-6D-KD":552,"10S-6D-KD":552,"10H-6D-KH":552,"10H-6D-KS":552,"10S-6D-KH":552,"10S-6D-KS":552,"10D-6H-KD":552,"10D-6S-KD":552,"10D-6H-KH":552,"10D-6H-KS":552,"10D-6S-KH":552,"10D-6S-KS":552,"10H-6H-KD":552,"10S-6H-KD":552,"10H-6S-KD":552,"10S-6S-KD":552,"10H-6H-KS":552,"10S-6H-KH":552,"10S-6H-KS":552,"10H-6S-KH":552,"10H-6S-KS":552,"10S-6S-KH":552,"10C-5C-KD":553,"10C-5C-KH":553,"10C-5C-KS":553,"10D-5C-KC":553,"10H-5C-KC":553,"10S-5C-KC":553,"10D-5C-KD":553,"10D-5C-KH":553,"10D-5C-KS":553,"10H-5C-KD":553,"10S-5C-KD":553,"10H-5C-KH":553,"10H-5C-KS":553,"10S-5C-KH":553,"10S-5C-KS":553,"10C-5D-KC":553,"10C-5H-KC":553,"10C-5S-KC":553,"10C-5D-KD":553,"10C-5D-KH":553,"10C-5D-KS":553,"10C-5H-KD":553,"10C-5S-KD":553,"10C-5H-KH":553,"10C-5H-KS":553,"10C-5S-KH":553,"10C-5S-KS":553,"10D-5D-KC":553,"10H-5D-KC":553,"10S-5D-KC":553,"10D-5H-KC":553,"10D-5S-KC":553,"10H-5H-KC":553,"10S-5H-KC":553,"10H-5S-KC":553,"10S-5S-KC":553,"10D-5D-KH":553,"10D-5D-KS":553,"10H-5D-KD":553,"10S-5D-KD":553,"10H-5D-KH":553,"10H-5D-KS":553,"10S-5D-KH":553,"10S-5D-KS":553,"10D-5H-KD":553,"10D-5S-KD":553,"10D-5H-KH":553,"10D-5H-KS":553,"10D-5S-KH":553,"10D-5S-KS":553,"10H-5H-KD":553,"10S-5H-KD":553,"10H-5S-KD":553,"10S-5S-KD":553,"10H-5H-KS":553,"10S-5H-KH":553,"10S-5H-KS":553,"10H-5S-KH":553,"10H-5S-KS":553,"10S-5S-KH":553,"10C-4C-KD":554,"10C-4C-KH":554,"10C-4C-KS":554,"10D-4C-KC":554,"10H-4C-KC":554,"10S-4C-KC":554,"10D-4C-KD":554,"10D-4C-KH":554,"10D-4C-KS":554,"10H-4C-KD":554,"10S-4C-KD":554,"10H-4C-KH":554,"10H-4C-KS":554,"10S-4C-KH":554,"10S-4C-KS":554,"10C-4D-KC":554,"10C-4H-KC":554,"10C-4S-KC":554,"10C-4D-KD":554,"10C-4D-KH":554,"10C-4D-KS":554,"10C-4H-KD":554,"10C-4S-KD":554,"10C-4H-KH":554,"10C-4H-KS":554,"10C-4S-KH":554,"10C-4S-KS":554,"10D-4D-KC":554,"10H-4D-KC":554,"10S-4D-KC":554,"10D-4H-KC":554,"10D-4S-KC":554,"10H-4H-KC":554,"10S-4H-KC":554,"10H-4S-KC":554,"10S-4S-KC":554,"10D-4D-KH":554,"10D-4D-KS":554,"10H-4D-KD":554,"10S-4D-KD":554,"10H-4D-KH":554,"10H-4D-KS":554,"10S-4D-KH":554,"10S-4D-KS":554,"10D-4H-KD":554,"10D-4S-KD":554,"10D-4H-KH":554,"10D-4H-KS":554,"10D-4S-KH":554,"10D-4S-KS":554,"10H-4H-KD":554,"10S-4H-KD":554,"10H-4S-KD":554,"10S-4S-KD":554,"10H-4H-KS":554,"10S-4H-KH":554,"10S-4H-KS":554,"10H-4S-KH":554,"10H-4S-KS":554,"10S-4S-KH":554,"10C-3C-KD":555,"10C-3C-KH":555,"10C-3C-KS":555,"10D-3C-KC":555,"10H-3C-KC":555,"10S-3C-KC":555,"10D-3C-KD":555,"10D-3C-KH":555,"10D-3C-KS":555,"10H-3C-KD":555,"10S-3C-KD":555,"10H-3C-KH":555,"10H-3C-KS":555,"10S-3C-KH":555,"10S-3C-KS":555,"10C-3D-KC":555,"10C-3H-KC":555,"10C-3S-KC":555,"10C-3D-KD":555,"10C-3D-KH":555,"10C-3D-KS":555,"10C-3H-KD":555,"10C-3S-KD":555,"10C-3H-KH":555,"10C-3H-KS":555,"10C-3S-KH":555,"10C-3S-KS":555,"10D-3D-KC":555,"10H-3D-KC":555,"10S-3D-KC":555,"10D-3H-KC":555,"10D-3S-KC":555,"10H-3H-KC":555,"10S-3H-KC":555,"10H-3S-KC":555,"10S-3S-KC":555,"10D-3D-KH":555,"10D-3D-KS":555,"10H-3D-KD":555,"10S-3D-KD":555,"10H-3D-KH":555,"10H-3D-KS":555,"10S-3D-KH":555,"10S-3D-KS":555,"10D-3H-KD":555,"10D-3S-KD":555,"10D-3H-KH":555,"10D-3H-KS":555,"10D-3S-KH":555,"10D-3S-KS":555,"10H-3H-KD":555,"10S-3H-KD":555,"10H-3S-KD":555,"10S-3S-KD":555,"10H-3H-KS":555,"10S-3H-KH":555,"10S-3H-KS":555,"10H-3S-KH":555,"10H-3S-KS":555,"10S-3S-KH":555,"10C-2C-KD":556,"10C-2C-KH":556,"10C-2C-KS":556,"10D-2C-KC":556,"10H-2C-KC":556,"10S-2C-KC":556,"10D-2C-KD":556,"10D-2C-KH":556,"10D-2C-KS":556,"10H-2C-KD":556,"10S-2C-KD":556,"10H-2C-KH":556,"10H-2C-KS":556,"10S-2C-KH":556,"10S-2C-KS":556,"10C-2D-KC":556,"10C-2H-KC":556,"10C-2S-KC":556,"10C-2D-KD":556,"10C-2D-KH":556,"10C-2D-KS":556,"10C-2H-KD":556,"10C-2S-KD":556,"10C-2H-KH":556,"10C-2H-KS":556,"10C-2S-KH":556,"10C-2S-KS":556,"10D-2D-KC":556,"10H-2D-KC":556,"10S-2D-KC":556,"10D-2H-KC":556,"10D-2S-KC":556,"10H-2H-KC":556,"10S-2H-KC":556,"10H-2S-KC":556,"10S-2S-KC":556,"10D-2D-KH":556,"10D-2D-KS":556,"10H-2D-KD":556,"10S-2D-KD":556,"10H-2D-KH":556,"10H-2D-KS":556,"10S-2D-KH":556,"10S-2D-KS":556,"10D-2H-KD":556,"10D-2S-KD":556,"10D-2H-KH":556,"10D-2H-KS":556,"10D-2S-KH":556,"10D-2S-KS":556,"10H-2H-KD":556,"10S-2H-KD":556,"10H-2S-KD":556,"10S-2S-KD":556,"10H-2H-KS":556,"10S-2H-KH":556,"10S-2H-KS":556,"10H-2S-KH":556,"10H-2S-KS":556,"10S-2S-KH":556,"8C-9C-KD":557,"8C-9C-KH":557,"8C-9C-KS":557,"8C-9D-KC":557,"8C-9H-KC":557,"8C-9S-KC":557,"8C-9D-KD":557,"8C-9D-KH":557,"8C-9D-KS":557,"8C-9H-KD":557,"8C-9S-KD":557,"8C-9H-KH":557,"8C-9H-KS":557,"8C-9S-KH":557,"8C-9S-KS":557,"8D-9C-KC":557,"8H-9C-KC":557,"8S-9C-KC":557,"8D-9C-KD":557,"8D-9C-KH":557,"8D-9C-KS":557,"8H-9C-KD":557,"8S-9C-KD":557,"8H-9C-KH":557,"8H-9C-KS":557,"8S-9C-KH":557,"8S-9C-KS":557,"8D-9D-KC":557,"8D-9H-KC":557,"8D-9S-KC":557,"8H-9D-KC":557,"8S-9D-KC":557,"8H-9H-KC":557,"8H-9S-KC":557,"8S-9H-KC":557,"8S-9S-KC":557,"8D-9D-KH":557,"8D-9D-KS":557,"8D-9H-KD":557,"8D-9S-KD":557,"8D-9H-KH":557,"8D-9H-KS":557,"8D-9S-KH":557,"8D-9S-KS":557,"8H-9D-KD":557,"8S-9D-KD":557,"8H-9D-KH":557,"8H-9D-KS":557,"8S-9D-KH":557,"8S-9D-KS":557,"8H-9H-KD":557,"8H-9S-KD":557,"8S-9H-KD":557,"8S-9S-KD":557,"8H-9H-KS":557,"8H-9S-KH":557,"8H-9S-KS":557,"8S-9H-KH":557,"8S-9H-KS":557,"8S-9S-KH":557,"7C-9C-KD":558,"7C-9C-KH":558,"7C-9C-KS":558,"7C-9D-KC":558,"7C-9H-KC":558,"7C-9S-KC":558,"7C-9D-KD":558,"7C-9D-KH":558,"7C-9D-KS":558,"7C-9H-KD":558,"7C-9S-KD":558,"7C-9H-KH":558,"7C-9H-KS":558,"7C-9S-KH":558,"7C-9S-KS":558,"7D-9C-KC":558,"7H-9C-KC":558,"7S-9C-KC":558,"7D-9C-KD":558,"7D-9C-KH":558,"7D-9C-KS":558,"7H-9C-KD":558,"7S-9C-KD":558,"7H-9C-KH":558,"7H-9C-KS":558,"7S-9C-KH":558,"7S-9C-KS":558,"7D-9D-KC":558,"7D-9H-KC":558,"7D-9S-KC":558,"7H-9D-KC":558,"7S-9D-KC":558,"7H-9H-KC":558,"7H-9S-KC":558,"7S-9H-KC":558,"7S-9S-KC":558,"7D-9D-KH":558,"7D-9D-KS":558,"7D-9H-KD":558,"7D-9S-KD":558,"7D-9H-KH":558,"7D-9H-KS":558,"7D-9S-KH":558,"7D-9S-KS":558,"7H-9D-KD":558,"7S-9D-KD":558,"7H-9D-KH":558,"7H-9D-KS":558,"7S-9D-KH":558,"7S-9D-KS":558,"7H-9H-KD":558,"7H-9S-KD":558,"7S-9H-KD":558,"7S-9S-KD":558,"7H-9H-KS":558,"7H-9S-KH":558,"7H-9S-KS":558,"7S-9H-KH":558,"7S-9H-KS":558,"7S-9S-KH":558,"6C-9C-KD":559,"6C-9C-KH":559,"6C-9C-KS":559,"6C-9D-KC":559,"6C-9H-KC":559,"6C-9S-KC":559,"6C-9D-KD":559,"6C-9D-KH":559,"6C-9D-KS":559,"6C-9H-KD":559,"6C-9S-KD":559,"6C-9H-KH":559,"6C-9H-KS":559,"6C-9S-KH":559,"6C-9S-KS":559,"6D-9C-KC":559,"6H-9C-KC":559,"6S-9C-KC":559,"6D-9C-KD":559,"6D-9C-KH":559,"6D-9C-KS":559,"6H-9C-KD":559,"6S-9C-KD":559,"6H-9C-KH":559,"6H-9C-KS":559,"6S-9C-KH":559,"6S-9C-KS":559,"6D-9D-KC":559,"6D-9H-KC":559,"6D-9S-KC":559,"6H-9D-KC":559,"6S-9D-KC":559,"6H-9H-KC":559,"6H-9S-KC":559,"6S-9H-KC":559,"6S-9S-KC":559,"6D-9D-KH":559,"6D-9D-KS":559,"6D-9H-KD":559,"6D-9S-KD":559,"6D-9H-KH":559,"6D-9H-KS":559,"6D-9S-KH":559,"6D-9S-KS":559,"6H-9D-KD":559,"6S-9D-KD":559,"6H-9D-KH":559,"6H-9D-KS":559,"6S-9D-KH":559,"6S-9D-KS":559,"6H-9H-KD":559,"6H-9S-KD":559,"6S-9H-KD":559,"6S-9S-KD":559,"6H-9H-KS":559,"6H-9S-KH":559,"6H-9S-KS":559,"6S-9H-KH":559,"6S-9H-KS":559,"6S-9S-KH":559,"5C-9C-KD":560,"5C-9C-KH":560,"5C-9C-KS":560,"5C-9D-KC":560,"5C-9H-KC":560,"5C-9S-KC":560,"5C-9D-KD":560,"5C-9D-KH":560,"5C-9D-KS":560,"5C-9H-KD":560,"5C-9S-KD":560,"5C-9H-KH":560,"5C-9H-KS":560,"5C-9S-KH":560,"5C-9S-KS":560,"5D-9C-KC":560,"5H-9C-KC":560,"5S-9C-KC":560,"5D-9C-KD":560,"5D-9C-KH":560,"5D-9C-KS":560,"5H-9C-KD":560,"5S-9C-KD":560,"5H-9C-KH":560,"5H-9C-KS":560,"5S-9C-KH":560,"5S-9C-KS":560,"5D-9D-KC":560,"5D-9H-KC":560,"5D-9S-KC":560,"5H-9D-KC":560,"5S-9D-KC":560,"5H-9H-KC":560,"5H-9S-KC":560,"5S-9H-KC":560,"5S-9S-KC":560,"5D-9D-KH":560,"5D-9D-KS":560,"5D-9H-KD":560,"5D-9S-KD":560,"5D-9H-KH":560,"5D-9H-KS":560,"5D-9S-KH":560,"5D-9S-KS":560,"5H-9D-KD":560,"5S-9D-KD":560,"5H-9D-KH":560,"5H-9D-KS":560,"5S-9D-KH":560,"5S-9D-KS":560,"5H-9H-KD":560,"5H-9S-KD":560,"5S-9H-KD":560,"5S-9S-KD":560,"5H-9H-KS":560,"5H-9S-KH":560,"5H-9S-KS":560,"5S-9H-KH":560,"5S-9H-KS":560,"5S-9S-KH":560,"4C-9C-KD":561,"4C-9C-KH":561,"4C-9C-KS":561,"4C-9D-KC":561,"4C-9H-KC":561,"4C-9S-KC":561,"4C-9D-KD":561,"4C-9D-KH":561,"4C-9D-KS":561,"4C-9H-KD":561,"4C-9S-KD":561,"4C-9H-KH":561,"4C-9H-KS":561,"4C-9S-KH":561,"4C-9S-KS":561,"4D-9C-KC":561,"4H-9C-KC":561,"4S-9C-KC":561,"4D-9C-KD":561,"4D-9C-KH":561,"4D-9C-KS":561,"4H-9C-KD":561,"4S-9C-KD":561,"4H-9C-KH":561,"4H-9C-KS":561,"4S-9C-KH":561,"4S-9C-KS":561,"4D-9D-KC":561,"4D-9H-KC":561,"4D-9S-KC":561,"4H-9D-KC":561,"4S-9D-KC":561,"4H-9H-KC":561,"4H-9S-KC":561,"4S-9H-KC":561,"4S-9S-KC":561,"4D-9D-KH":561,"4D-9D-KS":561,"4D-9H-KD":561,"4D-9S-KD":561,"4D-9H-KH":561,"4D-9H-KS":561,"4D-9S-KH":561,"4D-9S-KS":561,"4H-9D-KD":561,"4S-9D-KD":561,"4H-9D-KH":561,"4H-9D-KS":561,"4S-9D-KH":561,"4S-9D-KS":561,"4H-9H-KD":561,"4H-9S-KD":561,"4S-9H-KD":561,"4S-9S-KD":561,"4H-9H-KS":561,"4H-9S-KH":561,"4H-9S-KS":561,"4S-9H-KH":561,"4S-9H-KS":561,"4S-9S-KH":561,"3C-9C-KD":562,"3C-9C-KH":562,"3C-9C-KS":562,"3C-9D-KC":562,"3C-9H-KC":562,"3C-9S-KC":562,"3C-9D-KD":562,"3C-9D-KH":562,"3C-9D-KS":562,"3C-9H-KD":562,"3C-9S-KD":562,"3C-9H-KH":562,"3C-9H-KS":562,"3C-9S-KH":562,"3C-9S-KS":562,"3D-9C-KC":562,"3H-9C-KC":562,"3S-9C-KC":562,"3D-9C-KD":562,"3D-9C-KH":562,"3D-9C-KS":562,"3H-9C-KD":562,"3S-9C-KD":562,"3H-9C-KH":562,"3H-9C-KS":562,"3S-9C-KH":562,"3S-9C-KS":562,"3D-9D-KC":562,"3D-9H-KC":562,"3D-9S-KC":562,"3H-9D-KC":562,"3S-9D-KC":562,"3H-9H-KC":562,"3H-9S-KC":562,"3S-9H-KC":562,"3S-9S-KC":562,"3D-9D-KH":562,"3D-9D-KS":562,"3D-9H-KD":562,"3D-9S-KD":562,"3D-9H-KH":562,"3D-9H-KS":562,"3D-9S-KH":562,"3D-9S-KS":562,"3H-9D-KD":562,"3S-9D-KD":562,"3H-9D-KH":562,"3H-9D-KS":562,"3S-9D-KH":562,"3S-9D-KS":562,"3H-9H-KD":562,"3H-9S-KD":562,"3S-9H-KD":562,"3S-9S-KD":562,"3H-9H-KS":562,"3H-9S-KH":562,"3H-9S-KS":562,"3S-9H-KH":562,"3S-9H-KS":562,"3S-9S-KH":562,"2C-9C-KD":563,"2C-9C-KH":563,"2C-9C-KS":563,"2C-9D-KC":563,"2C-9H-KC":563,"2C-9S-KC":563,"2C-9D-KD":563,"2C-9D-KH":563,"2C-9D-KS":563,"2C-9H-KD":563,"2C-9S-KD":563,"2C-9H-KH":563,"2C-9H-KS":563,"2C-9S-KH":563,"2C-9S-KS":563,"2D-9C-KC":563,"2H-9C-KC":563,"2S-9C-KC":563,"2D-9C-KD":563,"2D-9C-KH":563,"2D-9C-KS":563,"2H-9C-KD":563,"2S-9C-KD":563,"2H-9C-KH":563,"2H-9C-KS":563,"2S-9C-KH":563,"2S-9C-KS":563,"2D-9D-KC":563,"2D-9H-KC":563,"2D-9S-KC":563,"2H-9D-KC":563,"2S-9D-KC":563,"2H-9H-KC":563,"2H-9S-KC":563,"2S-9H-KC":563,"2S-9S-KC":563,"2D-9D-KH":563,"2D-9D-KS":563,"2D-9H-KD":563,"2D-9S-KD":563,"2D-9H-KH":563,"2D-9H-KS":563,"2D-9S-KH":563,"2D-9S-KS":563,"2H-9D-KD":563,"2S-9D-KD":563,"2H-9D-KH":563,"2H-9D-KS":563,"2S-9D-KH":563,"2S-9D-KS":563,"2H-9H-KD":563,"2H-9S-KD":563,"2S-9H-KD":563,"2S-9S-KD":563,"2H-9H-KS":563,"2H-9S-KH":563,"2H-9S-KS":563,"2S-9H-KH":563,"2S-9H-KS":563,"2S-9S-KH":563,"7C-8C-KD":564,"7C-8C-KH":564,"7C-8C-KS":564,"7C-8D-KC":564,"7C-8H-KC":564,"7C-8S-KC":564,"7C-8D-KD":564,"7C-8D-KH":564,"7C-8D-KS":564,"7C-8H-KD":564,"7C-8S-KD":564,"7C-8H-KH":564,"7C-8H-KS":564,"7C-8S-KH":564,"7C-8S-KS":564,"7D-8C-KC":564,"7H-8C-KC":564,"7S-8C-KC":564,"7D-8C-KD":564,"7D-8C-KH":564,"7D-8C-KS":564,"7H-8C-KD":564,"7S-8C-KD":564,"7H-8C-KH":564,"7H-8C-KS":564,"7S-8C-KH":564,"7S-8C-KS":564,"7D-8D-KC":564,"7D-8H-KC":564,"7D-8S-KC":564,"7H-8D-KC":564,"7S-8D-KC":564,"7H-8H-KC":564,"7H-8S-KC":564,"7S-8H-KC":564,"7S-8S-KC":564,"7D-8D-KH":564,"7D-8D-KS":564,"7D-8H-KD":564,"7D-8S-KD":564,"7D-8H-KH":564,"7D-8H-KS":564,"7D-8S-KH":564,"7D-8S-KS":564,"7H-8D-KD":564,"7S-8D-KD":564,"7H-8D-KH":564,"7H-8D-KS":564,"7S-8D-KH":564,"7S-8D-KS":564,"7H-8H-KD":564,"7H-8S-KD":564,"7S-8H-KD":564,"7S-8S-KD":564,"7H-8H-KS":564,"7H-8S-KH":564,"7H-8S-KS":564,"7S-8H-KH":564,"7S-8H-KS":564,"7S-8S-KH":564,"6C-8C-KD":565,"6C-8C-KH":565,"6C-8C-KS":565,"6C-8D-KC":565,"6C-8H-KC":565,"6C-8S-KC":565,"6C-8D-KD":565,"6C-8D-KH":565,"6C-8D-KS":565,"6C-8H-KD":565,"6C-8S-KD":565,"6C-8H-KH":565,"6C-8H-KS":565,"6C-8S-KH":565,"6C-8S-KS":565,"6D-8C-KC":565,"6H-8C-KC":565,"6S-8C-KC":565,"6D-8C-KD":565,"6D-8C-KH":565,"6D-8C-KS":565,"6H-8C-KD":565,"6S-8C-KD":565,"6H-8C-KH":565,"6H-8C-KS":565,"6S-8C-KH":565,"6S-8C-KS":565,"6D-8D-KC":565,"6D-8H-KC":565,"6D-8S-KC":565,"6H-8D-KC":565,"6S-8D-KC":565,"6H-8H-KC":565,"6H-8S-KC":565,"6S-8H-KC":565,"6S-8S-KC":565,"6D-8D-KH":565,"6D-8D-KS":565,"6D-8H-KD":565,"6D-8S-KD":565,"6D-8H-KH":565,"6D-8H-KS":565,"6D-8S-KH":565,"6D-8S-KS":565,"6H-8D-KD":565,"6S-8D-KD":565,"6H-8D-KH":565,"6H-8D-KS":565,"6S-8D-KH":565,"6S-8D-KS":565,"6H-8H-KD":565,"6H-8S-KD":565,"6S-8H-KD":565,"6S-8S-KD":565,"6H-8H-KS":565,"6H-8S-KH":565,"6H-8S-KS":565,"6S-8H-KH":565,"6S-8H-KS":565,"6S-8S-KH":565,"5C-8C-KD":566,"5C-8C-KH":566,"5C-8C-KS":566,"5C-8D-KC":566,"5C-8H-KC":566,"5C-8S-KC":566,"5C-8D-KD":566,"5C-8D-KH":566,"5C-8D-KS":566,"5C-8H-KD":566,"5C-8S-KD":566,"5C-8H-KH":566,"5C-8H-KS":566,"5C-8S-KH":566,"5C-8S-KS":566,"5D-8C-KC":566,"5H-8C-KC":566,"5S-8C-KC":566,"5D-8C-KD":566,"5D-8C-KH":566,"5D-8C-KS":566,"5H-8C-KD":566,"5S-8C-KD":566,"5H-8C-KH":566,"5H-8C-KS":566,"5S-8C-KH":566,"5S-8C-KS":566,"5D-8D-KC":566,"5D-8H-KC":566,"5D-8S-KC":566,"5H-8D-KC":566,"5S-8D-KC":566,"5H-8H-KC":566,"5H-8S-KC":566,"5S-8H-KC":566,"5S-8S-KC":566,"5D-8D-KH":566,"5D-8D-KS":566,"5D-8H-KD":566,"5D-8S-KD":566,"5D-8H-KH":566,"5D-8H-KS":566,"5D-8S-KH":566,"5D-8S-KS":566,"5H-8D-KD":566,"5S-8D-KD":566,"5H-8D-KH":566,"5H-8D-KS":566,"5S-8D-KH":566,"5S-8D-KS":566,"5H-8H-KD":566,"5H-8S-KD":566,"5S-8H-KD":566,"5S-8S-KD":566,"5H-8H-KS":566,"5H-8S-KH":566,"5H-8S-KS":566,"5S-8H-KH":566,"5S-8H-KS":566,"5S-8S-KH":566,"4C-8C-KD":567,"4C-8C-KH":567,"4C-8C-KS":567,"4C-8D-KC":567,"4C-8H-KC":567,"4C-8S-KC":567,"4C-8D-KD":567,"4C-8D-KH":567,"4C-8D-KS":567,"4C-8H-KD":567,"4C-8S-KD":567,"4C-8H-KH":567,"4C-8H-KS":567,"4C-8S-KH":567,"4C-8S-KS":567,"4D-8C-KC":567,"4H-8C-KC":567,"4S-8C-KC":567,"4D-8C-KD":567,"4D-8C-KH":567,"4D-8C-KS":567,"4H-8C-KD":567,"4S-8C-KD":567,"4H-8C-KH":567,"4H-8C-KS":567,"4S-8C-KH":567,"4S-8C-KS":567,"4D-8D-KC":567,"4D-8H-KC":567,"4D-8S-KC":567,"4H-8D-KC":567,"4S-8D-KC":567,"4H-8H-KC":567,"4H-8S-KC":567,"4S-8H-KC":567,"4S-8S-KC":567,"4D-8D-KH":567,"4D-8D-KS":567,"4D-8H-KD":567,"4D-8S-KD":567,"4D-8H-KH":567,"4D-8H-KS":567,"4D-8S-KH":567,"4D-8S-KS":567,"4H-8D-KD":567,"4S-8D-KD":567,"4H-8D-KH":567,"4H-8D-KS":567,"4S-8D-KH":567,"4S-8D-KS":567,"4H-8H-KD":567,"4H-8S-KD":567,"4S-8H-KD":567,"4S-8S-KD":567,"4H-8H-KS":567,"4H-8S-KH":567,"4H-8S-KS":567,"4S-8H-KH":567,"4S-8H-KS":567,"4S-8S-KH":567,"3C-8C-KD":568,"3C-8C-KH":568,"3C-8C-KS":568,"3C-8D-KC":568,"3C-8H-KC":568,"3C-8S-KC":568,"3C-8D-KD":568,"3C-8D-KH":568,"3C-8D-KS":568,"3C-8H-KD":568,"3C-8S-KD":568,"3C-8H-KH":568,"3C-8H-KS":568,"3C-8S-KH":568,"3C-8S-KS":568,"3D-8C-KC":568,"3H-8C-KC":568,"3S-8C-KC":568,"3D-8C-KD":568,"3D-8C-KH":568,"3D-8C-KS":568,"3H-8C-KD":568,"3S-8C-KD":568,"3H-8C-KH":568,"3H-8C-KS":568,"3S-8C-KH":568,"3S-8C-KS":568,"3D-8D-KC":568,"3D-8H-KC":568,"3D-8S-KC":568,"3H-8D-KC":568,"3S-8D-KC":568,"3H-8H-KC":568,"3H-8S-KC":568,"3S-8H-KC":568,"3S-8S-KC":568,"3D-8D-KH":568,"3D-8D-KS":568,"3D-8H-KD":568,"3D-8S-KD":568,"3D-8H-KH":568,"3D-8H-KS":568,"3D-8S-KH":568,"3D-8S-KS":568,"3H-8D-KD":568,"3S-8D-KD":568,"3H-8D-KH":568,"3H-8D-KS":568,"3S-8D-KH":568,"3S-8D-KS":568,"3H-8H-KD":568,"3H-8S-KD":568,"3S-8H-KD":568,"3S-8S-KD":568,"3H-8H-KS":568,"3H-8S-KH":568,"3H-8S-KS":568,"3S-8H-KH":568,"3S-8H-KS":568,"3S-8S-KH":568,"2C-8C-KD":569,"2C-8C-KH":569,"2C-8C-KS":569,"2C-8D-KC":569,"2C-8H-KC":569,"2C-8S-KC":569,"2C-8D-KD":569,"2C-8D-KH":569,"2C-8D-KS":569,"2C-8H-KD":569,"2C-8S-KD":569,"2C-8H-KH":569,"2C-8H-KS":569,"2C-8S-KH":569,"2C-8S-KS":569,"2D-8C-KC":569,"2H-8C-KC":569,"2S-8C-KC":569,"2D-8C-KD":569,"2D-8C-KH":569,"2D-8C-KS":569,"2H-8C-KD":569,"2S-8C-KD":569,"2H-8C-KH":569,"2H-8C-KS":569,"2S-8C-KH":569,"2S-8C-KS":569,"2D-8D-KC":569,"2D-8H-KC":569,"2D-8S-KC":569,"2H-8D-KC":569,"2S-8D-KC":569,"2H-8H-KC":569,"2H-8S-KC":569,"2S-8H-KC":569,"2S-8S-KC":569,"2D-8D-KH":569,"2D-8D-KS":569,"2D-8H-KD":569,"2D-8S-KD":569,"2D-8H-KH":569,"2D-8H-KS":569,"2D-8S-KH":569,"2D-8S-KS":569,"2H-8D-KD":569,"2S-8D-KD":569,"2H-8D-KH":569,"2H-8D-KS":569,"2S-8D-KH":569,"2S-8D-KS":569,"2H-8H-KD":569,"2H-8S-KD":569,"2S-8H-KD":569,"2S-8S-KD":569,"2H-8H-KS":569,"2H-8S-KH":569,"2H-8S-KS":569,"2S-8H-KH":569,"2S-8H-KS":569,"2S-8S-KH":569,"6C-7C-KD":570,"6C-7C-KH":570,"6C-7C-KS":570,"6C-7D-KC":570,"6C-7H-KC":570,"6C-7S-KC":570,"6C-7D-KD":570,"6C-7D-KH":570,"6C-7D-KS":570,"6C-7H-KD":570,"6C-7S-KD":570,"6C-7H-KH":570,"6C-7H-KS":570,"6C-7S-KH":570,"6C-7S-KS":570,"6D-7C-KC":570,"6H-7C-KC":570,"6S-7C-KC":570,"6D-7C-KD":570,"6D-7C-KH":570,"6D-7C-KS":570,"6H-7C-KD":570,"6S-7C-KD":570,"6H-7C-KH":570,"6H-7C-KS":570,"6S-7C-KH":570,"6S-7C-KS":570,"6D-7D-KC":570,"6D-7H-KC":570,"6D-7S-KC":570,"6H-7D-KC":570,"6S-7D-KC":570,"6H-7H-KC":570,"6H-7S-KC":570,"6S-7H-KC":570,"6S-7S-KC":570,"6D-7D-KH":570,"6D-7D-KS":570,"6D-7H-KD":570,"6D-7S-KD":570,"6D-7H-KH":570,"6D-7H-KS":570,"6D-7S-KH":570,"6D-7S-KS":570,"6H-7D-KD":570,"6S-7D-KD":570,"6H-7D-KH":570,"6H-7D-KS":570,"6S-7D-KH":570,"6S-7D-KS":570,"6H-7H-KD":570,"6H-7S-KD":570,"6S-7H-KD":570,"6S-7S-KD":570,"6H-7H-KS":570,"6H-7S-KH":570,"6H-7S-KS":570,"6S-7H-KH":570,"6S-7H-KS":570,"6S-7S-KH":570,"5C-7C-KD":571,"5C-7C-KH":571,"5C-7C-KS":571,"5C-7D-KC":571,"5C-7H-KC":571,"5C-7S-KC":571,"5C-7D-KD":571,"5C-7D-KH":571,"5C-7D-KS":571,"5C-7H-KD":571,"5C-7S-KD":571,"5C-7H-KH":571,"5C-7H-KS":571,"5C-7S-KH":571,"5C-7S-KS":571,"5D-7C-KC":571,"5H-7C-KC":571,"5S-7C-KC":571,"5D-7C-KD":571,"5D-7C-KH":571,"5D-7C-KS":571,"5H-7C-KD":571,"5S-7C-KD":571,"5H-7C-KH":571,"5H-7C-KS":571,"5S-7C-KH":571,"5S-7C-KS":571,"5D-7D-KC":571,"5D-7H-KC":571,"5D-7S-KC":571,"5H-7D-KC":571,"5S-7D-KC":571,"5H-7H-KC":571,"5H-7S-KC":571,"5S-7H-KC":571,"5S-7S-KC":571,"5D-7D-KH":571,"5D-7D-KS":571,"5D-7H-KD":571,"5D-7S-KD":571,"5D-7H-KH":571,"5D-7H-KS":571,"5D-7S-KH":571,"5D-7S-KS":571,"5H-7D-KD":571,"5S-7D-KD":571,"5H-7D-KH":571,"5H-7D-KS":571,"5S-7D-KH":571,"5S-7D-KS":571,"5H-7H-KD":571,"5H-7S-KD":571,"5S-7H-KD":571,"5S-7S-KD":571,"5H-7H-KS":571,"5H-7S-KH":571,"5H-7S-KS":571,"5S-7H-KH":571,"5S-7H-KS":571,"5S-7S-KH":571,"4C-7C-KD":572,"4C-7C-KH":572,"4C-7C-KS":572,"4C-7D-KC":572,"4C-7H-KC":572,"4C-7S-KC":572,"4C-7D-KD":572,"4C-7D-KH":572,"4C-7D-KS":572,"4C-7H-KD":572,"4C-7S-KD":572,"4C-7H-KH":572,"4C-7H-KS":572,"4C-7S-KH":572,"4C-7S-KS":572,"4D-7C-KC":572,"4H-7C-KC":572,"4S-7C-KC":572,"4D-7C-KD":572,"4D-7C-KH":572,"4D-7C-KS":572,"4H-7C-KD":572,"4S-7C-KD":572,"4H-7C-KH":572,"4H-7C-KS":572,"4S-7C-KH":572,"4S-7C-KS":572,"4D-7D-KC":572,"4D-7H-KC":572,"4D-7S-KC":572,"4H-7D-KC":572,"4S-7D-KC":572,"4H-7H-KC":572,"4H-7S-KC":572,"4S-7H-KC":572,"4S-7S-KC":572,"4D-7D-KH":572,"4D-7D-KS":572,"4D-7H-KD":572,"4D-7S-KD":572,"4D-7H-KH":572,"4D-7H-KS":572,"4D-7S-KH":572,"4D-7S-KS":572,"4H-7D-KD":572,"4S-7D-KD":572,"4H-7D-KH":572,"4H-7D-KS":572,"4S-7D-KH":572,"4S-7D-KS":572,"4H-7H-KD":572,"4H-7S-KD":572,"4S-7H-KD":572,"4S-7S-KD":572,"4H-7H-KS":572,"4H-7S-KH":572,"4H-7S-KS":572,"4S-7H-KH":572,"4S-7H-KS":572,"4S-7S-KH":572,"3C-7C-KD":573,"3C-7C-KH":573,"3C-7C-KS":573,"3C-7D-KC":573,"3C-7H-KC":573,"3C-7S-KC":573,"3C-7D-KD":573,"3C-7D-KH":573,"3C-7D-KS":573,"3C-7H-KD":573,"3C-7S-KD":573,"3C-7H-KH":573,"3C-7H-KS":573,"3C-7S-KH":573,"3C-7S-KS":573,"3D-7C-KC":573,"3H-7C-KC":573,"3S-7C-KC":573,"3D-7C-KD":573,"3D-7C-KH":573,"3D-7C-KS":573,"3H-7C-KD":573,"3S-7C-KD":573,"3H-7C-KH":573,"3H-7C-KS":573,"3S-7C-KH":573,"3S-7C-KS":573,"3D-7D-KC":573,"3D-7H-KC":573,"3D-7S-KC":573,"3H-7D-KC":573,"3S-7D-KC":573,"3H-7H-KC":573,"3H-7S-KC":573,"3S-7H-KC":573,"3S-7S-KC":573,"3D-7D-KH":573,"3D-7D-KS":573,"3D-7H-KD":573,"3D-7S-KD":573,"3D-7H-KH":573,"3D-7H-KS":573,"3D-7S-KH":573,"3D-7S-KS":573,"3H-7D-KD":573,"3S-7D-KD":573,"3H-7D-KH":573,"3H-7D-KS":573,"3S-7D-KH":573,"3S-7D-KS":573,"3H-7H-KD":573,"3H-7S-KD":573,"3S-7H-KD":573,"3S-7S-KD":573,"3H-7H-KS":573,"3H-7S-KH":573,"3H-7S-KS":573,"3S-7H-KH":573,"3S-7H-KS":573,"3S-7S-KH":573,"2C-7C-KD":574,"2C-7C-KH":574,"2C-7C-KS":574,"2C-7D-KC":574,"2C-7H-KC":574,"2C-7S-KC":574,"2C-7D-KD":574,"2C-7D-KH":574,"2C-7D-KS":574,"2C-7H-KD":574,"2C-7S-KD":574,"2C-7H-KH":574,"2C-7H-KS":574,"2C-7S-KH":574,"2C-7S-KS":574,"2D-7C-KC":574,"2H-7C-KC":574,"2S-7C-KC":574,"2D-7C-KD":574,"2D-7C-KH":574,"2D-7C-KS":574,"2H-7C-KD":574,"2S-7C-KD":574,"2H-7C-KH":574,"2H-7C-KS":574,"2S-7C-KH":574,"2S-7C-KS":574,"2D-7D-KC":574,"2D-7H-KC":574,"2D-7S-KC":574,"2H-7D-KC":574,"2S-7D-KC":574,"2H-7H-KC":574,"2H-7S-KC":574,"2S-7H-KC":574,"2S-7S-KC":574,"2D-7D-KH":574,"2D-7D-KS":574,"2D-7H-KD":574,"2D-7S-KD":574,"2D-7H-KH":574,"2D-7H-KS":574,"2D-7S-KH":574,"2D-7S-KS":574,"2H-7D-KD":574,"2S-7D-KD":574,"2H-7D-KH":574,"2H-7D-KS":574,"2S-7D-KH":574,"2S-7D-KS":574,"2H-7H-KD":574,"2H-7S-KD":574,"2S-7H-KD":574,"2S-7S-KD":574,"2H-7H-KS":574,"2H-7S-KH":574,"2H-7S-KS":574,"2S-7H-KH":574,"2S-7H-KS":574,"2S-7S-KH":574,"5C-6C-KD":575,"5C-6C-KH":575,"5C-6C-KS":575,"5C-6D-KC":575,"5C-6H-KC":575,"5C-6S-KC":575,"5C-6D-KD":575,"5C-6D-KH":575,"5C-6D-KS":575,"5C-6H-KD":575,"5C-6S-KD":575,"5C-6H-KH":575,"5C-6H-KS":575,"5C-6S-KH":575,"5C-6S-KS":575,"5D-6C-KC":575,"5H-6C-KC":575,"5S-6C-KC":575,"5D-6C-KD":575,"5D-6C-KH":575,"5D-6C-KS":575,"5H-6C-KD":575,"5S-6C-KD":575,"5H-6C-KH":575,"5H-6C-KS":575,"5S-6C-KH":575,"5S-6C-KS":575,"5D-6D-KC":575,"5D-6H-KC":575,"5D-6S-KC":575,"5H-6D-KC":575,"5S-6D-KC":575,"5H-6H-KC":575,"5H-6S-KC":575,"5S-6H-KC":575,"5S-6S-KC":575,"5D-6D-KH":575,"5D-6D-KS":575,"5D-6H-KD":575,"5D-6S-KD":575,"5D-6H-KH":575,"5D-6H-KS":575,"5D-6S-KH":575,"5D-6S-KS":575,"5H-6D-KD":575,"5S-6D-KD":575,"5H-6D-KH":575,"5H-6D-KS":575,"5S-6D-KH":575,"5S-6D-KS":575,"5H-6H-KD":575,"5H-6S-KD":575,"5S-6H-KD":575,"5S-6S-KD":575,"5H-6H-KS":575,"5H-6S-KH":575,"5H-6S-KS":575,"5S-6H-KH":575,"5S-6H-KS":575,"5S-6S-KH":575,"4C-6C-KD":576,"4C-6C-KH":576,"4C-6C-KS":576,"4C-6D-KC":576,"4C-6H-KC":576,"4C-6S-KC":576,"4C-6D-KD":576,"4C-6D-KH":576,"4C-6D-KS":576,"4C-6H-KD":576,"4C-6S-KD":576,"4C-6H-KH":576,"4C-6H-KS":576,"4C-6S-KH":576,"4C-6S-KS":576,"4D-6C-KC":576,"4H-6C-KC":576,"4S-6C-KC":576,"4D-6C-KD":576,"4D-6C-KH":576,"4D-6C-KS":576,"4H-6C-KD":576,"4S-6C-KD":576,"4H-6C-KH":576,"4H-6C-KS":576,"4S-6C-KH":576,"4S-6C-KS":576,"4D-6D-KC":576,"4D-6H-KC":576,"4D-6S-KC":576,"4H-6D-KC":576,"4S-6D-KC":576,"4H-6H-KC":576,"4H-6S-KC":576,"4S-6H-KC":576,"4S-6S-KC":576,"4D-6D-KH":576,"4D-6D-KS":576,"4D-6H-KD":576,"4D-6S-KD":576,"4D-6H-KH":576,"4D-6H-KS":576,"4D-6S-KH":576,"4D-6S-KS":576,"4H-6D-KD":576,"4S-6D-KD":576,"4H-6D-KH":576,"4H-6D-KS":576,"4S-6D-KH":576,"4S-6D-KS":576,"4H-6H-KD":576,"4H-6S-KD":576,"4S-6H-KD":576,"4S-6S-KD":576,"4H-6H-KS":576,"4H-6S-KH":576,"4H-6S-KS":576,"4S-6H-KH":576,"4S-6H-KS":576,"4S-6S-KH":576,"3C-6C-KD":577,"3C-6C-KH":577,"3C-6C-KS":577,"3C-6D-KC":577,"3C-6H-KC":577,"3C-6S-KC":577,"3C-6D-KD":577,"3C-6D-KH":577,"3C-6D-KS":577,"3C-6H-KD":577,"3C-6S-KD":577,"3C-6H-KH":577,"3C-6H-KS":577,"3C-6S-KH":577,"3C-6S-KS":577,"3D-6C-KC":577,"3H-6C-KC":577,"3S-6C-KC":577,"3D-6C-KD":577,"3D-6C-KH":577,"3D-6C-KS":577,"3H-6C-KD":577,"3S-6C-KD":577,"3H-6C-KH":577,"3H-6C-KS":577,"3S-6C-KH":577,"3S-6C-KS":577,"3D-6D-KC":577,"3D-6H-KC":577,"3D-6S-KC":577,"3H-6D-KC":577,"3S-6D-KC":577,"3H-6H-KC":577,"3H-6S-KC":577,"3S-6H-KC":577,"3S-6S-KC":577,"3D-6D-KH":577,"3D-6D-KS":577,"3D-6H-KD":577,"3D-6S-KD":577,"3D-6H-KH":577,"3D-6H-KS":577,"3D-6S-KH":577,"3D-6S-KS":577,"3H-6D-KD":577,"3S-6D-KD":577,"3H-6D-KH":577,"3H-6D-KS":577,"3S-6D-KH":577,"3S-6D-KS":577,"3H-6H-KD":577,"3H-6S-KD":577,"3S-6H-KD":577,"3S-6S-KD":577,"3H-6H-KS":577,"3H-6S-KH":577,"3H-6S-KS":577,"3S-6H-KH":577,"3S-6H-KS":577,"3S-6S-KH":577,"2C-6C-KD":578,"2C-6C-KH":578,"2C-6C-KS":578,"2C-6D-KC":578,"2C-6H-KC":578,"2C-6S-KC":578,"2C-6D-KD":578,"2C-6D-KH":578,"2C-6D-KS":578,"2C-6H-KD":578,"2C-6S-KD":578,"2C-6H-KH":578,"2C-6H-KS":578,"2C-6S-KH":578,"2C-6S-KS":578,"2D-6C-KC":578,"2H-6C-KC":578,"2S-6C-KC":578,"2D-6C-KD":578,"2D-6C-KH":578,"2D-6C-KS":578,"2H-6C-KD":578,"2S-6C-KD":578,"2H-6C-KH":578,"2H-6C-KS":578,"2S-6C-KH":578,"2S-6C-KS":578,"2D-6D-KC":578,"2D-6H-KC":578,"2D-6S-KC":578,"2H-6D-KC":578,"2S-6D-KC":578,"2H-6H-KC":578,"2H-6S-KC":578,"2S-6H-KC":578,"2S-6S-KC":578,"2D-6D-KH":578,"2D-6D-KS":578,"2D-6H-KD":578,"2D-6S-KD":578,"2D-6H-KH":578,"2D-6H-KS":578,"2D-6S-KH":578,"2D-6S-KS":578,"2H-6D-KD":578,"2S-6D-KD":578,"2H-6D-KH":578,"2H-6D-KS":578,"2S-6D-KH":578,"2S-6D-KS":578,"2H-6H-KD":578,"2H-6S-KD":578,"2S-6H-KD":578,"2S-6S-KD":578,"2H-6H-KS":578,"2H-6S-KH":578,"2H-6S-KS":578,"2S-6H-KH":578,"2S-6H-KS":578,"2S-6S-KH":578,"4C-5C-KD":579,"4C-5C-KH":579,"4C-5C-KS":579,"4C-5D-KC":579,"4C-5H-KC":579,"4C-5S-KC":579,"4C-5D-KD":579,"4C-5D-KH":579,"4C-5D-KS":579,"4C-5H-KD":579,"4C-5S-KD":579,"4C-5H-KH":579,"4C-5H-KS":579,"4C-5S-KH":579,"4C-5S-KS":579,"4D-5C-KC":579,"4H-5C-KC":579,"4S-5C-KC":579,"4D-5C-KD":579,"4D-5C-KH":579,"4D-5C-KS":579,"4H-5C-KD":579,"4S-5C-KD":579,"4H-5C-KH":579,"4H-5C-KS":579,"4S-5C-KH":579,"4S-5C-KS":579,"4D-5D-KC":579,"4D-5H-KC":579,"4D-5S-KC":579,"4H-5D-KC":579,"4S-5D-KC":579,"4H-5H-KC":579,"4H-5S-KC":579,"4S-5H-KC":579,"4S-5S-KC":579,"4D-5D-KH":579,"4D-5D-KS":579,"4D-5H-KD":579,"4D-5S-KD":579,"4D-5H-KH":579,"4D-5H-KS":579,"4D-5S-KH":579,"4D-5S-KS":579,"4H-5D-KD":579,"4S-5D-KD":579,"4H-5D-KH":579,"4H-5D-KS":579,"4S-5D-KH":579,"4S-5D-KS":579,"4H-5H-KD":579,"4H-5S-KD":579,"4S-5H-KD":579,"4S-5S-KD":579,"4H-5H-KS":579,"4H-5S-KH":579,"4H-5S-KS":579,"4S-5H-KH":579,"4S-5H-KS":579,"4S-5S-KH":579,"3C-5C-KD":580,"3C-5C-KH":580,"3C-5C-KS":580,"3C-5D-KC":580,"3C-5H-KC":580,"3C-5S-KC":580,"3C-5D-KD":580,"3C-5D-KH":580,"3C-5D-KS":580,"3C-5H-KD":580,"3C-5S-KD":580,"3C-5H-KH":580,"3C-5H-KS":580,"3C-5S-KH":580,"3C-5S-KS":580,"3D-5C-KC":580,"3H-5C-KC":580,"3S-5C-KC":580,"3D-5C-KD":580,"3D-5C-KH":580,"3D-5C-KS":580,"3H-5C-KD":580,"3S-5C-KD":580,"3H-5C-KH":580,"3H-5C-KS":580,"3S-5C-KH":580,"3S-5C-KS":580,"3D-5D-KC":580,"3D-5H-KC":580,"3D-5S-KC":580,"3H-5D-KC":580,"3S-5D-KC":580,"3H-5H-KC":580,"3H-5S-KC":580,"3S-5H-KC":580,"3S-5S-KC":580,"3D-5D-KH":580,"3D-5D-KS":580,"3D-5H-KD":580,"3D-5S-KD":580,"3D-5H-KH":580,"3D-5H-KS":580,"3D-5S-KH":580,"3D-5S-KS":580,"3H-5D-KD":580,"3S-5D-KD":580,"3H-5D-KH":580,"3H-5D-KS":580,"3S-5D-KH":580,"3S-5D-KS":580,"3H-5H-KD":580,"3H-5S-KD":580,"3S-5H-KD":580,"3S-5S-KD":580,"3H-5H-KS":580,"3H-5S-KH":580,"3H-5S-KS":580,"3S-5H-KH":580,"3S-5H-KS":580,"3S-5S-KH":580,"2C-5C-KD":581,"2C-5C-KH":581,"2C-5C-KS":581,"2C-5D-KC":581,"2C-5H-KC":581,"2C-5S-KC":581,"2C-5D-KD":581,"2C-5D-KH":581,"2C-5D-KS":581,"2C-5H-KD":581,"2C-5S-KD":581,"2C-5H-KH":581,"2C-5H-KS":581,"2C-5S-KH":581,"2C-5S-KS":581,"2D-5C-KC":581,"2H-5C-KC":581,"2S-5C-KC":581,"2D-5C-KD":581,"2D-5C-KH":581,"2D-5C-KS":581,"2H-5C-KD":581,"2S-5C-KD":581,"2H-5C-KH":581,"2H-5C-KS":581,"2S-5C-KH":581,"2S-5C-KS":581,"2D-5D-KC":581,"2D-5H-KC":581,"2D-5S-KC":581,"2H-5D-KC":581,"2S-5D-KC":581,"2H-5H-KC":581,"2H-5S-KC":581,"2S-5H-KC":581,"2S-5S-KC":581,"2D-5D-KH":581,"2D-5D-KS":581,"2D-5H-KD":581,"2D-5S-KD":581,"2D-5H-KH":581,"2D-5H-KS":581,"2D-5S-KH":581,"2D-5S-KS":581,"2H-5D-KD":581,"2S-5D-KD":581,"2H-5D-KH":581,"2H-5D-KS":581,"2S-5D-KH":581,"2S-5D-KS":581,"2H-5H-KD":581,"2H-5S-KD":581,"2S-5H-KD":581,"2S-5S-KD":581,"2H-5H-KS":581,"2H-5S-KH":581,"2H-5S-KS":581,"2S-5H-KH":581,"2S-5H-KS":581,"2S-5S-KH":581,"3C-4C-KD":582,"3C-4C-KH":582,"3C-4C-KS":582,"3C-4D-KC":582,"3C-4H-KC":582,"3C-4S-KC":582,"3C-4D-KD":582,"3C-4D-KH":582,"3C-4D-KS":582,"3C-4H-KD":582,"3C-4S-KD":582,"3C-4H-KH":582,"3C-4H-KS":582,"3C-4S-KH":582,"3C-4S-KS":582,"3D-4C-KC":582,"3H-4C-KC":582,"3S-4C-KC":582,"3D-4C-KD":582,"3D-4C-KH":582,"3D-4C-KS":582,"3H-4C-KD":582,"3S-4C-KD":582,"3H-4C-KH":582,"3H-4C-KS":582,"3S-4C-KH":582,"3S-4C-KS":582,"3D-4D-KC":582,"3D-4H-KC":582,"3D-4S-KC":582,"3H-4D-KC":582,"3S-4D-KC":582,"3H-4H-KC":582,"3H-4S-KC":582,"3S-4H-KC":582,"3S-4S-KC":582,"3D-4D-KH":582,"3D-4D-KS":582,"3D-4H-KD":582,"3D-4S-KD":582,"3D-4H-KH":582,"3D-4H-KS":582,"3D-4S-KH":582,"3D-4S-KS":582,"3H-4D-KD":582,"3S-4D-KD":582,"3H-4D-KH":582,"3H-4D-KS":582,"3S-4D-KH":582,"3S-4D-KS":582,"3H-4H-KD":582,"3H-4S-KD":582,"3S-4H-KD":582,"3S-4S-KD":582,"3H-4H-KS":582,"3H-4S-KH":582,"3H-4S-KS":582,"3S-4H-KH":582,"3S-4H-KS":582,"3S-4S-KH":582,"2C-4C-KD":583,"2C-4C-KH":583,"2C-4C-KS":583,"2C-4D-KC":583,"2C-4H-KC":583,"2C-4S-KC":583,"2C-4D-KD":583,"2C-4D-KH":583,"2C-4D-KS":583,"2C-4H-KD":583,"2C-4S-KD":583,"2C-4H-KH":583,"2C-4H-KS":583,"2C-4S-KH":583,"2C-4S-KS":583,"2D-4C-KC":583,"2H-4C-KC":583,"2S-4C-KC":583,"2D-4C-KD":583,"2D-4C-KH":583,"2D-4C-KS":583,"2H-4C-KD":583,"2S-4C-KD":583,"2H-4C-KH":583,"2H-4C-KS":583,"2S-4C-KH":583,"2S-4C-KS":583,"2D-4D-KC":583,"2D-4H-KC":583,"2D-4S-KC":583,"2H-4D-KC":583,"2S-4D-KC":583,"2H-4H-KC":583,"2H-4S-KC":583,"2S-4H-KC":583,"2S-4S-KC":583,"2D-4D-KH":583,"2D-4D-KS":583,"2D-4H-KD":583,"2D-4S-KD":583,"2D-4H-KH":583,"2D-4H-KS":583,"2D-4S-KH":583,"2D-4S-KS":583,"2H-4D-KD":583,"2S-4D-KD":583,"2H-4D-KH":583,"2H-4D-KS":583,"2S-4D-KH":583,"2S-4D-KS":583,"2H-4H-KD":583,"2H-4S-KD":583,"2S-4H-KD":583,"2S-4S-KD":583,"2H-4H-KS":583,"2H-4S-KH":583,"2H-4S-KS":583,"2S-4H-KH":583,"2S-4H-KS":583,"2S-4S-KH":583,"2C-3C-KD":584,"2C-3C-KH":584,"2C-3C-KS":584,"2C-3D-KC":584,"2C-3H-KC":584,"2C-3S-KC":584,"2C-3D-KD":584,"2C-3D-KH":584,"2C-3D-KS":584,"2C-3H-KD":584,"2C-3S-KD":584,"2C-3H-KH":584,"2C-3H-KS":584,"2C-3S-KH":584,"2C-3S-KS":584,"2D-3C-KC":584,"2H-3C-KC":584,"2S-3C-KC":584,"2D-3C-KD":584,"2D-3C-KH":584,"2D-3C-KS":584,"2H-3C-KD":584,"2S-3C-KD":584,"2H-3C-KH":584,"2H-3C-KS":584,"2S-3C-KH":584,"2S-3C-KS":584,"2D-3D-KC":584,"2D-3H-KC":584,"2D-3S-KC":584,"2H-3D-KC":584,"2S-3D-KC":584,"2H-3H-KC":584,"2H-3S-KC":584,"2S-3H-KC":584,"2S-3S-KC":584,"2D-3D-KH":584,"2D-3D-KS":584,"2D-3H-KD":584,"2D-3S-KD":584,"2D-3H-KH":584,"2D-3H-KS":584,"2D-3S-KH":584,"2D-3S-KS":584,"2H-3D-KD":584,"2S-3D-KD":584,"2H-3D-KH":584,"2H-3D-KS":584,"2S-3D-KH":584,"2S-3D-KS":584,"2H-3H-KD":584,"2H-3S-KD":584,"2S-3H-KD":584,"2S-3S-KD":584,"2H-3H-KS":584,"2H-3S-KH":584,"2H-3S-KS":584,"2S-3H-KH":584,"2S-3H-KS":584,"2S-3S-KH":584,"9C-JC-QD":585,"9C-JC-QH":585,"9C-JC-QS":585,"9C-JD-QC":585,"9C-JH-QC":585,"9C-JS-QC":585,"9C-JD-QD":585,"9C-JD-QH":585,"9C-JD-QS":585,"9C-JH-QD":585,"9C-JS-QD":585,"9C-JH-QH":585,"9C-JH-QS":585,"9C-JS-QH":585,"9C-JS-QS":585,"9D-JC-QC":585,"9H-JC-QC":585,"9S-JC-QC":585,"9D-JC-QD":585,"9D-JC-QH":585,"9D-JC-QS":585,"9H-JC-QD":585,"9S-JC-QD":585,"9H-JC-QH":585,"9H-JC-QS":585,"9S-JC-QH":585,"9S-JC-QS":585,"9D-JD-QC":585,"9D-JH-QC":585,"9D-JS-QC":585,"9H-JD-QC":585,"9S-JD-QC":585,"9H-JH-QC":585,"9H-JS-QC":585,"9S-JH-QC":585,"9S-JS-QC":585,"9D-JD-QH":585,"9D-JD-QS":585,"9D-JH-QD":585,"9D-JS-QD":585,"9D-JH-QH":585,"9D-JH-QS":585,"9D-JS-QH":585,"9D-JS-QS":585,"9H-JD-QD":585,"9S-JD-QD":585,"9H-JD-QH":585,"9H-JD-QS":585,"9S-JD-QH":585,"9S-JD-QS":585,"9H-JH-QD":585,"9H-JS-QD":585,"9S-JH-QD":585,"9S-JS-QD":585,"9H-JH-QS":585,"9H-JS-QH":585,"9H-JS-QS":585,"9S-JH-QH":585,"9S-JH-QS":585,"9S-JS-QH":585,"8C-JC-QD":586,"8C-JC-QH":586,"8C-JC-QS":586,"8C-JD-QC":586,"8C-JH-QC":586,"8C-JS-QC":586,"8C-JD-QD":586,"8C-JD-QH":586,"8C-JD-QS":586,"8C-JH-QD":586,"8C-JS-QD":586,"8C-JH-QH":586,"8C-JH-QS":586,"8C-JS-QH":586,"8C-JS-QS":586,"8D-JC-QC":586,"8H-JC-QC":586,"8S-JC-QC":586,"8D-JC-QD":586,"8D-JC-QH":586,"8D-JC-QS":586,"8H-JC-QD":586,"8S-JC-QD":586,"8H-JC-QH":586,"8H-JC-QS":586,"8S-JC-QH":586,"8S-JC-QS":586,"8D-JD-QC":586,"8D-JH-QC":586,"8D-JS-QC":586,"8H-JD-QC":586,"8S-JD-QC":586,"8H-JH-QC":586,"8H-JS-QC":586,"8S-JH-QC":586,"8S-JS-QC":586,"8D-JD-QH":586,"8D-JD-QS":586,"8D-JH-QD":586,"8D-JS-QD":586,"8D-JH-QH":586,"8D-JH-QS":586,"8D-JS-QH":586,"8D-JS-QS":586,"8H-JD-QD":586,"8S-JD-QD":586,"8H-JD-QH":586,"8H-JD-QS":586,"8S-JD-QH":586,"8S-JD-QS":586,"8H-JH-QD":586,"8H-JS-QD":586,"8S-JH-QD":586,"8S-JS-QD":586,"8H-JH-QS":586,"8H-JS-QH":586,"8H-JS-QS":586,"8S-JH-QH":586,"8S-JH-QS":586,"8S-JS-QH":586,"7C-JC-QD":587,"7C-JC-QH":587,"7C-JC-QS":587,"7C-JD-QC":587,"7C-JH-QC":587,"7C-JS-QC":587,"7C-JD-QD":587,"7C-JD-QH":587,"7C-JD-QS":587,"7C-JH-QD":587,"7C-JS-QD":587,"7C-JH-QH":587,"7C-JH-QS":587,"7C-JS-QH":587,"7C-JS-QS":587,"7D-JC-QC":587,"7H-JC-QC":587,"7S-JC-QC":587,"7D-JC-QD":587,"7D-JC-QH":587,"7D-JC-QS":587,"7H-JC-QD":587,"7S-JC-QD":587,"7H-JC-QH":587,"7H-JC-QS":587,"7S-JC-QH":587,"7S-JC-QS":587,"7D-JD-QC":587,"7D-JH-QC":587,"7D-JS-QC":587,"7H-JD-QC":587,"7S-JD-QC":587,"7H-JH-QC":587,"7H-JS-QC":587,"7S-JH-QC":587,"7S-JS-QC":587,"7D-JD-QH":587,"7D-JD-QS":587,"7D-JH-QD":587,"7D-JS-QD":587,"7D-JH-QH":587,"7D-JH-QS":587,"7D-JS-QH":587,"7D-JS-QS":587,"7H-JD-QD":587,"7S-JD-QD":587,"7H-JD-QH":587,"7H-JD-QS":587,"7S-JD-QH":587,"7S-JD-QS":587,"7H-JH-QD":587,"7H-JS-QD":587,"7S-JH-QD":587,"7S-JS-QD":587,"7H-JH-QS":587,"7H-JS-QH":587,"7H-JS-QS":587,"7S-JH-QH":587,"7S-JH-QS":587,"7S-JS-QH":587,"6C-JC-QD":588,"6C-JC-QH":588,"6C-JC-QS":588,"6C-JD-QC":588,"6C-JH-QC":588,"6C-JS-QC":588,"6C-JD-QD":588,"6C-JD-QH":588,"6C-JD-QS":588,"6C-JH-QD":588,"6C-JS-QD":588,"6C-JH-QH":588,"6C-JH-QS":588,"6C-JS-QH":588,"6C-JS-QS":588,"6D-JC-QC":588,"6H-JC-QC":588,"6S-JC-QC":588,"6D-JC-QD":588,"6D-JC-QH":588,"6D-JC-QS":588,"6H-JC-QD":588,"6S-JC-QD":588,"6H-JC-QH":588,"6H-JC-QS":588,"6S-JC-QH":588,"6S-JC-QS":588,"6D-JD-QC":588,"6D-JH-QC":588,"6D-JS-QC":588,"6H-JD-QC":588,"6S-JD-QC":588,"6H-JH-QC":588,"6H-JS-QC":588,"6S-JH-QC":588,"6S-JS-QC":588,"6D-JD-QH":588,"6D-JD-QS":588,"6D-JH-QD":588,"6D-JS-QD":588,"6D-JH-QH":588,"6D-JH-QS":588,"6D-JS-QH":588,"6D-JS-QS":588,"6H-JD-QD":588,"6S-JD-QD":588,"6H-JD-QH":588,"6H-JD-QS":588,"6S-JD-QH":588,"6S-JD-QS":588,"6H-JH-QD":588,"6H-JS-QD":588,"6S-JH-QD":588,"6S-JS-QD":588,"6H-JH-QS":588,"6H-JS-QH":588,"6H-JS-QS":588,"6S-JH-QH":588,"6S-JH-QS":588,"6S-JS-QH":588,"5C-JC-QD":589,"5C-JC-QH":589,"5C-JC-QS":589,"5C-JD-QC":589,"5C-JH-QC":589,"5C-JS-QC":589,"5C-JD-QD":589,"5C-JD-QH":589,"5C-JD-QS":589,"5C-JH-QD":589,"5C-JS-QD":589,"5C-JH-QH":589,"5C-JH-QS":589,"5C-JS-QH":589,"5C-JS-QS":589,"5D-JC-QC":589,"5H-JC-QC":589,"5S-JC-QC":589,"5D-JC-QD":589,"5D-JC-QH":589,"5D-JC-QS":589,"5H-JC-QD":589,"5S-JC-QD":589,"5H-JC-QH":589,"5H-JC-QS":589,"5S-JC-QH":589,"5S-JC-QS":589,"5D-JD-QC":589,"5D-JH-QC":589,"5D-JS-QC":589,"5H-JD-QC":589,"5S-JD-QC":589,"5H-JH-QC":589,"5H-JS-QC":589,"5S-JH-QC":589,"5S-JS-QC":589,"5D-JD-QH":589,"5D-JD-QS":589,"5D-JH-QD":589,"5D-JS-QD":589,"5D-JH-QH":589,"5D-JH-QS":589,"5D-JS-QH":589,"5D-JS-QS":589,"5H-JD-QD":589,"5S-JD-QD":589,"5H-JD-QH":589,"5H-JD-QS":589,"5S-JD-QH":589,"5S-JD-QS":589,"5H-JH-QD":589,"5H-JS-QD":589,"5S-JH-QD":589,"5S-JS-QD":589,"5H-JH-QS":589,"5H-JS-QH":589,"5H-JS-QS":589,"5S-JH-QH":589,"5S-JH-QS":589,"5S-JS-QH":589,"4C-JC-QD":590,"4C-JC-QH":590,"4C-JC-QS":590,"4C-JD-QC":590,"4C-JH-QC":590,"4C-JS-QC":590,"4C-JD-QD":590,"4C-JD-QH":590,"4C-JD-QS":590,"4C-JH-QD":590,"4C-JS-QD":590,"4C-JH-QH":590,"4C-JH-QS":590,"4C-JS-QH":590,"4C-JS-QS":590,"4D-JC-QC":590,"4H-JC-QC":590,"4S-JC-QC":590,"4D-JC-QD":590,"4D-JC-QH":590,"4D-JC-QS":590,"4H-JC-QD":590,"4S-JC-QD":590,"4H-JC-QH":590,"4H-JC-QS":590,"4S-JC-QH":590,"4S-JC-QS":590,"4D-JD-QC":590,"4D-JH-QC":590,"4D-JS-QC":590,"4H-JD-QC":590,"4S-JD-QC":590,"4H-JH-QC":590,"4H-JS-QC":590,"4S-JH-QC":590,"4S-JS-QC":590,"4D-JD-QH":590,"4D-JD-QS":590,"4D-JH-QD":590,"4D-JS-QD":590,"4D-JH-QH":590,"4D-JH-QS":590,"4D-JS-QH":590,"4D-JS-QS":590,"4H-JD-QD":590,"4S-JD-QD":590,"4H-JD-QH":590,"4H-JD-QS":590,"4S-JD-QH":590,"4S-JD-QS":590,"4H-JH-QD":590,"4H-JS-QD":590,"4S-JH-QD":590,"4S-JS-QD":590,"4H-JH-QS":590,"4H-JS-QH":590,"4H-JS-QS":590,"4S-JH-QH":590,"4S-JH-QS":590,"4S-JS-QH":590,"3C-JC-QD":591,"3C-JC-QH":591,"3C-JC-QS":591,"3C-JD-QC":591,"3C-JH-QC":591,"3C-JS-QC":591,"3C-JD-QD":591,"3C-JD-QH":591,"3C-JD-QS":591,"3C-JH-QD":591,"3C-JS-QD":591,"3C-JH-QH":591,"3C-JH-QS":591,"3C-JS-QH":591,"3C-JS-QS":591,"3D-JC-QC":591,"3H-JC-QC":591,"3S-JC-QC":591,"3D-JC-QD":591,"3D-JC-QH":591,"3D-JC-QS":591,"3H-JC-QD":591,"3S-JC-QD":591,"3H-JC-QH":591,"3H-JC-QS":591,"3S-JC-QH":591,"3S-JC-QS":591,"3D-JD-QC":591,"3D-JH-QC":591,"3D-JS-QC":591,"3H-JD-QC":591,"3S-JD-QC":591,"3H-JH-QC":591,"3H-JS-QC":591,"3S-JH-QC":591,"3S-JS-QC":591,"3D-JD-QH":591,"3D-JD-QS":591,"3D-JH-QD":591,"3D-JS-QD":591,"3D-JH-QH":591,"3D-JH-QS":591,"3D-JS-QH":591,"3D-JS-QS":591,"3H-JD-QD":591,"3S-JD-QD":591,"3H-JD-QH":591,"3H-JD-QS":591,"3S-JD-QH":591,"3S-JD-QS":591,"3H-JH-QD":591,"3H-JS-QD":591,"3S-JH-QD":591,"3S-JS-QD":591,"3H-JH-QS":591,"3H-JS-QH":591,"3H-JS-QS":591,"3S-JH-QH":591,"3S-JH-QS":591,"3S-JS-QH":591,"2C-JC-QD":592,"2C-JC-QH":592,"2C-JC-QS":592,"2C-JD-QC":592,"2C-JH-QC":592,"2C-JS-QC":592,"2C-JD-QD":592,"2C-JD-QH":592,"2C-JD-QS":592,"2C-JH-QD":592,"2C-JS-QD":592,"2C-JH-QH":592,"2C-JH-QS":592,"2C-JS-QH":592,"2C-JS-QS":592,"2D-JC-QC":592,"2H-JC-QC":592,"2S-JC-QC":592,"2D-JC-QD":592,"2D-JC-QH":592,"2D-JC-QS":592,"2H-JC-QD":592,"2S-JC-QD":592,"2H-JC-QH":592,"2H-JC-QS":592,"2S-JC-QH":592,"2S-JC-QS":592,"2D-JD-QC":592,"2D-JH-QC":592,"2D-JS-QC":592,"2H-JD-QC":592,"2S-JD-QC":592,"2H-JH-QC":592,"2H-JS-QC":592,"2S-JH-QC":592,"2S-JS-QC":592,"2D-JD-QH":592,"2D-JD-QS":592,"2D-JH-QD":592,"2D-JS-QD":592,"2D-JH-QH":592,"2D-JH-QS":592,"2D-JS-QH":592,"2D-JS-QS":592,"2H-JD-QD":592,"2S-JD-QD":592,"2H-JD-QH":592,"2H-JD-QS":592,"2S-JD-QH":592,"2S-JD-QS":592,"2H-JH-QD":592,"2H-JS-QD":592,"2S-JH-QD":592,"2S-JS-QD":592,"2H-JH-QS":592,"2H-JS-QH":592,"2H-JS-QS":592,"2S-JH-QH":592,"2S-JH-QS":592,"2S-JS-QH":592,"10C-9C-QD":593,"10C-9C-QH":593,"10C-9C-QS":593,"10D-9C-QC":593,"10H-9C-QC":593,"10S-9C-QC":593,"10D-9C-QD":593,"10D-9C-QH":593,"10D-9C-QS":593,"10H-9C-QD":593,"10S-9C-QD":593,"10H-9C-QH":593,"10H-9C-QS":593,"10S-9C-QH":593,"10S-9C-QS":593,"10C-9D-QC":593,"10C-9H-QC":593,"10C-9S-QC":593,"10C-9D-QD":593,"10C-9D-QH":593,"10C-9D-QS":593,"10C-9H-QD":593,"10C-9S-QD":593,"10C-9H-QH":593,"10C-9H-QS":593,"10C-9S-QH":593,"10C-9S-QS":593,"10D-9D-QC":593,"10H-9D-QC":593,"10S-9D-QC":593,"10D-9H-QC":593,"10D-9S-QC":593,"10H-9H-QC":593,"10S-9H-QC":593,"10H-9S-QC":593,"10S-9S-QC":593,"10D-9D-QH":593,"10D-9D-QS":593,"10H-9D-QD":593,"10S-9D-QD":593,"10H-9D-QH":593,"10H-9D-QS":593,"10S-9D-QH":593,"10S-9D-QS":593,"10D-9H-QD":593,"10D-9S-QD":593,"10D-9H-QH":593,"10D-9H-QS":593,"10D-9S-QH":593,"10D-9S-QS":593,"10H-9H-QD":593,"10S-9H-QD":593,"10H-9S-QD":593,"10S-9S-QD":593,"10H-9H-QS":593,"10S-9H-QH":593,"10S-9H-QS":593,"10H-9S-QH":593,"10H-9S-QS":593,"10S-9S-QH":593,"10C-8C-QD":594,"10C-8C-QH":594,"10C-8C-QS":594,"10D-8C-QC":594,"10H-8C-QC":594,"10S-8C-QC":594,"10D-8C-QD":594,"10D-8C-QH":594,"10D-8C-QS":594,"10H-8C-QD":594,"10S-8C-QD":594,"10H-8C-QH":594,"10H-8C-QS":594,"10S-8C-QH":594,"10S-8C-QS":594,"10C-8D-QC":594,"10C-8H-QC":594,"10C-8S-QC":594,"10C-8D-QD":594,"10C-8D-QH":594,"10C-8D-QS":594,"10C-8H-QD":594,"10C-8S-QD":594,"10C-8H-QH":594,"10C-8H-QS":594,"10C-8S-QH":594,"10C-8S-QS":594,"10D-8D-QC":594,"10H-8D-QC":594,"10S-8D-QC":594,"10D-8H-QC":594,"10D-8S-QC":594,"10H-8H-QC":594,"10S-8H-QC":594,"10H-8S-QC":594,"10S-8S-QC":594,"10D-8D-QH":594,"10D-8D-QS":594,"10H-8D-QD":594,"10S-8D-QD":594,"10H-8D-QH":594,"10H-8D-QS":594,"10S-8D-QH":594,"10S-8D-QS":594,"10D-8H-QD":594,"10D-8S-QD":594,"10D-8H-QH":594,"10D-8H-QS":594,"10D-8S-QH":594,"10D-8S-QS":594,"10H-8H-QD":594,"10S-8H-QD":594,"10H-8S-QD":594,"10S-8S-QD":594,"10H-8H-QS":594,"10S-8H-QH":594,"10S-8H-QS":594,"10H-8S-QH":594,"10H-8S-QS":594,"10S-8S-QH":594,"10C-7C-QD":595,"10C-7C-QH":595,"10C-7C-QS":595,"10D-7C-QC":595,"10H-7C-QC":595,"10S-7C-QC":595,"10D-7C-QD":595,"10D-7C-QH":595,"10D-7C-QS":595,"10H-7C-QD":595,"10S-7C-QD":595,"10H-7C-QH":595,"10H-7C-QS":595,"10S-7C-QH":595,"10S-7C-QS":595,"10C-7D-QC":595,"10C-7H-QC":595,"10C-7S-QC":595,"10C-7D-QD":595,"10C-7D-QH":595,"10C-7D-QS":595,"10C-7H-QD":595,"10C-7S-QD":595,"10C-7H-QH":595,"10C-7H-QS":595,"10C-7S-QH":595,"10C-7S-QS":595,"10D-7D-QC":595,"10H-7D-QC":595,"10S-7D-QC":595,"10D-7H-QC":595,"10D-7S-QC":595,"10H-7H-QC":595,"10S-7H-QC":595,"10H-7S-QC":595,"10S-7S-QC":595,"10D-7D-QH":595,"10D-7D-QS":595,"10H-7D-QD":595,"10S-7D-QD":595,"10H-7D-QH":595,"10H-7D-QS":595,"10S-7D-QH":595,"10S-7D-QS":595,"10D-7H-QD":595,"10D-7S-QD":595,"10D-7H-QH":595,"10D-7H-QS":595,"10D-7S-QH":595,"10D-7S-QS":595,"10H-7H-QD":595,"10S-7H-QD":595,"10H-7S-QD":595,"10S-7S-QD":595,"10H-7H-QS":595,"10S-7H-QH":595,"10S-7H-QS":595,"10H-7S-QH":595,"10H-7S-QS":595,"10S-7S-QH":595,"10C-6C-QD":596,"10C-6C-QH":596,"10C-6C-QS":596,"10D-6C-QC":596,"10H-6C-QC":596,"10S-6C-QC":596,"10D-6C-QD":596,"10D-6C-QH":596,"10D-6C-QS":596,"10H-6C-QD":596,"10S-6C-QD":596,"10H-6C-QH":596,"10H-6C-QS":596,"10S-6C-QH":596,"10S-6C-QS":596,"10C-6D-QC":596,"10C-6H-QC":596,"10C-6S-QC":596,"10C-6D-QD":596,"10C-6D-QH":596,"10C-6D-QS":596,"10C-6H-QD":596,"10C-6S-QD":596,"10C-6H-QH":596,"10C-6H-QS":596,"10C-6S-QH":596,"10C-6S-QS":596,"10D-6D-QC":596,"10H-6D-QC":596,"10S-6D-QC":596,"10D-6H-QC":596,"10D-6S-QC":596,"10H-6H-QC":596,"10S-6H-QC":596,"10H-6S-QC":596,"10S-6S-QC":596,"10D-6D-QH":596,"10D-6D-QS":596,"10H-6D-QD":596,"10S-6D-QD":596,"10H-6D-QH":596,"10H-6D-QS":596,"10S-6D-QH":596,"10S-6D-QS":596,"10D-6H-QD":596,"10D-6S-QD":596,"10D-6H-QH":596,"10D-6H-QS":596,"10D-6S-QH":596,"10D-6S-QS":596,"10H-6H-QD":596,"10S-6H-QD":596,"10H-6S-QD":596,"10S-6S-QD":596,"10H-6H-QS":596,"10S-6H-QH":596,"10S-6H-QS":596,"10H-6S-QH":596,"10H-6S-QS":596,"10S-6S-QH":596,"10C-5C-QD":597,"10C-5C-QH":597,"10C-5C-QS":597,"10D-5C-QC":597,"10H-5C-QC":597,"10S-5C-QC":597,"10D-5C-QD":597,"10D-5C-QH":597,"10D-5C-QS":597,"10H-5C-QD":597,"10S-5C-QD":597,"10H-5C-QH":597,"10H-5C-QS":597,"10S-5C-QH":597,"10S-5C-QS":597,"10C-5D-QC":597,"10C-5H-QC":597,"10C-5S-QC":597,"10C-5D-QD":597,"10C-5D-QH":597,"10C-5D-QS":597,"10C-5H-QD":597,"10C-5S-QD":597,"10C-5H-QH":597,"10C-5H-QS":597,"10C-5S-QH":597,"10C-5S-QS":597,"10D-5D-QC":597,"10H-5D-QC":597,"10S-5D-QC":597,"10D-5H-QC":597,"10D-5S-QC":597,"10H-5H-QC":597,"10S-5H-QC":597,"10H-5S-QC":597,"10S-5S-QC":597,"10D-5D-QH":597,"10D-5D-QS":597,"10H-5D-QD":597,"10S-5D-QD":597,"10H-5D-QH":597,"10H-5D-QS":597,"10S-5D-QH":597,"10S-5D-QS":597,"10D-5H-QD":597,"10D-5S-QD":597,"10D-5H-QH":597,"10D-5H-QS":597,"10D-5S-QH":597,"10D-5S-QS":597,"10H-5H-QD":597,"10S-5H-QD":597,"10H-5S-QD":597,"10S-5S-QD":597,"10H-5H-QS":597,"10S-5H-QH":597,"10S-5H-QS":597,"10H-5S-QH":597,"10H-5S-QS":597,"10S-5S-QH":597,"10C-4C-QD":598,"10C-4C-QH":598,"10C-4C-QS":598,"10D-4C-QC":598,"10H-4C-QC":598,"10S-4C-QC":598,"10D-4C-QD":598,"10D-4C-QH":598,"10D-4C-QS":598,"10H-4C-QD":598,"10S-4C-QD":598,"10H-4C-QH":598,"10H-4C-QS":598,"10S-4C-QH":598,"10S-4C-QS":598,"10C-4D-QC":598,"10C-4H-QC":598,"10C-4S-QC":598,"10C-4D-QD":598,"10C-4D-QH":598,"10C-4D-QS":598,"10C-4H-QD":598,"10C-4S-QD":598,"10C-4H-QH":598,"10C-4H-QS":598,"10C-4S-QH":598,"10C-4S-QS":598,"10D-4D-QC":598,"10H-4D-QC":598,"10S-4D-QC":598,"10D-4H-QC":598,"10D-4S-QC":598,"10H-4H-QC":598,"10S-4H-QC":598,"10H-4S-QC":598,"10S-4S-QC":598,"10D-4D-QH":598,"10D-4D-QS":598,"10H-4D-QD":598,"10S-4D-QD":598,"10H-4D-QH":598,"10H-4D-QS":598,"10S-4D-QH":598,"10S-4D-QS":598,"10D-4H-QD":598,"10D-4S-QD":598,"10D-4H-QH":598,"10D-4H-QS":598,"10D-4S-QH":598,"10D-4S-QS":598,"10H-4H-QD":598,"10S-4H-QD":598,"10H-4S-QD":598,"10S-4S-QD":598,"10H-4H-QS":598,"10S-4H-QH":598,"10S-4H-QS":598,"10H-4S-QH":598,"10H-4S-QS":598,"10S-4S-QH":598,"10C-3C-QD":599,"10C-3C-QH":599,"10C-3C-QS":599,"10D-3C-QC":599,"10H-3C-QC":599,"10S-3C-QC":599,"10D-3C-QD":599,"10D-3C-QH":599,"10D-3C-QS":599,"10H-3C-QD":599,"10S-3C-QD":599,"10H-3C-QH":599,"10H-3C-QS":599,"10S-3C-QH":599,"10S-3C-QS":599,"10C-3D-QC":599,"10C-3H-QC":599,"10C-3S-QC":599,"10C-3D-QD":599,"10C-3D-QH":599,"10C-3D-QS":599,"10C-3H-QD":599,"10C-3S-QD":599,"10C-3H-QH":599,"10C-3H-QS":599,"10C-3S-QH":599,"10C-3S-QS":599,"10D-3D-QC":599,"10H-3D-QC":599,"10S-3D-QC":599,"10D-3H-QC":599,"10D-3S-QC":599,"10H-3H-QC":599,"10S-3H-QC":599,"10H-3S-QC":599,"10S-3S-QC":599,"10D-3D-QH":599,"10D-3D-QS":599,"10H-3D-QD":599,"10S-3D-QD":599,"10H-3D-QH":599,"10H-3D-QS":599,"10S-3D-QH":599,"10S-3D-QS":599,"10D-3H-QD":599,"10D-3S-QD":599,"10D-3H-QH":599,"10D-3H-QS":599,"10D-3S-QH":599,"10D-3S-QS":599,"10H-3H-QD":599,"10S-3H-QD":599,"10H-3S-QD":599,"10S-3S-QD":599,"10H-3H-QS":599,"10S-3H-QH":599,"10S-3H-QS":599,"10H-3S-QH":599,"10H-3S-QS":599,"10S-3S-QH":599,"10C-2C-QD":600,"10C-2C-QH":600,"10C-2C-QS":600,"10D-2C-QC":600,"10H-2C-QC":600,"10S-2C-QC":600,"10D-2C-QD":600,"10D-2C-QH":600,"10D-2C-QS":600,"10H-2C-QD":600,"10S-2C-QD":600,"10H-2C-QH":600,"10H-2C-QS":600,"10S-2C-QH":600,"10S-2C-QS":600,"10C-2D-QC":600,"10C-2H-QC":600,"10C-2S-QC":600,"10C-2D-QD":600,"10C-2D-QH":600,"10C-2D-QS":600,"10C-2H-QD":600,"10C-2S-QD":600,"10C-2H-QH":600,"10C-2H-QS":600,"10C-2S-QH":600,"10C-2S-QS":600,"10D-2D-QC":600,"10H-2D-QC":600,"10S-2D-QC":600,"10D-2H-QC":600,"10D-2S-QC":600,"10H-2H-QC":600,"10S-2H-QC":600,"10H-2S-QC":600,"10S-2S-QC":600,"10D-2D-QH":600,"10D-2D-QS":600,"10H-2D-QD":600,"10S-2D-QD":600,"10H-2D-QH":600,"10H-2D-QS":600,"10S-2D-QH":600,"10S-2D-QS":600,"10D-2H-QD":600,"10D-2S-QD":600,"10D-2H-QH":600,"10D-2H-QS":600,"10D-2S-QH":600,"10D-2S-QS":600,"10H-2H-QD":600,"10S-2H-QD":600,"10H-2S-QD":600,"10S-2S-QD":600,"10H-2H-QS":600,"10S-2H-QH":600,"10S-2H-QS":600,"10H-2S-QH":600,"10H-2S-QS":600,"10S-2S-QH":600,"8C-9C-QD":601,"8C-9C-QH":601,"8C-9C-QS":601,"8C-9D-QC":601,"8C-9H-QC":601,"8C-9S-QC":601,"8C-9D-QD":601,"8C-9D-QH":601,"8C-9D-QS":601,"8C-9H-QD":601,"8C-9S-QD":601,"8C-9H-QH":601,"8C-9H-QS":601,"8C-9S-QH":601,"8C-9S-QS":601,"8D-9C-QC":601,"8H-9C-QC":601,"8S-9C-QC":601,"8D-9C-QD":601,"8D-9C-QH":601,"8D-9C-QS":601,"8H-9C-QD":601,"8S-9C-QD":601,"8H-9C-QH":601,"8H-9C-QS":601,"8S-9C-QH":601,"8S-9C-QS":601,"8D-9D-QC":601,"8D-9H-QC":601,"8D-9S-QC":601,"8H-9D-QC":601,"8S-9D-QC":601,"8H-9H-QC":601,"8H-9S-QC":601,"8S-9H-QC":601,"8S-9S-QC":601,"8D-9D-QH":601,"8D-9D-QS":601,"8D-9H-QD":601,"8D-9S-QD":601,"8D-9H-QH":601,"8D-9H-QS":601,"8D-9S-QH":601,"8D-9S-QS":601,"8H-9D-QD":601,"8S-9D-QD":601,"8H-9D-QH":601,"8H-9D-QS":601,"8S-9D-QH":601,"8S-9D-QS":601,"8H-9H-QD":601,"8H-9S-QD":601,"8S-9H-QD":601,"8S-9S-QD":601,"8H-9H-QS":601,"8H-9S-QH":601,"8H-9S-QS":601,"8S-9H-QH":601,"8S-9H-QS":601,"8S-9S-QH":601,"7C-9C-QD":602,"7C-9C-QH":602,"7C-9C-QS":602,"7C-9D-QC":602,"7C-9H-QC":602,"7C-9S-QC":602,"7C-9D-QD":602,"7C-9D-QH":602,"7C-9D-QS":602,"7C-9H-QD":602,"7C-9S-QD":602,"7C-9H-QH":602,"7C-9H-QS":602,"7C-9S-QH":602,"7C-9S-QS":602,"7D-9C-QC":602,"7H-9C-QC":602,"7S-9C-QC":602,"7D-9C-QD":602,"7D-9C-QH":602,"7D-9C-QS":602,"7H-9C-QD":602,"7S-9C-QD":602,"7H-9C-QH":602,"7H-9C-QS":602,"7S-9C-QH":602,"7S-9C-QS":602,"7D-9D-QC":602,"7D-9H-QC":602,"7D-9S-QC":602,"7H-9D-QC":602,"7S-9D-QC":602,"7H-9H-QC":602,"7H-9S-QC":602,"7S-9H-QC":602,"7S-9S-QC":602,"7D-9D-QH":602,"7D-9D-QS":602,"7D-9H-QD":602,"7D-9S-QD":602,"7D-9H-QH":602,"7D-9H-QS":602,"7D-9S-QH":602,"7D-9S-QS":602,"7H-9D-QD":602,"7S-9D-QD":602,"7H-9D-QH":602,"7H-9D-QS":602,"7S-9D-QH":602,"7S-9D-QS":602,"7H-9H-QD":602,"7H-9S-QD":602,"7S-9H-QD":602,"7S-9S-QD":602,"7H-9H-QS":602,"7H-9S-QH":602,"7H-9S-QS":602,"7S-9H-QH":602,"7S-9H-QS":602,"7S-9S-QH":602,"6C-9C-QD":603,"6C-9C-QH":603,"6C-9C-QS":603,"6C-9D-QC":603,"6C-9H-QC":603,"6C-9S-QC":603,"6C-9D-QD":603,"6C-9D-QH":603,"6C-9D-QS":603,"6C-9H-QD":603,"6C-9S-QD":603,"6C-9H-QH":603,"6C-9H-QS":603,"6C-9S-QH":603,"6C-9S-QS":603,"6D-9C-QC":603,"6H-9C-QC":603,"6S-9C-QC":603,"6D-9C-QD":603,"6D-9C-QH":603,"6D-9C-QS":603,"6H-9C-QD":603,"6S-9C-QD":603,"6H-9C-QH":603,"6H-9C-QS":603,"6S-9C-QH":603,"6S-9C-QS":603,"6D-9D-QC":603,"6D-9H-QC":603,"6D-9S-QC":603,"6H-9D-QC":603,"6S-9D-QC":603,"6H-9H-QC":603,"6H-9S-QC":603,"6S-9H-QC":603,"6S-9S-QC":603,"6D-9D-QH":603,"6D-9D-QS":603,"6D-9H-QD":603,"6D-9S-QD":603,"6D-9H-QH":603,"6D-9H-QS":603,"6D-9S-QH":603,"6D-9S-QS":603,"6H-9D-QD":603,"6S-9D-QD":603,"6H-9D-QH":603,"6H-9D-QS":603,"6S-9D-QH":603,"6S-9D-QS":603,"6H-9H-QD":603,"6H-9S-QD":603,"6S-9H-QD":603,"6S-9S-QD":603,"6H-9H-QS":603,"6H-9S-QH":603,"6H-9S-QS":603,"6S-9H-QH":603,"6S-9H-QS":603,"6S-9S-QH":603,"5C-9C-QD":604,"5C-9C-QH":604,"5C-9C-QS":604,"5C-9D-QC":604,"5C-9H-QC":604,"5C-9S-QC":604,"5C-9D-QD":604,"5C-9D-QH":604,"5C-9D-QS":604,"5C-9H-QD":604,"5C-9S-QD":604,"5C-9H-QH":604,"5C-9H-QS":604,"5C-9S-QH":604,"5C-9S-QS":604,"5D-9C-QC":604,"5H-9C-QC":604,"5S-9C-QC":604,"5D-9C-QD":604,"5D-9C-QH":604,"5D-9C-QS":604,"5H-9C-QD":604,"5S-9C-QD":604,"5H-9C-QH":604,"5H-9C-QS":604,"5S-9C-QH":604,"5S-9C-QS":604,"5D-9D-QC":604,"5D-9H-QC":604,"5D-9S-QC":604,"5H-9D-QC":604,"5S-9D-QC":604,"5H-9H-QC":604,"5H-9S-QC":604,"5S-9H-QC":604,"5S-9S-QC":604,"5D-9D-QH":604,"5D-9D-QS":604,"5D-9H-QD":604,"5D-9S-QD":604,"5D-9H-QH":604,"5D-9H-QS":604,"5D-9S-QH":604,"5D-9S-QS":604,"5H-9D-QD":604,"5S-9D-QD":604,"5H-9D-QH":604,"5H-9D-QS":604,"5S-9D-QH":604,"5S-9D-QS":604,"5H-9H-QD":604,"5H-9S-QD":604,"5S-9H-QD":604,"5S-9S-QD":604,"5H-9H-QS":604,"5H-9S-QH":604,"5H-9S-QS":604,"5S-9H-QH":604,"5S-9H-QS":604,"5S-9S-QH":604,"4C-9C-QD":605,"4C-9C-QH":605,"4C-9C-QS":605,"4C-9D-QC":605,"4C-9H-QC":605,"4C-9S-QC":605,"4C-9D-QD":605,"4C-9D-QH":605,"4C-9D-QS":605,"4C-9H-QD":605,"4C-9S-QD":605,"4C-9H-QH":605,"4C-9H-QS":605,"4C-9S-QH":605,"4C-9S-QS":605,"4D-9C-QC":605,"4H-9C-QC":605,"4S-9C-QC":605,"4D-9C-QD":605,"4D-9C-QH":605,"4D-9C-QS":605,"4H-9C-QD":605,"4S-9C-QD":605,"4H-9C-QH":605,"4H-9C-QS":605,"4S-9C-QH":605,"4S-9C-QS":605,"4D-9D-QC":605,"4D-9H-QC":605,"4D-9S-QC":605,"4H-9D-QC":605,"4S-9D-QC":605,"4H-9H-QC":605,"4H-9S-QC":605,"4S-9H-QC":605,"4S-9S-QC":605,"4D-9D-QH":605,"4D-9D-QS":605,"4D-9H-QD":605,"4D-9S-QD":605,"4D-9H-QH":605,"4D-9H-QS":605,"4D-9S-QH":605,"4D-9S-QS":605,"4H-9D-QD":605,"4S-9D-QD":605,"4H-9D-QH":605,"4H-9D-QS":605,"4S-9D-QH":605,"4S-9D-QS":605,"4H-9H-QD":605,"4H-9S-QD":605,"4S-9H-QD":605,"4S-9S-QD":605,"4H-9H-QS":605,"4H-9S-QH":605,"4H-9S-QS":605,"4S-9H-QH":605,"4S-9H-QS":605,"4S-9S-QH":605,"3C-9C-QD":606,"3C-9C-QH":606,"3C-9C-QS":606,"3C-9D-QC":606,"3C-9H-QC":606,"3C-9S-QC":606,"3C-9D-QD":606,"3C-9D-QH":606,"3C-9D-QS":606,"3C-9H-QD":606,"3C-9S-QD":606,"3C-9H-QH":606,"3C-9H-QS":606,"3C-9S-QH":606,"3C-9S-QS":606,"3D-9C-QC":606,"3H-9C-QC":606,"3S-9C-QC":606,"3D-9C-QD":606,"3D-9C-QH":606,"3D-9C-QS":606,"3H-9C-QD":606,"3S-9C-QD":606,"3H-9C-QH":606,"3H-9C-QS":606,"3S-9C-QH":606,"3S-9C-QS":606,"3D-9D-QC":606,"3D-9H-QC":606,"3D-9S-QC":606,"3H-9D-QC":606,"3S-9D-QC":606,"3H-9H-QC":606,"3H-9S-QC":606,"3S-9H-QC":606,"3S-9S-QC":606,"3D-9D-QH":606,"3D-9D-QS":606,"3D-9H-QD":606,"3D-9S-QD":606,"3D-9H-QH":606,"3D-9H-QS":606,"3D-9S-QH":606,"3D-9S-QS":606,"3H-9D-QD":606,"3S-9D-QD":606,"3H-9D-QH":606,"3H-9D-QS":606,"3S-9D-QH":606,"3S-9D-QS":606,"3H-9H-QD":606,"3H-9S-QD":606,"3S-9H-QD":606,"3S-9S-QD":606,"3H-9H-QS":606,"3H-9S-QH":606,"3H-9S-QS":606,"3S-9H-QH":606,"3S-9H-QS":606,"3S-9S-QH":606,"2C-9C-QD":607,"2C-9C-QH":607,"2C-9C-QS":607,"2C-9D-QC":607,"2C-9H-QC":607,"2C-9S-QC":607,"2C-9D-QD":607,"2C-9D-QH":607,"2C-9D-QS":607,"2C-9H-QD":607,"2C-9S-QD":607,"2C-9H-QH":607,"2C-9H-QS":607,"2C-9S-QH":607,"2C-9S-QS":607,"2D-9C-QC":607,"2H-9C-QC":607,"2S-9C-QC":607,"2D-9C-QD":607,"2D-9C-QH":607,"2D-9C-QS":607,"2H-9C-QD":607,"2S-9C-QD":607,"2H-9C-QH":607,"2H-9C-QS":607,"2S-9C-QH":607,"2S-9C-QS":607,"2D-9D-QC":607,"2D-9H-QC":607,"2D-9S-QC":607,"2H-9D-QC":607,"2S-9D-QC":607,"2H-9H-QC":607,"2H-9S-QC":607,"2S-9H-QC":607,"2S-9S-QC":607,"2D-9D-QH":607,"2D-9D-QS":607,"2D-9H-QD":607,"2D-9S-QD":607,"2D-9H-QH":607,"2D-9H-QS":607,"2D-9S-QH":607,"2D-9S-QS":607,"2H-9D-QD":607,"2S-9D-QD":607,"2H-9D-QH":607,"2H-9D-QS":607,"2S-9D-QH":607,"2S-9D-QS":607,"2H-9H-QD":607,"2H-9S-QD":607,"2S-9H-QD":607,"2S-9S-QD":607,"2H-9H-QS":607,"2H-9S-QH":607,"2H-9S-QS":607,"2S-9H-QH":607,"2S-9H-QS":607,"2S-9S-QH":607,"7C-8C-QD":608,"7C-8C-QH":608,"7C-8C-QS":608,"7C-8D-QC":608,"7C-8H-QC":608,"7C-8S-QC":608,"7C-8D-QD":608,"7C-8D-QH":608,"7C-8D-QS":608,"7C-8H-QD":608,"7C-8S-QD":608,"7C-8H-QH":608,"7C-8H-QS":608,"7C-8S-QH":608,"7C-8S-QS":608,"7D-8C-QC":608,"7H-8C-QC":608,"7S-8C-QC":608,"7D-8C-QD":608,"7D-8C-QH":608,"7D-8C-QS":608,"7H-8C-QD":608,"7S-8C-QD":608,"7H-8C-QH":608,"7H-8C-QS":608,"7S-8C-QH":608,"7S-8C-QS":608,"7D-8D-QC":608,"7D-8H-QC":608,"7D-8S-QC":608,"7H-8D-QC":608,"7S-8D-QC":608,"7H-8H-QC":608,"7H-8S-QC":608,"7S-8H-QC":608,"7S-8S-QC":608,"7D-8D-QH":608,"7D-8D-QS":608,"7D-8H-QD":608,"7D-8S-QD":608,"7D-8H-QH":608,"7D-8H-QS":608,"7D-8S-QH":608,"7D-8S-QS":608,"7H-8D-QD":608,"7S-8D-QD":608,"7H-8D-QH":608,"7H-8D-QS":608,"7S-8D-QH":608,"7S-8D-QS":608,"7H-8H-QD":608,"7H-8S-QD":608,"7S-8H-QD":608,"7S-8S-QD":608,"7H-8H-QS":608,"7H-8S-QH":608,"7H-8S-QS":608,"7S-8H-QH":608,"7S-8H-QS":608,"7S-8S-QH":608,"6C-8C-QD":609,"6C-8C-QH":609,"6C-8C-QS":609,"6C-8D-QC":609,"6C-8H-QC":609,"6C-8S-QC":609,"6C-8D-QD":609,"6C-8D-QH":609,"6C-8D-QS":609,"6C-8H-QD":609,"6C-8S-QD":609,"6C-8H-QH":609,"6C-8H-QS":609,"6C-8S-QH":609,"6C-8S-QS":609,"6D-8C-QC":609,"6H-8C-QC":609,"6S-8C-QC":609,"6D-8C-QD":609,"6D-8C-QH":609,"6D-8C-QS":609,"6H-8C-QD":609,"6S-8C-QD":609,"6H-8C-QH":609,"6H-8C-QS":609,"6S-8C-QH":609,"6S-8C-QS":609,"6D-8D-QC":609,"6D-8H-QC":609,"6D-8S-QC":609,"6H-8D-QC":609,"6S-8D-QC":609,"6H-8H-QC":609,"6H-8S-QC":609,"6S-8H-QC":609,"6S-8S-QC":609,"6D-8D-QH":609,"6D-8D-QS":609,"6D-8H-QD":609,"6D-8S-QD":609,"6D-8H-QH":609,"6D-8H-QS":609,"6D-8S-QH":609,"6D-8S-QS":609,"6H-8D-QD":609,"6S-8D-QD":609,"6H-8D-QH":609,"6H-8D-QS":609,"6S-8D-QH":609,"6S-8D-QS":609,"6H-8H-QD":609,"6H-8S-QD":609,"6S-8H-QD":609,"6S-8S-QD":609,"6H-8H-QS":609,"6H-8S-QH":609,"6H-8S-QS":609,"6S-8H-QH":609,"6S-8H-QS":609,"6S-8S-QH":609,"5C-8C-QD":610,"5C-8C-QH":610,"5C-8C-QS":610,"5C-8D-QC":610,"5C-8H-QC":610,"5C-8S-QC":610,"5C-8D-QD":610,"5C-8D-QH":610,"5C-8D-QS":610,"5C-8H-QD":610,"5C-8S-QD":610,"5C-8H-QH":610,"5C-8H-QS":610,"5C-8S-QH":610,"5C-8S-QS":610,"5D-8C-QC":610,"5H-8C-QC":610,"5S-8C-QC":610,"5D-8C-QD":610,"5D-8C-QH":610,"5D-8C-QS":610,"5H-8C-QD":610,"5S-8C-QD":610,"5H-8C-QH":610,"5H-8C-QS":610,"5S-8C-QH":610,"5S-8C-QS":610,"5D-8D-QC":610,"5D-8H-QC":610,"5D-8S-QC":610,"5H-8D-QC":610,"5S-8D-QC":610,"5H-8H-QC":610,"5H-8S-QC":610,"5S-8H-QC":610,"5S-8S-QC":610,"5D-8D-QH":610,"5D-8D-QS":610,"5D-8H-QD":610,"5D-8S-QD":610,"5D-8H-QH":610,"5D-8H-QS":610,"5D-8S-QH":610,"5D-8S-QS":610,"5H-8D-QD":610,"5S-8D-QD":610,"5H-8D-QH":610,"5H-8D-QS":610,"5S-8D-QH":610,"5S-8D-QS":610,"5H-8H-QD":610,"5H-8S-QD":610,"5S-8H-QD":610,"5S-8S-QD":610,"5H-8H-QS":610,"5H-8S-QH":610,"5H-8S-QS":610,"5S-8H-QH":610,"5S-8H-QS":610,"5S-8S-QH":610,"4C-8C-QD":611,"4C-8C-QH":611,"4C-8C-QS":611,"4C-8D-QC":611,"4C-8H-QC":611,"4C-8S-QC":611,"4C-8D-QD":611,"4C-8D-QH":611,"4C-8D-QS":611,"4C-8H-QD":611,"4C-8S-QD":611,"4C-8H-QH":611,"4C-8H-QS":611,"4C-8S-QH":611,"4C-8S-QS":611,"4D-8C-QC":611,"4H-8C-QC":611,"4S-8C-QC":611,"4D-8C-QD":611,"4D-8C-QH":611,"4D-8C-QS":611,"4H-8C-QD":611,"4S-8C-QD":611,"4H-8C-QH":611,"4H-8C-QS":611,"4S-8C-QH":611,"4S-8C-QS":611,"4D-8D-QC":611,"4D-8H-QC":611,"4D-8S-QC":611,"4H-8D-QC":611,"4S-8D-QC":611,"4H-8H-QC":611,"4H-8S-QC":611,"4S-8H-QC":611,"4S-8S-QC":611,"4D-8D-QH":611,"4D-8D-QS":611,"4D-8H-QD":611,"4D-8S-QD":611,"4D-8H-QH":611,"4D-8H-QS":611,"4D-8S-QH":611,"4D-8S-QS":611,"4H-8D-QD":611,"4S-8D-QD":611,"4H-8D-QH":611,"4H-8D-QS":611,"4S-8D-QH":611,"4S-8D-QS":611,"4H-8H-QD":611,"4H-8S-QD":611,"4S-8H-QD":611,"4S-8S-QD":611,"4H-8H-QS":611,"4H-8S-QH":611,"4H-8S-QS":611,"4S-8H-QH":611,"4S-8H-QS":611,"4S-8S-QH":611,"3C-8C-QD":612,"3C-8C-QH":612,"3C-8C-QS":612,"3C-8D-QC":612,"3C-8H-QC":612,"3C-8S-QC":612,"3C-8D-QD":612,"3C-8D-QH":612,"3C-8D-QS":612,"3C-8H-QD":612,"3C-8S-QD":612,"3C-8H-QH":612,"3C-8H-QS":612,"3C-8S-QH":612,"3C-8S-QS":612,"3D-8C-QC":612,"3H-8C-QC":612,"3S-8C-QC":612,"3D-8C-QD":612,"3D-8C-QH":612,"3D-8C-QS":612,"3H-8C-QD":612,"3S-8C-QD":612,"3H-8C-QH":612,"3H-8C-QS":612,"3S-8C-QH":612,"3S-8C-QS":612,"3D-8D-QC":612,"3D-8H-QC":612,"3D-8S-QC":612,"3H-8D-QC":612,"3S-8D-QC":612,"3H-8H-QC":612,"3H-8S-QC":612,"3S-8H-QC":612,"3S-8S-QC":612,"3D-8D-QH":612,"3D-8D-QS":612,"3D-8H-QD":612,"3D-8S-QD":612,"3D-8H-QH":612,"3D-8H-QS":612,"3D-8S-QH":612,"3D-8S-QS":612,"3H-8D-QD":612,"3S-8D-QD":612,"3H-8D-QH":612,"3H-8D-QS":612,"3S-8D-QH":612,"3S-8D-QS":612,"3H-8H-QD":612,"3H-8S-QD":612,"3S-8H-QD":612,"3S-8S-QD":612,"3H-8H-QS":612,"3H-8S-QH":612,"3H-8S-QS":612,"3S-8H-QH":612,"3S-8H-QS":612,"3S-8S-QH":612,"2C-8C-QD":613,"2C-8C-QH":613,"2C-8C-QS":613,"2C-8D-QC":613,"2C-8H-QC":613,"2C-8S-QC":613,"2C-8D-QD":613,"2C-8D-QH":613,"2C-8D-QS":613,"2C-8H-QD":613,"2C-8S-QD":613,"2C-8H-QH":613,"2C-8H-QS":613,"2C-8S-QH":613,"2C-8S-QS":613,"2D-8C-QC":613,"2H-8C-QC":613,"2S-8C-QC":613,"2D-8C-QD":613,"2D-8C-QH":613,"2D-8C-QS":613,"2H-8C-QD":613,"2S-8C-QD":613,"2H-8C-QH":613,"2H-8C-QS":613,"2S-8C-QH":613,"2S-8C-QS":613,"2D-8D-QC":613,"2D-8H-QC":613,"2D-8S-QC":613,"2H-8D-QC":613,"2S-8D-QC":613,"2H-8H-QC":613,"2H-8S-QC":613,"2S-8H-QC":613,"2S-8S-QC":613,"2D-8D-QH":613,"2D-8D-QS":613,"2D-8H-QD":613,"2D-8S-QD":613,"2D-8H-QH":613,"2D-8H-QS":613,"2D-8S-QH":613,"2D-8S-QS":613,"2H-8D-QD":613,"2S-8D-QD":613,"2H-8D-QH":613,"2H-8D-QS":613,"2S-8D-QH":613,"2S-8D-QS":613,"2H-8H-QD":613,"2H-8S-QD":613,"2S-8H-QD":613,"2S-8S-QD":613,"2H-8H-QS":613,"2H-8S-QH":613,"2H-8S-QS":613,"2S-8H-QH":613,"2S-8H-QS":613,"2S-8S-QH":613,"6C-7C-QD":614,"6C-7C-QH":614,"6C-7C-QS":614,"6C-7D-QC":614,"6C-7H-QC":614,"6C-7S-QC":614,"6C-7D-QD":614,"6C-7D-QH":614,"6C-7D-QS":614,"6C-7H-QD":614,"6C-7S-QD":614,"6C-7H-QH":614,"6C-7H-QS":614,"6C-7S-QH":614,"6C-7S-QS":614,"6D-7C-QC":614,"6H-7C-QC":614,"6S-7C-QC":614,"6D-7C-QD":614,"6D-7C-QH":614,"6D-7C-QS":614,"6H-7C-QD":614,"6S-7C-QD":614,"6H-7C-QH":614,"6H-7C-QS":614,"6S-7C-QH":614,"6S-7C-QS":614,"6D-7D-QC":614,"6D-7H-QC":614,"6D-7S-QC":614,"6H-7D-QC":614,"6S-7D-QC":614,"6H-7H-QC":614,"6H-7S-QC":614,"6S-7H-QC":614,"6S-7S-QC":614,"6D-7D-QH":614,"6D-7D-QS":614,"6D-7H-QD":614,"6D-7S-QD":614,"6D-7H-QH":614,"6D-7H-QS":614,"6D-7S-QH":614,"6D-7S-QS":614,"6H-7D-QD":614,"6S-7D-QD":614,"6H-7D-QH":614,"6H-7D-QS":614,"6S-7D-QH":614,"6S-7D-QS":614,"6H-7H-QD":614,"6H-7S-QD":614,"6S-7H-QD":614,"6S-7S-QD":614,"6H-7H-QS":614,"6H-7S-QH":614,"6H-7S-QS":614,"6S-7H-QH":614,"6S-7H-QS":614,"6S-7S-QH":614,"5C-7C-QD":615,"5C-7C-QH":615,"5C-7C-QS":615,"5C-7D-QC":615,"5C-7H-QC":615,"5C-7S-QC":615,"5C-7D-QD":615,"5C-7D-QH":615,"5C-7D-QS":615,"5C-7H-QD":615,"5C-7S-QD":615,"5C-7H-QH":615,"5C-7H-QS":615,"5C-7S-QH":615,"5C-7S-QS":615,"5D-7C-QC":615,"5H-7C-QC":615,"5S-7C-QC":615,"5D-7C-QD":615,"5D-7C-QH":615,"5D-7C-QS":615,"5H-7C-QD":615,"5S-7C-QD":615,"5H-7C-QH":615,"5H-7C-QS":615,"5S-7C-QH":615,"5S-7C-QS":615,"5D-7D-QC":615,"5D-7H-QC":615,"5D-7S-QC":615,"5H-7D-QC":615,"5S-7D-QC":615,"5H-7H-QC":615,"5H-7S-QC":615,"5S-7H-QC":615,"5S-7S-QC":615,"5D-7D-QH":615,"5D-7D-QS":615,"5D-7H-QD":615,"5D-7S-QD":615,"5D-7H-QH":615,"5D-7H-QS":615,"5D-7S-QH":615,"5D-7S-QS":615,"5H-7D-QD":615,"5S-7D-QD":615,"5H-7D-QH":615,"5H-7D-QS":615,"5S-7D-QH":615,"5S-7D-QS":615,"5H-7H-QD":615,"5H-7S-QD":615,"5S-7H-QD":615,"5S-7S-QD":615,"5H-7H-QS":615,"5H-7S-QH":615,"5H-7S-QS":615,"5S-7H-QH":615,"5S-7H-QS":615,"5S-7S-QH":615,"4C-7C-QD":616,"4C-7C-QH":616,"4C-7C-QS":616,"4C-7D-QC":616,"4C-7H-QC":616,"4C-7S-QC":616,"4C-7D-QD":616,"4C-7D-QH":616,"4C-7D-QS":616,"4C-7H-QD":616,"4C-7S-QD":616,"4C-7H-QH":616,"4C-7H-QS":616,"4C-7S-QH":616,"4C-7S-QS":616,"4D-7C-QC":616,"4H-7C-QC":616,"4S-7C-QC":616,"4D-7C-QD":616,"4D-7C-QH":616,"4D-7C-QS":616,"4H-7C-QD":616,"4S-7C-QD":616,"4H-7C-QH":616,"4H-7C-QS":616,"4S-7C-QH":616,"4S-7C-QS":616,"4D-7D-QC":616,"4D-7H-QC":616,"4D-7S-QC":616,"4H-7D-QC":616,"4S-7D-QC":616,"4H-7H-QC":616,"4H-7S-QC":616,"4S-7H-QC":616,"4S-7S-QC":616,"4D-7D-QH":616,"4D-7D-QS":616,"4D-7H-QD":616,"4D-7S-QD":616,"4D-7H-QH":616,"4D-7H-QS":616,"4D-7S-QH":616,"4D-7S-QS":616,"4H-7D-QD":616,"4S-7D-QD":616,"4H-7D-QH":616,"4H-7D-QS":616,"4S-7D-QH":616,"4S-7D-QS":616,"4H-7H-QD":616,"4H-7S-QD":616,"4S-7H-QD":616,"4S-7S-QD":616,"4H-7H-QS":616,"4H-7S-QH":616,"4H-7S-QS":616,"4S-7H-QH":616,"4S-7H-QS":616,"4S-7S-QH":616,"3C-7C-QD":617,"3C-7C-QH":617,"3C-7C-QS":617,"3C-7D-QC":617,"3C-7H-QC":617,"3C-7S-QC":617,"3C-7D-QD":617,"3C-7D-QH":617,"3C-7D-QS":617,"3C-7H-QD":617,"3C-7S-QD":617,"3C-7H-QH":617,"3C-7H-QS":617,"3C-7S-QH":617,"3C-7S-QS":617,"3D-7C-QC":617,"3H-7C-QC":617,"3S-7C-QC":617,"3D-7C-QD":617,"3D-7C-QH":617,"3D-7C-QS":617,"3H-7C-QD":617,"3S-7C-QD":617,"3H-7C-QH":617,"3H-7C-QS":617,"3S-7C-QH":617,"3S-7C-QS":617,"3D-7D-QC":617,"3D-7H-QC":617,"3D-7S-QC":617,"3H-7D-QC":617,"3S-7D-QC":617,"3H-7H-QC":617,"3H-7S-QC":617,"3S-7H-QC":617,"3S-7S-QC":617,"3D-7D-QH":617,"3D-7D-QS":617,"3D-7H-QD":617,"3D-7S-QD":617,"3D-7H-QH":617,"3D-7H-QS":617,"3D-7S-QH":617,"3D-7S-QS":617,"3H-7D-QD":617,"3S-7D-QD":617,"3H-7D-QH":617,"3H-7D-QS":617,"3S-7D-QH":617,"3S-7D-QS":617,"3H-7H-QD":617,"3H-7S-QD":617,"3S-7H-QD":617,"3S-7S-QD":617,"3H-7H-QS":617,"3H-7S-QH":617,"3H-7S-QS":617,"3S-7H-QH":617,"3S-7H-QS":617,"3S-7S-QH":617,"2C-7C-QD":618,"2C-7C-QH":618,"2C-7C-QS":618,"2C-7D-QC":618,"2C-7H-QC":618,"2C-7S-QC":618,"2C-7D-QD":618,"2C-7D-QH":618,"2C-7D-QS":618,"2C-7H-QD":618,"2C-7S-QD":618,"2C-7H-QH":618,"2C-7H-QS":618,"2C-7S-QH":618,"2C-7S-QS":618,"2D-7C-QC":618,"2H-7C-QC":618,"2S-7C-QC":618,"2D-7C-QD":618,"2D-7C-QH":618,"2D-7C-QS":618,"2H-7C-QD":618,"2S-7C-QD":618,"2H-7C-QH":618,"2H-7C-QS":618,"2S-7C-QH":618,"2S-7C-QS":618,"2D-7D-QC":618,"2D-7H-QC":618,"2D-7S-QC":618,"2H-7D-QC":618,"2S-7D-QC":618,"2H-7H-QC":618,"2H-7S-QC":618,"2S-7H-QC":618,"2S-7S-QC":618,"2D-7D-QH":618,"2D-7D-QS":618,"2D-7H-QD":618,"2D-7S-QD":618,"2D-7H-QH":618,"2D-7H-QS":618,"2D-7S-QH":618,"2D-7S-QS":618,"2H-7D-QD":618,"2S-7D-QD":618,"2H-7D-QH":618,"2H-7D-QS":618,"2S-7D-QH":618,"2S-7D-QS":618,"2H-7H-QD":618,"2H-7S-QD":618,"2S-7H-QD":618,"2S-7S-QD":618,"2H-7H-QS":618,"2H-7S-QH":618,"2H-7S-QS":618,"2S-7H-QH":618,"2S-7H-QS":618,"2S-7S-QH":618,"5C-6C-QD":619,"5C-6C-QH":619,"5C-6C-QS":619,"5C-6D-QC":619,"5C-6H-QC":619,"5C-6S-QC":619,"5C-6D-QD":619,"5C-6D-QH":619,"5C-6D-QS":619,"5C-6H-QD":619,"5C-6S-QD":619,"5C-6H-QH":619,"5C-6H-QS":619,"5C-6S-QH":619,"5C-6S-QS":619,"5D-6C-QC":619,"5H-6C-QC":619,"5S-6C-QC":619,"5D-6C-QD":619,"5D-6C-QH":619,"5D-6C-QS":619,"5H-6C-QD":619,"5S-6C-QD":619,"5H-6C-QH":619,"5H-6C-QS":619,"5S-6C-QH":619,"5S-6C-QS":619,"5D-6D-QC":619,"5D-6H-QC":619,"5D-6S-QC":619,"5H-6D-QC":619,"5S-6D-QC":619,"5H-6H-QC":619,"5H-6S-QC":619,"5S-6H-QC":619,"5S-6S-QC":619,"5D-6D-QH":619,"5D-6D-QS":619,"5D-6H-QD":619,"5D-6S-QD":619,"5D-6H-QH":619,"5D-6H-QS":619,"5D-6S-QH":619,"5D-6S-QS":619,"5H-6D-QD":619,"5S-6D-QD":619,"5H-6D-QH":619,"5H-6D-QS":619,"5S-6D-QH":619,"5S-6D-QS":619,"5H-6H-QD":619,"5H-6S-QD":619,"5S-6H-QD":619,"5S-6S-QD":619,"5H-6H-QS":619,"5H-6S-QH":619,"5H-6S-QS":619,"5S-6H-QH":619,"5S-6H-QS":619,"5S-6S-QH":619,"4C-6C-QD":620,"4C-6C-QH":620,"4C-6C-QS":620,"4C-6D-QC":620,"4C-6H-QC":620,"4C-6S-QC":620,"4C-6D-QD":620,"4C-6D-QH":620,"4C-6D-QS":620,"4C-6H-QD":620,"4C-6S-QD":620,"4C-6H-QH":620,"4C-6H-QS":620,"4C-6S-QH":620,"4C-6S-QS":620,"4D-6C-QC":620,"4H-6C-QC":620,"4S-6C-QC":620,"4D-6C-QD":620,"4D-6C-QH":620,"4D-6C-QS":620,"4H-6C-QD":620,"4S-6C-QD":620,"4H-6C-QH":620,"4H-6C-QS":620,"4S-6C-QH":620,"4S-6C-QS":620,"4D-6D-QC":620,"4D-6H-QC":620,"4D-6S-QC":620,"4H-6D-QC":620,"4S-6D-QC":620,"4H-6H-QC":620,"4H-6S-QC":620,"4S-6H-QC":620,"4S-6S-QC":620,"4D-6D-QH":620,"4D-6D-QS":620,"4D-6H-QD":620,"4D-6S-QD":620,"4D-6H-QH":620,"4D-6H-QS":620,"4D-6S-QH":620,"4D-6S-QS":620,"4H-6D-QD":620,"4S-6D-QD":620,"4H-6D-QH":620,"4H-6D-QS":620,"4S-6D-QH":620,"4S-6D-QS":620,"4H-6H-QD":620,"4H-6S-QD":620,"4S-6H-QD":620,"4S-6S-QD":620,"4H-6H-QS":620,"4H-6S-QH":620,"4H-6S-QS":620,"4S-6H-QH":620,"4S-6H-QS":620,"4S-6S-QH":620,"3C-6C-QD":621,"3C-6C-QH":621,"3C-6C-QS":621,"3C-6D-QC":621,"3C-6H-QC":621,"3C-6S-QC":621,"3C-6D-QD":621,"3C-6D-QH":621,"3C-6D-QS":621,"3C-6H-QD":621,"3C-6S-QD":621,"3C-6H-QH":621,"3C-6H-QS":621,"3C-6S-QH":621,"3C-6S-QS":621,"3D-6C-QC":621,"3H-6C-QC":621,"3S-6C-QC":621,"3D-6C-QD":621,"3D-6C-QH":621,"3D-6C-QS":621,"3H-6C-QD":621,"3S-6C-QD":621,"3H-6C-QH":621,"3H-6C-QS":621,"3S-6C-QH":621,"3S-6C-QS":621,"3D-6D-QC":621,"3D-6H-QC":621,"3D-6S-QC":621,"3H-6D-QC":621,"3S-6D-QC":621,"3H-6H-QC":621,"3H-6S-QC":621,"3S-6H-QC":621,"3S-6S-QC":621,"3D-6D-QH":621,"3D-6D-QS":621,"3D-6H-QD":621,"3D-6S-QD":621,"3D-6H-QH":621,"3D-6H-QS":621,"3D-6S-QH":621,"3D-6S-QS":621,"3H-6D-QD":621,"3S-6D-QD":621,"3H-6D-QH":621,"3H-6D-QS":621,"3S-6D-QH":621,"3S-6D-QS":621,"3H-6H-QD":621,"3H-6S-QD":621,"3S-6H-QD":621,"3S-6S-QD":621,"3H-6H-QS":621,"3H-6S-QH":621,"3H-6S-QS":621,"3S-6H-QH":621,"3S-6H-QS":621,"3S-6S-QH":621,"2C-6C-QD":622,"2C-6C-QH":622,"2C-6C-QS":622,"2C-6D-QC":622,"2C-6H-QC":622,"2C-6S-QC":622,"2C-6D-QD":622,"2C-6D-QH":622,"2C-6D-QS":622,"2C-6H-QD":622,"2C-6S-QD":622,"2C-6H-QH":622,"2C-6H-QS":622,"2C-6S-QH":622,"2C-6S-QS":622,"2D-6C-QC":622,"2H-6C-QC":622,"2S-6C-QC":622,"2D-6C-QD":622,"2D-6C-QH":622,"2D-6C-QS":622,"2H-6C-QD":622,"2S-6C-QD":622,"2H-6C-QH":622,"2H-6C-QS":622,"2S-6C-QH":622,"2S-6C-QS":622,"2D-6D-QC":622,"2D-6H-QC":622,"2D-6S-QC":622,"2H-6D-QC":622,"2S-6D-QC":622,"2H-6H-QC":622,"2H-6S-QC":622,"2S-6H-QC":622,"2S-6S-QC":622,"2D-6D-QH":622,"2D-6D-QS":622,"2D-6H-QD":622,"2D-6S-QD":622,"2D-6H-QH":622,"2D-6H-QS":622,"2D-6S-QH":622,"2D-6S-QS":622,"2H-6D-QD":622,"2S-6D-QD":622,"2H-6D-QH":622,"2H-6D-QS":622,"2S-6D-QH":622,"2S-6D-QS":622,"2H-6H-QD":622,"2H-6S-QD":622,"2S-6H-QD":622,"2S-6S-QD":622,"2H-6H-QS":622,"2H-6S-QH":622,"2H-6S-QS":622,"2S-6H-QH":622,"2S-6H-QS":622,"2S-6S-QH":622,"4C-5C-QD":623,"4C-5C-QH":623,"4C-5C-QS":623,"4C-5D-QC":623,"4C-5H-QC":623,"4C-5S-QC":623,"4C-5D-QD":623,"4C-5D-QH":623,"4C-5D-QS":623,"4C-5H-QD":623,"4C-5S-QD":623,"4C-5H-QH":623,"4C-5H-QS":623,"4C-5S-QH":623,"4C-5S-QS":623,"4D-5C-QC":623,"4H-5C-QC":623,"4S-5C-QC":623,"4D-5C-QD":623,"4D-5C-QH":623,"4D-5C-QS":623,"4H-5C-QD":623,"4S-5C-QD":623,"4H-5C-QH":623,"4H-5C-QS":623,"4S-5C-QH":623,"4S-5C-QS":623,"4D-5D-QC":623,"4D-5H-QC":623,"4D-5S-QC":623,"4H-5D-QC":623,"4S-5D-QC":623,"4H-5H-QC":623,"4H-5S-QC":623,"4S-5H-QC":623,"4S-5S-QC":623,"4D-5D-QH":623,"4D-5D-QS":623,"4D-5H-QD":623,"4D-5S-QD":623,"4D-5H-QH":623,"4D-5H-QS":623,"4D-5S-QH":623,"4D-5S-QS":623,"4H-5D-QD":623,"4S-5D-QD":623,"4H-5D-QH":623,"4H-5D-QS":623,"4S-5D-QH":623,"4S-5D-QS":623,"4H-5H-QD":623,"4H-5S-QD":623,"4S-5H-QD":623,"4S-5S-QD":623,"4H-5H-QS":623,"4H-5S-QH":623,"4H-5S-QS":623,"4S-5H-QH":623,"4S-5H-QS":623,"4S-5S-QH":623,"3C-5C-QD":624,"3C-5C-QH":624,"3C-5C-QS":624,"3C-5D-QC":624,"3C-5H-QC":624,"3C-5S-QC":624,"3C-5D-QD":624,"3C-5D-QH":624,"3C-5D-QS":624,"3C-5H-QD":624,"3C-5S-QD":624,"3C-5H-QH":624,"3C-5H-QS":624,"3C-5S-QH":624,"3C-5S-QS":624,"3D-5C-QC":624,"3H-5C-QC":624,"3S-5C-QC":624,"3D-5C-QD":624,"3D-5C-QH":624,"3D-5C-QS":624,"3H-5C-QD":624,"3S-5C-QD":624,"3H-5C-QH":624,"3H-5C-QS":624,"3S-5C-QH":624,"3S-5C-QS":624,"3D-5D-QC":624,"3D-5H-QC":624,"3D-5S-QC":624,"3H-5D-QC":624,"3S-5D-QC":624,"3H-5H-QC":624,"3H-5S-QC":624,"3S-5H-QC":624,"3S-5S-QC":624,"3D-5D-QH":624,"3D-5D-QS":624,"3D-5H-QD":624,"3D-5S-QD":624,"3D-5H-QH":624,"3D-5H-QS":624,"3D-5S-QH":624,"3D-5S-QS":624,"3H-5D-QD":624,"3S-5D-QD":624,"3H-5D-QH":624,"3H-5D-QS":624,"3S-5D-QH":624,"3S-5D-QS":624,"3H-5H-QD":624,"3H-5S-QD":624,"3S-5H-QD":624,"3S-5S-QD":624,"3H-5H-QS":624,"3H-5S-QH":624,"3H-5S-QS":624,"3S-5H-QH":624,"3S-5H-QS":624,"3S-5S-QH":624,"2C-5C-QD":625,"2C-5C-QH":625,"2C-5C-QS":625,"2C-5D-QC":625,"2C-5H-QC":625,"2C-5S-QC":625,"2C-5D-QD":625,"2C-5D-QH":625,"2C-5D-QS":625,"2C-5H-QD":625,"2C-5S-QD":625,"2C-5H-QH":625,"2C-5H-QS":625,"2C-5S-QH":625,"2C-5S-QS":625,"2D-5C-QC":625,"2H-5C-QC":625,"2S-5C-QC":625,"2D-5C-QD":625,"2D-5C-QH":625,"2D-5C-QS":625,"2H-5C-QD":625,"2S-5C-QD":625,"2H-5C-QH":625,"2H-5C-QS":625,"2S-5C-QH":625,"2S-5C-QS":625,"2D-5D-QC":625,"2D-5H-QC":625,"2D-5S-QC":625,"2H-5D-QC":625,"2S-5D-QC":625,"2H-5H-QC":625,"2H-5S-QC":625,"2S-5H-QC":625,"2S-5S-QC":625,"2D-5D-QH":625,"2D-5D-QS":625,"2D-5H-QD":625,"2D-5S-QD":625,"2D-5H-QH":625,"2D-5H-QS":625,"2D-5S-QH":625,"2D-5S-QS":625,"2H-5D-QD":625,"2S-5D-QD":625,"2H-5D-QH":625,"2H-5D-QS":625,"2S-5D-QH":625,"2S-5D-QS":625,"2H-5H-QD":625,"2H-5S-QD":625,"2S-5H-QD":625,"2S-5S-QD":625,"2H-5H-QS":625,"2H-5S-QH":625,"2H-5S-QS":625,"2S-5H-QH":625,"2S-5H-QS":625,"2S-5S-QH":625,"3C-4C-QD":626,"3C-4C-QH":626,"3C-4C-QS":626,"3C-4D-QC":626,"3C-4H-QC":626,"3C-4S-QC":626,"3C-4D-QD":626,"3C-4D-QH":626,"3C-4D-QS":626,"3C-4H-QD":626,"3C-4S-QD":626,"3C-4H-QH":626,"3C-4H-QS":626,"3C-4S-QH":626,"3C-4S-QS":626,"3D-4C-QC":626,"3H-4C-QC":626,"3S-4C-QC":626,"3D-4C-QD":626,"3D-4C-QH":626,"3D-4C-QS":626,"3H-4C-QD":626,"3S-4C-QD":626,"3H-4C-QH":626,"3H-4C-QS":626,"3S-4C-QH":626,"3S-4C-QS":626,"3D-4D-QC":626,"3D-4H-QC":626,"3D-4S-QC":626,"3H-4D-QC":626,"3S-4D-QC":626,"3H-4H-QC":626,"3H-4S-QC":626,"3S-4H-QC":626,"3S-4S-QC":626,"3D-4D-QH":626,"3D-4D-QS":626,"3D-4H-QD":626,"3D-4S-QD":626,"3D-4H-QH":626,"3D-4H-QS":626,"3D-4S-QH":626,"3D-4S-QS":626,"3H-4D-QD":626,"3S-4D-QD":626,"3H-4D-QH":626,"3H-4D-QS":626,"3S-4D-QH":626,"3S-4D-QS":626,"3H-4H-QD":626,"3H-4S-QD":626,"3S-4H-QD":626,"3S-4S-QD":626,"3H-4H-QS":626,"3H-4S-QH":626,"3H-4S-QS":626,"3S-4H-QH":626,"3S-4H-QS":626,"3S-4S-QH":626,"2C-4C-QD":627,"2C-4C-QH":627,"2C-4C-QS":627,"2C-4D-QC":627,"2C-4H-QC":627,"2C-4S-QC":627,"2C-4D-QD":627,"2C-4D-QH":627,"2C-4D-QS":627,"2C-4H-QD":627,"2C-4S-QD":627,"2C-4H-QH":627,"2C-4H-QS":627,"2C-4S-QH":627,"2C-4S-QS":627,"2D-4C-QC":627,"2H-4C-QC":627,"2S-4C-QC":627,"2D-4C-QD":627,"2D-4C-QH":627,"2D-4C-QS":627,"2H-4C-QD":627,"2S-4C-QD":627,"2H-4C-QH":627,"2H-4C-QS":627,"2S-4C-QH":627,"2S-4C-QS":627,"2D-4D-QC":627,"2D-4H-QC":627,"2D-4S-QC":627,"2H-4D-QC":627,"2S-4D-QC":627,"2H-4H-QC":627,"2H-4S-QC":627,"2S-4H-QC":627,"2S-4S-QC":627,"2D-4D-QH":627,"2D-4D-QS":627,"2D-4H-QD":627,"2D-4S-QD":627,"2D-4H-QH":627,"2D-4H-QS":627,"2D-4S-QH":627,"2D-4S-QS":627,"2H-4D-QD":627,"2S-4D-QD":627,"2H-4D-QH":627,"2H-4D-QS":627,"2S-4D-QH":627,"2S-4D-QS":627,"2H-4H-QD":627,"2H-4S-QD":627,"2S-4H-QD":627,"2S-4S-QD":627,"2H-4H-QS":627,"2H-4S-QH":627,"2H-4S-QS":627,"2S-4H-QH":627,"2S-4H-QS":627,"2S-4S-QH":627,"2C-3C-QD":628,"2C-3C-QH":628,"2C-3C-QS":628,"2C-3D-QC":628,"2C-3H-QC":628,"2C-3S-QC":628,"2C-3D-QD":628,"2C-3D-QH":628,"2C-3D-QS":628,"2C-3H-QD":628,"2C-3S-QD":628,"2C-3H-QH":628,"2C-3H-QS":628,"2C-3S-QH":628,"2C-3S-QS":628,"2D-3C-QC":628,"2H-3C-QC":628,"2S-3C-QC":628,"2D-3C-QD":628,"2D-3C-QH":628,"2D-3C-QS":628,"2H-3C-QD":628,"2S-3C-QD":628,"2H-3C-QH":628,"2H-3C-QS":628,"2S-3C-QH":628,"2S-3C-QS":628,"2D-3D-QC":628,"2D-3H-QC":628,"2D-3S-QC":628,"2H-3D-QC":628,"2S-3D-QC":628,"2H-3H-QC":628,"2H-3S-QC":628,"2S-3H-QC":628,"2S-3S-QC":628,"2D-3D-QH":628,"2D-3D-QS":628,"2D-3H-QD":628,"2D-3S-QD":628,"2D-3H-QH":628,"2D-3H-QS":628,"2D-3S-QH":628,"2D-3S-QS":628,"2H-3D-QD":628,"2S-3D-QD":628,"2H-3D-QH":628,"2H-3D-QS":628,"2S-3D-QH":628,"2S-3D-QS":628,"2H-3H-QD":628,"2H-3S-QD":628,"2S-3H-QD":628,"2S-3S-QD":628,"2H-3H-QS":628,"2H-3S-QH":628,"2H-3S-QS":628,"2S-3H-QH":628,"2S-3H-QS":628,"2S-3S-QH":628,"10C-8C-JD":629,"10C-8C-JH":629,"10C-8C-JS":629,"10D-8C-JC":629,"10H-8C-JC":629,"10S-8C-JC":629,"10D-8C-JD":629,"10D-8C-JH":629,"10D-8C-JS":629,"10H-8C-JD":629,"10S-8C-JD":629,"10H-8C-JH":629,"10H-8C-JS":629,"10S-8C-JH":629,"10S-8C-JS":629,"10C-8D-JC":629,"10C-8H-JC":629,"10C-8S-JC":629,"10C-8D-JD":629,"10C-8D-JH":629,"10C-8D-JS":629,"10C-8H-JD":629,"10C-8S-JD":629,"10C-8H-JH":629,"10C-8H-JS":629,"10C-8S-JH":629,"10C-8S-JS":629,"10D-8D-JC":629,"10H-8D-JC":629,"10S-8D-JC":629,"10D-8H-JC":629,"10D-8S-JC":629,"10H-8H-JC":629,"10S-8H-JC":629,"10H-8S-JC":629,"10S-8S-JC":629,"10D-8D-JH":629,"10D-8D-JS":629,"10H-8D-JD":629,"10S-8D-JD":629,"10H-8D-JH":629,"10H-8D-JS":629,"10S-8D-JH":629,"10S-8D-JS":629,"10D-8H-JD":629,"10D-8S-JD":629,"10D-8H-JH":629,"10D-8H-JS":629,"10D-8S-JH":629,"10D-8S-JS":629,"10H-8H-JD":629,"10S-8H-JD":629,"10H-8S-JD":629,"10S-8S-JD":629,"10H-8H-JS":629,"10S-8H-JH":629,"10S-8H-JS":629,"10H-8S-JH":629,"10H-8S-JS":629,"10S-8S-JH":629,"10C-7C-JD":630,"10C-7C-JH":630,"10C-7C-JS":630,"10D-7C-JC":630,"10H-7C-JC":630,"10S-7C-JC":630,"10D-7C-JD":630,"10D-7C-JH":630,"10D-7C-JS":630,"10H-7C-JD":630,"10S-7C-JD":630,"10H-7C-JH":630,"10H-7C-JS":630,"10S-7C-JH":630,"10S-7C-JS":630,"10C-7D-JC":630,"10C-7H-JC":630,"10C-7S-JC":630,"10C-7D-JD":630,"10C-7D-JH":630,"10C-7D-JS":630,"10C-7H-JD":630,"10C-7S-JD":630,"10C-7H-JH":630,"10C-7H-JS":630,"10C-7S-JH":630,"10C-7S-JS":630,"10D-7D-JC":630,"10H-7D-JC":630,"10S-7D-JC":630,"10D-7H-JC":630,"10D-7S-JC":630,"10H-7H-JC":630,"10S-7H-JC":630,"10H-7S-JC":630,"10S-7S-JC":630,"10D-7D-JH":630,"10D-7D-JS":630,"10H-7D-JD":630,"10S-7D-JD":630,"10H-7D-JH":630,"10H-7D-JS":630,"10S-7D-JH":630,"10S-7D-JS":630,"10D-7H-JD":630,"10D-7S-JD":630,"10D-7H-JH":630,"10D-7H-JS":630,"10D-7S-JH":630,"10D-7S-JS":630,"10H-7H-JD":630,"10S-7H-JD":630,"10H-7S-JD":630,"10S-7S-JD":630,"10H-7H-JS":630,"10S-7H-JH":630,"10S-7H-JS":630,"10H-7S-JH":630,"10H-7S-JS":630,"10S-7S-JH":630,"10C-6C-JD":631,"10C-6C-JH":631,"10C-6C-JS":631,"10D-6C-JC":631,"10H-6C-JC":631,"10S-6C-JC":631,"10D-6C-JD":631,"10D-6C-JH":631,"10D-6C-JS":631,"10H-6C-JD":631,"10S-6C-JD":631,"10H-6C-JH":631,"10H-6C-JS":631,"10S-6C-JH":631,"10S-6C-JS":631,"10C-6D-JC":631,"10C-6H-JC":631,"10C-6S-JC":631,"10C-6D-JD":631,"10C-6D-JH":631,"10C-6D-JS":631,"10C-6H-JD":631,"10C-6S-JD":631,"10C-6H-JH":631,"10C-6H-JS":631,"10C-6S-JH":631,"10C-6S-JS":631,"10D-6D-JC":631,"10H-6D-JC":631,"10S-6D-JC":631,"10D-6H-JC":631,"10D-6S-JC":631,"10H-6H-JC":631,"10S-6H-JC":631,"10H-6S-JC":631,"10S-6S-JC":631,"10D-6D-JH":631,"10D-6D-JS":631,"10H-6D-JD":631,"10S-6D-JD":631,"10H-6D-JH":631,"10H-6D-JS":631,"10S-6D-JH":631,"10S-6D-JS":631,"10D-6H-JD":631,"10D-6S-JD":631,"10D-6H-JH":631,"10D-6H-JS":631,"10D-6S-JH":631,"10D-6S-JS":631,"10H-6H-JD":631,"10S-6H-JD":631,"10H-6S-JD":631,"10S-6S-JD":631,"10H-6H-JS":631,"10S-6H-JH":631,"10S-6H-JS":631,"10H-6S-JH":631,"10H-6S-JS":631,"10S-6S-JH":631,"10C-5C-JD":632,"10C-5C-JH":632,"10C-5C-JS":632,"10D-5C-JC":632,"10H-5C-JC":632,"10S-5C-JC":632,"10D-5C-JD":632,"10D-5C-JH":632,"10D-5C-JS":632,"10H-5C-JD":632,"10S-5C-JD":632,"10H-5C-JH":632,"10H-5C-JS":632,"10S-5C-JH":632,"10S-5C-JS":632,"10C-5D-JC":632,"10C-5H-JC":632,"10C-5S-JC":632,"10C-5D-JD":632,"10C-5D-JH":632,"10C-5D-JS":632,"10C-5H-JD":632,"10C-5S-JD":632,"10C-5H-JH":632,"10C-5H-JS":632,"10C-5S-JH":632,"10C-5S-JS":632,"10D-5D-JC":632,"10H-5D-JC":632,"10S-5D-JC":632,"10D-5H-JC":632,"10D-5S-JC":632,"10H-5H-JC":632,"10S-5H-JC":632,"10H-5S-JC":632,"10S-5S-JC":632,"10D-5D-JH":632,"10D-5D-JS":632,"10H-5D-JD":632,"10S-5D-JD":632,"10H-5D-JH":632,"10H-5D-JS":632,"10S-5D-JH":632,"10S-5D-JS":632,"10D-5H-JD":632,"10D-5S-JD":632,"10D-5H-JH":632,"10D-5H-JS":632,"10D-5S-JH":632,"10D-5S-JS":632,"10H-5H-JD":632,"10S-5H-JD":632,"10H-5S-JD":632,"10S-5S-JD":632,"10H-5H-JS":632,"10S-5H-JH":632,"10S-5H-JS":632,"10H-5S-JH":632,"10H-5S-JS":632,"10S-5S-JH":632,"10C-4C-JD":633,"10C-4C-JH":633,"10C-4C-JS":633,"10D-4C-JC":633,"10H-4C-JC":633,"10S-4C-JC":633,"10D-4C-JD":633,"10D-4C-JH":633,"10D-4C-JS":633,"10H-4C-JD":633,"10S-4C-JD":633,"10H-4C-JH":633,"10H-4C-JS":633,"10S-4C-JH":633,"10S-4C-JS":633,"10C-4D-JC":633,"10C-4H-JC":633,"10C-4S-JC":633,"10C-4D-JD":633,"10C-4D-JH":633,"10C-4D-JS":633,"10C-4H-JD":633,"10C-4S-JD":633,"10C-4H-JH":633,"10C-4H-JS":633,"10C-4S-JH":633,"10C-4S-JS":633,"10D-4D-JC":633,"10H-4D-JC":633,"10S-4D-JC":633,"10D-4H-JC":633,"10D-4S-JC":633,"10H-4H-JC":633,"10S-4H-JC":633,"10H-4S-JC":633,"10S-4S-JC":633,"10D-4D-JH":633,"10D-4D-JS":633,"10H-4D-JD":633,"10S-4D-JD":633,"10H-4D-JH":633,"10H-4D-JS":633,"10S-4D-JH":633,"10S-4D-JS":633,"10D-4H-JD":633,"10D-4S-JD":633,"10D-4H-JH":633,"10D-4H-JS":633,"10D-4S-JH":633,"10D-4S-JS":633,"10H-4H-JD":633,"10S-4H-JD":633,"10H-4S-JD":633,"10S-4S-JD":633,"10H-4H-JS":633,"10S-4H-JH":633,"10S-4H-JS":633,"10H-4S-JH":633,"10H-4S-JS":633,"10S-4S-JH":633,"10C-3C-JD":634,"10C-3C-JH":634,"10C-3C-JS":634,"10D-3C-JC":634,"10H-3C-JC":634,"10S-3C-JC":634,"10D-3C-JD":634,"10D-3C-JH":634,"10D-3C-JS":634,"10H-3C-JD":634,"10S-3C-JD":634,"10H-3C-JH":634,"10H-3C-JS":634,"10S-3C-JH":634,"10S-3C-JS":634,"10C-3D-JC":634,"10C-3H-JC":634,"10C-3S-JC":634,"10C-3D-JD":634,"10C-3D-JH":634,"10C-3D-JS":634,"10C-3H-JD":634,"10C-3S-JD":634,"10C-3H-JH":634,"10C-3H-JS":634,"10C-3S-JH":634,"10C-3S-JS":634,"10D-3D-JC":634,"10H-3D-JC":634,"10S-3D-JC":634,"10D-3H-JC":634,"10D-3S-JC":634,"10H-3H-JC":634,"10S-3H-JC":634,"10H-3S-JC":634,"10S-3S-JC":634,"10D-3D-JH":634,"10D-3D-JS":634,"10H-3D-JD":634,"10S-3D-JD":634,"10H-3D-JH":634,"10H-3D-JS":634,"10S-3D-JH":634,"10S-3D-JS":634,"10D-3H-JD":634,"10D-3S-JD":634,"10D-3H-JH":634,"10D-3H-JS":634,"10D-3S-JH":634,"10D-3S-JS":634,"10H-3H-JD":634,"10S-3H-JD":634,"10H-3S-JD":634,"10S-3S-JD":634,"10H-3H-JS":634,"10S-3H-JH":634,"10S-3H-JS":634,"10H-3S-JH":634,"10H-3S-JS":634,"10S-3S-JH":634,"10C-2C-JD":635,"10C-2C-JH":635,"10C-2C-JS":635,"10D-2C-JC":635,"10H-2C-JC":635,"10S-2C-JC":635,"10D-2C-JD":635,"10D-2C-JH":635,"10D-2C-JS":635,"10H-2C-JD":635,"10S-2C-JD":635,"10H-2C-JH":635,"10H-2C-JS":635,"10S-2C-JH":635,"10S-2C-JS":635,"10C-2D-JC":635,"10C-2H-JC":635,"10C-2S-JC":635,"10C-2D-JD":635,"10C-2D-JH":635,"10C-2D-JS":635,"10C-2H-JD":635,"10C-2S-JD":635,"10C-2H-JH":635,"10C-2H-JS":635,"10C-2S-JH":635,"10C-2S-JS":635,"10D-2D-JC":635,"10H-2D-JC":635,"10S-2D-JC":635,"10D-2H-JC":635,"10D-2S-JC":635,"10H-2H-JC":635,"10S-2H-JC":635,"10H-2S-JC":635,"10S-2S-JC":635,"10D-2D-JH":635,"10D-2D-JS":635,"10H-2D-JD":635,"10S-2D-JD":635,"10H-2D-JH":635,"10H-2D-JS":635,"10S-2D-JH":635,"10S-2D-JS":635,"10D-2H-JD":635,"10D-2S-JD":635,"10D-2H-JH":635,"10D-2H-JS":635,"10D-2S-JH":635,"10D-2S-JS":635,"10H-2H-JD":635,"10S-2H-JD":635,"10H-2S-JD":635,"10S-2S-JD":635,"10H-2H-JS":635,"10S-2H-JH":635,"10S-2H-JS":635,"10H-2S-JH":635,"10H-2S-JS":635,"10S-2S-JH":635,"8C-9C-JD":636,"8C-9C-JH":636,"8C-9C-JS":636,"8C-9D-JC":636,"8C-9H-JC":636,"8C-9S-JC":636,"8C-9D-JD":636,"8C-9D-JH":636,"8C-9D-JS":636,"8C-9H-JD":636,"8C-9S-JD":636,"8C-9H-JH":636,"8C-9H-JS":636,"8C-9S-JH":636,"8C-9S-JS":636,"8D-9C-JC":636,"8H-9C-JC":636,"8S-9C-JC":636,"8D-9C-JD":636,"8D-9C-JH":636,"8D-9C-JS":636,"8H-9C-JD":636,"8S-9C-JD":636,"8H-9C-JH":636,"8H-9C-JS":636,"8S-9C-JH":636,"8S-9C-JS":636,"8D-9D-JC":636,"8D-9H-JC":636,"8D-9S-JC":636,"8H-9D-JC":636,"8S-9D-JC":636,"8H-9H-JC":636,"8H-9S-JC":636,"8S-9H-JC":636,"8S-9S-JC":636,"8D-9D-JH":636,"8D-9D-JS":636,"8D-9H-JD":636,"8D-9S-JD":636,"8D-9H-JH":636,"8D-9H-JS":636,"8D-9S-JH":636,"8D-9S-JS":636,"8H-9D-JD":636,"8S-9D-JD":636,"8H-9D-JH":636,"8H-9D-JS":636,"8S-9D-JH":636,"8S-9D-JS":636,"8H-9H-JD":636,"8H-9S-JD":636,"8S-9H-JD":636,"8S-9S-JD":636,"8H-9H-JS":636,"8H-9S-JH":636,"8H-9S-JS":636,"8S-9H-JH":636,"8S-9H-JS":636,"8S-9S-JH":636,"7C-9C-JD":637,"7C-9C-JH":637,"7C-9C-JS":637,"7C-9D-JC":637,"7C-9H-JC":637,"7C-9S-JC":637,"7C-9D-JD":637,"7C-9D-JH":637,"7C-9D-JS":637,"7C-9H-JD":637,"7C-9S-JD":637,"7C-9H-JH":637,"7C-9H-JS":637,"7C-9S-JH":637,"7C-9S-JS":637,"7D-9C-JC":637,"7H-9C-JC":637,"7S-9C-JC":637,"7D-9C-JD":637,"7D-9C-JH":637,"7D-9C-JS":637,"7H-9C-JD":637,"7S-9C-JD":637,"7H-9C-JH":637,"7H-9C-JS":637,"7S-9C-JH":637,"7S-9C-JS":637,"7D-9D-JC":637,"7D-9H-JC":637,"7D-9S-JC":637,"7H-9D-JC":637,"7S-9D-JC":637,"7H-9H-JC":637,"7H-9S-JC":637,"7S-9H-JC":637,"7S-9S-JC":637,"7D-9D-JH":637,"7D-9D-JS":637,"7D-9H-JD":637,"7D-9S-JD":637,"7D-9H-JH":637,"7D-9H-JS":637,"7D-9S-JH":637,"7D-9S-JS":637,"7H-9D-JD":637,"7S-9D-JD":637,"7H-9D-JH":637,"7H-9D-JS":637,"7S-9D-JH":637,"7S-9D-JS":637,"7H-9H-JD":637,"7H-9S-JD":637,"7S-9H-JD":637,"7S-9S-JD":637,"7H-9H-JS":637,"7H-9S-JH":637,"7H-9S-JS":637,"7S-9H-JH":637,"7S-9H-JS":637,"7S-9S-JH":637,"6C-9C-JD":638,"6C-9C-JH":638,"6C-9C-JS":638,"6C-9D-JC":638,"6C-9H-JC":638,"6C-9S-JC":638,"6C-9D-JD":638,"6C-9D-JH":638,"6C-9D-JS":638,"6C-9H-JD":638,"6C-9S-JD":638,"6C-9H-JH":638,"6C-9H-JS":638,"6C-9S-JH":638,"6C-9S-JS":638,"6D-9C-JC":638,"6H-9C-JC":638,"6S-9C-JC":638,"6D-9C-JD":638,"6D-9C-JH":638,"6D-9C-JS":638,"6H-9C-JD":638,"6S-9C-JD":638,"6H-9C-JH":638,"6H-9C-JS":638,"6S-9C-JH":638,"6S-9C-JS":638,"6D-9D-JC":638,"6D-9H-JC":638,"6D-9S-JC":638,"6H-9D-JC":638,"6S-9D-JC":638,"6H-9H-JC":638,"6H-9S-JC":638,"6S-9H-JC":638,"6S-9S-JC":638,"6D-9D-JH":638,"6D-9D-JS":638,"6D-9H-JD":638,"6D-9S-JD":638,"6D-9H-JH":638,"6D-9H-JS":638,"6D-9S-JH":638,"6D-9S-JS":638,"6H-9D-JD":638,"6S-9D-JD":638,"6H-9D-JH":638,"6H-9D-JS":638,"6S-9D-JH":638,"6S-9D-JS":638,"6H-9H-JD":638,"6H-9S-JD":638,"6S-9H-JD":638,"6S-9S-JD":638,"6H-9H-JS":638,"6H-9S-JH":638,"6H-9S-JS":638,"6S-9H-JH":638,"6S-9H-JS":638,"6S-9S-JH":638,"5C-9C-JD":639,"5C-9C-JH":639,"5C-9C-JS":639,"5C-9D-JC":639,"5C-9H-JC":639,"5C-9S-JC":639,"5C-9D-JD":639,"5C-9D-JH":639,"5C-9D-JS":639,"5C-9H-JD":639,"5C-9S-JD":639,"5C-9H-JH":639,"5C-9H-JS":639,"5C-9S-JH":639,"5C-9S-JS":639,"5D-9C-JC":639,"5H-9C-JC":639,"5S-9C-JC":639,"5D-9C-JD":639,"5D-9C-JH":639,"5D-9C-JS":639,"5H-9C-JD":639,"5S-9C-JD":639,"5H-9C-JH":639,"5H-9C-JS":639,"5S-9C-JH":639,"5S-9C-JS":639,"5D-9D-JC":639,"5D-9H-JC":639,"5D-9S-JC":639,"5H-9D-JC":639,"5S-9D-JC":639,"5H-9H-JC":639,"5H-9S-JC":639,"5S-9H-JC":639,"5S-9S-JC":639,"5D-9D-JH":639,"5D-9D-JS":639,"5D-9H-JD":639,"5D-9S-JD":639,"5D-9H-JH":639,"5D-9H-JS":639,"5D-9S-JH":639,"5D-9S-JS":639,"5H-9D-JD":639,"5S-9D-JD":639,"5H-9D-JH":639,"5H-9D-JS":639,"5S-9D-JH":639,"5S-9D-JS":639,"5H-9H-JD":639,"5H-9S-JD":639,"5S-9H-JD":639,"5S-9S-JD":639,"5H-9H-JS":639,"5H-9S-JH":639,"5H-9S-JS":639,"5S-9H-JH":639,"5S-9H-JS":639,"5S-9S-JH":639,"4C-9C-JD":640,"4C-9C-JH":640,"4C-9C-JS":640,"4C-9D-JC":640,"4C-9H-JC":640,"4C-9S-JC":640,"4C-9D-JD":640,"4C-9D-JH":640,"4C-9D-JS":640,"4C-9H-JD":640,"4C-9S-JD":640,"4C-9H-JH":640,"4C-9H-JS":640,"4C-9S-JH":640,"4C-9S-JS":640,"4D-9C-JC":640,"4H-9C-JC":640,"4S-9C-JC":640,"4D-9C-JD":640,"4D-9C-JH":640,"4D-9C-JS":640,"4H-9C-JD":640,"4S-9C-JD":640,"4H-9C-JH":640,"4H-9C-JS":640,"4S-9C-JH":640,"4S-9C-JS":640,"4D-9D-JC":640,"4D-9H-JC":640,"4D-9S-JC":640,"4H-9D-JC":640,"4S-9D-JC":640,"4H-9H-JC":640,"4H-9S-JC":640,"4S-9H-JC":640,"4S-9S-JC":640,"4D-9D-JH":640,"4D-9D-JS":640,"4D-9H-JD":640,"4D-9S-JD":640,"4D-9H-JH":640,"4D-9H-JS":640,"4D-9S-JH":640,"4D-9S-JS":640,"4H-9D-JD":640,"4S-9D-JD":640,"4H-9D-JH":640,"4H-9D-JS":640,"4S-9D-JH":640,"4S-9D-JS":640,"4H-9H-JD":640,"4H-9S-JD":640,"4S-9H-JD":640,"4S-9S-JD":640,"4H-9H-JS":640,"4H-9S-JH":640,"4H-9S-JS":640,"4S-9H-JH":640,"4S-9H-JS":640,"4S-9S-JH":640,"3C-9C-JD":641,"3C-9C-JH":641,"3C-9C-JS":641,"3C-9D-JC":641,"3C-9H-JC":641,"3C-9S-JC":641,"3C-9D-JD":641,"3C-9D-JH":641,"3C-9D-JS":641,"3C-9H-JD":641,"3C-9S-JD":641,"3C-9H-JH":641,"3C-9H-JS":641,"3C-9S-JH":641,"3C-9S-JS":641,"3D-9C-JC":641,"3H-9C-JC":641,"3S-9C-JC":641,"3D-9C-JD":641,"3D-9C-JH":641,"3D-9C-JS":641,"3H-9C-JD":641,"3S-9C-JD":641,"3H-9C-JH":641,"3H-9C-JS":641,"3S-9C-JH":641,"3S-9C-JS":641,"3D-9D-JC":641,"3D-9H-JC":641,"3D-9S-JC":641,"3H-9D-JC":641,"3S-9D-JC":641,"3H-9H-JC":641,"3H-9S-JC":641,"3S-9H-JC":641,"3S-9S-JC":641,"3D-9D-JH":641,"3D-9D-JS":641,"3D-9H-JD":641,"3D-9S-JD":641,"3D-9H-JH":641,"3D-9H-JS":641,"3D-9S-JH":641,"3D-9S-JS":641,"3H-9D-JD":641,"3S-9D-JD":641,"3H-9D-JH":641,"3H-9D-JS":641,"3S-9D-JH":641,"3S-9D-JS":641,"3H-9H-JD":641,"3H-9S-JD":641,"3S-9H-JD":641,"3S-9S-JD":641,"3H-9H-JS":641,"3H-9S-JH":641,"3H-9S-JS":641,"3S-9H-JH":641,"3S-9H-JS":641,"3S-9S-JH":641,"2C-9C-JD":642,"2C-9C-JH":642,"2C-9C-JS":642,"2C-9D-JC":642,"2C-9H-JC":642,"2C-9S-JC":642,"2C-9D-JD":642,"2C-9D-JH":642,"2C-9D-JS":642,"2C-9H-JD":642,"2C-9S-JD":642,"2C-9H-JH":642,"2C-9H-JS":642,"2C-9S-JH":642,"2C-9S-JS":642,"2D-9C-JC":642,"2H-9C-JC":642,"2S-9C-JC":642,"2D-9C-JD":642,"2D-9C-JH":642,"2D-9C-JS":642,"2H-9C-JD":642,"2S-9C-JD":642,"2H-9C-JH":642,"2H-9C-JS":642,"2S-9C-JH":642,"2S-9C-JS":642,"2D-9D-JC":642,"2D-9H-JC":642,"2D-9S-JC":642,"2H-9D-JC":642,"2S-9D-JC":642,"2H-9H-JC":642,"2H-9S-JC":642,"2S-9H-JC":642,"2S-9S-JC":642,"2D-9D-JH":642,"2D-9D-JS":642,"2D-9H-JD":642,"2D-9S-JD":642,"2D-9H-JH":642,"2D-9H-JS":642,"2D-9S-JH":642,"2D-9S-JS":642,"2H-9D-JD":642,"2S-9D-JD":642,"2H-9D-JH":642,"2H-9D-JS":642,"2S-9D-JH":642,"2S-9D-JS":642,"2H-9H-JD":642,"2H-9S-JD":642,"2S-9H-JD":642,"2S-9S-JD":642,"2H-9H-JS":642,"2H-9S-JH":642,"2H-9S-JS":642,"2S-9H-JH":642,"2S-9H-JS":642,"2S-9S-JH":642,"7C-8C-JD":643,"7C-8C-JH":643,"7C-8C-JS":643,"7C-8D-JC":643,"7C-8H-JC":643,"7C-8S-JC":643,"7C-8D-JD":643,"7C-8D-JH":643,"7C-8D-JS":643,"7C-8H-JD":643,"7C-8S-JD":643,"7C-8H-JH":643,"7C-8H-JS":643,"7C-8S-JH":643,"7C-8S-JS":643,"7D-8C-JC":643,"7H-8C-JC":643,"7S-8C-JC":643,"7D-8C-JD":643,"7D-8C-JH":643,"7D-8C-JS":643,"7H-8C-JD":643,"7S-8C-JD":643,"7H-8C-JH":643,"7H-8C-JS":643,"7S-8C-JH":643,"7S-8C-JS":643,"7D-8D-JC":643,"7D-8H-JC":643,"7D-8S-JC":643,"7H-8D-JC":643,"7S-8D-JC":643,"7H-8H-JC":643,"7H-8S-JC":643,"7S-8H-JC":643,"7S-8S-JC":643,"7D-8D-JH":643,"7D-8D-JS":643,"7D-8H-JD":643,"7D-8S-JD":643,"7D-8H-JH":643,"7D-8H-JS":643,"7D-8S-JH":643,"7D-8S-JS":643,"7H-8D-JD":643,"7S-8D-JD":643,"7H-8D-JH":643,"7H-8D-JS":643,"7S-8D-JH":643,"7S-8D-JS":643,"7H-8H-JD":643,"7H-8S-JD":643,"7S-8H-JD":643,"7S-8S-JD":643,"7H-8H-JS":643,"7H-8S-JH":643,"7H-8S-JS":643,"7S-8H-JH":643,"7S-8H-JS":643,"7S-8S-JH":643,"6C-8C-JD":644,"6C-8C-JH":644,"6C-8C-JS":644,"6C-8D-JC":644,"6C-8H-JC":644,"6C-8S-JC":644,"6C-8D-JD":644,"6C-8D-JH":644,"6C-8D-JS":644,"6C-8H-JD":644,"6C-8S-JD":644,"6C-8H-JH":644,"6C-8H-JS":644,"6C-8S-JH":644,"6C-8S-JS":644,"6D-8C-JC":644,"6H-8C-JC":644,"6S-8C-JC":644,"6D-8C-JD":644,"6D-8C-JH":644,"6D-8C-JS":644,"6H-8C-JD":644,"6S-8C-JD":644,"6H-8C-JH":644,"6H-8C-JS":644,"6S-8C-JH":644,"6S-8C-JS":644,"6D-8D-JC":644,"6D-8H-JC":644,"6D-8S-JC":644,"6H-8D-JC":644,"6S-8D-JC":644,"6H-8H-JC":644,"6H-8S-JC":644,"6S-8H-JC":644,"6S-8S-JC":644,"6D-8D-JH":644,"6D-8D-JS":644,"6D-8H-JD":644,"6D-8S-JD":644,"6D-8H-JH":644,"6D-8H-JS":644,"6D-8S-JH":644,"6D-8S-JS":644,"6H-8D-JD":644,"6S-8D-JD":644,"6H-8D-JH":644,"6H-8D-JS":644,"6S-8D-JH":644,"6S-8D-JS":644,"6H-8H-JD":644,"6H-8S-JD":644,"6S-8H-JD":644,"6S-8S-JD":644,"6H-8H-JS":644,"6H-8S-JH":644,"6H-8S-JS":644,"6S-8H-JH":644,"6S-8H-JS":644,"6S-8S-JH":644,"5C-8C-JD":645,"5C-8C-JH":645,"5C-8C-JS":645,"5C-8D-JC":645,"5C-8H-JC":645,"5C-8S-JC":645,"5C-8D-JD":645,"5C-8D-JH":645,"5C-8D-JS":645,"5C-8H-JD":645,"5C-8S-JD":645,"5C-8H-JH":645,"5C-8H-JS":645,"5C-8S-JH":645,"5C-8S-JS":645,"5D-8C-JC":645,"5H-8C-JC":645,"5S-8C-JC":645,"5D-8C-JD":645,"5D-8C-JH":645,"5D-8C-JS":645,"5H-8C-JD":645,"5S-8C-JD":645,"5H-8C-JH":645,"5H-8C-JS":645,"5S-8C-JH":645,"5S-8C-JS":645,"5D-8D-JC":645,"5D-8H-JC":645,"5D-8S-JC":645,"5H-8D-JC":645,"5S-8D-JC":645,"5H-8H-JC":645,"5H-8S-JC":645,"5S-8H-JC":645,"5S-8S-JC":645,"5D-8D-JH":645,"5D-8D-JS":645,"5D-8H-JD":645,"5D-8S-JD":645,"5D-8H-JH":645,"5D-8H-JS":645,"5D-8S-JH":645,"5D-8S-JS":645,"5H-8D-JD":645,"5S-8D-JD":645,"5H-8D-JH":645,"5H-8D-JS":645,"5S-8D-JH":645,"5S-8D-JS":645,"5H-8H-JD":645,"5H-8S-JD":645,"5S-8H-JD":645,"5S-8S-JD":645,"5H-8H-JS":645,"5H-8S-JH":645,"5H-8S-JS":645,"5S-8H-JH":645,"5S-8H-JS":645,"5S-8S-JH":645,"4C-8C-JD":646,"4C-8C-JH":646,"4C-8C-JS":646,"4C-8D-JC":646,"4C-8H-JC":646,"4C-8S-JC":646,"4C-8D-JD":646,"4C-8D-JH":646,"4C-8D-JS":646,"4C-8H-JD":646,"4C-8S-JD":646,"4C-8H-JH":646,"4C-8H-JS":646,"4C-8S-JH":646,"4C-8S-JS":646,"4D-8C-JC":646,"4H-8C-JC":646,"4S-8C-JC":646,"4D-8C-JD":646,"4D-8C-JH":646,"4D-8C-JS":646,"4H-8C-JD":646,"4S-8C-JD":646,"4H-8C-JH":646,"4H-8C-JS":646,"4S-8C-JH":646,"4S-8C-JS":646,"4D-8D-JC":646,"4D-8H-JC":646,"4D-8S-JC":646,"4H-8D-JC":646,"4S-8D-JC":646,"4H-8H-JC":646,"4H-8S-JC":646,"4S-8H-JC":646,"4S-8S-JC":646,"4D-8D-JH":646,"4D-8D-JS":646,"4D-8H-JD":646,"4D-8S-JD":646,"4D-8H-JH":646,"4D-8H-JS":646,"4D-8S-JH":646,"4D-8S-JS":646,"4H-8D-JD":646,"4S-8D-JD":646,"4H-8D-JH":646,"4H-8D-JS":646,"4S-8D-JH":646,"4S-8D-JS":646,"4H-8H-JD":646,"4H-8S-JD":646,"4S-8H-JD":646,"4S-8S-JD":646,"4H-8H-JS":646,"4H-8S-JH":646,"4H-8S-JS":646,"4S-8H-JH":646,"4S-8H-JS":646,"4S-8S-JH":646,"3C-8C-JD":647,"3C-8C-JH":647,"3C-8C-JS":647,"3C-8D-JC":647,"3C-8H-JC":647,"3C-8S-JC":647,"3C-8D-JD":647,"3C-8D-JH":647,"3C-8D-JS":647,"3C-8H-JD":647,"3C-8S-JD":647,"3C-8H-JH":647,"3C-8H-JS":647,"3C-8S-JH":647,"3C-8S-JS":647,"3D-8C-JC":647,"3H-8C-JC":647,"3S-8C-JC":647,"3D-8C-JD":647,"3D-8C-JH":647,"3D-8C-JS":647,"3H-8C-JD":647,"3S-8C-JD":647,"3H-8C-JH":647,"3H-8C-JS":647,"3S-8C-JH":647,"3S-8C-JS":647,"3D-8D-JC":647,"3D-8H-JC":647,"3D-8S-JC":647,"3H-8D-JC":647,"3S-8D-JC":647,"3H-8H-JC":647,"3H-8S-JC":647,"3S-8H-JC":647,"3S-8S-JC":647,"3D-8D-JH":647,"3D-8D-JS":647,"3D-8H-JD":647,"3D-8S-JD":647,"3D-8H-JH":647,"3D-8H-JS":647,"3D-8S-JH":647,"3D-8S-JS":647,"3H-8D-JD":647,"3S-8D-JD":647,"3H-8D-JH":647,"3H-8D-JS":647,"3S-8D-JH":647,"3S-8D-JS":647,"3H-8H-JD":647,"3H-8S-JD":647,"3S-8H-JD":647,"3S-8S-JD":647,"3H-8H-JS":647,"3H-8S-JH":647,"3H-8S-JS":647,"3S-8H-JH":647,"3S-8H-JS":647,"3S-8S-JH":647,"2C-8C-JD":648,"2C-8C-JH":648,"2C-8C-JS":648,"2C-8D-JC":648,"2C-8H-JC":648,"2C-8S-JC":648,"2C-8D-JD":648,"2C-8D-JH":648,"2C-8D-JS":648,"2C-8H-JD":648,"2C-8S-JD":648,"2C-8H-JH":648,"2C-8H-JS":648,"2C-8S-JH":648,"2C-8S-JS":648,"2D-8C-JC":648,"2H-8C-JC":648,"2S-8C-JC":648,"2D-8C-JD":648,"2D-8C-JH":648,"2D-8C-JS":648,"2H-8C-JD":648,"2S-8C-JD":648,"2H-8C-JH":648,"2H-8C-JS":648,"2S-8C-JH":648,"2S-8C-JS":648,"2D-8D-JC":648,"2D-8H-JC":648,"2D-8S-JC":648,"2H-8D-JC":648,"2S-8D-JC":648,"2H-8H-JC":648,"2H-8S-JC":648,"2S-8H-JC":648,"2S-8S-JC":648,"2D-8D-JH":648,"2D-8D-JS":648,"2D-8H-JD":648,"2D-8S-JD":648,"2D-8H-JH":648,"2D-8H-JS":648,"2D-8S-JH":648,"2D-8S-JS":648,"2H-8D-JD":648,"2S-8D-JD":648,"2H-8D-JH":648,"2H-8D-JS":648,"2S-8D-JH":648,"2S-8D-JS":648,"2H-8H-JD":648,"2H-8S-JD":648,"2S-8H-JD":648,"2S-8S-JD":648,"2H-8H-JS":648,"2H-8S-JH":648,"2H-8S-JS":648,"2S-8H-JH":648,"2S-8H-JS":648,"2S-8S-JH":648,"6C-7C-JD":649,"6C-7C-JH":649,"6C-7C-JS":649,"6C-7D-JC":649,"6C-7H-JC":649,"6C-7S-JC":649,"6C-7D-JD":649,"6C-7D-JH":649,"6C-7D-JS":649,"6C-7H-JD":649,"6C-7S-JD":649,"6C-7H-JH":649,"6C-7H-JS":649,"6C-7S-JH":649,"6C-7S-JS":649,"6D-7C-JC":649,"6H-7C-JC":649,"6S-7C-JC":649,"6D-7C-JD":649,"6D-7C-JH":649,"6D-7C-JS":649,"6H-7C-JD":649,"6S-7C-JD":649,"6H-7C-JH":649,"6H-7C-JS":649,"6S-7C-JH":649,"6S-7C-JS":649,"6D-7D-JC":649,"6D-7H-JC":649,"6D-7S-JC":649,"6H-7D-JC":649,"6S-7D-JC":649,"6H-7H-JC":649,"6H-7S-JC":649,"6S-7H-JC":649,"6S-7S-JC":649,"6D-7D-JH":649,"6D-7D-JS":649,"6D-7H-JD":649,"6D-7S-JD":649,"6D-7H-JH":649,"6D-7H-JS":649,"6D-7S-JH":649,"6D-7S-JS":649,"6H-7D-JD":649,"6S-7D-JD":649,"6H-7D-JH":649,"6H-7D-JS":649,"6S-7D-JH":649,"6S-7D-JS":649,"6H-7H-JD":649,"6H-7S-JD":649,"6S-7H-JD":649,"6S-7S-JD":649,"6H-7H-JS":649,"6H-7S-JH":649,"6H-7S-JS":649,"6S-7H-JH":649,"6S-7H-JS":649,"6S-7S-JH":649,"5C-7C-JD":650,"5C-7C-JH":650,"5C-7C-JS":650,"5C-7D-JC":650,"5C-7H-JC":650,"5C-7S-JC":650,"5C-7D-JD":650,"5C-7D-JH":650,"5C-7D-JS":650,"5C-7H-JD":650,"5C-7S-JD":650,"5C-7H-JH":650,"5C-7H-JS":650,"5C-7S-JH":650,"5C-7S-JS":650,"5D-7C-JC":650,"5H-7C-JC":650,"5S-7C-JC":650,"5D-7C-JD":650,"5D-7C-JH":650,"5D-7C-JS":650,"5H-7C-JD":650,"5S-7C-JD":650,"5H-7C-JH":650,"5H-7C-JS":650,"5S-7C-JH":650,"5S-7C-JS":650,"5D-7D-JC":650,"5D-7H-JC":650,"5D-7S-JC":650,"5H-7D-JC":650,"5S-7D-JC":650,"5H-7H-JC":650,"5H-7S-JC":650,"5S-7H-JC":650,"5S-7S-JC":650,"5D-7D-JH":650,"5D-7D-JS":650,"5D-7H-JD":650,"5D-7S-JD":650,"5D-7H-JH":650,"5D-7H-JS":650,"5D-7S-JH":650,"5D-7S-JS":650,"5H-7D-JD":650,"5S-7D-JD":650,"5H-7D-JH":650,"5H-7D-JS":650,"5S-7D-JH":650,"5S-7D-JS":650,"5H-7H-JD":650,"5H-7S-JD":650,"5S-7H-JD":650,"5S-7S-JD":650,"5H-7H-JS":650,"5H-7S-JH":650,"5H-7S-JS":650,"5S-7H-JH":650,"5S-7H-JS":650,"5S-7S-JH":650,"4C-7C-JD":651,"4C-7C-JH":651,"4C-7C-JS":651,"4C-7D-JC":651,"4C-7H-JC":651,"4C-7S-JC":651,"4C-7D-JD":651,"4C-7D-JH":651,"4C-7D-JS":651,"4C-7H-JD":651,"4C-7S-JD":651,"4C-7H-JH":651,"4C-7H-JS":651,"4C-7S-JH":651,"4C-7S-JS":651,"4D-7C-JC":651,"4H-7C-JC":651,"4S-7C-JC":651,"4D-7C-JD":651,"4D-7C-JH":651,"4D-7C-JS":651,"4H-7C-JD":651,"4S-7C-JD":651,"4H-7C-JH":651,"4H-7C-JS":651,"4S-7C-JH":651,"4S-7C-JS":651,"4D-7D-JC":651,"4D-7H-JC":651,"4D-7S-JC":651,"4H-7D-JC":651,"4S-7D-JC":651,"4H-7H-JC":651,"4H-7S-JC":651,"4S-7H-JC":651,"4S-7S-JC":651,"4D-7D-JH":651,"4D-7D-JS":651,"4D-7H-JD":651,"4D-7S-JD":651,"4D-7H-JH":651,"4D-7H-JS":651,"4D-7S-JH":651,"4D-7S-JS":651,"4H-7D-JD":651,"4S-7D-JD":651,"4H-7D-JH":651,"4H-7D-JS":651,"4S-7D-JH":651,"4S-7D-JS":651,"4H-7H-JD":651,"4H-7S-JD":651,"4S-7H-JD":651,"4S-7S-JD":651,"4H-7H-JS":651,"4H-7S-JH":651,"4H-7S-JS":651,"4S-7H-JH":651,"4S-7H-JS":651,"4S-7S-JH":651,"3C-7C-JD":652,"3C-7C-JH":652,"3C-7C-JS":652,"3C-7D-JC":652,"3C-7H-JC":652,"3C-7S-JC":652,"3C-7D-JD":652,"3C-7D-JH":652,"3C-7D-JS":652,"3C-7H-JD":652,"3C-7S-JD":652,"3C-7H-JH":652,"3C-7H-JS":652,"3C-7S-JH":652,"3C-7S-JS":652,"3D-7C-JC":652,"3H-7C-JC":652,"3S-7C-JC":652,"3D-7C-JD":652,"3D-7C-JH":652,"3D-7C-JS":652,"3H-7C-JD":652,"3S-7C-JD":652,"3H-7C-JH":652,"3H-7C-JS":652,"3S-7C-JH":652,"3S-7C-JS":652,"3D-7D-JC":652,"3D-7H-JC":652,"3D-7S-JC":652,"3H-7D-JC":652,"3S-7D-JC":652,"3H-7H-JC":652,"3H-7S-JC":652,"3S-7H-JC":652,"3S-7S-JC":652,"3D-7D-JH":652,"3D-7D-JS":652,"3D-7H-JD":652,"3D-7S-JD":652,"3D-7H-JH":652,"3D-7H-JS":652,"3D-7S-JH":652,"3D-7S-JS":652,"3H-7D-JD":652,"3S-7D-JD":652,"3H-7D-JH":652,"3H-7D-JS":652,"3S-7D-JH":652,"3S-7D-JS":652,"3H-7H-JD":652,"3H-7S-JD":652,"3S-7H-JD":652,"3S-7S-JD":652,"3H-7H-JS":652,"3H-7S-JH":652,"3H-7S-JS":652,"3S-7H-JH":652,"3S-7H-JS":652,"3S-7S-JH":652,"2C-7C-JD":653,"2C-7C-JH":653,"2C-7C-JS":653,"2C-7D-JC":653,"2C-7H-JC":653,"2C-7S-JC":653,"2C-7D-JD":653,"2C-7D-JH":653,"2C-7D-JS":653,"2C-7H-JD":653,"2C-7S-JD":653,"2C-7H-JH":653,"2C-7H-JS":653,"2C-7S-JH":653,"2C-7S-JS":653,"2D-7C-JC":653,"2H-7C-JC":653,"2S-7C-JC":653,"2D-7C-JD":653,"2D-7C-JH":653,"2D-7C-JS":653,"2H-7C-JD":653,"2S-7C-JD":653,"2H-7C-JH":653,"2H-7C-JS":653,"2S-7C-JH":653,"2S-7C-JS":653,"2D-7D-JC":653,"2D-7H-JC":653,"2D-7S-JC":653,"2H-7D-JC":653,"2S-7D-JC":653,"2H-7H-JC":653,"2H-7S-JC":653,"2S-7H-JC":653,"2S-7S-JC":653,"2D-7D-JH":653,"2D-7D-JS":653,"2D-7H-JD":653,"2D-7S-JD":653,"2D-7H-JH":653,"2D-7H-JS":653,"2D-7S-JH":653,"2D-7S-JS":653,"2H-7D-JD":653,"2S-7D-JD":653,"2H-7D-JH":653,"2H-7D-JS":653,"2S-7D-JH":653,"2S-7D-JS":653,"2H-7H-JD":653,"2H-7S-JD":653,"2S-7H-JD":653,"2S-7S-JD":653,"2H-7H-JS":653,"2H-7S-JH":653,"2H-7S-JS":653,"2S-7H-JH":653,"2S-7H-JS":653,"2S-7S-JH":653,"5C-6C-JD":654,"5C-6C-JH":654,"5C-6C-JS":654,"5C-6D-JC":654,"5C-6H-JC":654,"5C-6S-JC":654,"5C-6D-JD":654,"5C-6D-JH":654,"5C-6D-JS":654,"5C-6H-JD":654,"5C-6S-JD":654,"5C-6H-JH":654,"5C-6H-JS":654,"5C-6S-JH":654,"5C-6S-JS":654,"5D-6C-JC":654,"5H-6C-JC":654,"5S-6C-JC":654,"5D-6C-JD":654,"5D-6C-JH":654,"5D-6C-JS":654,"5H-6C-JD":654,"5S-6C-JD":654,"5H-6C-JH":654,"5H-6C-JS":654,"5S-6C-JH":654,"5S-6C-JS":654,"5D-6D-JC":654,"5D-6H-JC":654,"5D-6S-JC":654,"5H-6D-JC":654,"5S-6D-JC":654,"5H-6H-JC":654,"5H-6S-JC":654,"5S-6H-JC":654,"5S-6S-JC":654,"5D-6D-JH":654,"5D-6D-JS":654,"5D-6H-JD":654,"5D-6S-JD":654,"5D-6H-JH":654,"5D-6H-JS":654,"5D-6S-JH":654,"5D-6S-JS":654,"5H-6D-JD":654,"5S-6D-JD":654,"5H-6D-JH":654,"5H-6D-JS":654,"5S-6D-JH":654,"5S-6D-JS":654,"5H-6H-JD":654,"5H-6S-JD":654,"5S-6H-JD":654,"5S-6S-JD":654,"5H-6H-JS":654,"5H-6S-JH":654,"5H-6S-JS":654,"5S-6H-JH":654,"5S-6H-JS":654,"5S-6S-JH":654,"4C-6C-JD":655,"4C-6C-JH":655,"4C-6C-JS":655,"4C-6D-JC":655,"4C-6H-JC":655,"4C-6S-JC":655,"4C-6D-JD":655,"4C-6D-JH":655,"4C-6D-JS":655,"4C-6H-JD":655,"4C-6S-JD":655,"4C-6H-JH":655,"4C-6H-JS":655,"4C-6S-JH":655,"4C-6S-JS":655,"4D-6C-JC":655,"4H-6C-JC":655,"4S-6C-JC":655,"4D-6C-JD":655,"4D-6C-JH":655,"4D-6C-JS":655,"4H-6C-JD":655,"4S-6C-JD":655,"4H-6C-JH":655,"4H-6C-JS":655,"4S-6C-JH":655,"4S-6C-JS":655,"4D-6D-JC":655,"4D-6H-JC":655,"4D-6S-JC":655,"4H-6D-JC":655,"4S-6D-JC":655,"4H-6H-JC":655,"4H-6S-JC":655,"4S-6H-JC":655,"4S-6S-JC":655,"4D-6D-JH":655,"4D-6D-JS":655,"4D-6H-JD":655,"4D-6S-JD":655,"4D-6H-JH":655,"4D-6H-JS":655,"4D-6S-JH":655,"4D-6S-JS":655,"4H-6D-JD":655,"4S-6D-JD":655,"4H-6D-JH":655,"4H-6D-JS":655,"4S-6D-JH":655,"4S-6D-JS":655,"4H-6H-JD":655,"4H-6S-JD":655,"4S-6H-JD":655,"4S-6S-JD":655,"4H-6H-JS":655,"4H-6S-JH":655,"4H-6S-JS":655,"4S-6H-JH":655,"4S-6H-JS":655,"4S-6S-JH":655,"3C-6C-JD":656,"3C-6C-JH":656,"3C-6C-JS":656,"3C-6D-JC":656,"3C-6H-JC":656,"3C-6S-JC":656,"3C-6D-JD":656,"3C-6D-JH":656,"3C-6D-JS":656,"3C-6H-JD":656,"3C-6S-JD":656,"3C-6H-JH":656,"3C-6H-JS":656,"3C-6S-JH":656,"3C-6S-JS":656,"3D-6C-JC":656,"3H-6C-JC":656,"3S-6C-JC":656,"3D-6C-JD":656,"3D-6C-JH":656,"3D-6C-JS":656,"3H-6C-JD":656,"3S-6C-JD":656,"3H-6C-JH":656,"3H-6C-JS":656,"3S-6C-JH":656,"3S-6C-JS":656,"3D-6D-JC":656,"3D-6H-JC":656,"3D-6S-JC":656,"3H-6D-JC":656,"3S-6D-JC":656,"3H-6H-JC":656,"3H-6S-JC":656,"3S-6H-JC":656,"3S-6S-JC":656,"3D-6D-JH":656,"3D-6D-JS":656,"3D-6H-JD":656,"3D-6S-JD":656,"3D-6H-JH":656,"3D-6H-JS":656,"3D-6S-JH":656,"3D-6S-JS":656,"3H-6D-JD":656,"3S-6D-JD":656,"3H-6D-JH":656,"3H-6D-JS":656,"3S-6D-JH":656,"3S-6D-JS":656,"3H-6H-JD":656,"3H-6S-JD":656,"3S-6H-JD":656,"3S-6S-JD":656,"3H-6H-JS":656,"3H-6S-JH":656,"3H-6S-JS":656,"3S-6H-JH":656,"3S-6H-JS":656,"3S-6S-JH":656,"2C-6C-JD":657,"2C-6C-JH":657,"2C-6C-JS":657,"2C-6D-JC":657,"2C-6H-JC":657,"2C-6S-JC":657,"2C-6D-JD":657,"2C-6D-JH":657,"2C-6D-JS":657,"2C-6H-JD":657,"2C-6S-JD":657,"2C-6H-JH":657,"2C-6H-JS":657,"2C-6S-JH":657,"2C-6S-JS":657,"2D-6C-JC":657,"2H-6C-JC":657,"2S-6C-JC":657,"2D-6C-JD":657,"2D-6C-JH":657,"2D-6C-JS":657,"2H-6C-JD":657,"2S-6C-JD":657,"2H-6C-JH":657,"2H-6C-JS":657,"2S-6C-JH":657,"2S-6C-JS":657,"2D-6D-JC":657,"2D-6H-JC":657,"2D-6S-JC":657,"2H-6D-JC":657,"2S-6D-JC":657,"2H-6H-JC":657,"2H-6S-JC":657,"2S-6H-JC":657,"2S-6S-JC":657,"2D-6D-JH":657,"2D-6D-JS":657,"2D-6H-JD":657,"2D-6S-JD":657,"2D-6H-JH":657,"2D-6H-JS":657,"2D-6S-JH":657,"2D-6S-JS":657,"2H-6D-JD":657,"2S-6D-JD":657,"2H-6D-JH":657,"2H-6D-JS":657,"2S-6D-JH":657,"2S-6D-JS":657,"2H-6H-JD":657,"2H-6S-JD":657,"2S-6H-JD":657,"2S-6S-JD":657,"2H-6H-JS":657,"2H-6S-JH":657,"2H-6S-JS":657,"2S-6H-JH":657,"2S-6H-JS":657,"2S-6S-JH":657,"4C-5C-JD":658,"4C-5C-JH":658,"4C-5C-JS":658,"4C-5D-JC":658,"4C-5H-JC":658,"4C-5S-JC":658,"4C-5D-JD":658,"4C-5D-JH":658,"4C-5D-JS":658,"4C-5H-JD":658,"4C-5S-JD":658,"4C-5H-JH":658,"4C-5H-JS":658,"4C-5S-JH":658,"4C-5S-JS":658,"4D-5C-JC":658,"4H-5C-JC":658,"4S-5C-JC":658,"4D-5C-JD":658,"4D-5C-JH":658,"4D-5C-JS":658,"4H-5C-JD":658,"4S-5C-JD":658,"4H-5C-JH":658,"4H-5C-JS":658,"4S-5C-JH":658,"4S-5C-JS":658,"4D-5D-JC":658,"4D-5H-JC":658,"4D-5S-JC":658,"4H-5D-JC":658,"4S-5D-JC":658,"4H-5H-JC":658,"4H-5S-JC":658,"4S-5H-JC":658,"4S-5S-JC":658,"4D-5D-JH":658,"4D-5D-JS":658,"4D-5H-JD":658,"4D-5S-JD":658,"4D-5H-JH":658,"4D-5H-JS":658,"4D-5S-JH":658,"4D-5S-JS":658,"4H-5D-JD":658,"4S-5D-JD":658,"4H-5D-JH":658,"4H-5D-JS":658,"4S-5D-JH":658,"4S-5D-JS":658,"4H-5H-JD":658,"4H-5S-JD":658,"4S-5H-JD":658,"4S-5S-JD":658,"4H-5H-JS":658,"4H-5S-JH":658,"4H-5S-JS":658,"4S-5H-JH":658,"4S-5H-JS":658,"4S-5S-JH":658,"3C-5C-JD":659,"3C-5C-JH":659,"3C-5C-JS":659,"3C-5D-JC":659,"3C-5H-JC":659,"3C-5S-JC":659,"3C-5D-JD":659,"3C-5D-JH":659,"3C-5D-JS":659,"3C-5H-JD":659,"3C-5S-JD":659,"3C-5H-JH":659,"3C-5H-JS":659,"3C-5S-JH":659,"3C-5S-JS":659,"3D-5C-JC":659,"3H-5C-JC":659,"3S-5C-JC":659,"3D-5C-JD":659,"3D-5C-JH":659,"3D-5C-JS":659,"3H-5C-JD":659,"3S-5C-JD":659,"3H-5C-JH":659,"3H-5C-JS":659,"3S-5C-JH":659,"3S-5C-JS":659,"3D-5D-JC":659,"3D-5H-JC":659,"3D-5S-JC":659,"3H-5D-JC":659,"3S-5D-JC":659,"3H-5H-JC":659,"3H-5S-JC":659,"3S-5H-JC":659,"3S-5S-JC":659,"3D-5D-JH":659,"3D-5D-JS":659,"3D-5H-JD":659,"3D-5S-JD":659,"3D-5H-JH":659,"3D-5H-JS":659,"3D-5S-JH":659,"3D-5S-JS":659,"3H-5D-JD":659,"3S-5D-JD":659,"3H-5D-JH":659,"3H-5D-JS":659,"3S-5D-JH":659,"3S-5D-JS":659,"3H-5H-JD":659,"3H-5S-JD":659,"3S-5H-JD":659,"3S-5S-JD":659,"3H-5H-JS":659,"3H-5S-JH":659,"3H-5S-JS":659,"3S-5H-JH":659,"3S-5H-JS":659,"3S-5S-JH":659,"2C-5C-JD":660,"2C-5C-JH":660,"2C-5C-JS":660,"2C-5D-JC":660,"2C-5H-JC":660,"2C-5S-JC":660,"2C-5D-JD":660,"2C-5D-JH":660,"2C-5D-JS":660,"2C-5H-JD":660,"2C-5S-JD":660,"2C-5H-JH":660,"2C-5H-JS":660,"2C-5S-JH":660,"2C-5S-JS":660,"2D-5C-JC":660,"2H-5C-JC":660,"2S-5C-JC":660,"2D-5C-JD":660,"2D-5C-JH":660,"2D-5C-JS":660,"2H-5C-JD":660,"2S-5C-JD":660,"2H-5C-JH":660,"2H-5C-JS":660,"2S-5C-JH":660,"2S-5C-JS":660,"2D-5D-JC":660,"2D-5H-JC":660,"2D-5S-JC":660,"2H-5D-JC":660,"2S-5D-JC":660,"2H-5H-JC":660,"2H-5S-JC":660,"2S-5H-JC":660,"2S-5S-JC":660,"2D-5D-JH":660,"2D-5D-JS":660,"2D-5H-JD":660,"2D-5S-JD":660,"2D-5H-JH":660,"2D-5H-JS":660,"2D-5S-JH":660,"2D-5S-JS":660,"2H-5D-JD":660,"2S-5D-JD":660,"2H-5D-JH":660,"2H-5D-JS":660,"2S-5D-JH":660,"2S-5D-JS":660,"2H-5H-JD":660,"2H-5S-JD":660,"2S-5H-JD":660,"2S-5S-JD":660,"2H-5H-JS":660,"2H-5S-JH":660,"2H-5S-JS":660,"2S-5H-JH":660,"2S-5H-JS":660,"2S-5S-JH":660,"3C-4C-JD":661,"3C-4C-JH":661,"3C-4C-JS":661,"3C-4D-JC":661,"3C-4H-JC":661,"3C-4S-JC":661,"3C-4D-JD":661,"3C-4D-JH":661,"3C-4D-JS":661,"3C-4H-JD":661,"3C-4S-JD":661,"3C-4H-JH":661,"3C-4H-JS":661,"3C-4S-JH":661,"3C-4S-JS":661,"3D-4C-JC":661,"3H-4C-JC":661,"3S-4C-JC":661,"3D-4C-JD":661,"3D-4C-JH":661,"3D-4C-JS":661,"3H-4C-JD":661,"3S-4C-JD":661,"3H-4C-JH":661,"3H-4C-JS":661,"3S-4C-JH":661,"3S-4C-JS":661,"3D-4D-JC":661,"3D-4H-JC":661,"3D-4S-JC":661,"3H-4D-JC":661,"3S-4D-JC":661,"3H-4H-JC":661,"3H-4S-JC":661,"3S-4H-JC":661,"3S-4S-JC":661,"3D-4D-JH":661,"3D-4D-JS":661,"3D-4H-JD":661,"3D-4S-JD":661,"3D-4H-JH":661,"3D-4H-JS":661,"3D-4S-JH":661,"3D-4S-JS":661,"3H-4D-JD":661,"3S-4D-JD":661,"3H-4D-JH":661,"3H-4D-JS":661,"3S-4D-JH":661,"3S-4D-JS":661,"3H-4H-JD":661,"3H-4S-JD":661,"3S-4H-JD":661,"3S-4S-JD":661,"3H-4H-JS":661,"3H-4S-JH":661,"3H-4S-JS":661,"3S-4H-JH":661,"3S-4H-JS":661,"3S-4S-JH":661,"2C-4C-JD":662,"2C-4C-JH":662,"2C-4C-JS":662,"2C-4D-JC":662,"2C-4H-JC":662,"2C-4S-JC":662,"2C-4D-JD":662,"2C-4D-JH":662,"2C-4D-JS":662,"2C-4H-JD":662,"2C-4S-JD":662,"2C-4H-JH":662,"2C-4H-JS":662,"2C-4S-JH":662,"2C-4S-JS":662,"2D-4C-JC":662,"2H-4C-JC":662,"2S-4C-JC":662,"2D-4C-JD":662,"2D-4C-JH":662,"2D-4C-JS":662,"2H-4C-JD":662,"2S-4C-JD":662,"2H-4C-JH":662,"2H-4C-JS":662,"2S-4C-JH":662,"2S-4C-JS":662,"2D-4D-JC":662,"2D-4H-JC":662,"2D-4S-JC":662,"2H-4D-JC":662,"2S-4D-JC":662,"2H-4H-JC":662,"2H-4S-JC":662,"2S-4H-JC":662,"2S-4S-JC":662,"2D-4D-JH":662,"2D-4D-JS":662,"2D-4H-JD":662,"2D-4S-JD":662,"2D-4H-JH":662,"2D-4H-JS":662,"2D-4S-JH":662,"2D-4S-JS":662,"2H-4D-JD":662,"2S-4D-JD":662,"2H-4D-JH":662,"2H-4D-JS":662,"2S-4D-JH":662,"2S-4D-JS":662,"2H-4H-JD":662,"2H-4S-JD":662,"2S-4H-JD":662,"2S-4S-JD":662,"2H-4H-JS":662,"2H-4S-JH":662,"2H-4S-JS":662,"2S-4H-JH":662,"2S-4H-JS":662,"2S-4S-JH":662,"2C-3C-JD":663,"2C-3C-JH":663,"2C-3C-JS":663,"2C-3D-JC":663,"2C-3H-JC":663,"2C-3S-JC":663,"2C-3D-JD":663,"2C-3D-JH":663,"2C-3D-JS":663,"2C-3H-JD":663,"2C-3S-JD":663,"2C-3H-JH":663,"2C-3H-JS":663,"2C-3S-JH":663,"2C-3S-JS":663,"2D-3C-JC":663,"2H-3C-JC":663,"2S-3C-JC":663,"2D-3C-JD":663,"2D-3C-JH":663,"2D-3C-JS":663,"2H-3C-JD":663,"2S-3C-JD":663,"2H-3C-JH":663,"2H-3C-JS":663,"2S-3C-JH":663,"2S-3C-JS":663,"2D-3D-JC":663,"2D-3H-JC":663,"2D-3S-JC":663,"2H-3D-JC":663,"2S-3D-JC":663,"2H-3H-JC":663,"2H-3S-JC":663,"2S-3H-JC":663,"2S-3S-JC":663,"2D-3D-JH":663,"2D-3D-JS":663,"2D-3H-JD":663,"2D-3S-JD":663,"2D-3H-JH":663,"2D-3H-JS":663,"2D-3S-JH":663,"2D-3S-JS":663,"2H-3D-JD":663,"2S-3D-JD":663,"2H-3D-JH":663,"2H-3D-JS":663,"2S-3D-JH":663,"2S-3D-JS":663,"2H-3H-JD":663,"2H-3S-JD":663,"2S-3H-JD":663,"2S-3S-JD":663,"2H-3H-JS":663,"2H-3S-JH":663,"2H-3S-JS":663,"2S-3H-JH":663,"2S-3H-JS":663,"2S-3S-JH":663,"10D-7C-9C":664,"10H-7C-9C":664,"10S-7C-9C":664,"10C-7C-9D":664,"10C-7C-9H":664,"10C-7C-9S":664,"10D-7C-9D":664,"10H-7C-9D":664,"10S-7C-9D":664,"10D-7C-9H":664,"10D-7C-9S":664,"10H-7C-9H":664,"10S-7C-9H":664,"10H-7C-9S":664,"10S-7C-9S":664,"10C-7D-9C":664,"10C-7H-9C":664,"10C-7S-9C":664,"10D-7D-9C":664,"10H-7D-9C":664,"10S-7D-9C":664,"10D-7H-9C":664,"10D-7S-9C":664,"10H-7H-9C":664,"10S-7H-9C":664,"10H-7S-9C":664,"10S-7S-9C":664,"10C-7D-9D":664,"10C-7D-9H":664,"10C-7D-9S":664,"10C-7H-9D":664,"10C-7S-9D":664,"10C-7H-9H":664,"10C-7H-9S":664,"10C-7S-9H":664,"10C-7S-9S":664,"10H-7D-9D":664,"10S-7D-9D":664,"10D-7D-9H":664,"10D-7D-9S":664,"10H-7D-9H":664,"10S-7D-9H":664,"10H-7D-9S":664,"10S-7D-9S":664,"10D-7H-9D":664,"10D-7S-9D":664,"10H-7H-9D":664,"10S-7H-9D":664,"10H-7S-9D":664,"10S-7S-9D":664,"10D-7H-9H":664,"10D-7H-9S":664,"10D-7S-9H":664,"10D-7S-9S":664,"10S-7H-9H":664,"10H-7H-9S":664,"10S-7H-9S":664,"10H-7S-9H":664,"10S-7S-9H":664,"10H-7S-9S":664,"10D-6C-9C":665,"10H-6C-9C":665,"10S-6C-9C":665,"10C-6C-9D":665,"10C-6C-9H":665,"10C-6C-9S":665,"10D-6C-9D":665,"10H-6C-9D":665,"10S-6C-9D":665,"10D-6C-9H":665,"10D-6C-9S":665,"10H-6C-9H":665,"10S-6C-9H":665,"10H-6C-9S":665,"10S-6C-9S":665,"10C-6D-9C":665,"10C-6H-9C":665,"10C-6S-9C":665,"10D-6D-9C":665,"10H-6D-9C":665,"10S-6D-9C":665,"10D-6H-9C":665,"10D-6S-9C":665,"10H-6H-9C":665,"10S-6H-9C":665,"10H-6S-9C":665,"10S-6S-9C":665,"10C-6D-9D":665,"10C-6D-9H":665,"10C-6D-9S":665,"10C-6H-9D":665,"10C-6S-9D":665,"10C-6H-9H":665,"10C-6H-9S":665,"10C-6S-9H":665,"10C-6S-9S":665,"10H-6D-9D":665,"10S-6D-9D":665,"10D-6D-9H":665,"10D-6D-9S":665,"10H-6D-9H":665,"10S-6D-9H":665,"10H-6D-9S":665,"10S-6D-9S":665,"10D-6H-9D":665,"10D-6S-9D":665,"10H-6H-9D":665,"10S-6H-9D":665,"10H-6S-9D":665,"10S-6S-9D":665,"10D-6H-9H":665,"10D-6H-9S":665,"10D-6S-9H":665,"10D-6S-9S":665,"10S-6H-9H":665,"10H-6H-9S":665,"10S-6H-9S":665,"10H-6S-9H":665,"10S-6S-9H":665,"10H-6S-9S":665,"10D-5C-9C":666,"10H-5C-9C":666,"10S-5C-9C":666,"10C-5C-9D":666,"10C-5C-9H":666,"10C-5C-9S":666,"10D-5C-9D":666,"10H-5C-9D":666,"10S-5C-9D":666,"10D-5C-9H":666,"10D-5C-9S":666,"10H-5C-9H":666,"10S-5C-9H":666,"10H-5C-9S":666,"10S-5C-9S":666,"10C-5D-9C":666,"10C-5H-9C":666,"10C-5S-9C":666,"10D-5D-9C":666,"10H-5D-9C":666,"10S-5D-9C":666,"10D-5H-9C":666,"10D-5S-9C":666,"10H-5H-9C":666,"10S-5H-9C":666,"10H-5S-9C":666,"10S-5S-9C":666,"10C-5D-9D":666,"10C-5D-9H":666,"10C-5D-9S":666,"10C-5H-9D":666,"10C-5S-9D":666,"10C-5H-9H":666,"10C-5H-9S":666,"10C-5S-9H":666,"10C-5S-9S":666,"10H-5D-9D":666,"10S-5D-9D":666,"10D-5D-9H":666,"10D-5D-9S":666,"10H-5D-9H":666,"10S-5D-9H":666,"10H-5D-9S":666,"10S-5D-9S":666,"10D-5H-9D":666,"10D-5S-9D":666,"10H-5H-9D":666,"10S-5H-9D":666,"10H-5S-9D":666,"10S-5S-9D":666,"10D-5H-9H":666,"10D-5H-9S":666,"10D-5S-9H":666,"10D-5S-9S":666,"10S-5H-9H":666,"10H-5H-9S":666,"10S-5H-9S":666,"10H-5S-9H":666,"10S-5S-9H":666,"10H-5S-9S":666,"10D-4C-9C":667,"10H-4C-9C":667,"10S-4C-9C":667,"10C-4C-9D":667,"10C-4C-9H":667,"10C-4C-9S":667,"10D-4C-9D":667,"10H-4C-9D":667,"10S-4C-9D":667,"10D-4C-9H":667,"10D-4C-9S":667,"10H-4C-9H":667,"10S-4C-9H":667,"10H-4C-9S":667,"10S-4C-9S":667,"10C-4D-9C":667,"10C-4H-9C":667,"10C-4S-9C":667,"10D-4D-9C":667,"10H-4D-9C":667,"10S-4D-9C":667,"10D-4H-9C":667,"10D-4S-9C":667,"10H-4H-9C":667,"10S-4H-9C":667,"10H-4S-9C":667,"10S-4S-9C":667,"10C-4D-9D":667,"10C-4D-9H":667,"10C-4D-9S":667,"10C-4H-9D":667,"10C-4S-9D":667,"10C-4H-9H":667,"10C-4H-9S":667,"10C-4S-9H":667,"10C-4S-9S":667,"10H-4D-9D":667,"10S-4D-9D":667,"10D-4D-9H":667,"10D-4D-9S":667,"10H-4D-9H":667,"10S-4D-9H":667,"10H-4D-9S":667,"10S-4D-9S":667,"10D-4H-9D":667,"10D-4S-9D":667,"10H-4H-9D":667,"10S-4H-9D":667,"10H-4S-9D":667,"10S-4S-9D":667,"10D-4H-9H":667,"10D-4H-9S":667,"10D-4S-9H":667,"10D-4S-9S":667,"10S-4H-9H":667,"10H-4H-9S":667,"10S-4H-9S":667,"10H-4S-9H":667,"10S-4S-9H":667,"10H-4S-9S":667,"10D-3C-9C":668,"10H-3C-9C":668,"10S-3C-9C":668,"10C-3C-9D":668,"10C-3C-9H":668,"10C-3C-9S":668,"10D-3C-9D":668,"10H-3C-9D":668,"10S-3C-9D":668,"10D-3C-9H":668,"10D-3C-9S":668,"10H-3C-9H":668,"10S-3C-9H":668,"10H-3C-9S":668,"10S-3C-9S":668,"10C-3D-9C":668,"10C-3H-9C":668,"10C-3S-9C":668,"10D-3D-9C":668,"10H-3D-9C":668,"10S-3D-9C":668,"10D-3H-9C":668,"10D-3S-9C":668,"10H-3H-9C":668,"10S-3H-9C":668,"10H-3S-9C":668,"10S-3S-9C":668,"10C-3D-9D":668,"10C-3D-9H":668,"10C-3D-9S":668,"10C-3H-9D":668,"10C-3S-9D":668,"10C-3H-9H":668,"10C-3H-9S":668,"10C-3S-9H":668,"10C-3S-9S":668,"10H-3D-9D":668,"10S-3D-9D":668,"10D-3D-9H":668,"10D-3D-9S":668,"10H-3D-9H":668,"10S-3D-9H":668,"10H-3D-9S":668,"10S-3D-9S":668,"10D-3H-9D":668,"10D-3S-9D":668,"10H-3H-9D":668,"10S-3H-9D":668,"10H-3S-9D":668,"10S-3S-9D":668,"10D-3H-9H":668,"10D-3H-9S":668,"10D-3S-9H":668,"10D-3S-9S":668,"10S-3H-9H":668,"10H-3H-9S":668,"10S-3H-9S":668,"10H-3S-9H":668,"10S-3S-9H":668,"10H-3S-9S":668,"10D-2C-9C":669,"10H-2C-9C":669,"10S-2C-9C":669,"10C-2C-9D":669,"10C-2C-9H":669,"10C-2C-9S":669,"10D-2C-9D":669,"10H-2C-9D":669,"10S-2C-9D":669,"10D-2C-9H":669,"10D-2C-9S":669,"10H-2C-9H":669,"10S-2C-9H":669,"10H-2C-9S":669,"10S-2C-9S":669,"10C-2D-9C":669,"10C-2H-9C":669,"10C-2S-9C":669,"10D-2D-9C":669,"10H-2D-9C":669,"10S-2D-9C":669,"10D-2H-9C":669,"10D-2S-9C":669,"10H-2H-9C":669,"10S-2H-9C":669,"10H-2S-9C":669,"10S-2S-9C":669,"10C-2D-9D":669,"10C-2D-9H":669,"10C-2D-9S":669,"10C-2H-9D":669,"10C-2S-9D":669,"10C-2H-9H":669,"10C-2H-9S":669,"10C-2S-9H":669,"10C-2S-9S":669,"10H-2D-9D":669,"10S-2D-9D":669,"10D-2D-9H":669,"10D-2D-9S":669,"10H-2D-9H":669,"10S-2D-9H":669,"10H-2D-9S":669,"10S-2D-9S":669,"10D-2H-9D":669,"10D-2S-9D":669,"10H-2H-9D":669,"10S-2H-9D":669,"10H-2S-9D":669,"10S-2S-9D":669,"10D-2H-9H":669,"10D-2H-9S":669,"10D-2S-9H":669,"10D-2S-9S":669,"10S-2H-9H":669,"10H-2H-9S":669,"10S-2H-9S":669,"10H-2S-9H":669,"10S-2S-9H":669,"10H-2S-9S":669,"10D-7C-8C":670,"10H-7C-8C":670,"10S-7C-8C":670,"10C-7C-8D":670,"10C-7C-8H":670,"10C-7C-8S":670,"10D-7C-8D":670,"10H-7C-8D":670,"10S-7C-8D":670,"10D-7C-8H":670,"10D-7C-8S":670,"10H-7C-8H":670,"10S-7C-8H":670,"10H-7C-8S":670,"10S-7C-8S":670,"10C-7D-8C":670,"10C-7H-8C":670,"10C-7S-8C":670,"10D-7D-8C":670,"10H-7D-8C":670,"10S-7D-8C":670,"10D-7H-8C":670,"10D-7S-8C":670,"10H-7H-8C":670,"10S-7H-8C":670,"10H-7S-8C":670,"10S-7S-8C":670,"10C-7D-8D":670,"10C-7D-8H":670,"10C-7D-8S":670,"10C-7H-8D":670,"10C-7S-8D":670,"10C-7H-8H":670,"10C-7H-8S":670,"10C-7S-8H":670,"10C-7S-8S":670,"10H-7D-8D":670,"10S-7D-8D":670,"10D-7D-8H":670,"10D-7D-8S":670,"10H-7D-8H":670,"10S-7D-8H":670,"10H-7D-8S":670,"10S-7D-8S":670,"10D-7H-8D":670,"10D-7S-8D":670,"10H-7H-8D":670,"10S-7H-8D":670,"10H-7S-8D":670,"10S-7S-8D":670,"10D-7H-8H":670,"10D-7H-8S":670,"10D-7S-8H":670,"10D-7S-8S":670,"10S-7H-8H":670,"10H-7H-8S":670,"10S-7H-8S":670,"10H-7S-8H":670,"10S-7S-8H":670,"10H-7S-8S":670,"10D-6C-8C":671,"10H-6C-8C":671,"10S-6C-8C":671,"10C-6C-8D":671,"10C-6C-8H":671,"10C-6C-8S":671,"10D-6C-8D":671,"10H-6C-8D":671,"10S-6C-8D":671,"10D-6C-8H":671,"10D-6C-8S":671,"10H-6C-8H":671,"10S-6C-8H":671,"10H-6C-8S":671,"10S-6C-8S":671,"10C-6D-8C":671,"10C-6H-8C":671,"10C-6S-8C":671,"10D-6D-8C":671,"10H-6D-8C":671,"10S-6D-8C":671,"10D-6H-8C":671,"10D-6S-8C":671,"10H-6H-8C":671,"10S-6H-8C":671,"10H-6S-8C":671,"10S-6S-8C":671,"10C-6D-8D":671,"10C-6D-8H":671,"10C-6D-8S":671,"10C-6H-8D":671,"10C-6S-8D":671,"10C-6H-8H":671,"10C-6H-8S":671,"10C-6S-8H":671,"10C-6S-8S":671,"10H-6D-8D":671,"10S-6D-8D":671,"10D-6D-8H":671,"10D-6D-8S":671,"10H-6D-8H":671,"10S-6D-8H":671,"10H-6D-8S":671,"10S-6D-8S":671,"10D-6H-8D":671,"10D-6S-8D":671,"10H-6H-8D":671,"10S-6H-8D":671,"10H-6S-8D":671,"10S-6S-8D":671,"10D-6H-8H":671,"10D-6H-8S":671,"10D-6S-8H":671,"10D-6S-8S":671,"10S-6H-8H":671,"10H-6H-8S":671,"10S-6H-8S":671,"10H-6S-8H":671,"10S-6S-8H":671,"10H-6S-8S":671,"10D-5C-8C":672,"10H-5C-8C":672,"10S-5C-8C":672,"10C-5C-8D":672,"10C-5C-8H":672,"10C-5C-8S":672,"10D-5C-8D":672,"10H-5C-8D":672,"10S-5C-8D":672,"10D-5C-8H":672,"10D-5C-8S":672,"10H-5C-8H":672,"10S-5C-8H":672,"10H-5C-8S":672,"10S-5C-8S":672,"10C-5D-8C":672,"10C-5H-8C":672,"10C-5S-8C":672,"10D-5D-8C":672,"10H-5D-8C":672,"10S-5D-8C":672,"10D-5H-8C":672,"10D-5S-8C":672,"10H-5H-8C":672,"10S-5H-8C":672,"10H-5S-8C":672,"10S-5S-8C":672,"10C-5D-8D":672,"10C-5D-8H":672,"10C-5D-8S":672,"10C-5H-8D":672,"10C-5S-8D":672,"10C-5H-8H":672,"10C-5H-8S":672,"10C-5S-8H":672,"10C-5S-8S":672,"10H-5D-8D":672,"10S-5D-8D":672,"10D-5D-8H":672,"10D-5D-8S":672,"10H-5D-8H":672,"10S-5D-8H":672,"10H-5D-8S":672,"10S-5D-8S":672,"10D-5H-8D":672,"10D-5S-8D":672,"10H-5H-8D":672,"10S-5H-8D":672,"10H-5S-8D":672,"10S-5S-8D":672,"10D-5H-8H":672,"10D-5H-8S":672,"10D-5S-8H":672,"10D-5S-8S":672,"10S-5H-8H":672,"10H-5H-8S":672,"10S-5H-8S":672,"10H-5S-8H":672,"10S-5S-8H":672,"10H-5S-8S":672,"10D-4C-8C":673,"10H-4C-8C":673,"10S-4C-8C":673,"10C-4C-8D":673,"10C-4C-8H":673,"10C-4C-8S":673,"10D-4C-8D":673,"10H-4C-8D":673,"10S-4C-8D":673,"10D-4C-8H":673,"10D-4C-8S":673,"10H-4C-8H":673,"10S-4C-8H":673,"10H-4C-8S":673,"10S-4C-8S":673,"10C-4D-8C":673,"10C-4H-8C":673,"10C-4S-8C":673,"10D-4D-8C":673,"10H-4D-8C":673,"10S-4D-8C":673,"10D-4H-8C":673,"10D-4S-8C":673,"10H-4H-8C":673,"10S-4H-8C":673,"10H-4S-8C":673,"10S-4S-8C":673,"10C-4D-8D":673,"10C-4D-8H":673,"10C-4D-8S":673,"10C-4H-8D":673,"10C-4S-8D":673,"10C-4H-8H":673,"10C-4H-8S":673,"10C-4S-8H":673,"10C-4S-8S":673,"10H-4D-8D":673,"10S-4D-8D":673,"10D-4D-8H":673,"10D-4D-8S":673,"10H-4D-8H":673,"10S-4D-8H":673,"10H-4D-8S":673,"10S-4D-8S":673,"10D-4H-8D":673,"10D-4S-8D":673,"10H-4H-8D":673,"10S-4H-8D":673,"10H-4S-8D":673,"10S-4S-8D":673,"10D-4H-8H":673,"10D-4H-8S":673,"10D-4S-8H":673,"10D-4S-8S":673,"10S-4H-8H":673,"10H-4H-8S":673,"10S-4H-8S":673,"10H-4S-8H":673,"10S-4S-8H":673,"10H-4S-8S":673,"10D-3C-8C":674,"10H-3C-8C":674,"10S-3C-8C":674,"10C-3C-8D":674,"10C-3C-8H":674,"10C-3C-8S":674,"10D-3C-8D":674,"10H-3C-8D":674,"10S-3C-8D":674,"10D-3C-8H":674,"10D-3C-8S":674,"10H-3C-8H":674,"10S-3C-8H":674,"10H-3C-8S":674,"10S-3C-8S":674,"10C-3D-8C":674,"10C-3H-8C":674,"10C-3S-8C":674,"10D-3D-8C":674,"10H-3D-8C":674,"10S-3D-8C":674,"10D-3H-8C":674,"10D-3S-8C":674,"10H-3H-8C":674,"10S-3H-8C":674,"10H-3S-8C":674,"10S-3S-8C":674,"10C-3D-8D":674,"10C-3D-8H":674,"10C-3D-8S":674,"10C-3H-8D":674,"10C-3S-8D":674,"10C-3H-8H":674,"10C-3H-8S":674,"10C-3S-8H":674,"10C-3S-8S":674,"10H-3D-8D":674,"10S-3D-8D":674,"10D-3D-8H":674,"10D-3D-8S":674,"10H-3D-8H":674,"10S-3D-8H":674,"10H-3D-8S":674,"10S-3D-8S":674,"10D-3H-8D":674,"10D-3S-8D":674,"10H-3H-8D":674,"10S-3H-8D":674,"10H-3S-8D":674,"10S-3S-8D":674,"10D-3H-8H":674,"10D-3H-8S":674,"10D-3S-8H":674,"10D-3S-8S":674,"10S-3H-8H":674,"10H-3H-8S":674,"10S-3H-8S":674,"10H-3S-8H":674,"10S-3S-8H":674,"10H-3S-8S":674,"10D-2C-8C":675,"10H-2C-8C":675,"10S-2C-8C":675,"10C-2C-8D":675,"10C-2C-8H":675,"10C-2C-8S":675,"10D-2C-8D":675,"10H-2C-8D":675,"10S-2C-8D":675,"10D-2C-8H":675,"10D-2C-8S":675,"10H-2C-8H":675,"10S-2C-8H":675,"10H-2C-8S":675,"10S-2C-8S":675,"10C-2D-8C":675,"10C-2H-8C":675,"10C-2S-8C":675,"10D-2D-8C":675,"10H-2D-8C":675,"10S-2D-8C":675,"10D-2H-8C":675,"10D-2S-8C":675,"10H-2H-8C":675,"10S-2H-8C":675,"10H-2S-8C":675,"10S-2S-8C":675,"10C-2D-8D":675,"10C-2D-8H":675,"10C-2D-8S":675,"10C-2H-8D":675,"10C-2S-8D":675,"10C-2H-8H":675,"10C-2H-8S":675,"10C-2S-8H":675,"10C-2S-8S":675,"10H-2D-8D":675,"10S-2D-8D":675,"10D-2D-8H":675,"10D-2D-8S":675,"10H-2D-8H":675,"10S-2D-8H":675,"10H-2D-8S":675,"10S-2D-8S":675,"10D-2H-8D":675,"10D-2S-8D":675,"10H-2H-8D":675,"10S-2H-8D":675,"10H-2S-8D":675,"10S-2S-8D":675,"10D-2H-8H":675,"10D-2H-8S":675,"10D-2S-8H":675,"10D-2S-8S":675,"10S-2H-8H":675,"10H-2H-8S":675,"10S-2H-8S":675,"10H-2S-8H":675,"10S-2S-8H":675,"10H-2S-8S":675,"10D-6C-7C":676,"10H-6C-7C":676,"10S-6C-7C":676,"10C-6C-7D":676,"10C-6C-7H":676,"10C-6C-7S":676,"10D-6C-7D":676,"10H-6C-7D":676,"10S-6C-7D":676,"10D-6C-7H":676,"10D-6C-7S":676,"10H-6C-7H":676,"10S-6C-7H":676,"10H-6C-7S":676,"10S-6C-7S":676,"10C-6D-7C":676,"10C-6H-7C":676,"10C-6S-7C":676,"10D-6D-7C":676,"10H-6D-7C":676,"10S-6D-7C":676,"10D-6H-7C":676,"10D-6S-7C":676,"10H-6H-7C":676,"10S-6H-7C":676,"10H-6S-7C":676,"10S-6S-7C":676,"10C-6D-7D":676,"10C-6D-7H":676,"10C-6D-7S":676,"10C-6H-7D":676,"10C-6S-7D":676,"10C-6H-7H":676,"10C-6H-7S":676,"10C-6S-7H":676,"10C-6S-7S":676,"10H-6D-7D":676,"10S-6D-7D":676,"10D-6D-7H":676,"10D-6D-7S":676,"10H-6D-7H":676,"10S-6D-7H":676,"10H-6D-7S":676,"10S-6D-7S":676,"10D-6H-7D":676,"10D-6S-7D":676,"10H-6H-7D":676,"10S-6H-7D":676,"10H-6S-7D":676,"10S-6S-7D":676,"10D-6H-7H":676,"10D-6H-7S":676,"10D-6S-7H":676,"10D-6S-7S":676,"10S-6H-7H":676,"10H-6H-7S":676,"10S-6H-7S":676,"10H-6S-7H":676,"10S-6S-7H":676,"10H-6S-7S":676,"10D-5C-7C":677,"10H-5C-7C":677,"10S-5C-7C":677,"10C-5C-7D":677,"10C-5C-7H":677,"10C-5C-7S":677,"10D-5C-7D":677,"10H-5C-7D":677,"10S-5C-7D":677,"10D-5C-7H":677,"10D-5C-7S":677,"10H-5C-7H":677,"10S-5C-7H":677,"10H-5C-7S":677,"10S-5C-7S":677,"10C-5D-7C":677,"10C-5H-7C":677,"10C-5S-7C":677,"10D-5D-7C":677,"10H-5D-7C":677,"10S-5D-7C":677,"10D-5H-7C":677,"10D-5S-7C":677,"10H-5H-7C":677,"10S-5H-7C":677,"10H-5S-7C":677,"10S-5S-7C":677,"10C-5D-7D":677,"10C-5D-7H":677,"10C-5D-7S":677,"10C-5H-7D":677,"10C-5S-7D":677,"10C-5H-7H":677,"10C-5H-7S":677,"10C-5S-7H":677,"10C-5S-7S":677,"10H-5D-7D":677,"10S-5D-7D":677,"10D-5D-7H":677,"10D-5D-7S":677,"10H-5D-7H":677,"10S-5D-7H":677,"10H-5D-7S":677,"10S-5D-7S":677,"10D-5H-7D":677,"10D-5S-7D":677,"10H-5H-7D":677,"10S-5H-7D":677,"10H-5S-7D":677,"10S-5S-7D":677,"10D-5H-7H":677,"10D-5H-7S":677,"10D-5S-7H":677,"10D-5S-7S":677,"10S-5H-7H":677,"10H-5H-7S":677,"10S-5H-7S":677,"10H-5S-7H":677,"10S-5S-7H":677,"10H-5S-7S":677,"10D-4C-7C":678,"10H-4C-7C":678,"10S-4C-7C":678,"10C-4C-7D":678,"10C-4C-7H":678,"10C-4C-7S":678,"10D-4C-7D":678,"10H-4C-7D":678,"10S-4C-7D":678,"10D-4C-7H":678,"10D-4C-7S":678,"10H-4C-7H":678,"10S-4C-7H":678,"10H-4C-7S":678,"10S-4C-7S":678,"10C-4D-7C":678,"10C-4H-7C":678,"10C-4S-7C":678,"10D-4D-7C":678,"10H-4D-7C":678,"10S-4D-7C":678,"10D-4H-7C":678,"10D-4S-7C":678,"10H-4H-7C":678,"10S-4H-7C":678,"10H-4S-7C":678,"10S-4S-7C":678,"10C-4D-7D":678,"10C-4D-7H":678,"10C-4D-7S":678,"10C-4H-7D":678,"10C-4S-7D":678,"10C-4H-7H":678,"10C-4H-7S":678,"10C-4S-7H":678,"10C-4S-7S":678,"10H-4D-7D":678,"10S-4D-7D":678,"10D-4D-7H":678,"10D-4D-7S":678,"10H-4D-7H":678,"10S-4D-7H":678,"10H-4D-7S":678,"10S-4D-7S":678,"10D-4H-7D":678,"10D-4S-7D":678,"10H-4H-7D":678,"10S-4H-7D":678,"10H-4S-7D":678,"10S-4S-7D":678,"10D-4H-7H":678,"10D-4H-7S":678,"10D-4S-7H":678,"10D-4S-7S":678,"10S-4H-7H":678,"10H-4H-7S":678,"10S-4H-7S":678,"10H-4S-7H":678,"10S-4S-7H":678,"10H-4S-7S":678,"10D-3C-7C":679,"10H-3C-7C":679,"10S-3C-7C":679,"10C-3C-7D":679,"10C-3C-7H":679,"10C-3C-7S":679,"10D-3C-7D":679,"10H-3C-7D":679,"10S-3C-7D":679,"10D-3C-7H":679,"10D-3C-7S":679,"10H-3C-7H":679,"10S-3C-7H":679,"10H-3C-7S":679,"10S-3C-7S":679,"10C-3D-7C":679,"10C-3H-7C":679,"10C-3S-7C":679,"10D-3D-7C":679,"10H-3D-7C":679,"10S-3D-7C":679,"10D-3H-7C":679,"10D-3S-7C":679,"10H-3H-7C":679,"10S-3H-7C":679,"10H-3S-7C":679,"10S-3S-7C":679,"10C-3D-7D":679,"10C-3D-7H":679,"10C-3D-7S":679,"10C-3H-7D":679,"10C-3S-7D":679,"10C-3H-7H":679,"10C-3H-7S":679,"10C-3S-7H":679,"10C-3S-7S":679,"10H-3D-7D":679,"10S-3D-7D":679,"10D-3D-7H":679,"10D-3D-7S":679,"10H-3D-7H":679,"10S-3D-7H":679,"10H-3D-7S":679,"10S-3D-7S":679,"10D-3H-7D":679,"10D-3S-7D":679,"10H-3H-7D":679,"10S-3H-7D":679,"10H-3S-7D":679,"10S-3S-7D":679,"10D-3H-7H":679,"10D-3H-7S":679,"10D-3S-7H":679,"10D-3S-7S":679,"10S-3H-7H":679,"10H-3H-7S":679,"10S-3H-7S":679,"10H-3S-7H":679,"10S-3S-7H":679,"10H-3S-7S":679,"10D-2C-7C":680,"10H-2C-7C":680,"10S-2C-7C":680,"10C-2C-7D":680,"10C-2C-7H":680,"10C-2C-7S":680,"10D-2C-7D":680,"10H-2C-7D":680,"10S-2C-7D":680,"10D-2C-7H":680,"10D-2C-7S":680,"10H-2C-7H":680,"10S-2C-7H":680,"10H-2C-7S":680,"10S-2C-7S":680,"10C-2D-7C":680,"10C-2H-7C":680,"10C-2S-7C":680,"10D-2D-7C":680,"10H-2D-7C":680,"10S-2D-7C":680,"10D-2H-7C":680,"10D-2S-7C":680,"10H-2H-7C":680,"10S-2H-7C":680,"10H-2S-7C":680,"10S-2S-7C":680,"10C-2D-7D":680,"10C-2D-7H":680,"10C-2D-7S":680,"10C-2H-7D":680,"10C-2S-7D":680,"10C-2H-7H":680,"10C-2H-7S":680,"10C-2S-7H":680,"10C-2S-7S":680,"10H-2D-7D":680,"10S-2D-7D":680,"10D-2D-7H":680,"10D-2D-7S":680,"10H-2D-7H":680,"10S-2D-7H":680,"10H-2D-7S":680,"10S-2D-7S":680,"10D-2H-7D":680,"10D-2S-7D":680,"10H-2H-7D":680,"10S-2H-7D":680,"10H-2S-7D":680,"10S-2S-7D":680,"10D-2H-7H":680,"10D-2H-7S":680,"10D-2S-7H":680,"10D-2S-7S":680,"10S-2H-7H":680,"10H-2H-7S":680,"10S-2H-7S":680,"10H-2S-7H":680,"10S-2S-7H":680,"10H-2S-7S":680,"10D-5C-6C":681,"10H-5C-6C":681,"10S-5C-6C":681,"10C-5C-6D":681,"10C-5C-6H":681,"10C-5C-6S":681,"10D-5C-6D":681,"10H-5C-6D":681,"10S-5C-6D":681,"10D-5C-6H":681,"10D-5C-6S":681,"10H-5C-6H":681,"10S-5C-6H":681,"10H-5C-6S":681,"10S-5C-6S":681,"10C-5D-6C":681,"10C-5H-6C":681,"10C-5S-6C":681,"10D-5D-6C":681,"10H-5D-6C":681,"10S-5D-6C":681,"10D-5H-6C":681,"10D-5S-6C":681,"10H-5H-6C":681,"10S-5H-6C":681,"10H-5S-6C":681,"10S-5S-6C":681,"10C-5D-6D":681,"10C-5D-6H":681,"10C-5D-6S":681,"10C-5H-6D":681,"10C-5S-6D":681,"10C-5H-6H":681,"10C-5H-6S":681,"10C-5S-6H":681,"10C-5S-6S":681,"10H-5D-6D":681,"10S-5D-6D":681,"10D-5D-6H":681,"10D-5D-6S":681,"10H-5D-6H":681,"10S-5D-6H":681,"10H-5D-6S":681,"10S-5D-6S":681,"10D-5H-6D":681,"10D-5S-6D":681,"10H-5H-6D":681,"10S-5H-6D":681,"10H-5S-6D":681,"10S-5S-6D":681,"10D-5H-6H":681,"10D-5H-6S":681,"10D-5S-6H":681,"10D-5S-6S":681,"10S-5H-6H":681,"10H-5H-6S":681,"10S-5H-6S":681,"10H-5S-6H":681,"10S-5S-6H":681,"10H-5S-6S":681,"10D-4C-6C":682,"10H-4C-6C":682,"10S-4C-6C":682,"10C-4C-6D":682,"10C-4C-6H":682,"10C-4C-6S":682,"10D-4C-6D":682,"10H-4C-6D":682,"10S-4C-6D":682,"10D-4C-6H":682,"10D-4C-6S":682,"10H-4C-6H":682,"10S-4C-6H":682,"10H-4C-6S":682,"10S-4C-6S":682,"10C-4D-6C":682,"10C-4H-6C":682,"10C-4S-6C":682,"10D-4D-6C":682,"10H-4D-6C":682,"10S-4D-6C":682,"10D-4H-6C":682,"10D-4S-6C":682,"10H-4H-6C":682,"10S-4H-6C":682,"10H-4S-6C":682,"10S-4S-6C":682,"10C-4D-6D":682,"10C-4D-6H":682,"10C-4D-6S":682,"10C-4H-6D":682,"10C-4S-6D":682,"10C-4H-6H":682,"10C-4H-6S":682,"10C-4S-6H":682,"10C-4S-6S":682,"10H-4D-6D":682,"10S-4D-6D":682,"10D-4D-6H":682,"10D-4D-6S":682,"10H-4D-6H":682,"10S-4D-6H":682,"10H-4D-6S":682,"10S-4D-6S":682,"10D-4H-6D":682,"10D-4S-6D":682,"10H-4H-6D":682,"10S-4H-6D":682,"10H-4S-6D":682,"10S-4S-6D":682,"10D-4H-6H":682,"10D-4H-6S":682,"10D-4S-6H":682,"10D-4S-6S":682,"10S-4H-6H":682,"10H-4H-6S":682,"10S-4H-6S":682,"10H-4S-6H":682,"10S-4S-6H":682,"10H-4S-6S":682,"10D-3C-6C":683,"10H-3C-6C":683,"10S-3C-6C":683,"10C-3C-6D":683,"10C-3C-6H":683,"10C-3C-6S":683,"10D-3C-6D":683,"10H-3C-6D":683,"10S-3C-6D":683,"10D-3C-6H":683,"10D-3C-6S":683,"10H-3C-6H":683,"10S-3C-6H":683,"10H-3C-6S":683,"10S-3C-6S":683,"10C-3D-6C":683,"10C-3H-6C":683,"10C-3S-6C":683,"10D-3D-6C":683,"10H-3D-6C":683,"10S-3D-6C":683,"10D-3H-6C":683,"10D-3S-6C":683,"10H-3H-6C":683,"10S-3H-6C":683,"10H-3S-6C":683,"10S-3S-6C":683,"10C-3D-6D":683,"10C-3D-6H":683,"10C-3D-6S":683,"10C-3H-6D":683,"10C-3S-6D":683,"10C-3H-6H":683,"10C-3H-6S":683,"10C-3S-6H":683,"10C-3S-6S":683,"10H-3D-6D":683,"10S-3D-6D":683,"10D-3D-6H":683,"10D-3D-6S":683,"10H-3D-6H":683,"10S-3D-6H":683,"10H-3D-6S":683,"10S-3D-6S":683,"10D-3H-6D":683,"10D-3S-6D":683,"10H-3H-6D":683,"10S-3H-6D":683,"10H-3S-6D":683,"10S-3S-6D":683,"10D-3H-6H":683,"10D-3H-6S":683,"10D-3S-6H":683,"10D-3S-6S":683,"10S-3H-6H":683,"10H-3H-6S":683,"10S-3H-6S":683,"10H-3S-6H":683,"10S-3S-6H":683,"10H-3S-6S":683,"10D-2C-6C":684,"10H-2C-6C":684,"10S-2C-6C":684,"10C-2C-6D":684,"10C-2C-6H":684,"10C-2C-6S":684,"10D-2C-6D":684,"10H-2C-6D":684,"10S-2C-6D":684,"10D-2C-6H":684,"10D-2C-6S":684,"10H-2C-6H":684,"10S-2C-6H":684,"10H-2C-6S":684,"10S-2C-6S":684,"10C-2D-6C":684,"10C-2H-6C":684,"10C-2S-6C":684,"10D-2D-6C":684,"10H-2D-6C":684,"10S-2D-6C":684,"10D-2H-6C":684,"10D-2S-6C":684,"10H-2H-6C":684,"10S-2H-6C":684,"10H-2S-6C":684,"10S-2S-6C":684,"10C-2D-6D":684,"10C-2D-6H":684,"10C-2D-6S":684,"10C-2H-6D":684,"10C-2S-6D":684,"10C-2H-6H":684,"10C-2H-6S":684,"10C-2S-6H":684,"10C-2S-6S":684,"10H-2D-6D":684,"10S-2D-6D":684,"10D-2D-6H":684,"10D-2D-6S":684,"10H-2D-6H":684,"10S-2D-6H":684,"10H-2D-6S":684,"10S-2D-6S":684,"10D-2H-6D":684,"10D-2S-6D":684,"10H-2H-6D":684,"10S-2H-6D":684,"10H-2S-6D":684,"10S-2S-6D":684,"10D-2H-6H":684,"10D-2H-6S":684,"10D-2S-6H":684,"10D-2S-6S":684,"10S-2H-6H":684,"10H-2H-6S":684,"10S-2H-6S":684,"10H-2S-6H":684,"10S-2S-6H":684,"10H-2S-6S":684,"10D-4C-5C":685,"10H-4C-5C":685,"10S-4C-5C":685,"10C-4C-5D":685,"10C-4C-5H":685,"10C-4C-5S":685,"10D-4C-5D":685,"10H-4C-5D":685,"10S-4C-5D":685,"10D-4C-5H":685,"10D-4C-5S":685,"10H-4C-5H":685,"10S-4C-5H":685,"10H-4C-5S":685,"10S-4C-5S":685,"10C-4D-5C":685,"10C-4H-5C":685,"10C-4S-5C":685,"10D-4D-5C":685,"10H-4D-5C":685,"10S-4D-5C":685,"10D-4H-5C":685,"10D-4S-5C":685,"10H-4H-5C":685,"10S-4H-5C":685,"10H-4S-5C":685,"10S-4S-5C":685,"10C-4D-5D":685,"10C-4D-5H":685,"10C-4D-5S":685,"10C-4H-5D":685,"10C-4S-5D":685,"10C-4H-5H":685,"10C-4H-5S":685,"10C-4S-5H":685,"10C-4S-5S":685,"10H-4D-5D":685,"10S-4D-5D":685,"10D-4D-5H":685,"10D-4D-5S":685,"10H-4D-5H":685,"10S-4D-5H":685,"10H-4D-5S":685,"10S-4D-5S":685,"10D-4H-5D":685,"10D-4S-5D":685,"10H-4H-5D":685,"10S-4H-5D":685,"10H-4S-5D":685,"10S-4S-5D":685,"10D-4H-5H":685,"10D-4H-5S":685,"10D-4S-5H":685,"10D-4S-5S":685,"10S-4H-5H":685,"10H-4H-5S":685,"10S-4H-5S":685,"10H-4S-5H":685,"10S-4S-5H":685,"10H-4S-5S":685,"10D-3C-5C":686,"10H-3C-5C":686,"10S-3C-5C":686,"10C-3C-5D":686,"10C-3C-5H":686,"10C-3C-5S":686,"10D-3C-5D":686,"10H-3C-5D":686,"10S-3C-5D":686,"10D-3C-5H":686,"10D-3C-5S":686,"10H-3C-5H":686,"10S-3C-5H":686,"10H-3C-5S":686,"10S-3C-5S":686,"10C-3D-5C":686,"10C-3H-5C":686,"10C-3S-5C":686,"10D-3D-5C":686,"10H-3D-5C":686,"10S-3D-5C":686,"10D-3H-5C":686,"10D-3S-5C":686,"10H-3H-5C":686,"10S-3H-5C":686,"10H-3S-5C":686,"10S-3S-5C":686,"10C-3D-5D":686,"10C-3D-5H":686,"10C-3D-5S":686,"10C-3H-5D":686,"10C-3S-5D":686,"10C-3H-5H":686,"10C-3H-5S":686,"10C-3S-5H":686,"10C-3S-5S":686,"10H-3D-5D":686,"10S-3D-5D":686,"10D-3D-5H":686,"10D-3D-5S":686,"10H-3D-5H":686,"10S-3D-5H":686,"10H-3D-5S":686,"10S-3D-5S":686,"10D-3H-5D":686,"10D-3S-5D":686,"10H-3H-5D":686,"10S-3H-5D":686,"10H-3S-5D":686,"10S-3S-5D":686,"10D-3H-5H":686,"10D-3H-5S":686,"10D-3S-5H":686,"10D-3S-5S":686,"10S-3H-5H":686,"10H-3H-5S":686,"10S-3H-5S":686,"10H-3S-5H":686,"10S-3S-5H":686,"10H-3S-5S":686,"10D-2C-5C":687,"10H-2C-5C":687,"10S-2C-5C":687,"10C-2C-5D":687,"10C-2C-5H":687,"10C-2C-5S":687,"10D-2C-5D":687,"10H-2C-5D":687,"10S-2C-5D":687,"10D-2C-5H":687,"10D-2C-5S":687,"10H-2C-5H":687,"10S-2C-5H":687,"10H-2C-5S":687,"10S-2C-5S":687,"10C-2D-5C":687,"10C-2H-5C":687,"10C-2S-5C":687,"10D-2D-5C":687,"10H-2D-5C":687,"10S-2D-5C":687,"10D-2H-5C":687,"10D-2S-5C":687,"10H-2H-5C":687,"10S-2H-5C":687,"10H-2S-5C":687,"10S-2S-5C":687,"10C-2D-5D":687,"10C-2D-5H":687,"10C-2D-5S":687,"10C-2H-5D":687,"10C-2S-5D":687,"10C-2H-5H":687,"10C-2H-5S":687,"10C-2S-5H":687,"10C-2S-5S":687,"10H-2D-5D":687,"10S-2D-5D":687,"10D-2D-5H":687,"10D-2D-5S":687,"10H-2D-5H":687,"10S-2D-5H":687,"10H-2D-5S":687,"10S-2D-5S":687,"10D-2H-5D":687,"10D-2S-5D":687,"10H-2H-5D":687,"10S-2H-5D":687,"10H-2S-5D":687,"10S-2S-5D":687,"10D-2H-5H":687,"10D-2H-5S":687,"10D-2S-5H":687,"10D-2S-5S":687,"10S-2H-5H":687,"10H-2H-5S":687,"10S-2H-5S":687,"10H-2S-5H":687,"10S-2S-5H":687,"10H-2S-5S":687,"10D-3C-4C":688,"10H-3C-4C":688,"10S-3C-4C":688,"10C-3C-4D":688,"10C-3C-4H":688,"10C-3C-4S":688,"10D-3C-4D":688,"10H-3C-4D":688,"10S-3C-4D":688,"10D-3C-4H":688,"10D-3C-4S":688,"10H-3C-4H":688,"10S-3C-4H":688,"10H-3C-4S":688,"10S-3C-4S":688,"10C-3D-4C":688,"10C-3H-4C":688,"10C-3S-4C":688,"10D-3D-4C":688,"10H-3D-4C":688,"10S-3D-4C":688,"10D-3H-4C":688,"10D-3S-4C":688,"10H-3H-4C":688,"10S-3H-4C":688,"10H-3S-4C":688,"10S-3S-4C":688,"10C-3D-4D":688,"10C-3D-4H":688,"10C-3D-4S":688,"10C-3H-4D":688,"10C-3S-4D":688,"10C-3H-4H":688,"10C-3H-4S":688,"10C-3S-4H":688,"10C-3S-4S":688,"10H-3D-4D":688,"10S-3D-4D":688,"10D-3D-4H":688,"10D-3D-4S":688,"10H-3D-4H":688,"10S-3D-4H":688,"10H-3D-4S":688,"10S-3D-4S":688,"10D-3H-4D":688,"10D-3S-4D":688,"10H-3H-4D":688,"10S-3H-4D":688,"10H-3S-4D":688,"10S-3S-4D":688,"10D-3H-4H":688,"10D-3H-4S":688,"10D-3S-4H":688,"10D-3S-4S":688,"10S-3H-4H":688,"10H-3H-4S":688,"10S-3H-4S":688,"10H-3S-4H":688,"10S-3S-4H":688,"10H-3S-4S":688,"10D-2C-4C":689,"10H-2C-4C":689,"10S-2C-4C":689,"10C-2C-4D":689,"10C-2C-4H":689,"10C-2C-4S":689,"10D-2C-4D":689,"10H-2C-4D":689,"10S-2C-4D":689,"10D-2C-4H":689,"10D-2C-4S":689,"10H-2C-4H":689,"10S-2C-4H":689,"10H-2C-4S":689,"10S-2C-4S":689,"10C-2D-4C":689,"10C-2H-4C":689,"10C-2S-4C":689,"10D-2D-4C":689,"10H-2D-4C":689,"10S-2D-4C":689,"10D-2H-4C":689,"10D-2S-4C":689,"10H-2H-4C":689,"10S-2H-4C":689,"10H-2S-4C":689,"10S-2S-4C":689,"10C-2D-4D":689,"10C-2D-4H":689,"10C-2D-4S":689,"10C-2H-4D":689,"10C-2S-4D":689,"10C-2H-4H":689,"10C-2H-4S":689,"10C-2S-4H":689,"10C-2S-4S":689,"10H-2D-4D":689,"10S-2D-4D":689,"10D-2D-4H":689,"10D-2D-4S":689,"10H-2D-4H":689,"10S-2D-4H":689,"10H-2D-4S":689,"10S-2D-4S":689,"10D-2H-4D":689,"10D-2S-4D":689,"10H-2H-4D":689,"10S-2H-4D":689,"10H-2S-4D":689,"10S-2S-4D":689,"10D-2H-4H":689,"10D-2H-4S":689,"10D-2S-4H":689,"10D-2S-4S":689,"10S-2H-4H":689,"10H-2H-4S":689,"10S-2H-4S":689,"10H-2S-4H":689,"10S-2S-4H":689,"10H-2S-4S":689,"10D-2C-3C":690,"10H-2C-3C":690,"10S-2C-3C":690,"10C-2C-3D":690,"10C-2C-3H":690,"10C-2C-3S":690,"10D-2C-3D":690,"10H-2C-3D":690,"10S-2C-3D":690,"10D-2C-3H":690,"10D-2C-3S":690,"10H-2C-3H":690,"10S-2C-3H":690,"10H-2C-3S":690,"10S-2C-3S":690,"10C-2D-3C":690,"10C-2H-3C":690,"10C-2S-3C":690,"10D-2D-3C":690,"10H-2D-3C":690,"10S-2D-3C":690,"10D-2H-3C":690,"10D-2S-3C":690,"10H-2H-3C":690,"10S-2H-3C":690,"10H-2S-3C":690,"10S-2S-3C":690,"10C-2D-3D":690,"10C-2D-3H":690,"10C-2D-3S":690,"10C-2H-3D":690,"10C-2S-3D":690,"10C-2H-3H":690,"10C-2H-3S":690,"10C-2S-3H":690,"10C-2S-3S":690,"10H-2D-3D":690,"10S-2D-3D":690,"10D-2D-3H":690,"10D-2D-3S":690,"10H-2D-3H":690,"10S-2D-3H":690,"10H-2D-3S":690,"10S-2D-3S":690,"10D-2H-3D":690,"10D-2S-3D":690,"10H-2H-3D":690,"10S-2H-3D":690,"10H-2S-3D":690,"10S-2S-3D":690,"10D-2H-3H":690,"10D-2H-3S":690,"10D-2S-3H":690,"10D-2S-3S":690,"10S-2H-3H":690,"10H-2H-3S":690,"10S-2H-3S":690,"10H-2S-3H":690,"10S-2S-3H":690,"10H-2S-3S":690,"6C-8C-9D":691,"6C-8C-9H":691,"6C-8C-9S":691,"6C-8D-9C":691,"6C-8H-9C":691,"6C-8S-9C":691,"6C-8D-9D":691,"6C-8D-9H":691,"6C-8D-9S":691,"6C-8H-9D":691,"6C-8S-9D":691,"6C-8H-9H":691,"6C-8H-9S":691,"6C-8S-9H":691,"6C-8S-9S":691,"6D-8C-9C":691,"6H-8C-9C":691,"6S-8C-9C":691,"6D-8C-9D":691,"6D-8C-9H":691,"6D-8C-9S":691,"6H-8C-9D":691,"6S-8C-9D":691,"6H-8C-9H":691,"6H-8C-9S":691,"6S-8C-9H":691,"6S-8C-9S":691,"6D-8D-9C":691,"6D-8H-9C":691,"6D-8S-9C":691,"6H-8D-9C":691,"6S-8D-9C":691,"6H-8H-9C":691,"6H-8S-9C":691,"6S-8H-9C":691,"6S-8S-9C":691,"6D-8D-9H":691,"6D-8D-9S":691,"6D-8H-9D":691,"6D-8S-9D":691,"6D-8H-9H":691,"6D-8H-9S":691,"6D-8S-9H":691,"6D-8S-9S":691,"6H-8D-9D":691,"6S-8D-9D":691,"6H-8D-9H":691,"6H-8D-9S":691,"6S-8D-9H":691,"6S-8D-9S":691,"6H-8H-9D":691,"6H-8S-9D":691,"6S-8H-9D":691,"6S-8S-9D":691,"6H-8H-9S":691,"6H-8S-9H":691,"6H-8S-9S":691,"6S-8H-9H":691,"6S-8H-9S":691,"6S-8S-9H":691,"5C-8C-9D":692,"5C-8C-9H":692,"5C-8C-9S":692,"5C-8D-9C":692,"5C-8H-9C":692,"5C-8S-9C":692,"5C-8D-9D":692,"5C-8D-9H":692,"5C-8D-9S":692,"5C-8H-9D":692,"5C-8S-9D":692,"5C-8H-9H":692,"5C-8H-9S":692,"5C-8S-9H":692,"5C-8S-9S":692,"5D-8C-9C":692,"5H-8C-9C":692,"5S-8C-9C":692,"5D-8C-9D":692,"5D-8C-9H":692,"5D-8C-9S":692,"5H-8C-9D":692,"5S-8C-9D":692,"5H-8C-9H":692,"5H-8C-9S":692,"5S-8C-9H":692,"5S-8C-9S":692,"5D-8D-9C":692,"5D-8H-9C":692,"5D-8S-9C":692,"5H-8D-9C":692,"5S-8D-9C":692,"5H-8H-9C":692,"5H-8S-9C":692,"5S-8H-9C":692,"5S-8S-9C":692,"5D-8D-9H":692,"5D-8D-9S":692,"5D-8H-9D":692,"5D-8S-9D":692,"5D-8H-9H":692,"5D-8H-9S":692,"5D-8S-9H":692,"5D-8S-9S":692,"5H-8D-9D":692,"5S-8D-9D":692,"5H-8D-9H":692,"5H-8D-9S":692,"5S-8D-9H":692,"5S-8D-9S":692,"5H-8H-9D":692,"5H-8S-9D":692,"5S-8H-9D":692,"5S-8S-9D":692,"5H-8H-9S":692,"5H-8S-9H":692,"5H-8S-9S":692,"5S-8H-9H":692,"5S-8H-9S":692,"5S-8S-9H":692,"4C-8C-9D":693,"4C-8C-9H":693,"4C-8C-9S":693,"4C-8D-9C":693,"4C-8H-9C":693,"4C-8S-9C":693,"4C-8D-9D":693,"4C-8D-9H":693,"4C-8D-9S":693,"4C-8H-9D":693,"4C-8S-9D":693,"4C-8H-9H":693,"4C-8H-9S":693,"4C-8S-9H":693,"4C-8S-9S":693,"4D-8C-9C":693,"4H-8C-9C":693,"4S-8C-9C":693,"4D-8C-9D":693,"4D-8C-9H":693,"4D-8C-9S":693,"4H-8C-9D":693,"4S-8C-9D":693,"4H-8C-9H":693,"4H-8C-9S":693,"4S-8C-9H":693,"4S-8C-9S":693,"4D-8D-9C":693,"4D-8H-9C":693,"4D-8S-9C":693,"4H-8D-9C":693,"4S-8D-9C":693,"4H-8H-9C":693,"4H-8S-9C":693,"4S-8H-9C":693,"4S-8S-9C":693,"4D-8D-9H":693,"4D-8D-9S":693,"4D-8H-9D":693,"4D-8S-9D":693,"4D-8H-9H":693,"4D-8H-9S":693,"4D-8S-9H":693,"4D-8S-9S":693,"4H-8D-9D":693,"4S-8D-9D":693,"4H-8D-9H":693,"4H-8D-9S":693,"4S-8D-9H":693,"4S-8D-9S":693,"4H-8H-9D":693,"4H-8S-9D":693,"4S-8H-9D":693,"4S-8S-9D":693,"4H-8H-9S":693,"4H-8S-9H":693,"4H-8S-9S":693,"4S-8H-9H":693,"4S-8H-9S":693,"4S-8S-9H":693,"3C-8C-9D":694,"3C-8C-9H":694,"3C-8C-9S":694,"3C-8D-9C":694,"3C-8H-9C":694,"3C-8S-9C":694,"3C-8D-9D":694,"3C-8D-9H":694,"3C-8D-9S":694,"3C-8H-9D":694,"3C-8S-9D":694,"3C-8H-9H":694,"3C-8H-9S":694,"3C-8S-9H":694,"3C-8S-9S":694,"3D-8C-9C":694,"3H-8C-9C":694,"3S-8C-9C":694,"3D-8C-9D":694,"3D-8C-9H":694,"3D-8C-9S":694,"3H-8C-9D":694,"3S-8C-9D":694,"3H-8C-9H":694,"3H-8C-9S":694,"3S-8C-9H":694,"3S-8C-9S":694,"3D-8D-9C":694,"3D-8H-9C":694,"3D-8S-9C":694,"3H-8D-9C":694,"3S-8D-9C":694,"3H-8H-9C":694,"3H-8S-9C":694,"3S-8H-9C":694,"3S-8S-9C":694,"3D-8D-9H":694,"3D-8D-9S":694,"3D-8H-9D":694,"3D-8S-9D":694,"3D-8H-9H":694,"3D-8H-9S":694,"3D-8S-9H":694,"3D-8S-9S":694,"3H-8D-9D":694,"3S-8D-9D":694,"3H-8D-9H":694,"3H-8D-9S":694,"3S-8D-9H":694,"3S-8D-9S":694,"3H-8H-9D":694,"3H-8S-9D":694,"3S-8H-9D":694,"3S-8S-9D":694,"3H-8H-9S":694,"3H-8S-9H":694,"3H-8S-9S":694,"3S-8H-9H":694,"3S-8H-9S":694,"3S-8S-9H":694,"2C-8C-9D":695,"2C-8C-9H":695,"2C-8C-9S":695,"2C-8D-9C":695,"2C-8H-9C":695,"2C-8S-9C":695,"2C-8D-9D":695,"2C-8D-9H":695,"2C-8D-9S":695,"2C-8H-9D":695,"2C-8S-9D":695,"2C-8H-9H":695,"2C-8H-9S":695,"2C-8S-9H":695,"2C-8S-9S":695,"2D-8C-9C":695,"2H-8C-9C":695,"2S-8C-9C":695,"2D-8C-9D":695,"2D-8C-9H":695,"2D-8C-9S":695,"2H-8C-9D":695,"2S-8C-9D":695,"2H-8C-9H":695,"2H-8C-9S":695,"2S-8C-9H":695,"2S-8C-9S":695,"2D-8D-9C":695,"2D-8H-9C":695,"2D-8S-9C":695,"2H-8D-9C":695,"2S-8D-9C":695,"2H-8H-9C":695,"2H-8S-9C":695,"2S-8H-9C":695,"2S-8S-9C":695,"2D-8D-9H":695,"2D-8D-9S":695,"2D-8H-9D":695,"2D-8S-9D":695,"2D-8H-9H":695,"2D-8H-9S":695,"2D-8S-9H":695,"2D-8S-9S":695,"2H-8D-9D":695,"2S-8D-9D":695,"2H-8D-9H":695,"2H-8D-9S":695,"2S-8D-9H":695,"2S-8D-9S":695,"2H-8H-9D":695,"2H-8S-9D":695,"2S-8H-9D":695,"2S-8S-9D":695,"2H-8H-9S":695,"2H-8S-9H":695,"2H-8S-9S":695,"2S-8H-9H":695,"2S-8H-9S":695,"2S-8S-9H":695,"6C-7C-9D":696,"6C-7C-9H":696,"6C-7C-9S":696,"6C-7D-9C":696,"6C-7H-9C":696,"6C-7S-9C":696,"6C-7D-9D":696,"6C-7D-9H":696,"6C-7D-9S":696,"6C-7H-9D":696,"6C-7S-9D":696,"6C-7H-9H":696,"6C-7H-9S":696,"6C-7S-9H":696,"6C-7S-9S":696,"6D-7C-9C":696,"6H-7C-9C":696,"6S-7C-9C":696,"6D-7C-9D":696,"6D-7C-9H":696,"6D-7C-9S":696,"6H-7C-9D":696,"6S-7C-9D":696,"6H-7C-9H":696,"6H-7C-9S":696,"6S-7C-9H":696,"6S-7C-9S":696,"6D-7D-9C":696,"6D-7H-9C":696,"6D-7S-9C":696,"6H-7D-9C":696,"6S-7D-9C":696,"6H-7H-9C":696,"6H-7S-9C":696,"6S-7H-9C":696,"6S-7S-9C":696,"6D-7D-9H":696,"6D-7D-9S":696,"6D-7H-9D":696,"6D-7S-9D":696,"6D-7H-9H":696,"6D-7H-9S":696,"6D-7S-9H":696,"6D-7S-9S":696,"6H-7D-9D":696,"6S-7D-9D":696,"6H-7D-9H":696,"6H-7D-9S":696,"6S-7D-9H":696,"6S-7D-9S":696,"6H-7H-9D":696,"6H-7S-9D":696,"6S-7H-9D":696,"6S-7S-9D":696,"6H-7H-9S":696,"6H-7S-9H":696,"6H-7S-9S":696,"6S-7H-9H":696,"6S-7H-9S":696,"6S-7S-9H":696,"5C-7C-9D":697,"5C-7C-9H":697,"5C-7C-9S":697,"5C-7D-9C":697,"5C-7H-9C":697,"5C-7S-9C":697,"5C-7D-9D":697,"5C-7D-9H":697,"5C-7D-9S":697,"5C-7H-9D":697,"5C-7S-9D":697,"5C-7H-9H":697,"5C-7H-9S":697,"5C-7S-9H":697,"5C-7S-9S":697,"5D-7C-9C":697,"5H-7C-9C":697,"5S-7C-9C":697,"5D-7C-9D":697,"5D-7C-9H":697,"5D-7C-9S":697,"5H-7C-9D":697,"5S-7C-9D":697,"5H-7C-9H":697,"5H-7C-9S":697,"5S-7C-9H":697,"5S-7C-9S":697,"5D-7D-9C":697,"5D-7H-9C":697,"5D-7S-9C":697,"5H-7D-9C":697,"5S-7D-9C":697,"5H-7H-9C":697,"5H-7S-9C":697,"5S-7H-9C":697,"5S-7S-9C":697,"5D-7D-9H":697,"5D-7D-9S":697,"5D-7H-9D":697,"5D-7S-9D":697,"5D-7H-9H":697,"5D-7H-9S":697,"5D-7S-9H":697,"5D-7S-9S":697,"5H-7D-9D":697,"5S-7D-9D":697,"5H-7D-9H":697,"5H-7D-9S":697,"5S-7D-9H":697,"5S-7D-9S":697,"5H-7H-9D":697,"5H-7S-9D":697,"5S-7H-9D":697,"5S-7S-9D":697,"5H-7H-9S":697,"5H-7S-9H":697,"5H-7S-9S":697,"5S-7H-9H":697,"5S-7H-9S":697,"5S-7S-9H":697,"4C-7C-9D":698,"4C-7C-9H":698,"4C-7C-9S":698,"4C-7D-9C":698,"4C-7H-9C":698,"4C-7S-9C":698,"4C-7D-9D":698,"4C-7D-9H":698,"4C-7D-9S":698,"4C-7H-9D":698,"4C-7S-9D":698,"4C-7H-9H":698,"4C-7H-9S":698,"4C-7S-9H":698,"4C-7S-9S":698,"4D-7C-9C":698,"4H-7C-9C":698,"4S-7C-9C":698,"4D-7C-9D":698,"4D-7C-9H":698,"4D-7C-9S":698,"4H-7C-9D":698,"4S-7C-9D":698,"4H-7C-9H":698,"4H-7C-9S":698,"4S-7C-9H":698,"4S-7C-9S":698,"4D-7D-9C":698,"4D-7H-9C":698,"4D-7S-9C":698,"4H-7D-9C":698,"4S-7D-9C":698,"4H-7H-9C":698,"4H-7S-9C":698,"4S-7H-9C":698,"4S-7S-9C":698,"4D-7D-9H":698,"4D-7D-9S":698,"4D-7H-9D":698,"4D-7S-9D":698,"4D-7H-9H":698,"4D-7H-9S":698,"4D-7S-9H":698,"4D-7S-9S":698,"4H-7D-9D":698,"4S-7D-9D":698,"4H-7D-9H":698,"4H-7D-9S":698,"4S-7D-9H":698,"4S-7D-9S":698,"4H-7H-9D":698,"4H-7S-9D":698,"4S-7H-9D":698,"4S-7S-9D":698,"4H-7H-9S":698,"4H-7S-9H":698,"4H-7S-9S":698,"4S-7H-9H":698,"4S-7H-9S":698,"4S-7S-9H":698,"3C-7C-9D":699,"3C-7C-9H":699,"3C-7C-9S":699,"3C-7D-9C":699,"3C-7H-9C":699,"3C-7S-9C":699,"3C-7D-9D":699,"3C-7D-9H":699,"3C-7D-9S":699,"3C-7H-9D":699,"3C-7S-9D":699,"3C-7H-9H":699,"3C-7H-9S":699,"3C-7S-9H":699,"3C-7S-9S":699,"3D-7C-9C":699,"3H-7C-9C":699,"3S-7C-9C":699,"3D-7C-9D":699,"3D-7C-9H":699,"3D-7C-9S":699,"3H-7C-9D":699,"3S-7C-9D":699,"3H-7C-9H":699,"3H-7C-9S":699,"3S-7C-9H":699,"3S-7C-9S":699,"3D-7D-9C":699,"3D-7H-9C":699,"3D-7S-9C":699,"3H-7D-9C":699,"3S-7D-9C":699,"3H-7H-9C":699,"3H-7S-9C":699,"3S-7H-9C":699,"3S-7S-9C":699,"3D-7D-9H":699,"3D-7D-9S":699,"3D-7H-9D":699,"3D-7S-9D":699,"3D-7H-9H":699,"3D-7H-9S":699,"3D-7S-9H":699,"3D-7S-9S":699,"3H-7D-9D":699,"3S-7D-9D":699,"3H-7D-9H":699,"3H-7D-9S":699,"3S-7D-9H":699,"3S-7D-9S":699,"3H-7H-9D":699,"3H-7S-9D":699,"3S-7H-9D":699,"3S-7S-9D":699,"3H-7H-9S":699,"3H-7S-9H":699,"3H-7S-9S":699,"3S-7H-9H":699,"3S-7H-9S":699,"3S-7S-9H":699,"2C-7C-9D":700,"2C-7C-9H":700,"2C-7C-9S":700,"2C-7D-9C":700,"2C-7H-9C":700,"2C-7S-9C":700,"2C-7D-9D":700,"2C-7D-9H":700,"2C-7D-9S":700,"2C-7H-9D":700,"2C-7S-9D":700,"2C-7H-9H":700,"2C-7H-9S":700,"2C-7S-9H":700,"2C-7S-9S":700,"2D-7C-9C":700,"2H-7C-9C":700,"2S-7C-9C":700,"2D-7C-9D":700,"2D-7C-9H":700,"2D-7C-9S":700,"2H-7C-9D":700,"2S-7C-9D":700,"2H-7C-9H":700,"2H-7C-9S":700,"2S-7C-9H":700,"2S-7C-9S":700,"2D-7D-9C":700,"2D-7H-9C":700,"2D-7S-9C":700,"2H-7D-9C":700,"2S-7D-9C":700,"2H-7H-9C":700,"2H-7S-9C":700,"2S-7H-9C":700,"2S-7S-9C":700,"2D-7D-9H":700,"2D-7D-9S":700,"2D-7H-9D":700,"2D-7S-9D":700,"2D-7H-9H":700,"2D-7H-9S":700,"2D-7S-9H":700,"2D-7S-9S":700,"2H-7D-9D":700,"2S-7D-9D":700,"2H-7D-9H":700,"2H-7D-9S":700,"2S-7D-9H":700,"2S-7D-9S":700,"2H-7H-9D":700,"2H-7S-9D":700,"2S-7H-9D":700,"2S-7S-9D":700,"2H-7H-9S":700,"2H-7S-9H":700,"2H-7S-9S":700,"2S-7H-9H":700,"2S-7H-9S":700,"2S-7S-9H":700,"5C-6C-9D":701,"5C-6C-9H":701,"5C-6C-9S":701,"5C-6D-9C":701,"5C-6H-9C":701,"5C-6S-9C":701,"5C-6D-9D":701,"5C-6D-9H":701,"5C-6D-9S":701,"5C-6H-9D":701,"5C-6S-9D":701,"5C-6H-9H":701,"5C-6H-9S":701,"5C-6S-9H":701,"5C-6S-9S":701,"5D-6C-9C":701,"5H-6C-9C":701,"5S-6C-9C":701,"5D-6C-9D":701,"5D-6C-9H":701,"5D-6C-9S":701,"5H-6C-9D":701,"5S-6C-9D":701,"5H-6C-9H":701,"5H-6C-9S":701,"5S-6C-9H":701,"5S-6C-9S":701,"5D-6D-9C":701,"5D-6H-9C":701,"5D-6S-9C":701,"5H-6D-9C":701,"5S-6D-9C":701,"5H-6H-9C":701,"5H-6S-9C":701,"5S-6H-9C":701,"5S-6S-9C":701,"5D-6D-9H":701,"5D-6D-9S":701,"5D-6H-9D":701,"5D-6S-9D":701,"5D-6H-9H":701,"5D-6H-9S":701,"5D-6S-9H":701,"5D-6S-9S":701,"5H-6D-9D":701,"5S-6D-9D":701,"5H-6D-9H":701,"5H-6D-9S":701,"5S-6D-9H":701,"5S-6D-9S":701,"5H-6H-9D":701,"5H-6S-9D":701,"5S-6H-9D":701,"5S-6S-9D":701,"5H-6H-9S":701,"5H-6S-9H":701,"5H-6S-9S":701,"5S-6H-9H":701,"5S-6H-9S":701,"5S-6S-9H":701,"4C-6C-9D":702,"4C-6C-9H":702,"4C-6C-9S":702,"4C-6D-9C":702,"4C-6H-9C":702,"4C-6S-9C":702,"4C-6D-9D":702,"4C-6D-9H":702,"4C-6D-9S":702,"4C-6H-9D":702,"4C-6S-9D":702,"4C-6H-9H":702,"4C-6H-9S":702,"4C-6S-9H":702,"4C-6S-9S":702,"4D-6C-9C":702,"4H-6C-9C":702,"4S-6C-9C":702,"4D-6C-9D":702,"4D-6C-9H":702,"4D-6C-9S":702,"4H-6C-9D":702,"4S-6C-9D":702,"4H-6C-9H":702,"4H-6C-9S":702,"4S-6C-9H":702,"4S-6C-9S":702,"4D-6D-9C":702,"4D-6H-9C":702,"4D-6S-9C":702,"4H-6D-9C":702,"4S-6D-9C":702,"4H-6H-9C":702,"4H-6S-9C":702,"4S-6H-9C":702,"4S-6S-9C":702,"4D-6D-9H":702,"4D-6D-9S":702,"4D-6H-9D":702,"4D-6S-9D":702,"4D-6H-9H":702,"4D-6H-9S":702,"4D-6S-9H":702,"4D-6S-9S":702,"4H-6D-9D":702,"4S-6D-9D":702,"4H-6D-9H":702,"4H-6D-9S":702,"4S-6D-9H":702,"4S-6D-9S":702,"4H-6H-9D":702,"4H-6S-9D":702,"4S-6H-9D":702,"4S-6S-9D":702,"4H-6H-9S":702,"4H-6S-9H":702,"4H-6S-9S":702,"4S-6H-9H":702,"4S-6H-9S":702,"4S-6S-9H":702,"3C-6C-9D":703,"3C-6C-9H":703,"3C-6C-9S":703,"3C-6D-9C":703,"3C-6H-9C":703,"3C-6S-9C":703,"3C-6D-9D":703,"3C-6D-9H":703,"3C-6D-9S":703,"3C-6H-9D":703,"3C-6S-9D":703,"3C-6H-9H":703,"3C-6H-9S":703,"3C-6S-9H":703,"3C-6S-9S":703,"3D-6C-9C":703,"3H-6C-9C":703,"3S-6C-9C":703,"3D-6C-9D":703,"3D-6C-9H":703,"3D-6C-9S":703,"3H-6C-9D":703,"3S-6C-9D":703,"3H-6C-9H":703,"3H-6C-9S":703,"3S-6C-9H":703,"3S-6C-9S":703,"3D-6D-9C":703,"3D-6H-9C":703,"3D-6S-9C":703,"3H-6D-9C":703,"3S-6D-9C":703,"3H-6H-9C":703,"3H-6S-9C":703,"3S-6H-9C":703,"3S-6S-9C":703,"3D-6D-9H":703,"3D-6D-9S":703,"3D-6H-9D":703,"3D-6S-9D":703,"3D-6H-9H":703,"3D-6H-9S":703,"3D-6S-9H":703,"3D-6S-9S":703,"3H-6D-9D":703,"3S-6D-9D":703,"3H-6D-9H":703,"3H-6D-9S":703,"3S-6D-9H":703,"3S-6D-9S":703,"3H-6H-9D":703,"3H-6S-9D":703,"3S-6H-9D":703,"3S-6S-9D":703,"3H-6H-9S":703,"3H-6S-9H":703,"3H-6S-9S":703,"3S-6H-9H":703,"3S-6H-9S":703,"3S-6S-9H":703,"2C-6C-9D":704,"2C-6C-9H":704,"2C-6C-9S":704,"2C-6D-9C":704,"2C-6H-9C":704,"2C-6S-9C":704,"2C-6D-9D":704,"2C-6D-9H":704,"2C-6D-9S":704,"2C-6H-9D":704,"2C-6S-9D":704,"2C-6H-9H":704,"2C-6H-9S":704,"2C-6S-9H":704,"2C-6S-9S":704,"2D-6C-9C":704,"2H-6C-9C":704,"2S-6C-9C":704,"2D-6C-9D":704,"2D-6C-9H":704,"2D-6C-9S":704,"2H-6C-9D":704,"2S-6C-9D":704,"2H-6C-9H":704,"2H-6C-9S":704,"2S-6C-9H":704,"2S-6C-9S":704,"2D-6D-9C":704,"2D-6H-9C":704,"2D-6S-9C":704,"2H-6D-9C":704,"2S-6D-9C":704,"2H-6H-9C":704,"2H-6S-9C":704,"2S-6H-9C":704,"2S-6S-9C":704,"2D-6D-9H":704,"2D-6D-9S":704,"2D-6H-9D":704,"2D-6S-9D":704,"2D-6H-9H":704,"2D-6H-9S":704,"2D-6S-9H":704,"2D-6S-9S":704,"2H-6D-9D":704,"2S-6D-9D":704,"2H-6D-9H":704,"2H-6D-9S":704,"2S-6D-9H":704,"2S-6D-9S":704,"2H-6H-9D":704,"2H-6S-9D":704,"2S-6H-9D":704,"2S-6S-9D":704,"2H-6H-9S":704,"2H-6S-9H":704,"2H-6S-9S":704,"2S-6H-9H":704,"2S-6H-9S":704,"2S-6S-9H":704,"4C-5C-9D":705,"4C-5C-9H":705,"4C-5C-9S":705,"4C-5D-9C":705,"4C-5H-9C":705,"4C-5S-9C":705,"4C-5D-9D":705,"4C-5D-9H":705,"4C-5D-9S":705,"4C-5H-9D":705,"4C-5S-9D":705,"4C-5H-9H":705,"4C-5H-9S":705,"4C-5S-9H":705,"4C-5S-9S":705,"4D-5C-9C":705,"4H-5C-9C":705,"4S-5C-9C":705,"4D-5C-9D":705,"4D-5C-9H":705,"4D-5C-9S":705,"4H-5C-9D":705,"4S-5C-9D":705,"4H-5C-9H":705,"4H-5C-9S":705,"4S-5C-9H":705,"4S-5C-9S":705,"4D-5D-9C":705,"4D-5H-9C":705,"4D-5S-9C":705,"4H-5D-9C":705,"4S-5D-9C":705,"4H-5H-9C":705,"4H-5S-9C":705,"4S-5H-9C":705,"4S-5S-9C":705,"4D-5D-9H":705,"4D-5D-9S":705,"4D-5H-9D":705,"4D-5S-9D":705,"4D-5H-9H":705,"4D-5H-9S":705,"4D-5S-9H":705,"4D-5S-9S":705,"4H-5D-9D":705,"4S-5D-9D":705,"4H-5D-9H":705,"4H-5D-9S":705,"4S-5D-9H":705,"4S-5D-9S":705,"4H-5H-9D":705,"4H-5S-9D":705,"4S-5H-9D":705,"4S-5S-9D":705,"4H-5H-9S":705,"4H-5S-9H":705,"4H-5S-9S":705,"4S-5H-9H":705,"4S-5H-9S":705,"4S-5S-9H":705,"3C-5C-9D":706,"3C-5C-9H":706,"3C-5C-9S":706,"3C-5D-9C":706,"3C-5H-9C":706,"3C-5S-9C":706,"3C-5D-9D":706,"3C-5D-9H":706,"3C-5D-9S":706,"3C-5H-9D":706,"3C-5S-9D":706,"3C-5H-9H":706,"3C-5H-9S":706,"3C-5S-9H":706,"3C-5S-9S":706,"3D-5C-9C":706,"3H-5C-9C":706,"3S-5C-9C":706,"3D-5C-9D":706,"3D-5C-9H":706,"3D-5C-9S":706,"3H-5C-9D":706,"3S-5C-9D":706,"3H-5C-9H":706,"3H-5C-9S":706,"3S-5C-9H":706,"3S-5C-9S":706,"3D-5D-9C":706,"3D-5H-9C":706,"3D-5S-9C":706,"3H-5D-9C":706,"3S-5D-9C":706,"3H-5H-9C":706,"3H-5S-9C":706,"3S-5H-9C":706,"3S-5S-9C":706,"3D-5D-9H":706,"3D-5D-9S":706,"3D-5H-9D":706,"3D-5S-9D":706,"3D-5H-9H":706,"3D-5H-9S":706,"3D-5S-9H":706,"3D-5S-9S":706,"3H-5D-9D":706,"3S-5D-9D":706,"3H-5D-9H":706,"3H-5D-9S":706,"3S-5D-9H":706,"3S-5D-9S":706,"3H-5H-9D":706,"3H-5S-9D":706,"3S-5H-9D":706,"3S-5S-9D":706,"3H-5H-9S":706,"3H-5S-9H":706,"3H-5S-9S":706,"3S-5H-9H":706,"3S-5H-9S":706,"3S-5S-9H":706,"2C-5C-9D":707,"2C-5C-9H":707,"2C-5C-9S":707,"2C-5D-9C":707,"2C-5H-9C":707,"2C-5S-9C":707,"2C-5D-9D":707,"2C-5D-9H":707,"2C-5D-9S":707,"2C-5H-9D":707,"2C-5S-9D":707,"2C-5H-9H":707,"2C-5H-9S":707,"2C-5S-9H":707,"2C-5S-9S":707,"2D-5C-9C":707,"2H-5C-9C":707,"2S-5C-9C":707,"2D-5C-9D":707,"2D-5C-9H":707,"2D-5C-9S":707,"2H-5C-9D":707,"2S-5C-9D":707,"2H-5C-9H":707,"2H-5C-9S":707,"2S-5C-9H":707,"2S-5C-9S":707,"2D-5D-9C":707,"2D-5H-9C":707,"2D-5S-9C":707,"2H-5D-9C":707,"2S-5D-9C":707,"2H-5H-9C":707,"2H-5S-9C":707,"2S-5H-9C":707,"2S-5S-9C":707,"2D-5D-9H":707,"2D-5D-9S":707,"2D-5H-9D":707,"2D-5S-9D":707,"2D-5H-9H":707,"2D-5H-9S":707,"2D-5S-9H":707,"2D-5S-9S":707,"2H-5D-9D":707,"2S-5D-9D":707,"2H-5D-9H":707,"2H-5D-9S":707,"2S-5D-9H":707,"2S-5D-9S":707,"2H-5H-9D":707,"2H-5S-9D":707,"2S-5H-9D":707,"2S-5S-9D":707,"2H-5H-9S":707,"2H-5S-9H":707,"2H-5S-9S":707,"2S-5H-9H":707,"2S-5H-9S":707,"2S-5S-9H":707,"3C-4C-9D":708,"3C-4C-9H":708,"3C-4C-9S":708,"3C-4D-9C":708,"3C-4H-9C":708,"3C-4S-9C":708,"3C-4D-9D":708,"3C-4D-9H":708,"3C-4D-9S":708,"3C-4H-9D":708,"3C-4S-9D":708,"3C-4H-9H":708,"3C-4H-9S":708,"3C-4S-9H":708,"3C-4S-9S":708,"3D-4C-9C":708,"3H-4C-9C":708,"3S-4C-9C":708,"3D-4C-9D":708,"3D-4C-9H":708,"3D-4C-9S":708,"3H-4C-9D":708,"3S-4C-9D":708,"3H-4C-9H":708,"3H-4C-9S":708,"3S-4C-9H":708,"3S-4C-9S":708,"3D-4D-9C":708,"3D-4H-9C":708,"3D-4S-9C":708,"3H-4D-9C":708,"3S-4D-9C":708,"3H-4H-9C":708,"3H-4S-9C":708,"3S-4H-9C":708,"3S-4S-9C":708,"3D-4D-9H":708,"3D-4D-9S":708,"3D-4H-9D":708,"3D-4S-9D":708,"3D-4H-9H":708,"3D-4H-9S":708,"3D-4S-9H":708,"3D-4S-9S":708,"3H-4D-9D":708,"3S-4D-9D":708,"3H-4D-9H":708,"3H-4D-9S":708,"3S-4D-9H":708,"3S-4D-9S":708,"3H-4H-9D":708,"3H-4S-9D":708,"3S-4H-9D":708,"3S-4S-9D":708,"3H-4H-9S":708,"3H-4S-9H":708,"3H-4S-9S":708,"3S-4H-9H":708,"3S-4H-9S":708,"3S-4S-9H":708,"2C-4C-9D":709,"2C-4C-9H":709,"2C-4C-9S":709,"2C-4D-9C":709,"2C-4H-9C":709,"2C-4S-9C":709,"2C-4D-9D":709,"2C-4D-9H":709,"2C-4D-9S":709,"2C-4H-9D":709,"2C-4S-9D":709,"2C-4H-9H":709,"2C-4H-9S":709,"2C-4S-9H":709,"2C-4S-9S":709,"2D-4C-9C":709,"2H-4C-9C":709,"2S-4C-9C":709,"2D-4C-9D":709,"2D-4C-9H":709,"2D-4C-9S":709,"2H-4C-9D":709,"2S-4C-9D":709,"2H-4C-9H":709,"2H-4C-9S":709,"2S-4C-9H":709,"2S-4C-9S":709,"2D-4D-9C":709,"2D-4H-9C":709,"2D-4S-9C":709,"2H-4D-9C":709,"2S-4D-9C":709,"2H-4H-9C":709,"2H-4S-9C":709,"2S-4H-9C":709,"2S-4S-9C":709,"2D-4D-9H":709,"2D-4D-9S":709,"2D-4H-9D":709,"2D-4S-9D":709,"2D-4H-9H":709,"2D-4H-9S":709,"2D-4S-9H":709,"2D-4S-9S":709,"2H-4D-9D":709,"2S-4D-9D":709,"2H-4D-9H":709,"2H-4D-9S":709,"2S-4D-9H":709,"2S-4D-9S":709,"2H-4H-9D":709,"2H-4S-9D":709,"2S-4H-9D":709,"2S-4S-9D":709,"2H-4H-9S":709,"2H-4S-9H":709,"2H-4S-9S":709,"2S-4H-9H":709,"2S-4H-9S":709,"2S-4S-9H":709,"2C-3C-9D":710,"2C-3C-9H":710,"2C-3C-9S":710,"2C-3D-9C":710,"2C-3H-9C":710,"2C-3S-9C":710,"2C-3D-9D":710,"2C-3D-9H":710,"2C-3D-9S":710,"2C-3H-9D":710,"2C-3S-9D":710,"2C-3H-9H":710,"2C-3H-9S":710,"2C-3S-9H":710,"2C-3S-9S":710,"2D-3C-9C":710,"2H-3C-9C":710,"2S-3C-9C":710,"2D-3C-9D":710,"2D-3C-9H":710,"2D-3C-9S":710,"2H-3C-9D":710,"2S-3C-9D":710,"2H-3C-9H":710,"2H-3C-9S":710,"2S-3C-9H":710,"2S-3C-9S":710,"2D-3D-9C":710,"2D-3H-9C":710,"2D-3S-9C":710,"2H-3D-9C":710,"2S-3D-9C":710,"2H-3H-9C":710,"2H-3S-9C":710,"2S-3H-9C":710,"2S-3S-9C":710,"2D-3D-9H":710,"2D-3D-9S":710,"2D-3H-9D":710,"2D-3S-9D":710,"2D-3H-9H":710,"2D-3H-9S":710,"2D-3S-9H":710,"2D-3S-9S":710,"2H-3D-9D":710,"2S-3D-9D":710,"2H-3D-9H":710,"2H-3D-9S":710,"2S-3D-9H":710,"2S-3D-9S":710,"2H-3H-9D":710,"2H-3S-9D":710,"2S-3H-9D":710,"2S-3S-9D":710,"2H-3H-9S":710,"2H-3S-9H":710,"2H-3S-9S":710,"2S-3H-9H":710,"2S-3H-9S":710,"2S-3S-9H":710,"5C-7C-8D":711,"5C-7C-8H":711,"5C-7C-8S":711,"5C-7D-8C":711,"5C-7H-8C":711,"5C-7S-8C":711,"5C-7D-8D":711,"5C-7D-8H":711,"5C-7D-8S":711,"5C-7H-8D":711,"5C-7S-8D":711,"5C-7H-8H":711,"5C-7H-8S":711,"5C-7S-8H":711,"5C-7S-8S":711,"5D-7C-8C":711,"5H-7C-8C":711,"5S-7C-8C":711,"5D-7C-8D":711,"5D-7C-8H":711,"5D-7C-8S":711,"5H-7C-8D":711,"5S-7C-8D":711,"5H-7C-8H":711,"5H-7C-8S":711,"5S-7C-8H":711,"5S-7C-8S":711,"5D-7D-8C":711,"5D-7H-8C":711,"5D-7S-8C":711,"5H-7D-8C":711,"5S-7D-8C":711,"5H-7H-8C":711,"5H-7S-8C":711,"5S-7H-8C":711,"5S-7S-8C":711,"5D-7D-8H":711,"5D-7D-8S":711,"5D-7H-8D":711,"5D-7S-8D":711,"5D-7H-8H":711,"5D-7H-8S":711,"5D-7S-8H":711,"5D-7S-8S":711,"5H-7D-8D":711,"5S-7D-8D":711,"5H-7D-8H":711,"5H-7D-8S":711,"5S-7D-8H":711,"5S-7D-8S":711,"5H-7H-8D":711,"5H-7S-8D":711,"5S-7H-8D":711,"5S-7S-8D":711,"5H-7H-8S":711,"5H-7S-8H":711,"5H-7S-8S":711,"5S-7H-8H":711,"5S-7H-8S":711,"5S-7S-8H":711,"4C-7C-8D":712,"4C-7C-8H":712,"4C-7C-8S":712,"4C-7D-8C":712,"4C-7H-8C":712,"4C-7S-8C":712,"4C-7D-8D":712,"4C-7D-8H":712,"4C-7D-8S":712,"4C-7H-8D":712,"4C-7S-8D":712,"4C-7H-8H":712,"4C-7H-8S":712,"4C-7S-8H":712,"4C-7S-8S":712,"4D-7C-8C":712,"4H-7C-8C":712,"4S-7C-8C":712,"4D-7C-8D":712,"4D-7C-8H":712,"4D-7C-8S":712,"4H-7C-8D":712,"4S-7C-8D":712,"4H-7C-8H":712,"4H-7C-8S":712,"4S-7C-8H":712,"4S-7C-8S":712,"4D-7D-8C":712,"4D-7H-8C":712,"4D-7S-8C":712,"4H-7D-8C":712,"4S-7D-8C":712,"4H-7H-8C":712,"4H-7S-8C":712,"4S-7H-8C":712,"4S-7S-8C":712,"4D-7D-8H":712,"4D-7D-8S":712,"4D-7H-8D":712,"4D-7S-8D":712,"4D-7H-8H":712,"4D-7H-8S":712,"4D-7S-8H":712,"4D-7S-8S":712,"4H-7D-8D":712,"4S-7D-8D":712,"4H-7D-8H":712,"4H-7D-8S":712,"4S-7D-8H":712,"4S-7D-8S":712,"4H-7H-8D":712,"4H-7S-8D":712,"4S-7H-8D":712,"4S-7S-8D":712,"4H-7H-8S":712,"4H-7S-8H":712,"4H-7S-8S":712,"4S-7H-8H":712,"4S-7H-8S":712,"4S-7S-8H":712,"3C-7C-8D":713,"3C-7C-8H":713,"3C-7C-8S":713,"3C-7D-8C":713,"3C-7H-8C":713,"3C-7S-8C":713,"3C-7D-8D":713,"3C-7D-8H":713,"3C-7D-8S":713,"3C-7H-8D":713,"3C-7S-8D":713,"3C-7H-8H":713,"3C-7H-8S":713,"3C-7S-8H":713,"3C-7S-8S":713,"3D-7C-8C":713,"3H-7C-8C":713,"3S-7C-8C":713,"3D-7C-8D":713,"3D-7C-8H":713,"3D-7C-8S":713,"3H-7C-8D":713,"3S-7C-8D":713,"3H-7C-8H":713,"3H-7C-8S":713,"3S-7C-8H":713,"3S-7C-8S":713,"3D-7D-8C":713,"3D-7H-8C":713,"3D-7S-8C":713,"3H-7D-8C":713,"3S-7D-8C":713,"3H-7H-8C":713,"3H-7S-8C":713,"3S-7H-8C":713,"3S-7S-8C":713,"3D-7D-8H":713,"3D-7D-8S":713,"3D-7H-8D":713,"3D-7S-8D":713,"3D-7H-8H":713,"3D-7H-8S":713,"3D-7S-8H":713,"3D-7S-8S":713,"3H-7D-8D":713,"3S-7D-8D":713,"3H-7D-8H":713,"3H-7D-8S":713,"3S-7D-8H":713,"3S-7D-8S":713,"3H-7H-8D":713,"3H-7S-8D":713,"3S-7H-8D":713,"3S-7S-8D":713,"3H-7H-8S":713,"3H-7S-8H":713,"3H-7S-8S":713,"3S-7H-8H":713,"3S-7H-8S":713,"3S-7S-8H":713,"2C-7C-8D":714,"2C-7C-8H":714,"2C-7C-8S":714,"2C-7D-8C":714,"2C-7H-8C":714,"2C-7S-8C":714,"2C-7D-8D":714,"2C-7D-8H":714,"2C-7D-8S":714,"2C-7H-8D":714,"2C-7S-8D":714,"2C-7H-8H":714,"2C-7H-8S":714,"2C-7S-8H":714,"2C-7S-8S":714,"2D-7C-8C":714,"2H-7C-8C":714,"2S-7C-8C":714,"2D-7C-8D":714,"2D-7C-8H":714,"2D-7C-8S":714,"2H-7C-8D":714,"2S-7C-8D":714,"2H-7C-8H":714,"2H-7C-8S":714,"2S-7C-8H":714,"2S-7C-8S":714,"2D-7D-8C":714,"2D-7H-8C":714,"2D-7S-8C":714,"2H-7D-8C":714,"2S-7D-8C":714,"2H-7H-8C":714,"2H-7S-8C":714,"2S-7H-8C":714,"2S-7S-8C":714,"2D-7D-8H":714,"2D-7D-8S":714,"2D-7H-8D":714,"2D-7S-8D":714,"2D-7H-8H":714,"2D-7H-8S":714,"2D-7S-8H":714,"2D-7S-8S":714,"2H-7D-8D":714,"2S-7D-8D":714,"2H-7D-8H":714,"2H-7D-8S":714,"2S-7D-8H":714,"2S-7D-8S":714,"2H-7H-8D":714,"2H-7S-8D":714,"2S-7H-8D":714,"2S-7S-8D":714,"2H-7H-8S":714,"2H-7S-8H":714,"2H-7S-8S":714,"2S-7H-8H":714,"2S-7H-8S":714,"2S-7S-8H":714,"5C-6C-8D":715,"5C-6C-8H":715,"5C-6C-8S":715,"5C-6D-8C":715,"5C-6H-8C":715,"5C-6S-8C":715,"5C-6D-8D":715,"5C-6D-8H":715,"5C-6D-8S":715,"5C-6H-8D":715,"5C-6S-8D":715,"5C-6H-8H":715,"5C-6H-8S":715,"5C-6S-8H":715,"5C-6S-8S":715,"5D-6C-8C":715,"5H-6C-8C":715,"5S-6C-8C":715,"5D-6C-8D":715,"5D-6C-8H":715,"5D-6C-8S":715,"5H-6C-8D":715,"5S-6C-8D":715,"5H-6C-8H":715,"5H-6C-8S":715,"5S-6C-8H":715,"5S-6C-8S":715,"5D-6D-8C":715,"5D-6H-8C":715,"5D-6S-8C":715,"5H-6D-8C":715,"5S-6D-8C":715,"5H-6H-8C":715,"5H-6S-8C":715,"5S-6H-8C":715,"5S-6S-8C":715,"5D-6D-8H":715,"5D-6D-8S":715,"5D-6H-8D":715,"5D-6S-8D":715,"5D-6H-8H":715,"5D-6H-8S":715,"5D-6S-8H":715,"5D-6S-8S":715,"5H-6D-8D":715,"5S-6D-8D":715,"5H-6D-8H":715,"5H-6D-8S":715,"5S-6D-8H":715,"5S-6D-8S":715,"5H-6H-8D":715,"5H-6S-8D":715,"5S-6H-8D":715,"5S-6S-8D":715,"5H-6H-8S":715,"5H-6S-8H":715,"5H-6S-8S":715,"5S-6H-8H":715,"5S-6H-8S":715,"5S-6S-8H":715,"4C-6C-8D":716,"4C-6C-8H":716,"4C-6C-8S":716,"4C-6D-8C":716,"4C-6H-8C":716,"4C-6S-8C":716,"4C-6D-8D":716,"4C-6D-8H":716,"4C-6D-8S":716,"4C-6H-8D":716,"4C-6S-8D":716,"4C-6H-8H":716,"4C-6H-8S":716,"4C-6S-8H":716,"4C-6S-8S":716,"4D-6C-8C":716,"4H-6C-8C":716,"4S-6C-8C":716,"4D-6C-8D":716,"4D-6C-8H":716,"4D-6C-8S":716,"4H-6C-8D":716,"4S-6C-8D":716,"4H-6C-8H":716,"4H-6C-8S":716,"4S-6C-8H":716,"4S-6C-8S":716,"4D-6D-8C":716,"4D-6H-8C":716,"4D-6S-8C":716,"4H-6D-8C":716,"4S-6D-8C":716,"4H-6H-8C":716,"4H-6S-8C":716,"4S-6H-8C":716,"4S-6S-8C":716,"4D-6D-8H":716,"4D-6D-8S":716,"4D-6H-8D":716,"4D-6S-8D":716,"4D-6H-8H":716,"4D-6H-8S":716,"4D-6S-8H":716,"4D-6S-8S":716,"4H-6D-8D":716,"4S-6D-8D":716,"4H-6D-8H":716,"4H-6D-8S":716,"4S-6D-8H":716,"4S-6D-8S":716,"4H-6H-8D":716,"4H-6S-8D":716,"4S-6H-8D":716,"4S-6S-8D":716,"4H-6H-8S":716,"4H-6S-8H":716,"4H-6S-8S":716,"4S-6H-8H":716,"4S-6H-8S":716,"4S-6S-8H":716,"3C-6C-8D":717,"3C-6C-8H":717,"3C-6C-8S":717,"3C-6D-8C":717,"3C-6H-8C":717,"3C-6S-8C":717,"3C-6D-8D":717,"3C-6D-8H":717,"3C-6D-8S":717,"3C-6H-8D":717,"3C-6S-8D":717,"3C-6H-8H":717,"3C-6H-8S":717,"3C-6S-8H":717,"3C-6S-8S":717,"3D-6C-8C":717,"3H-6C-8C":717,"3S-6C-8C":717,"3D-6C-8D":717,"3D-6C-8H":717,"3D-6C-8S":717,"3H-6C-8D":717,"3S-6C-8D":717,"3H-6C-8H":717,"3H-6C-8S":717,"3S-6C-8H":717,"3S-6C-8S":717,"3D-6D-8C":717,"3D-6H-8C":717,"3D-6S-8C":717,"3H-6D-8C":717,"3S-6D-8C":717,"3H-6H-8C":717,"3H-6S-8C":717,"3S-6H-8C":717,"3S-6S-8C":717,"3D-6D-8H":717,"3D-6D-8S":717,"3D-6H-8D":717,"3D-6S-8D":717,"3D-6H-8H":717,"3D-6H-8S":717,"3D-6S-8H":717,"3D-6S-8S":717,"3H-6D-8D":717,"3S-6D-8D":717,"3H-6D-8H":717,"3H-6D-8S":717,"3S-6D-8H":717,"3S-6D-8S":717,"3H-6H-8D":717,"3H-6S-8D":717,"3S-6H-8D":717,"3S-6S-8D":717,"3H-6H-8S":717,"3H-6S-8H":717,"3H-6S-8S":717,"3S-6H-8H":717,"3S-6H-8S":717,"3S-6S-8H":717,"2C-6C-8D":718,"2C-6C-8H":718,"2C-6C-8S":718,"2C-6D-8C":718,"2C-6H-8C":718,"2C-6S-8C":718,"2C-6D-8D":718,"2C-6D-8H":718,"2C-6D-8S":718,"2C-6H-8D":718,"2C-6S-8D":718,"2C-6H-8H":718,"2C-6H-8S":718,"2C-6S-8H":718,"2C-6S-8S":718,"2D-6C-8C":718,"2H-6C-8C":718,"2S-6C-8C":718,"2D-6C-8D":718,"2D-6C-8H":718,"2D-6C-8S":718,"2H-6C-8D":718,"2S-6C-8D":718,"2H-6C-8H":718,"2H-6C-8S":718,"2S-6C-8H":718,"2S-6C-8S":718,"2D-6D-8C":718,"2D-6H-8C":718,"2D-6S-8C":718,"2H-6D-8C":718,"2S-6D-8C":718,"2H-6H-8C":718,"2H-6S-8C":718,"2S-6H-8C":718,"2S-6S-8C":718,"2D-6D-8H":718,"2D-6D-8S":718,"2D-6H-8D":718,"2D-6S-8D":718,"2D-6H-8H":718,"2D-6H-8S":718,"2D-6S-8H":718,"2D-6S-8S":718,"2H-6D-8D":718,"2S-6D-8D":718,"2H-6D-8H":718,"2H-6D-8S":718,"2S-6D-8H":718,"2S-6D-8S":718,"2H-6H-8D":718,"2H-6S-8D":718,"2S-6H-8D":718,"2S-6S-8D":718,"2H-6H-8S":718,"2H-6S-8H":718,"2H-6S-8S":718,"2S-6H-8H":718,"2S-6H-8S":718,"2S-6S-8H":718,"4C-5C-8D":719,"4C-5C-8H":719,"4C-5C-8S":719,"4C-5D-8C":719,"4C-5H-8C":719,"4C-5S-8C":719,"4C-5D-8D":719,"4C-5D-8H":719,"4C-5D-8S":719,"4C-5H-8D":719,"4C-5S-8D":719,"4C-5H-8H":719,"4C-5H-8S":719,"4C-5S-8H":719,"4C-5S-8S":719,"4D-5C-8C":719,"4H-5C-8C":719,"4S-5C-8C":719,"4D-5C-8D":719,"4D-5C-8H":719,"4D-5C-8S":719,"4H-5C-8D":719,"4S-5C-8D":719,"4H-5C-8H":719,"4H-5C-8S":719,"4S-5C-8H":719,"4S-5C-8S":719,"4D-5D-8C":719,"4D-5H-8C":719,"4D-5S-8C":719,"4H-5D-8C":719,"4S-5D-8C":719,"4H-5H-8C":719,"4H-5S-8C":719,"4S-5H-8C":719,"4S-5S-8C":719,"4D-5D-8H":719,"4D-5D-8S":719,"4D-5H-8D":719,"4D-5S-8D":719,"4D-5H-8H":719,"4D-5H-8S":719,"4D-5S-8H":719,"4D-5S-8S":719,"4H-5D-8D":719,"4S-5D-8D":719,"4H-5D-8H":719,"4H-5D-8S":719,"4S-5D-8H":719,"4S-5D-8S":719,"4H-5H-8D":719,"4H-5S-8D":719,"4S-5H-8D":719,"4S-5S-8D":719,"4H-5H-8S":719,"4H-5S-8H":719,"4H-5S-8S":719,"4S-5H-8H":719,"4S-5H-8S":719,"4S-5S-8H":719,"3C-5C-8D":720,"3C-5C-8H":720,"3C-5C-8S":720,"3C-5D-8C":720,"3C-5H-8C":720,"3C-5S-8C":720,"3C-5D-8D":720,"3C-5D-8H":720,"3C-5D-8S":720,"3C-5H-8D":720,"3C-5S-8D":720,"3C-5H-8H":720,"3C-5H-8S":720,"3C-5S-8H":720,"3C-5S-8S":720,"3D-5C-8C":720,"3H-5C-8C":720,"3S-5C-8C":720,"3D-5C-8D":720,"3D-5C-8H":720,"3D-5C-8S":720,"3H-5C-8D":720,"3S-5C-8D":720,"3H-5C-8H":720,"3H-5C-8S":720,"3S-5C-8H":720,"3S-5C-8S":720,"3D-5D-8C":720,"3D-5H-8C":720,"3D-5S-8C":720,"3H-5D-8C":720,"3S-5D-8C":720,"3H-5H-8C":720,"3H-5S-8C":720,"3S-5H-8C":720,"3S-5S-8C":720,"3D-5D-8H":720,"3D-5D-8S":720,"3D-5H-8D":720,"3D-5S-8D":720,"3D-5H-8H":720,"3D-5H-8S":720,"3D-5S-8H":720,"3D-5S-8S":720,"3H-5D-8D":720,"3S-5D-8D":720,"3H-5D-8H":720,"3H-5D-8S":720,"3S-5D-8H":720,"3S-5D-8S":720,"3H-5H-8D":720,"3H-5S-8D":720,"3S-5H-8D":720,"3S-5S-8D":720,"3H-5H-8S":720,"3H-5S-8H":720,"3H-5S-8S":720,"3S-5H-8H":720,"3S-5H-8S":720,"3S-5S-8H":720,"2C-5C-8D":721,"2C-5C-8H":721,"2C-5C-8S":721,"2C-5D-8C":721,"2C-5H-8C":721,"2C-5S-8C":721,"2C-5D-8D":721,"2C-5D-8H":721,"2C-5D-8S":721,"2C-5H-8D":721,"2C-5S-8D":721,"2C-5H-8H":721,"2C-5H-8S":721,"2C-5S-8H":721,"2C-5S-8S":721,"2D-5C-8C":721,"2H-5C-8C":721,"2S-5C-8C":721,"2D-5C-8D":721,"2D-5C-8H":721,"2D-5C-8S":721,"2H-5C-8D":721,"2S-5C-8D":721,"2H-5C-8H":721,"2H-5C-8S":721,"2S-5C-8H":721,"2S-5C-8S":721,"2D-5D-8C":721,"2D-5H-8C":721,"2D-5S-8C":721,"2H-5D-8C":721,"2S-5D-8C":721,"2H-5H-8C":721,"2H-5S-8C":721,"2S-5H-8C":721,"2S-5S-8C":721,"2D-5D-8H":721,"2D-5D-8S":721,"2D-5H-8D":721,"2D-5S-8D":721,"2D-5H-8H":721,"2D-5H-8S":721,"2D-5S-8H":721,"2D-5S-8S":721,"2H-5D-8D":721,"2S-5D-8D":721,"2H-5D-8H":721,"2H-5D-8S":721,"2S-5D-8H":721,"2S-5D-8S":721,"2H-5H-8D":721,"2H-5S-8D":721,"2S-5H-8D":721,"2S-5S-8D":721,"2H-5H-8S":721,"2H-5S-8H":721,"2H-5S-8S":721,"2S-5H-8H":721,"2S-5H-8S":721,"2S-5S-8H":721,"3C-4C-8D":722,"3C-4C-8H":722,"3C-4C-8S":722,"3C-4D-8C":722,"3C-4H-8C":722,"3C-4S-8C":722,"3C-4D-8D":722,"3C-4D-8H":722,"3C-4D-8S":722,"3C-4H-8D":722,"3C-4S-8D":722,"3C-4H-8H":722,"3C-4H-8S":722,"3C-4S-8H":722,"3C-4S-8S":722,"3D-4C-8C":722,"3H-4C-8C":722,"3S-4C-8C":722,"3D-4C-8D":722,"3D-4C-8H":722,"3D-4C-8S":722,"3H-4C-8D":722,"3S-4C-8D":722,"3H-4C-8H":722,"3H-4C-8S":722,"3S-4C-8H":722,"3S-4C-8S":722,"3D-4D-8C":722,"3D-4H-8C":722,"3D-4S-8C":722,"3H-4D-8C":722,"3S-4D-8C":722,"3H-4H-8C":722,"3H-4S-8C":722,"3S-4H-8C":722,"3S-4S-8C":722,"3D-4D-8H":722,"3D-4D-8S":722,"3D-4H-8D":722,"3D-4S-8D":722,"3D-4H-8H":722,"3D-4H-8S":722,"3D-4S-8H":722,"3D-4S-8S":722,"3H-4D-8D":722,"3S-4D-8D":722,"3H-4D-8H":722,"3H-4D-8S":722,"3S-4D-8H":722,"3S-4D-8S":722,"3H-4H-8D":722,"3H-4S-8D":722,"3S-4H-8D":722,"3S-4S-8D":722,"3H-4H-8S":722,"3H-4S-8H":722,"3H-4S-8S":722,"3S-4H-8H":722,"3S-4H-8S":722,"3S-4S-8H":722,"2C-4C-8D":723,"2C-4C-8H":723,"2C-4C-8S":723,"2C-4D-8C":723,"2C-4H-8C":723,"2C-4S-8C":723,"2C-4D-8D":723,"2C-4D-8H":723,"2C-4D-8S":723,"2C-4H-8D":723,"2C-4S-8D":723,"2C-4H-8H":723,"2C-4H-8S":723,"2C-4S-8H":723,"2C-4S-8S":723,"2D-4C-8C":723,"2H-4C-8C":723,"2S-4C-8C":723,"2D-4C-8D":723,"2D-4C-8H":723,"2D-4C-8S":723,"2H-4C-8D":723,"2S-4C-8D":723,"2H-4C-8H":723,"2H-4C-8S":723,"2S-4C-8H":723,"2S-4C-8S":723,"2D-4D-8C":723,"2D-4H-8C":723,"2D-4S-8C":723,"2H-4D-8C":723,"2S-4D-8C":723,"2H-4H-8C":723,"2H-4S-8C":723,"2S-4H-8C":723,"2S-4S-8C":723,"2D-4D-8H":723,"2D-4D-8S":723,"2D-4H-8D":723,"2D-4S-8D":723,"2D-4H-8H":723,"2D-4H-8S":723,"2D-4S-8H":723,"2D-4S-8S":723,"2H-4D-8D":723,"2S-4D-8D":723,"2H-4D-8H":723,"2H-4D-8S":723,"2S-4D-8H":723,"2S-4D-8S":723,"2H-4H-8D":723,"2H-4S-8D":723,"2S-4H-8D":723,"2S-4S-8D":723,"2H-4H-8S":723,"2H-4S-8H":723,"2H-4S-8S":723,"2S-4H-8H":723,"2S-4H-8S":723,"2S-4S-8H":723,"2C-3C-8D":724,"2C-3C-8H":724,"2C-3C-8S":724,"2C-3D-8C":724,"2C-3H-8C":724,"2C-3S-8C":724,"2C-3D-8D":724,"2C-3D-8H":724,"2C-3D-8S":724,"2C-3H-8D":724,"2C-3S-8D":724,"2C-3H-8H":724,"2C-3H-8S":724,"2C-3S-8H":724,"2C-3S-8S":724,"2D-3C-8C":724,"2H-3C-8C":724,"2S-3C-8C":724,"2D-3C-8D":724,"2D-3C-8H":724,"2D-3C-8S":724,"2H-3C-8D":724,"2S-3C-8D":724,"2H-3C-8H":724,"2H-3C-8S":724,"2S-3C-8H":724,"2S-3C-8S":724,"2D-3D-8C":724,"2D-3H-8C":724,"2D-3S-8C":724,"2H-3D-8C":724,"2S-3D-8C":724,"2H-3H-8C":724,"2H-3S-8C":724,"2S-3H-8C":724,"2S-3S-8C":724,"2D-3D-8H":724,"2D-3D-8S":724,"2D-3H-8D":724,"2D-3S-8D":724,"2D-3H-8H":724,"2D-3H-8S":724,"2D-3S-8H":724,"2D-3S-8S":724,"2H-3D-8D":724,"2S-3D-8D":724,"2H-3D-8H":724,"2H-3D-8S":724,"2S-3D-8H":724,"2S-3D-8S":724,"2H-3H-8D":724,"2H-3S-8D":724,"2S-3H-8D":724,"2S-3S-8D":724,"2H-3H-8S":724,"2H-3S-8H":724,"2H-3S-8S":724,"2S-3H-8H":724,"2S-3H-8S":724,"2S-3S-8H":724,"4C-6C-7D":725,"4C-6C-7H":725,"4C-6C-7S":725,"4C-6D-7C":725,"4C-6H-7C":725,"4C-6S-7C":725,"4C-6D-7D":725,"4C-6D-7H":725,"4C-6D-7S":725,"4C-6H-7D":725,"4C-6S-7D":725,"4C-6H-7H":725,"4C-6H-7S":725,"4C-6S-7H":725,"4C-6S-7S":725,"4D-6C-7C":725,"4H-6C-7C":725,"4S-6C-7C":725,"4D-6C-7D":725,"4D-6C-7H":725,"4D-6C-7S":725,"4H-6C-7D":725,"4S-6C-7D":725,"4H-6C-7H":725,"4H-6C-7S":725,"4S-6C-7H":725,"4S-6C-7S":725,"4D-6D-7C":725,"4D-6H-7C":725,"4D-6S-7C":725,"4H-6D-7C":725,"4S-6D-7C":725,"4H-6H-7C":725,"4H-6S-7C":725,"4S-6H-7C":725,"4S-6S-7C":725,"4D-6D-7H":725,"4D-6D-7S":725,"4D-6H-7D":725,"4D-6S-7D":725,"4D-6H-7H":725,"4D-6H-7S":725,"4D-6S-7H":725,"4D-6S-7S":725,"4H-6D-7D":725,"4S-6D-7D":725,"4H-6D-7H":725,"4H-6D-7S":725,"4S-6D-7H":725,"4S-6D-7S":725,"4H-6H-7D":725,"4H-6S-7D":725,"4S-6H-7D":725,"4S-6S-7D":725,"4H-6H-7S":725,"4H-6S-7H":725,"4H-6S-7S":725,"4S-6H-7H":725,"4S-6H-7S":725,"4S-6S-7H":725,"3C-6C-7D":726,"3C-6C-7H":726,"3C-6C-7S":726,"3C-6D-7C":726,"3C-6H-7C":726,"3C-6S-7C":726,"3C-6D-7D":726,"3C-6D-7H":726,"3C-6D-7S":726,"3C-6H-7D":726,"3C-6S-7D":726,"3C-6H-7H":726,"3C-6H-7S":726,"3C-6S-7H":726,"3C-6S-7S":726,"3D-6C-7C":726,"3H-6C-7C":726,"3S-6C-7C":726,"3D-6C-7D":726,"3D-6C-7H":726,"3D-6C-7S":726,"3H-6C-7D":726,"3S-6C-7D":726,"3H-6C-7H":726,"3H-6C-7S":726,"3S-6C-7H":726,"3S-6C-7S":726,"3D-6D-7C":726,"3D-6H-7C":726,"3D-6S-7C":726,"3H-6D-7C":726,"3S-6D-7C":726,"3H-6H-7C":726,"3H-6S-7C":726,"3S-6H-7C":726,"3S-6S-7C":726,"3D-6D-7H":726,"3D-6D-7S":726,"3D-6H-7D":726,"3D-6S-7D":726,"3D-6H-7H":726,"3D-6H-7S":726,"3D-6S-7H":726,"3D-6S-7S":726,"3H-6D-7D":726,"3S-6D-7D":726,"3H-6D-7H":726,"3H-6D-7S":726,"3S-6D-7H":726,"3S-6D-7S":726,"3H-6H-7D":726,"3H-6S-7D":726,"3S-6H-7D":726,"3S-6S-7D":726,"3H-6H-7S":726,"3H-6S-7H":726,"3H-6S-7S":726,"3S-6H-7H":726,"3S-6H-7S":726,"3S-6S-7H":726,"2C-6C-7D":727,"2C-6C-7H":727,"2C-6C-7S":727,"2C-6D-7C":727,"2C-6H-7C":727,"2C-6S-7C":727,"2C-6D-7D":727,"2C-6D-7H":727,"2C-6D-7S":727,"2C-6H-7D":727,"2C-6S-7D":727,"2C-6H-7H":727,"2C-6H-7S":727,"2C-6S-7H":727,"2C-6S-7S":727,"2D-6C-7C":727,"2H-6C-7C":727,"2S-6C-7C":727,"2D-6C-7D":727,"2D-6C-7H":727,"2D-6C-7S":727,"2H-6C-7D":727,"2S-6C-7D":727,"2H-6C-7H":727,"2H-6C-7S":727,"2S-6C-7H":727,"2S-6C-7S":727,"2D-6D-7C":727,"2D-6H-7C":727,"2D-6S-7C":727,"2H-6D-7C":727,"2S-6D-7C":727,"2H-6H-7C":727,"2H-6S-7C":727,"2S-6H-7C":727,"2S-6S-7C":727,"2D-6D-7H":727,"2D-6D-7S":727,"2D-6H-7D":727,"2D-6S-7D":727,"2D-6H-7H":727,"2D-6H-7S":727,"2D-6S-7H":727,"2D-6S-7S":727,"2H-6D-7D":727,"2S-6D-7D":727,"2H-6D-7H":727,"2H-6D-7S":727,"2S-6D-7H":727,"2S-6D-7S":727,"2H-6H-7D":727,"2H-6S-7D":727,"2S-6H-7D":727,"2S-6S-7D":727,"2H-6H-7S":727,"2H-6S-7H":727,"2H-6S-7S":727,"2S-6H-7H":727,"2S-6H-7S":727,"2S-6S-7H":727,"4C-5C-7D":728,"4C-5C-7H":728,"4C-5C-7S":728,"4C-5D-7C":728,"4C-5H-7C":728,"4C-5S-7C":728,"4C-5D-7D":728,"4C-5D-7H":728,"4C-5D-7S":728,"4C-5H-7D":728,"4C-5S-7D":728,"4C-5H-7H":728,"4C-5H-7S":728,"4C-5S-7H":728,"4C-5S-7S":728,"4D-5C-7C":728,"4H-5C-7C":728,"4S-5C-7C":728,"4D-5C-7D":728,"4D-5C-7H":728,"4D-5C-7S":728,"4H-5C-7D":728,"4S-5C-7D":728,"4H-5C-7H":728,"4H-5C-7S":728,"4S-5C-7H":728,"4S-5C-7S":728,"4D-5D-7C":728,"4D-5H-7C":728,"4D-5S-7C":728,"4H-5D-7C":728,"4S-5D-7C":728,"4H-5H-7C":728,"4H-5S-7C":728,"4S-5H-7C":728,"4S-5S-7C":728,"4D-5D-7H":728,"4D-5D-7S":728,"4D-5H-7D":728,"4D-5S-7D":728,"4D-5H-7H":728,"4D-5H-7S":728,"4D-5S-7H":728,"4D-5S-7S":728,"4H-5D-7D":728,"4S-5D-7D":728,"4H-5D-7H":728,"4H-5D-7S":728,"4S-5D-7H":728,"4S-5D-7S":728,"4H-5H-7D":728,"4H-5S-7D":728,"4S-5H-7D":728,"4S-5S-7D":728,"4H-5H-7S":728,"4H-5S-7H":728,"4H-5S-7S":728,"4S-5H-7H":728,"4S-5H-7S":728,"4S-5S-7H":728,"3C-5C-7D":729,"3C-5C-7H":729,"3C-5C-7S":729,"3C-5D-7C":729,"3C-5H-7C":729,"3C-5S-7C":729,"3C-5D-7D":729,"3C-5D-7H":729,"3C-5D-7S":729,"3C-5H-7D":729,"3C-5S-7D":729,"3C-5H-7H":729,"3C-5H-7S":729,"3C-5S-7H":729,"3C-5S-7S":729,"3D-5C-7C":729,"3H-5C-7C":729,"3S-5C-7C":729,"3D-5C-7D":729,"3D-5C-7H":729,"3D-5C-7S":729,"3H-5C-7D":729,"3S-5C-7D":729,"3H-5C-7H":729,"3H-5C-7S":729,"3S-5C-7H":729,"3S-5C-7S":729,"3D-5D-7C":729,"3D-5H-7C":729,"3D-5S-7C":729,"3H-5D-7C":729,"3S-5D-7C":729,"3H-5H-7C":729,"3H-5S-7C":729,"3S-5H-7C":729,"3S-5S-7C":729,"3D-5D-7H":729,"3D-5D-7S":729,"3D-5H-7D":729,"3D-5S-7D":729,"3D-5H-7H":729,"3D-5H-7S":729,"3D-5S-7H":729,"3D-5S-7S":729,"3H-5D-7D":729,"3S-5D-7D":729,"3H-5D-7H":729,"3H-5D-7S":729,"3S-5D-7H":729,"3S-5D-7S":729,"3H-5H-7D":729,"3H-5S-7D":729,"3S-5H-7D":729,"3S-5S-7D":729,"3H-5H-7S":729,"3H-5S-7H":729,"3H-5S-7S":729,"3S-5H-7H":729,"3S-5H-7S":729,"3S-5S-7H":729,"2C-5C-7D":730,"2C-5C-7H":730,"2C-5C-7S":730,"2C-5D-7C":730,"2C-5H-7C":730,"2C-5S-7C":730,"2C-5D-7D":730,"2C-5D-7H":730,"2C-5D-7S":730,"2C-5H-7D":730,"2C-5S-7D":730,"2C-5H-7H":730,"2C-5H-7S":730,"2C-5S-7H":730,"2C-5S-7S":730,"2D-5C-7C":730,"2H-5C-7C":730,"2S-5C-7C":730,"2D-5C-7D":730,"2D-5C-7H":730,"2D-5C-7S":730,"2H-5C-7D":730,"2S-5C-7D":730,"2H-5C-7H":730,"2H-5C-7S":730,"2S-5C-7H":730,"2S-5C-7S":730,"2D-5D-7C":730,"2D-5H-7C":730,"2D-5S-7C":730,"2H-5D-7C":730,"2S-5D-7C":730,"2H-5H-7C":730,"2H-5S-7C":730,"2S-5H-7C":730,"2S-5S-7C":730,"2D-5D-7H":730,"2D-5D-7S":730,"2D-5H-7D":730,"2D-5S-7D":730,"2D-5H-7H":730,"2D-5H-7S":730,"2D-5S-7H":730,"2D-5S-7S":730,"2H-5D-7D":730,"2S-5D-7D":730,"2H-5D-7H":730,"2H-5D-7S":730,"2S-5D-7H":730,"2S-5D-7S":730,"2H-5H-7D":730,"2H-5S-7D":730,"2S-5H-7D":730,"2S-5S-7D":730,"2H-5H-7S":730,"2H-5S-7H":730,"2H-5S-7S":730,"2S-5H-7H":730,"2S-5H-7S":730,"2S-5S-7H":730,"3C-4C-7D":731,"3C-4C-7H":731,"3C-4C-7S":731,"3C-4D-7C":731,"3C-4H-7C":731,"3C-4S-7C":731,"3C-4D-7D":731,"3C-4D-7H":731,"3C-4D-7S":731,"3C-4H-7D":731,"3C-4S-7D":731,"3C-4H-7H":731,"3C-4H-7S":731,"3C-4S-7H":731,"3C-4S-7S":731,"3D-4C-7C":731,"3H-4C-7C":731,"3S-4C-7C":731,"3D-4C-7D":731,"3D-4C-7H":731,"3D-4C-7S":731,"3H-4C-7D":731,"3S-4C-7D":731,"3H-4C-7H":731,"3H-4C-7S":731,"3S-4C-7H":731,"3S-4C-7S":731,"3D-4D-7C":731,"3D-4H-7C":731,"3D-4S-7C":731,"3H-4D-7C":731,"3S-4D-7C":731,"3H-4H-7C":731,"3H-4S-7C":731,"3S-4H-7C":731,"3S-4S-7C":731,"3D-4D-7H":731,"3D-4D-7S":731,"3D-4H-7D":731,"3D-4S-7D":731,"3D-4H-7H":731,"3D-4H-7S":731,"3D-4S-7H":731,"3D-4S-7S":731,"3H-4D-7D":731,"3S-4D-7D":731,"3H-4D-7H":731,"3H-4D-7S":731,"3S-4D-7H":731,"3S-4D-7S":731,"3H-4H-7D":731,"3H-4S-7D":731,"3S-4H-7D":731,"3S-4S-7D":731,"3H-4H-7S":731,"3H-4S-7H":731,"3H-4S-7S":731,"3S-4H-7H":731,"3S-4H-7S":731,"3S-4S-7H":731,"2C-4C-7D":732,"2C-4C-7H":732,"2C-4C-7S":732,"2C-4D-7C":732,"2C-4H-7C":732,"2C-4S-7C":732,"2C-4D-7D":732,"2C-4D-7H":732,"2C-4D-7S":732,"2C-4H-7D":732,"2C-4S-7D":732,"2C-4H-7H":732,"2C-4H-7S":732,"2C-4S-7H":732,"2C-4S-7S":732,"2D-4C-7C":732,"2H-4C-7C":732,"2S-4C-7C":732,"2D-4C-7D":732,"2D-4C-7H":732,"2D-4C-7S":732,"2H-4C-7D":732,"2S-4C-7D":732,"2H-4C-7H":732,"2H-4C-7S":732,"2S-4C-7H":732,"2S-4C-7S":732,"2D-4D-7C":732,"2D-4H-7C":732,"2D-4S-7C":732,"2H-4D-7C":732,"2S-4D-7C":732,"2H-4H-7C":732,"2H-4S-7C":732,"2S-4H-7C":732,"2S-4S-7C":732,"2D-4D-7H":732,"2D-4D-7S":732,"2D-4H-7D":732,"2D-4S-7D":732,"2D-4H-7H":732,"2D-4H-7S":732,"2D-4S-7H":732,"2D-4S-7S":732,"2H-4D-7D":732,"2S-4D-7D":732,"2H-4D-7H":732,"2H-4D-7S":732,"2S-4D-7H":732,"2S-4D-7S":732,"2H-4H-7D":732,"2H-4S-7D":732,"2S-4H-7D":732,"2S-4S-7D":732,"2H-4H-7S":732,"2H-4S-7H":732,"2H-4S-7S":732,"2S-4H-7H":732,"2S-4H-7S":732,"2S-4S-7H":732,"2C-3C-7D":733,"2C-3C-7H":733,"2C-3C-7S":733,"2C-3D-7C":733,"2C-3H-7C":733,"2C-3S-7C":733,"2C-3D-7D":733,"2C-3D-7H":733,"2C-3D-7S":733,"2C-3H-7D":733,"2C-3S-7D":733,"2C-3H-7H":733,"2C-3H-7S":733,"2C-3S-7H":733,"2C-3S-7S":733,"2D-3C-7C":733,"2H-3C-7C":733,"2S-3C-7C":733,"2D-3C-7D":733,"2D-3C-7H":733,"2D-3C-7S":733,"2H-3C-7D":733,"2S-3C-7D":733,"2H-3C-7H":733,"2H-3C-7S":733,"2S-3C-7H":733,"2S-3C-7S":733,"2D-3D-7C":733,"2D-3H-7C":733,"2D-3S-7C":733,"2H-3D-7C":733,"2S-3D-7C":733,"2H-3H-7C":733,"2H-3S-7C":733,"2S-3H-7C":733,"2S-3S-7C":733,"2D-3D-7H":733,"2D-3D-7S":733,"2D-3H-7D":733,"2D-3S-7D":733,"2D-3H-7H":733,"2D-3H-7S":733,"2D-3S-7H":733,"2D-3S-7S":733,"2H-3D-7D":733,"2S-3D-7D":733,"2H-3D-7H":733,"2H-3D-7S":733,"2S-3D-7H":733,"2S-3D-7S":733,"2H-3H-7D":733,"2H-3S-7D":733,"2S-3H-7D":733,"2S-3S-7D":733,"2H-3H-7S":733,"2H-3S-7H":733,"2H-3S-7S":733,"2S-3H-7H":733,"2S-3H-7S":733,"2S-3S-7H":733,"3C-5C-6D":734,"3C-5C-6H":734,"3C-5C-6S":734,"3C-5D-6C":734,"3C-5H-6C":734,"3C-5S-6C":734,"3C-5D-6D":734,"3C-5D-6H":734,"3C-5D-6S":734,"3C-5H-6D":734,"3C-5S-6D":734,"3C-5H-6H":734,"3C-5H-6S":734,"3C-5S-6H":734,"3C-5S-6S":734,"3D-5C-6C":734,"3H-5C-6C":734,"3S-5C-6C":734,"3D-5C-6D":734,"3D-5C-6H":734,"3D-5C-6S":734,"3H-5C-6D":734,"3S-5C-6D":734,"3H-5C-6H":734,"3H-5C-6S":734,"3S-5C-6H":734,"3S-5C-6S":734,"3D-5D-6C":734,"3D-5H-6C":734,"3D-5S-6C":734,"3H-5D-6C":734,"3S-5D-6C":734,"3H-5H-6C":734,"3H-5S-6C":734,"3S-5H-6C":734,"3S-5S-6C":734,"3D-5D-6H":734,"3D-5D-6S":734,"3D-5H-6D":734,"3D-5S-6D":734,"3D-5H-6H":734,"3D-5H-6S":734,"3D-5S-6H":734,"3D-5S-6S":734,"3H-5D-6D":734,"3S-5D-6D":734,"3H-5D-6H":734,"3H-5D-6S":734,"3S-5D-6H":734,"3S-5D-6S":734,"3H-5H-6D":734,"3H-5S-6D":734,"3S-5H-6D":734,"3S-5S-6D":734,"3H-5H-6S":734,"3H-5S-6H":734,"3H-5S-6S":734,"3S-5H-6H":734,"3S-5H-6S":734,"3S-5S-6H":734,"2C-5C-6D":735,"2C-5C-6H":735,"2C-5C-6S":735,"2C-5D-6C":735,"2C-5H-6C":735,"2C-5S-6C":735,"2C-5D-6D":735,"2C-5D-6H":735,"2C-5D-6S":735,"2C-5H-6D":735,"2C-5S-6D":735,"2C-5H-6H":735,"2C-5H-6S":735,"2C-5S-6H":735,"2C-5S-6S":735,"2D-5C-6C":735,"2H-5C-6C":735,"2S-5C-6C":735,"2D-5C-6D":735,"2D-5C-6H":735,"2D-5C-6S":735,"2H-5C-6D":735,"2S-5C-6D":735,"2H-5C-6H":735,"2H-5C-6S":735,"2S-5C-6H":735,"2S-5C-6S":735,"2D-5D-6C":735,"2D-5H-6C":735,"2D-5S-6C":735,"2H-5D-6C":735,"2S-5D-6C":735,"2H-5H-6C":735,"2H-5S-6C":735,"2S-5H-6C":735,"2S-5S-6C":735,"2D-5D-6H":735,"2D-5D-6S":735,"2D-5H-6D":735,"2D-5S-6D":735,"2D-5H-6H":735,"2D-5H-6S":735,"2D-5S-6H":735,"2D-5S-6S":735,"2H-5D-6D":735,"2S-5D-6D":735,"2H-5D-6H":735,"2H-5D-6S":735,"2S-5D-6H":735,"2S-5D-6S":735,"2H-5H-6D":735,"2H-5S-6D":735,"2S-5H-6D":735,"2S-5S-6D":735,"2H-5H-6S":735,"2H-5S-6H":735,"2H-5S-6S":735,"2S-5H-6H":735,"2S-5H-6S":735,"2S-5S-6H":735,"3C-4C-6D":736,"3C-4C-6H":736,"3C-4C-6S":736,"3C-4D-6C":736,"3C-4H-6C":736,"3C-4S-6C":736,"3C-4D-6D":736,"3C-4D-6H":736,"3C-4D-6S":736,"3C-4H-6D":736,"3C-4S-6D":736,"3C-4H-6H":736,"3C-4H-6S":736,"3C-4S-6H":736,"3C-4S-6S":736,"3D-4C-6C":736,"3H-4C-6C":736,"3S-4C-6C":736,"3D-4C-6D":736,"3D-4C-6H":736,"3D-4C-6S":736,"3H-4C-6D":736,"3S-4C-6D":736,"3H-4C-6H":736,"3H-4C-6S":736,"3S-4C-6H":736,"3S-4C-6S":736,"3D-4D-6C":736,"3D-4H-6C":736,"3D-4S-6C":736,"3H-4D-6C":736,"3S-4D-6C":736,"3H-4H-6C":736,"3H-4S-6C":736,"3S-4H-6C":736,"3S-4S-6C":736,"3D-4D-6H":736,"3D-4D-6S":736,"3D-4H-6D":736,"3D-4S-6D":736,"3D-4H-6H":736,"3D-4H-6S":736,"3D-4S-6H":736,"3D-4S-6S":736,"3H-4D-6D":736,"3S-4D-6D":736,"3H-4D-6H":736,"3H-4D-6S":736,"3S-4D-6H":736,"3S-4D-6S":736,"3H-4H-6D":736,"3H-4S-6D":736,"3S-4H-6D":736,"3S-4S-6D":736,"3H-4H-6S":736,"3H-4S-6H":736,"3H-4S-6S":736,"3S-4H-6H":736,"3S-4H-6S":736,"3S-4S-6H":736,"2C-4C-6D":737,"2C-4C-6H":737,"2C-4C-6S":737,"2C-4D-6C":737,"2C-4H-6C":737,"2C-4S-6C":737,"2C-4D-6D":737,"2C-4D-6H":737,"2C-4D-6S":737,"2C-4H-6D":737,"2C-4S-6D":737,"2C-4H-6H":737,"2C-4H-6S":737,"2C-4S-6H":737,"2C-4S-6S":737,"2D-4C-6C":737,"2H-4C-6C":737,"2S-4C-6C":737,"2D-4C-6D":737,"2D-4C-6H":737,"2D-4C-6S":737,"2H-4C-6D":737,"2S-4C-6D":737,"2H-4C-6H":737,"2H-4C-6S":737,"2S-4C-6H":737,"2S-4C-6S":737,"2D-4D-6C":737,"2D-4H-6C":737,"2D-4S-6C":737,"2H-4D-6C":737,"2S-4D-6C":737,"2H-4H-6C":737,"2H-4S-6C":737,"2S-4H-6C":737,"2S-4S-6C":737,"2D-4D-6H":737,"2D-4D-6S":737,"2D-4H-6D":737,"2D-4S-6D":737,"2D-4H-6H":737,"2D-4H-6S":737,"2D-4S-6H":737,"2D-4S-6S":737,"2H-4D-6D":737,"2S-4D-6D":737,"2H-4D-6H":737,"2H-4D-6S":737,"2S-4D-6H":737,"2S-4D-6S":737,"2H-4H-6D":737,"2H-4S-6D":737,"2S-4H-6D":737,"2S-4S-6D":737,"2H-4H-6S":737,"2H-4S-6H":737,"2H-4S-6S":737,"2S-4H-6H":737,"2S-4H-6S":737,"2S-4S-6H":737,"2C-3C-6D":738,"2C-3C-6H":738,"2C-3C-6S":738,"2C-3D-6C":738,"2C-3H-6C":738,"2C-3S-6C":738,"2C-3D-6D":738,"2C-3D-6H":738,"2C-3D-6S":738,"2C-3H-6D":738,"2C-3S-6D":738,"2C-3H-6H":738,"2C-3H-6S":738,"2C-3S-6H":738,"2C-3S-6S":738,"2D-3C-6C":738,"2H-3C-6C":738,"2S-3C-6C":738,"2D-3C-6D":738,"2D-3C-6H":738,"2D-3C-6S":738,"2H-3C-6D":738,"2S-3C-6D":738,"2H-3C-6H":738,"2H-3C-6S":738,"2S-3C-6H":738,"2S-3C-6S":738,"2D-3D-6C":738,"2D-3H-6C":738,"2D-3S-6C":738,"2H-3D-6C":738,"2S-3D-6C":738,"2H-3H-6C":738,"2H-3S-6C":738,"2S-3H-6C":738,"2S-3S-6C":738,"2D-3D-6H":738,"2D-3D-6S":738,"2D-3H-6D":738,"2D-3S-6D":738,"2D-3H-6H":738,"2D-3H-6S":738,"2D-3S-6H":738,"2D-3S-6S":738,"2H-3D-6D":738,"2S-3D-6D":738,"2H-3D-6H":738,"2H-3D-6S":738,"2S-3D-6H":738,"2S-3D-6S":738,"2H-3H-6D":738,"2H-3S-6D":738,"2S-3H-6D":738,"2S-3S-6D":738,"2H-3H-6S":738,"2H-3S-6H":738,"2H-3S-6S":738,"2S-3H-6H":738,"2S-3H-6S":738,"2S-3S-6H":738,"2C-4C-5D":739,"2C-4C-5H":739,"2C-4C-5S":739,"2C-4D-5C":739,"2C-4H-5C":739,"2C-4S-5C":739,"2C-4D-5D":739,"2C-4D-5H":739,"2C-4D-5S":739,"2C-4H-5D":739,"2C-4S-5D":739,"2C-4H-5H":739,"2C-4H-5S":739,"2C-4S-5H":739,"2C-4S-5S":739,"2D-4C-5C":739,"2H-4C-5C":739,"2S-4C-5C":739,"2D-4C-5D":739,"2D-4C-5H":739,"2D-4C-5S":739,"2H-4C-5D":739,"2S-4C-5D":739,"2H-4C-5H":739,"2H-4C-5S":739,"2S-4C-5H":739,"2S-4C-5S":739,"2D-4D-5C":739,"2D-4H-5C":739,"2D-4S-5C":739,"2H-4D-5C":739,"2S-4D-5C":739,"2H-4H-5C":739,"2H-4S-5C":739,"2S-4H-5C":739,"2S-4S-5C":739,"2D-4D-5H":739,"2D-4D-5S":739,"2D-4H-5D":739,"2D-4S-5D":739,"2D-4H-5H":739,"2D-4H-5S":739,"2D-4S-5H":739,"2D-4S-5S":739,"2H-4D-5D":739,"2S-4D-5D":739,"2H-4D-5H":739,"2H-4D-5S":739,"2S-4D-5H":739,"2S-4D-5S":739,"2H-4H-5D":739,"2H-4S-5D":739,"2S-4H-5D":739,"2S-4S-5D":739,"2H-4H-5S":739,"2H-4S-5H":739,"2H-4S-5S":739,"2S-4H-5H":739,"2S-4H-5S":739,"2S-4S-5H":739,"2C-3C-5D":740,"2C-3C-5H":740,"2C-3C-5S":740,"2C-3D-5C":740,"2C-3H-5C":740,"2C-3S-5C":740,"2C-3D-5D":740,"2C-3D-5H":740,"2C-3D-5S":740,"2C-3H-5D":740,"2C-3S-5D":740,"2C-3H-5H":740,"2C-3H-5S":740,"2C-3S-5H":740,"2C-3S-5S":740,"2D-3C-5C":740,"2H-3C-5C":740,"2S-3C-5C":740,"2D-3C-5D":740,"2D-3C-5H":740,"2D-3C-5S":740,"2H-3C-5D":740,"2S-3C-5D":740,"2H-3C-5H":740,"2H-3C-5S":740,"2S-3C-5H":740,"2S-3C-5S":740,"2D-3D-5C":740,"2D-3H-5C":740,"2D-3S-5C":740,"2H-3D-5C":740,"2S-3D-5C":740,"2H-3H-5C":740,"2H-3S-5C":740,"2S-3H-5C":740,"2S-3S-5C":740,"2D-3D-5H":740,"2D-3D-5S":740,"2D-3H-5D":740,"2D-3S-5D":740,"2D-3H-5H":740,"2D-3H-5S":740,"2D-3S-5H":740,"2D-3S-5S":740,"2H-3D-5D":740,"2S-3D-5D":740,"2H-3D-5H":740,"2H-3D-5S":740,"2S-3D-5H":740,"2S-3D-5S":740,"2H-3H-5D":740,"2H-3S-5D":740,"2S-3H-5D":740,"2S-3S-5D":740,"2H-3H-5S":740,"2H-3S-5H":740,"2H-3S-5S":740,"2S-3H-5H":740,"2S-3H-5S":740,"2S-3S-5H":740}`)
-}
-
-func Winners() []byte {
-  return []byte(`[{"Wins":22096,"Ties":4,"Loses":0},{"Wins":22092,"Ties":4,"Loses":4},{"Wins":22088,"Ties":4,"Loses":8},{"Wins":22084,"Ties":4,"Loses":12},{"Wins":22080,"Ties":4,"Loses":16},{"Wins":22076,"Ties":4,"Loses":20},{"Wins":22072,"Ties":4,"Loses":24},{"Wins":22068,"Ties":4,"Loses":28},{"Wins":22064,"Ties":4,"Loses":32},{"Wins":22060,"Ties":4,"Loses":36},{"Wins":22056,"Ties":4,"Loses":40},{"Wins":22052,"Ties":4,"Loses":44},{"Wins":22048,"Ties":4,"Loses":48},{"Wins":22044,"Ties":4,"Loses":52},{"Wins":22040,"Ties":4,"Loses":56},{"Wins":22036,"Ties":4,"Loses":60},{"Wins":22032,"Ties":4,"Loses":64},{"Wins":22028,"Ties":4,"Loses":68},{"Wins":22024,"Ties":4,"Loses":72},{"Wins":22020,"Ties":4,"Loses":76},{"Wins":22016,"Ties":4,"Loses":80},{"Wins":22012,"Ties":4,"Loses":84},{"Wins":22008,"Ties":4,"Loses":88},{"Wins":22004,"Ties":4,"Loses":92},{"Wins":22000,"Ties":4,"Loses":96},{"Wins":21940,"Ties":60,"Loses":100},{"Wins":21880,"Ties":60,"Loses":160},{"Wins":21820,"Ties":60,"Loses":220},{"Wins":21760,"Ties":60,"Loses":280},{"Wins":21700,"Ties":60,"Loses":340},{"Wins":21640,"Ties":60,"Loses":400},{"Wins":21580,"Ties":60,"Loses":460},{"Wins":21520,"Ties":60,"Loses":520},{"Wins":21460,"Ties":60,"Loses":580},{"Wins":21400,"Ties":60,"Loses":640},{"Wins":21340,"Ties":60,"Loses":700},{"Wins":21280,"Ties":60,"Loses":760},{"Wins":21276,"Ties":4,"Loses":820},{"Wins":21272,"Ties":4,"Loses":824},{"Wins":21268,"Ties":4,"Loses":828},{"Wins":21264,"Ties":4,"Loses":832},{"Wins":21260,"Ties":4,"Loses":836},{"Wins":21256,"Ties":4,"Loses":840},{"Wins":21252,"Ties":4,"Loses":844},{"Wins":21248,"Ties":4,"Loses":848},{"Wins":21244,"Ties":4,"Loses":852},{"Wins":21240,"Ties":4,"Loses":856},{"Wins":21236,"Ties":4,"Loses":860},{"Wins":21232,"Ties":4,"Loses":864},{"Wins":21228,"Ties":4,"Loses":868},{"Wins":21224,"Ties":4,"Loses":872},{"Wins":21220,"Ties":4,"Loses":876},{"Wins":21216,"Ties":4,"Loses":880},{"Wins":21212,"Ties":4,"Loses":884},{"Wins":21208,"Ties":4,"Loses":888},{"Wins":21204,"Ties":4,"Loses":892},{"Wins":21200,"Ties":4,"Loses":896},{"Wins":21196,"Ties":4,"Loses":900},{"Wins":21192,"Ties":4,"Loses":904},{"Wins":21188,"Ties":4,"Loses":908},{"Wins":21184,"Ties":4,"Loses":912},{"Wins":21180,"Ties":4,"Loses":916},{"Wins":21176,"Ties":4,"Loses":920},{"Wins":21172,"Ties":4,"Loses":924},{"Wins":21168,"Ties":4,"Loses":928},{"Wins":21164,"Ties":4,"Loses":932},{"Wins":21160,"Ties":4,"Loses":936},{"Wins":21156,"Ties":4,"Loses":940},{"Wins":21152,"Ties":4,"Loses":944},{"Wins":21148,"Ties":4,"Loses":948},{"Wins":21144,"Ties":4,"Loses":952},{"Wins":21140,"Ties":4,"Loses":956},{"Wins":21136,"Ties":4,"Loses":960},{"Wins":21132,"Ties":4,"Loses":964},{"Wins":21128,"Ties":4,"Loses":968},{"Wins":21124,"Ties":4,"Loses":972},{"Wins":21120,"Ties":4,"Loses":976},{"Wins":21116,"Ties":4,"Loses":980},{"Wins":21112,"Ties":4,"Loses":984},{"Wins":21108,"Ties":4,"Loses":988},{"Wins":21104,"Ties":4,"Loses":992},{"Wins":21100,"Ties":4,"Loses":996},{"Wins":21096,"Ties":4,"Loses":1000},{"Wins":21092,"Ties":4,"Loses":1004},{"Wins":21088,"Ties":4,"Loses":1008},{"Wins":21084,"Ties":4,"Loses":1012},{"Wins":21080,"Ties":4,"Loses":1016},{"Wins":21076,"Ties":4,"Loses":1020},{"Wins":21072,"Ties":4,"Loses":1024},{"Wins":21068,"Ties":4,"Loses":1028},{"Wins":21064,"Ties":4,"Loses":1032},{"Wins":21060,"Ties":4,"Loses":1036},{"Wins":21056,"Ties":4,"Loses":1040},{"Wins":21052,"Ties":4,"Loses":1044},{"Wins":21048,"Ties":4,"Loses":1048},{"Wins":21044,"Ties":4,"Loses":1052},{"Wins":21040,"Ties":4,"Loses":1056},{"Wins":21036,"Ties":4,"Loses":1060},{"Wins":21032,"Ties":4,"Loses":1064},{"Wins":21028,"Ties":4,"Loses":1068},{"Wins":21024,"Ties":4,"Loses":1072},{"Wins":21020,"Ties":4,"Loses":1076},{"Wins":21016,"Ties":4,"Loses":1080},{"Wins":21012,"Ties":4,"Loses":1084},{"Wins":21008,"Ties":4,"Loses":1088},{"Wins":21004,"Ties":4,"Loses":1092},{"Wins":21000,"Ties":4,"Loses":1096},{"Wins":20996,"Ties":4,"Loses":1100},{"Wins":20992,"Ties":4,"Loses":1104},{"Wins":20988,"Ties":4,"Loses":1108},{"Wins":20984,"Ties":4,"Loses":1112},{"Wins":20980,"Ties":4,"Loses":1116},{"Wins":20976,"Ties":4,"Loses":1120},{"Wins":20972,"Ties":4,"Loses":1124},{"Wins":20968,"Ties":4,"Loses":1128},{"Wins":20964,"Ties":4,"Loses":1132},{"Wins":20960,"Ties":4,"Loses":1136},{"Wins":20956,"Ties":4,"Loses":1140},{"Wins":20952,"Ties":4,"Loses":1144},{"Wins":20948,"Ties":4,"Loses":1148},{"Wins":20944,"Ties":4,"Loses":1152},{"Wins":20940,"Ties":4,"Loses":1156},{"Wins":20936,"Ties":4,"Loses":1160},{"Wins":20932,"Ties":4,"Loses":1164},{"Wins":20928,"Ties":4,"Loses":1168},{"Wins":20924,"Ties":4,"Loses":1172},{"Wins":20920,"Ties":4,"Loses":1176},{"Wins":20916,"Ties":4,"Loses":1180},{"Wins":20912,"Ties":4,"Loses":1184},{"Wins":20908,"Ties":4,"Loses":1188},{"Wins":20904,"Ties":4,"Loses":1192},{"Wins":20900,"Ties":4,"Loses":1196},{"Wins":20896,"Ties":4,"Loses":1200},{"Wins":20892,"Ties":4,"Loses":1204},{"Wins":20888,"Ties":4,"Loses":1208},{"Wins":20884,"Ties":4,"Loses":1212},{"Wins":20880,"Ties":4,"Loses":1216},{"Wins":20876,"Ties":4,"Loses":1220},{"Wins":20872,"Ties":4,"Loses":1224},{"Wins":20868,"Ties":4,"Loses":1228},{"Wins":20864,"Ties":4,"Loses":1232},{"Wins":20860,"Ties":4,"Loses":1236},{"Wins":20856,"Ties":4,"Loses":1240},{"Wins":20852,"Ties":4,"Loses":1244},{"Wins":20848,"Ties":4,"Loses":1248},{"Wins":20844,"Ties":4,"Loses":1252},{"Wins":20840,"Ties":4,"Loses":1256},{"Wins":20836,"Ties":4,"Loses":1260},{"Wins":20832,"Ties":4,"Loses":1264},{"Wins":20828,"Ties":4,"Loses":1268},{"Wins":20824,"Ties":4,"Loses":1272},{"Wins":20820,"Ties":4,"Loses":1276},{"Wins":20816,"Ties":4,"Loses":1280},{"Wins":20812,"Ties":4,"Loses":1284},{"Wins":20808,"Ties":4,"Loses":1288},{"Wins":20804,"Ties":4,"Loses":1292},{"Wins":20800,"Ties":4,"Loses":1296},{"Wins":20796,"Ties":4,"Loses":1300},{"Wins":20792,"Ties":4,"Loses":1304},{"Wins":20788,"Ties":4,"Loses":1308},{"Wins":20784,"Ties":4,"Loses":1312},{"Wins":20780,"Ties":4,"Loses":1316},{"Wins":20776,"Ties":4,"Loses":1320},{"Wins":20772,"Ties":4,"Loses":1324},{"Wins":20768,"Ties":4,"Loses":1328},{"Wins":20764,"Ties":4,"Loses":1332},{"Wins":20760,"Ties":4,"Loses":1336},{"Wins":20756,"Ties":4,"Loses":1340},{"Wins":20752,"Ties":4,"Loses":1344},{"Wins":20748,"Ties":4,"Loses":1348},{"Wins":20744,"Ties":4,"Loses":1352},{"Wins":20740,"Ties":4,"Loses":1356},{"Wins":20736,"Ties":4,"Loses":1360},{"Wins":20732,"Ties":4,"Loses":1364},{"Wins":20728,"Ties":4,"Loses":1368},{"Wins":20724,"Ties":4,"Loses":1372},{"Wins":20720,"Ties":4,"Loses":1376},{"Wins":20716,"Ties":4,"Loses":1380},{"Wins":20712,"Ties":4,"Loses":1384},{"Wins":20708,"Ties":4,"Loses":1388},{"Wins":20704,"Ties":4,"Loses":1392},{"Wins":20700,"Ties":4,"Loses":1396},{"Wins":20696,"Ties":4,"Loses":1400},{"Wins":20692,"Ties":4,"Loses":1404},{"Wins":20688,"Ties":4,"Loses":1408},{"Wins":20684,"Ties":4,"Loses":1412},{"Wins":20680,"Ties":4,"Loses":1416},{"Wins":20676,"Ties":4,"Loses":1420},{"Wins":20672,"Ties":4,"Loses":1424},{"Wins":20668,"Ties":4,"Loses":1428},{"Wins":20664,"Ties":4,"Loses":1432},{"Wins":20660,"Ties":4,"Loses":1436},{"Wins":20656,"Ties":4,"Loses":1440},{"Wins":20652,"Ties":4,"Loses":1444},{"Wins":20648,"Ties":4,"Loses":1448},{"Wins":20644,"Ties":4,"Loses":1452},{"Wins":20640,"Ties":4,"Loses":1456},{"Wins":20636,"Ties":4,"Loses":1460},{"Wins":20632,"Ties":4,"Loses":1464},{"Wins":20628,"Ties":4,"Loses":1468},{"Wins":20624,"Ties":4,"Loses":1472},{"Wins":20620,"Ties":4,"Loses":1476},{"Wins":20616,"Ties":4,"Loses":1480},{"Wins":20612,"Ties":4,"Loses":1484},{"Wins":20608,"Ties":4,"Loses":1488},{"Wins":20604,"Ties":4,"Loses":1492},{"Wins":20600,"Ties":4,"Loses":1496},{"Wins":20596,"Ties":4,"Loses":1500},{"Wins":20592,"Ties":4,"Loses":1504},{"Wins":20588,"Ties":4,"Loses":1508},{"Wins":20584,"Ties":4,"Loses":1512},{"Wins":20580,"Ties":4,"Loses":1516},{"Wins":20576,"Ties":4,"Loses":1520},{"Wins":20572,"Ties":4,"Loses":1524},{"Wins":20568,"Ties":4,"Loses":1528},{"Wins":20564,"Ties":4,"Loses":1532},{"Wins":20560,"Ties":4,"Loses":1536},{"Wins":20556,"Ties":4,"Loses":1540},{"Wins":20552,"Ties":4,"Loses":1544},{"Wins":20548,"Ties":4,"Loses":1548},{"Wins":20544,"Ties":4,"Loses":1552},{"Wins":20540,"Ties":4,"Loses":1556},{"Wins":20536,"Ties":4,"Loses":1560},{"Wins":20532,"Ties":4,"Loses":1564},{"Wins":20528,"Ties":4,"Loses":1568},{"Wins":20524,"Ties":4,"Loses":1572},{"Wins":20520,"Ties":4,"Loses":1576},{"Wins":20516,"Ties":4,"Loses":1580},{"Wins":20512,"Ties":4,"Loses":1584},{"Wins":20508,"Ties":4,"Loses":1588},{"Wins":20504,"Ties":4,"Loses":1592},{"Wins":20500,"Ties":4,"Loses":1596},{"Wins":20496,"Ties":4,"Loses":1600},{"Wins":20492,"Ties":4,"Loses":1604},{"Wins":20488,"Ties":4,"Loses":1608},{"Wins":20484,"Ties":4,"Loses":1612},{"Wins":20480,"Ties":4,"Loses":1616},{"Wins":20476,"Ties":4,"Loses":1620},{"Wins":20472,"Ties":4,"Loses":1624},{"Wins":20468,"Ties":4,"Loses":1628},{"Wins":20464,"Ties":4,"Loses":1632},{"Wins":20460,"Ties":4,"Loses":1636},{"Wins":20456,"Ties":4,"Loses":1640},{"Wins":20452,"Ties":4,"Loses":1644},{"Wins":20448,"Ties":4,"Loses":1648},{"Wins":20444,"Ties":4,"Loses":1652},{"Wins":20440,"Ties":4,"Loses":1656},{"Wins":20436,"Ties":4,"Loses":1660},{"Wins":20432,"Ties":4,"Loses":1664},{"Wins":20428,"Ties":4,"Loses":1668},{"Wins":20424,"Ties":4,"Loses":1672},{"Wins":20420,"Ties":4,"Loses":1676},{"Wins":20416,"Ties":4,"Loses":1680},{"Wins":20412,"Ties":4,"Loses":1684},{"Wins":20408,"Ties":4,"Loses":1688},{"Wins":20404,"Ties":4,"Loses":1692},{"Wins":20400,"Ties":4,"Loses":1696},{"Wins":20396,"Ties":4,"Loses":1700},{"Wins":20392,"Ties":4,"Loses":1704},{"Wins":20388,"Ties":4,"Loses":1708},{"Wins":20384,"Ties":4,"Loses":1712},{"Wins":20380,"Ties":4,"Loses":1716},{"Wins":20376,"Ties":4,"Loses":1720},{"Wins":20372,"Ties":4,"Loses":1724},{"Wins":20368,"Ties":4,"Loses":1728},{"Wins":20364,"Ties":4,"Loses":1732},{"Wins":20360,"Ties":4,"Loses":1736},{"Wins":20356,"Ties":4,"Loses":1740},{"Wins":20352,"Ties":4,"Loses":1744},{"Wins":20348,"Ties":4,"Loses":1748},{"Wins":20344,"Ties":4,"Loses":1752},{"Wins":20340,"Ties":4,"Loses":1756},{"Wins":20336,"Ties":4,"Loses":1760},{"Wins":20332,"Ties":4,"Loses":1764},{"Wins":20328,"Ties":4,"Loses":1768},{"Wins":20324,"Ties":4,"Loses":1772},{"Wins":20320,"Ties":4,"Loses":1776},{"Wins":20316,"Ties":4,"Loses":1780},{"Wins":20312,"Ties":4,"Loses":1784},{"Wins":20308,"Ties":4,"Loses":1788},{"Wins":20304,"Ties":4,"Loses":1792},{"Wins":20300,"Ties":4,"Loses":1796},{"Wins":20296,"Ties":4,"Loses":1800},{"Wins":20292,"Ties":4,"Loses":1804},{"Wins":20288,"Ties":4,"Loses":1808},{"Wins":20284,"Ties":4,"Loses":1812},{"Wins":20280,"Ties":4,"Loses":1816},{"Wins":20276,"Ties":4,"Loses":1820},{"Wins":20272,"Ties":4,"Loses":1824},{"Wins":20268,"Ties":4,"Loses":1828},{"Wins":20264,"Ties":4,"Loses":1832},{"Wins":20260,"Ties":4,"Loses":1836},{"Wins":20256,"Ties":4,"Loses":1840},{"Wins":20252,"Ties":4,"Loses":1844},{"Wins":20248,"Ties":4,"Loses":1848},{"Wins":20244,"Ties":4,"Loses":1852},{"Wins":20240,"Ties":4,"Loses":1856},{"Wins":20236,"Ties":4,"Loses":1860},{"Wins":20232,"Ties":4,"Loses":1864},{"Wins":20228,"Ties":4,"Loses":1868},{"Wins":20224,"Ties":4,"Loses":1872},{"Wins":20220,"Ties":4,"Loses":1876},{"Wins":20216,"Ties":4,"Loses":1880},{"Wins":20212,"Ties":4,"Loses":1884},{"Wins":20208,"Ties":4,"Loses":1888},{"Wins":20204,"Ties":4,"Loses":1892},{"Wins":20200,"Ties":4,"Loses":1896},{"Wins":20196,"Ties":4,"Loses":1900},{"Wins":20192,"Ties":4,"Loses":1904},{"Wins":20188,"Ties":4,"Loses":1908},{"Wins":20184,"Ties":4,"Loses":1912},{"Wins":20160,"Ties":24,"Loses":1916},{"Wins":20136,"Ties":24,"Loses":1940},{"Wins":20112,"Ties":24,"Loses":1964},{"Wins":20088,"Ties":24,"Loses":1988},{"Wins":20064,"Ties":24,"Loses":2012},{"Wins":20040,"Ties":24,"Loses":2036},{"Wins":20016,"Ties":24,"Loses":2060},{"Wins":19992,"Ties":24,"Loses":2084},{"Wins":19968,"Ties":24,"Loses":2108},{"Wins":19944,"Ties":24,"Loses":2132},{"Wins":19920,"Ties":24,"Loses":2156},{"Wins":19896,"Ties":24,"Loses":2180},{"Wins":19872,"Ties":24,"Loses":2204},{"Wins":19848,"Ties":24,"Loses":2228},{"Wins":19824,"Ties":24,"Loses":2252},{"Wins":19800,"Ties":24,"Loses":2276},{"Wins":19776,"Ties":24,"Loses":2300},{"Wins":19752,"Ties":24,"Loses":2324},{"Wins":19728,"Ties":24,"Loses":2348},{"Wins":19704,"Ties":24,"Loses":2372},{"Wins":19680,"Ties":24,"Loses":2396},{"Wins":19656,"Ties":24,"Loses":2420},{"Wins":19632,"Ties":24,"Loses":2444},{"Wins":19608,"Ties":24,"Loses":2468},{"Wins":19584,"Ties":24,"Loses":2492},{"Wins":19560,"Ties":24,"Loses":2516},{"Wins":19536,"Ties":24,"Loses":2540},{"Wins":19512,"Ties":24,"Loses":2564},{"Wins":19488,"Ties":24,"Loses":2588},{"Wins":19464,"Ties":24,"Loses":2612},{"Wins":19440,"Ties":24,"Loses":2636},{"Wins":19416,"Ties":24,"Loses":2660},{"Wins":19392,"Ties":24,"Loses":2684},{"Wins":19368,"Ties":24,"Loses":2708},{"Wins":19344,"Ties":24,"Loses":2732},{"Wins":19320,"Ties":24,"Loses":2756},{"Wins":19296,"Ties":24,"Loses":2780},{"Wins":19272,"Ties":24,"Loses":2804},{"Wins":19248,"Ties":24,"Loses":2828},{"Wins":19224,"Ties":24,"Loses":2852},{"Wins":19200,"Ties":24,"Loses":2876},{"Wins":19176,"Ties":24,"Loses":2900},{"Wins":19152,"Ties":24,"Loses":2924},{"Wins":19128,"Ties":24,"Loses":2948},{"Wins":19104,"Ties":24,"Loses":2972},{"Wins":19080,"Ties":24,"Loses":2996},{"Wins":19056,"Ties":24,"Loses":3020},{"Wins":19032,"Ties":24,"Loses":3044},{"Wins":19008,"Ties":24,"Loses":3068},{"Wins":18984,"Ties":24,"Loses":3092},{"Wins":18960,"Ties":24,"Loses":3116},{"Wins":18936,"Ties":24,"Loses":3140},{"Wins":18912,"Ties":24,"Loses":3164},{"Wins":18888,"Ties":24,"Loses":3188},{"Wins":18864,"Ties":24,"Loses":3212},{"Wins":18840,"Ties":24,"Loses":3236},{"Wins":18816,"Ties":24,"Loses":3260},{"Wins":18792,"Ties":24,"Loses":3284},{"Wins":18768,"Ties":24,"Loses":3308},{"Wins":18744,"Ties":24,"Loses":3332},{"Wins":18720,"Ties":24,"Loses":3356},{"Wins":18696,"Ties":24,"Loses":3380},{"Wins":18672,"Ties":24,"Loses":3404},{"Wins":18648,"Ties":24,"Loses":3428},{"Wins":18624,"Ties":24,"Loses":3452},{"Wins":18600,"Ties":24,"Loses":3476},{"Wins":18576,"Ties":24,"Loses":3500},{"Wins":18552,"Ties":24,"Loses":3524},{"Wins":18528,"Ties":24,"Loses":3548},{"Wins":18504,"Ties":24,"Loses":3572},{"Wins":18480,"Ties":24,"Loses":3596},{"Wins":18456,"Ties":24,"Loses":3620},{"Wins":18432,"Ties":24,"Loses":3644},{"Wins":18408,"Ties":24,"Loses":3668},{"Wins":18384,"Ties":24,"Loses":3692},{"Wins":18360,"Ties":24,"Loses":3716},{"Wins":18336,"Ties":24,"Loses":3740},{"Wins":18312,"Ties":24,"Loses":3764},{"Wins":18288,"Ties":24,"Loses":3788},{"Wins":18264,"Ties":24,"Loses":3812},{"Wins":18240,"Ties":24,"Loses":3836},{"Wins":18216,"Ties":24,"Loses":3860},{"Wins":18192,"Ties":24,"Loses":3884},{"Wins":18168,"Ties":24,"Loses":3908},{"Wins":18144,"Ties":24,"Loses":3932},{"Wins":18120,"Ties":24,"Loses":3956},{"Wins":18096,"Ties":24,"Loses":3980},{"Wins":18072,"Ties":24,"Loses":4004},{"Wins":18048,"Ties":24,"Loses":4028},{"Wins":18024,"Ties":24,"Loses":4052},{"Wins":18000,"Ties":24,"Loses":4076},{"Wins":17976,"Ties":24,"Loses":4100},{"Wins":17952,"Ties":24,"Loses":4124},{"Wins":17928,"Ties":24,"Loses":4148},{"Wins":17904,"Ties":24,"Loses":4172},{"Wins":17880,"Ties":24,"Loses":4196},{"Wins":17856,"Ties":24,"Loses":4220},{"Wins":17832,"Ties":24,"Loses":4244},{"Wins":17808,"Ties":24,"Loses":4268},{"Wins":17784,"Ties":24,"Loses":4292},{"Wins":17760,"Ties":24,"Loses":4316},{"Wins":17736,"Ties":24,"Loses":4340},{"Wins":17712,"Ties":24,"Loses":4364},{"Wins":17688,"Ties":24,"Loses":4388},{"Wins":17664,"Ties":24,"Loses":4412},{"Wins":17640,"Ties":24,"Loses":4436},{"Wins":17616,"Ties":24,"Loses":4460},{"Wins":17592,"Ties":24,"Loses":4484},{"Wins":17568,"Ties":24,"Loses":4508},{"Wins":17544,"Ties":24,"Loses":4532},{"Wins":17520,"Ties":24,"Loses":4556},{"Wins":17496,"Ties":24,"Loses":4580},{"Wins":17472,"Ties":24,"Loses":4604},{"Wins":17448,"Ties":24,"Loses":4628},{"Wins":17424,"Ties":24,"Loses":4652},{"Wins":17400,"Ties":24,"Loses":4676},{"Wins":17376,"Ties":24,"Loses":4700},{"Wins":17352,"Ties":24,"Loses":4724},{"Wins":17328,"Ties":24,"Loses":4748},{"Wins":17304,"Ties":24,"Loses":4772},{"Wins":17280,"Ties":24,"Loses":4796},{"Wins":17256,"Ties":24,"Loses":4820},{"Wins":17232,"Ties":24,"Loses":4844},{"Wins":17208,"Ties":24,"Loses":4868},{"Wins":17184,"Ties":24,"Loses":4892},{"Wins":17160,"Ties":24,"Loses":4916},{"Wins":17136,"Ties":24,"Loses":4940},{"Wins":17112,"Ties":24,"Loses":4964},{"Wins":17088,"Ties":24,"Loses":4988},{"Wins":17064,"Ties":24,"Loses":5012},{"Wins":17040,"Ties":24,"Loses":5036},{"Wins":17016,"Ties":24,"Loses":5060},{"Wins":16992,"Ties":24,"Loses":5084},{"Wins":16968,"Ties":24,"Loses":5108},{"Wins":16944,"Ties":24,"Loses":5132},{"Wins":16920,"Ties":24,"Loses":5156},{"Wins":16896,"Ties":24,"Loses":5180},{"Wins":16872,"Ties":24,"Loses":5204},{"Wins":16848,"Ties":24,"Loses":5228},{"Wins":16824,"Ties":24,"Loses":5252},{"Wins":16800,"Ties":24,"Loses":5276},{"Wins":16776,"Ties":24,"Loses":5300},{"Wins":16752,"Ties":24,"Loses":5324},{"Wins":16728,"Ties":24,"Loses":5348},{"Wins":16704,"Ties":24,"Loses":5372},{"Wins":16680,"Ties":24,"Loses":5396},{"Wins":16656,"Ties":24,"Loses":5420},{"Wins":16632,"Ties":24,"Loses":5444},{"Wins":16608,"Ties":24,"Loses":5468},{"Wins":16584,"Ties":24,"Loses":5492},{"Wins":16560,"Ties":24,"Loses":5516},{"Wins":16536,"Ties":24,"Loses":5540},{"Wins":16512,"Ties":24,"Loses":5564},{"Wins":16488,"Ties":24,"Loses":5588},{"Wins":16464,"Ties":24,"Loses":5612},{"Wins":16440,"Ties":24,"Loses":5636},{"Wins":16380,"Ties":60,"Loses":5660},{"Wins":16320,"Ties":60,"Loses":5720},{"Wins":16260,"Ties":60,"Loses":5780},{"Wins":16200,"Ties":60,"Loses":5840},{"Wins":16140,"Ties":60,"Loses":5900},{"Wins":16080,"Ties":60,"Loses":5960},{"Wins":16020,"Ties":60,"Loses":6020},{"Wins":15960,"Ties":60,"Loses":6080},{"Wins":15900,"Ties":60,"Loses":6140},{"Wins":15840,"Ties":60,"Loses":6200},{"Wins":15780,"Ties":60,"Loses":6260},{"Wins":15720,"Ties":60,"Loses":6320},{"Wins":15660,"Ties":60,"Loses":6380},{"Wins":15600,"Ties":60,"Loses":6440},{"Wins":15540,"Ties":60,"Loses":6500},{"Wins":15480,"Ties":60,"Loses":6560},{"Wins":15420,"Ties":60,"Loses":6620},{"Wins":15360,"Ties":60,"Loses":6680},{"Wins":15300,"Ties":60,"Loses":6740},{"Wins":15240,"Ties":60,"Loses":6800},{"Wins":15180,"Ties":60,"Loses":6860},{"Wins":15120,"Ties":60,"Loses":6920},{"Wins":15060,"Ties":60,"Loses":6980},{"Wins":15000,"Ties":60,"Loses":7040},{"Wins":14940,"Ties":60,"Loses":7100},{"Wins":14880,"Ties":60,"Loses":7160},{"Wins":14820,"Ties":60,"Loses":7220},{"Wins":14760,"Ties":60,"Loses":7280},{"Wins":14700,"Ties":60,"Loses":7340},{"Wins":14640,"Ties":60,"Loses":7400},{"Wins":14580,"Ties":60,"Loses":7460},{"Wins":14520,"Ties":60,"Loses":7520},{"Wins":14460,"Ties":60,"Loses":7580},{"Wins":14400,"Ties":60,"Loses":7640},{"Wins":14340,"Ties":60,"Loses":7700},{"Wins":14280,"Ties":60,"Loses":7760},{"Wins":14220,"Ties":60,"Loses":7820},{"Wins":14160,"Ties":60,"Loses":7880},{"Wins":14100,"Ties":60,"Loses":7940},{"Wins":14040,"Ties":60,"Loses":8000},{"Wins":13980,"Ties":60,"Loses":8060},{"Wins":13920,"Ties":60,"Loses":8120},{"Wins":13860,"Ties":60,"Loses":8180},{"Wins":13800,"Ties":60,"Loses":8240},{"Wins":13740,"Ties":60,"Loses":8300},{"Wins":13680,"Ties":60,"Loses":8360},{"Wins":13620,"Ties":60,"Loses":8420},{"Wins":13560,"Ties":60,"Loses":8480},{"Wins":13500,"Ties":60,"Loses":8540},{"Wins":13440,"Ties":60,"Loses":8600},{"Wins":13380,"Ties":60,"Loses":8660},{"Wins":13320,"Ties":60,"Loses":8720},{"Wins":13260,"Ties":60,"Loses":8780},{"Wins":13200,"Ties":60,"Loses":8840},{"Wins":13140,"Ties":60,"Loses":8900},{"Wins":13080,"Ties":60,"Loses":8960},{"Wins":13020,"Ties":60,"Loses":9020},{"Wins":12960,"Ties":60,"Loses":9080},{"Wins":12900,"Ties":60,"Loses":9140},{"Wins":12840,"Ties":60,"Loses":9200},{"Wins":12780,"Ties":60,"Loses":9260},{"Wins":12720,"Ties":60,"Loses":9320},{"Wins":12660,"Ties":60,"Loses":9380},{"Wins":12600,"Ties":60,"Loses":9440},{"Wins":12540,"Ties":60,"Loses":9500},{"Wins":12480,"Ties":60,"Loses":9560},{"Wins":12420,"Ties":60,"Loses":9620},{"Wins":12360,"Ties":60,"Loses":9680},{"Wins":12300,"Ties":60,"Loses":9740},{"Wins":12240,"Ties":60,"Loses":9800},{"Wins":12180,"Ties":60,"Loses":9860},{"Wins":12120,"Ties":60,"Loses":9920},{"Wins":12060,"Ties":60,"Loses":9980},{"Wins":12000,"Ties":60,"Loses":10040},{"Wins":11940,"Ties":60,"Loses":10100},{"Wins":11880,"Ties":60,"Loses":10160},{"Wins":11820,"Ties":60,"Loses":10220},{"Wins":11760,"Ties":60,"Loses":10280},{"Wins":11700,"Ties":60,"Loses":10340},{"Wins":11640,"Ties":60,"Loses":10400},{"Wins":11580,"Ties":60,"Loses":10460},{"Wins":11520,"Ties":60,"Loses":10520},{"Wins":11460,"Ties":60,"Loses":10580},{"Wins":11400,"Ties":60,"Loses":10640},{"Wins":11340,"Ties":60,"Loses":10700},{"Wins":11280,"Ties":60,"Loses":10760},{"Wins":11220,"Ties":60,"Loses":10820},{"Wins":11160,"Ties":60,"Loses":10880},{"Wins":11100,"Ties":60,"Loses":10940},{"Wins":11040,"Ties":60,"Loses":11000},{"Wins":10980,"Ties":60,"Loses":11060},{"Wins":10920,"Ties":60,"Loses":11120},{"Wins":10860,"Ties":60,"Loses":11180},{"Wins":10800,"Ties":60,"Loses":11240},{"Wins":10740,"Ties":60,"Loses":11300},{"Wins":10680,"Ties":60,"Loses":11360},{"Wins":10620,"Ties":60,"Loses":11420},{"Wins":10560,"Ties":60,"Loses":11480},{"Wins":10500,"Ties":60,"Loses":11540},{"Wins":10440,"Ties":60,"Loses":11600},{"Wins":10380,"Ties":60,"Loses":11660},{"Wins":10320,"Ties":60,"Loses":11720},{"Wins":10260,"Ties":60,"Loses":11780},{"Wins":10200,"Ties":60,"Loses":11840},{"Wins":10140,"Ties":60,"Loses":11900},{"Wins":10080,"Ties":60,"Loses":11960},{"Wins":10020,"Ties":60,"Loses":12020},{"Wins":9960,"Ties":60,"Loses":12080},{"Wins":9900,"Ties":60,"Loses":12140},{"Wins":9840,"Ties":60,"Loses":12200},{"Wins":9780,"Ties":60,"Loses":12260},{"Wins":9720,"Ties":60,"Loses":12320},{"Wins":9660,"Ties":60,"Loses":12380},{"Wins":9600,"Ties":60,"Loses":12440},{"Wins":9540,"Ties":60,"Loses":12500},{"Wins":9480,"Ties":60,"Loses":12560},{"Wins":9420,"Ties":60,"Loses":12620},{"Wins":9360,"Ties":60,"Loses":12680},{"Wins":9300,"Ties":60,"Loses":12740},{"Wins":9240,"Ties":60,"Loses":12800},{"Wins":9180,"Ties":60,"Loses":12860},{"Wins":9120,"Ties":60,"Loses":12920},{"Wins":9060,"Ties":60,"Loses":12980},{"Wins":9000,"Ties":60,"Loses":13040},{"Wins":8940,"Ties":60,"Loses":13100},{"Wins":8880,"Ties":60,"Loses":13160},{"Wins":8820,"Ties":60,"Loses":13220},{"Wins":8760,"Ties":60,"Loses":13280},{"Wins":8700,"Ties":60,"Loses":13340},{"Wins":8640,"Ties":60,"Loses":13400},{"Wins":8580,"Ties":60,"Loses":13460},{"Wins":8520,"Ties":60,"Loses":13520},{"Wins":8460,"Ties":60,"Loses":13580},{"Wins":8400,"Ties":60,"Loses":13640},{"Wins":8340,"Ties":60,"Loses":13700},{"Wins":8280,"Ties":60,"Loses":13760},{"Wins":8220,"Ties":60,"Loses":13820},{"Wins":8160,"Ties":60,"Loses":13880},{"Wins":8100,"Ties":60,"Loses":13940},{"Wins":8040,"Ties":60,"Loses":14000},{"Wins":7980,"Ties":60,"Loses":14060},{"Wins":7920,"Ties":60,"Loses":14120},{"Wins":7860,"Ties":60,"Loses":14180},{"Wins":7800,"Ties":60,"Loses":14240},{"Wins":7740,"Ties":60,"Loses":14300},{"Wins":7680,"Ties":60,"Loses":14360},{"Wins":7620,"Ties":60,"Loses":14420},{"Wins":7560,"Ties":60,"Loses":14480},{"Wins":7500,"Ties":60,"Loses":14540},{"Wins":7440,"Ties":60,"Loses":14600},{"Wins":7380,"Ties":60,"Loses":14660},{"Wins":7320,"Ties":60,"Loses":14720},{"Wins":7260,"Ties":60,"Loses":14780},{"Wins":7200,"Ties":60,"Loses":14840},{"Wins":7140,"Ties":60,"Loses":14900},{"Wins":7080,"Ties":60,"Loses":14960},{"Wins":7020,"Ties":60,"Loses":15020},{"Wins":6960,"Ties":60,"Loses":15080},{"Wins":6900,"Ties":60,"Loses":15140},{"Wins":6840,"Ties":60,"Loses":15200},{"Wins":6780,"Ties":60,"Loses":15260},{"Wins":6720,"Ties":60,"Loses":15320},{"Wins":6660,"Ties":60,"Loses":15380},{"Wins":6600,"Ties":60,"Loses":15440},{"Wins":6540,"Ties":60,"Loses":15500},{"Wins":6480,"Ties":60,"Loses":15560},{"Wins":6420,"Ties":60,"Loses":15620},{"Wins":6360,"Ties":60,"Loses":15680},{"Wins":6300,"Ties":60,"Loses":15740},{"Wins":6240,"Ties":60,"Loses":15800},{"Wins":6180,"Ties":60,"Loses":15860},{"Wins":6120,"Ties":60,"Loses":15920},{"Wins":6060,"Ties":60,"Loses":15980},{"Wins":6000,"Ties":60,"Loses":16040},{"Wins":5940,"Ties":60,"Loses":16100},{"Wins":5880,"Ties":60,"Loses":16160},{"Wins":5820,"Ties":60,"Loses":16220},{"Wins":5760,"Ties":60,"Loses":16280},{"Wins":5700,"Ties":60,"Loses":16340},{"Wins":5640,"Ties":60,"Loses":16400},{"Wins":5580,"Ties":60,"Loses":16460},{"Wins":5520,"Ties":60,"Loses":16520},{"Wins":5460,"Ties":60,"Loses":16580},{"Wins":5400,"Ties":60,"Loses":16640},{"Wins":5340,"Ties":60,"Loses":16700},{"Wins":5280,"Ties":60,"Loses":16760},{"Wins":5220,"Ties":60,"Loses":16820},{"Wins":5160,"Ties":60,"Loses":16880},{"Wins":5100,"Ties":60,"Loses":16940},{"Wins":5040,"Ties":60,"Loses":17000},{"Wins":4980,"Ties":60,"Loses":17060},{"Wins":4920,"Ties":60,"Loses":17120},{"Wins":4860,"Ties":60,"Loses":17180},{"Wins":4800,"Ties":60,"Loses":17240},{"Wins":4740,"Ties":60,"Loses":17300},{"Wins":4680,"Ties":60,"Loses":17360},{"Wins":4620,"Ties":60,"Loses":17420},{"Wins":4560,"Ties":60,"Loses":17480},{"Wins":4500,"Ties":60,"Loses":17540},{"Wins":4440,"Ties":60,"Loses":17600},{"Wins":4380,"Ties":60,"Loses":17660},{"Wins":4320,"Ties":60,"Loses":17720},{"Wins":4260,"Ties":60,"Loses":17780},{"Wins":4200,"Ties":60,"Loses":17840},{"Wins":4140,"Ties":60,"Loses":17900},{"Wins":4080,"Ties":60,"Loses":17960},{"Wins":4020,"Ties":60,"Loses":18020},{"Wins":3960,"Ties":60,"Loses":18080},{"Wins":3900,"Ties":60,"Loses":18140},{"Wins":3840,"Ties":60,"Loses":18200},{"Wins":3780,"Ties":60,"Loses":18260},{"Wins":3720,"Ties":60,"Loses":18320},{"Wins":3660,"Ties":60,"Loses":18380},{"Wins":3600,"Ties":60,"Loses":18440},{"Wins":3540,"Ties":60,"Loses":18500},{"Wins":3480,"Ties":60,"Loses":18560},{"Wins":3420,"Ties":60,"Loses":18620},{"Wins":3360,"Ties":60,"Loses":18680},{"Wins":3300,"Ties":60,"Loses":18740},{"Wins":3240,"Ties":60,"Loses":18800},{"Wins":3180,"Ties":60,"Loses":18860},{"Wins":3120,"Ties":60,"Loses":18920},{"Wins":3060,"Ties":60,"Loses":18980},{"Wins":3000,"Ties":60,"Loses":19040},{"Wins":2940,"Ties":60,"Loses":19100},{"Wins":2880,"Ties":60,"Loses":19160},{"Wins":2820,"Ties":60,"Loses":19220},{"Wins":2760,"Ties":60,"Loses":19280},{"Wins":2700,"Ties":60,"Loses":19340},{"Wins":2640,"Ties":60,"Loses":19400},{"Wins":2580,"Ties":60,"Loses":19460},{"Wins":2520,"Ties":60,"Loses":19520},{"Wins":2460,"Ties":60,"Loses":19580},{"Wins":2400,"Ties":60,"Loses":19640},{"Wins":2340,"Ties":60,"Loses":19700},{"Wins":2280,"Ties":60,"Loses":19760},{"Wins":2220,"Ties":60,"Loses":19820},{"Wins":2160,"Ties":60,"Loses":19880},{"Wins":2100,"Ties":60,"Loses":19940},{"Wins":2040,"Ties":60,"Loses":20000},{"Wins":1980,"Ties":60,"Loses":20060},{"Wins":1920,"Ties":60,"Loses":20120},{"Wins":1860,"Ties":60,"Loses":20180},{"Wins":1800,"Ties":60,"Loses":20240},{"Wins":1740,"Ties":60,"Loses":20300},{"Wins":1680,"Ties":60,"Loses":20360},{"Wins":1620,"Ties":60,"Loses":20420},{"Wins":1560,"Ties":60,"Loses":20480},{"Wins":1500,"Ties":60,"Loses":20540},{"Wins":1440,"Ties":60,"Loses":20600},{"Wins":1380,"Ties":60,"Loses":20660},{"Wins":1320,"Ties":60,"Loses":20720},{"Wins":1260,"Ties":60,"Loses":20780},{"Wins":1200,"Ties":60,"Loses":20840},{"Wins":1140,"Ties":60,"Loses":20900},{"Wins":1080,"Ties":60,"Loses":20960},{"Wins":1020,"Ties":60,"Loses":21020},{"Wins":960,"Ties":60,"Loses":21080},{"Wins":900,"Ties":60,"Loses":21140},{"Wins":840,"Ties":60,"Loses":21200},{"Wins":780,"Ties":60,"Loses":21260},{"Wins":720,"Ties":60,"Loses":21320},{"Wins":660,"Ties":60,"Loses":21380},{"Wins":600,"Ties":60,"Loses":21440},{"Wins":540,"Ties":60,"Loses":21500},{"Wins":480,"Ties":60,"Loses":21560},{"Wins":420,"Ties":60,"Loses":21620},{"Wins":360,"Ties":60,"Loses":21680},{"Wins":300,"Ties":60,"Loses":21740},{"Wins":240,"Ties":60,"Loses":21800},{"Wins":180,"Ties":60,"Loses":21860},{"Wins":120,"Ties":60,"Loses":21920},{"Wins":60,"Ties":60,"Loses":21980},{"Wins":0,"Ties":60,"Loses":22040}]`)
-}
\ No newline at end of file
@@ -0,0 +1,373 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Card is a card numbered 0 to 51 in the same order the analyzer builds
+// its deck: rank*4 + suit, with ranks running deuce to ace and suits
+// C, D, H, S, or the joker. Working with numbers instead of strings
+// lets hands be looked up by index rather than by building map keys.
+type Card int8
+
+// Joker comes after the 52 natural cards
+const Joker Card = 52
+
+// NumCards is the number of different cards, counting the joker
+const NumCards = 53
+
+// NumHands is how many distinct three card hands a single deck has
+const NumHands = 22100
+
+// NumSlots is how many three card hands there are once a shoe can hold
+// more than one of a card, and the size of tables indexed by HandIndex
+const NumSlots = 26235
+
+var rankNames = []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "J", "Q", "K", "A"}
+var suitNames = []string{"C", "D", "H", "S"}
+
+// Rank returns 0 for a deuce up to 12 for an ace
+func (c Card) Rank() int {
+	return int(c) / 4
+}
+
+// Suit returns 0 to 3 for C, D, H, S
+func (c Card) Suit() int {
+	return int(c) % 4
+}
+
+func (c Card) String() string {
+	if c == Joker {
+		return "JKR"
+	}
+	return rankNames[c.Rank()] + suitNames[c.Suit()]
+}
+
+// ParseCard reads a card like "10C" or "AS", or "JKR" for the joker
+func ParseCard(s string) (Card, error) {
+	if strings.EqualFold(s, Joker.String()) {
+		return Joker, nil
+	}
+	if len(s) < 2 {
+		return 0, fmt.Errorf("bad card %q", s)
+	}
+	rank, suit := -1, -1
+	for i, r := range rankNames {
+		if strings.EqualFold(s[:len(s)-1], r) {
+			rank = i
+		}
+	}
+	for i, su := range suitNames {
+		if strings.EqualFold(s[len(s)-1:], su) {
+			suit = i
+		}
+	}
+	if rank < 0 || suit < 0 {
+		return 0, fmt.Errorf("bad card %q", s)
+	}
+	return Card(rank*4 + suit), nil
+}
+
+// ParseCards reads a list of cards separated by commas, spaces or dashes
+func ParseCards(s string) ([]Card, error) {
+	var cards []Card
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '-' }) {
+		c, err := ParseCard(f)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return cards, nil
+}
+
+// ParseHand reads three cards separated by dashes, as used for the keys
+// of the ranking and strategy files. A card can repeat, since a shoe
+// can hold more than one of it.
+func ParseHand(s string) ([3]Card, error) {
+	var hand [3]Card
+	parts := strings.Split(s, "-")
+	if len(parts) != 3 {
+		return hand, fmt.Errorf("bad hand %q: need three cards", s)
+	}
+	for i, p := range parts {
+		c, err := ParseCard(strings.TrimSpace(p))
+		if err != nil {
+			return hand, fmt.Errorf("bad hand %q: %v", s, err)
+		}
+		hand[i] = c
+	}
+	return hand, nil
+}
+
+// NewDeck returns the 52 natural cards in order
+func NewDeck() []Card {
+	deck := make([]Card, Joker)
+	for i := range deck {
+		deck[i] = Card(i)
+	}
+	return deck
+}
+
+// Sort3 puts three cards in increasing order
+func Sort3(a, b, c Card) (Card, Card, Card) {
+	if a > b {
+		a, b = b, a
+	}
+	if b > c {
+		b, c = c, b
+	}
+	if a > b {
+		a, b = b, a
+	}
+	return a, b, c
+}
+
+// HandIndex numbers each three card hand from 0 to NumSlots-1. The
+// cards must be sorted (see Sort3) and may repeat.
+func HandIndex(a, b, c Card) int {
+	x, y, z := int(a), int(b)+1, int(c)+2
+	return x + y*(y-1)/2 + z*(z-1)*(z-2)/6
+}
+
+// handAt returns the sorted cards of a HandIndex
+func handAt(idx int) [3]Card {
+	var hand [3]Card
+	for i := 2; i >= 0; i-- {
+		// Find the largest c with C(c, i+1) <= idx
+		c := i
+		for binomial(c+1, i+1) <= idx {
+			c++
+		}
+		hand[i] = Card(c - i)
+		idx -= binomial(c, i+1)
+	}
+	return hand
+}
+
+func binomial(n, k int) int {
+	if k < 0 || n < k {
+		return 0
+	}
+	r := 1
+	for i := 0; i < k; i++ {
+		r = r * (n - i) / (i + 1)
+	}
+	return r
+}
+
+// HandKey returns the hand as the key used in the ranking and strategy
+// files, with the cards sorted as strings
+func HandKey(a, b, c Card) string {
+	return handtostring([]string{a.String(), b.String(), c.String()})
+}
+
+// keyOrder returns, for each position in a sorted hand's key, which of
+// the sorted cards is there. Repeated cards keep their order.
+func keyOrder(sorted [3]Card) [3]int {
+	order := [3]int{0, 1, 2}
+	sort.SliceStable(order[:], func(i, j int) bool {
+		return sorted[order[i]].String() < sorted[order[j]].String()
+	})
+	return order
+}
+
+// Holds says which cards each hand keeps, by HandIndex. Bit i of a
+// hand's mask keeps the i'th of its cards in Card order.
+type Holds [NumSlots]uint8
+
+// Tables holds the rank and winner data for a shoe and rules, indexed
+// by hand so callers don't need to go through the string keyed ranking
+// map
+type Tables struct {
+	Shoe  *Shoe
+	Rules Rules
+
+	// Rank of every hand the shoe can deal by key (0 is best) and, for
+	// each rank, how many ways there are to be dealt a worse, equal or
+	// better hand
+	Ranking map[string]int
+	Winners []WinRatio
+
+	// Hands the shoe can deal by HandIndex, the ways each can be
+	// dealt and their rank
+	hands     []int32
+	ways      [NumSlots]int64
+	totalWays int64
+	rank      [NumSlots]int16
+
+	// the cards each hand plays as once wild cards are resolved
+	resolved [NumSlots][3]Card
+
+	// canonical hand (lowest HandIndex over every relabeling of the
+	// suits that leaves the shoe the same) and the relabeling that
+	// gets there, by HandIndex
+	canon [NumSlots]int32
+	perm  [NumSlots]uint8
+
+	draws drawTable
+}
+
+// suitPerms lists the 24 ways to relabel the suits
+var suitPerms = func() [][4]int {
+	var perms [][4]int
+	for a := 0; a < 4; a++ {
+		for b := 0; b < 4; b++ {
+			for c := 0; c < 4; c++ {
+				d := 6 - a - b - c
+				if a != b && a != c && b != c && d != a && d != b && d != c {
+					perms = append(perms, [4]int{a, b, c, d})
+				}
+			}
+		}
+	}
+	return perms
+}()
+
+// Relabels the card's suit with permutation p
+func permute(c Card, p int) Card {
+	if c == Joker {
+		return c
+	}
+	return Card(c.Rank()*4 + suitPerms[p][c.Suit()])
+}
+
+// LoadTables returns the tables for a single deck under the skill's
+// rules
+func LoadTables() (*Tables, error) {
+	return NewTables(NewShoe(1), SkillRules)
+}
+
+// NewTables ranks every hand the shoe can deal under the rules and
+// works out the winners table for them
+func NewTables(shoe *Shoe, rules Rules) (*Tables, error) {
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	// Enough for a player and a dealer to each draw three
+	if shoe.Size() < 12 {
+		return nil, fmt.Errorf("a shoe of %d cards is too small to deal from", shoe.Size())
+	}
+	t := &Tables{Shoe: shoe, Rules: rules, Ranking: make(map[string]int)}
+
+	// Ranks are the distinct hand values in order
+	var values []int
+	value := make(map[int32]int)
+	seen := make(map[int]bool)
+	for idx := 0; idx < NumSlots; idx++ {
+		hand := handAt(idx)
+		t.rank[idx] = -1
+		if t.ways[idx] = shoe.ways(hand); t.ways[idx] == 0 {
+			continue
+		}
+		t.hands = append(t.hands, int32(idx))
+		t.totalWays += t.ways[idx]
+		v, played := rules.resolve(hand)
+		value[int32(idx)] = v
+		t.resolved[idx] = played
+		if !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	sort.Ints(values)
+	rankOf := make(map[int]int, len(values))
+	for r, v := range values {
+		rankOf[v] = r
+	}
+
+	byRank := make([]int64, len(values))
+	for _, idx := range t.hands {
+		hand := handAt(int(idx))
+		r := rankOf[value[idx]]
+		t.rank[idx] = int16(r)
+		t.Ranking[HandKey(hand[0], hand[1], hand[2])] = r
+		byRank[r] += t.ways[idx]
+	}
+	var better int64
+	for _, n := range byRank {
+		t.Winners = append(t.Winners, WinRatio{Wins: int(t.totalWays - better - n), Ties: int(n), Loses: int(better)})
+		better += n
+	}
+
+	// Hands are only equivalent under relabelings that leave the
+	// shoe the same
+	var perms []int
+	for p := range suitPerms {
+		same := true
+		for c := Card(0); c < NumCards; c++ {
+			same = same && shoe.Count(permute(c, p)) == shoe.Count(c)
+		}
+		if same {
+			perms = append(perms, p)
+		}
+	}
+	for _, idx := range t.hands {
+		hand := handAt(int(idx))
+		t.canon[idx] = idx
+		for _, p := range perms {
+			x, y, z := Sort3(permute(hand[0], p), permute(hand[1], p), permute(hand[2], p))
+			if i := HandIndex(x, y, z); i < int(t.canon[idx]) {
+				t.canon[idx] = int32(i)
+				t.perm[idx] = uint8(p)
+			}
+		}
+	}
+	return t, nil
+}
+
+// Rank returns the rank of three cards in any order (0 is best)
+func (t *Tables) Rank(a, b, c Card) int {
+	a, b, c = Sort3(a, b, c)
+	return int(t.rank[HandIndex(a, b, c)])
+}
+
+// Classify returns the class of the hand the cards play as
+func (t *Tables) Classify(hand [3]Card) HandClass {
+	a, b, c := Sort3(hand[0], hand[1], hand[2])
+	played := t.resolved[HandIndex(a, b, c)]
+	return t.Rules.Classify(played[0], played[1], played[2])
+}
+
+// Ways returns how many ways the shoe can deal a hand (by HandIndex)
+func (t *Tables) Ways(idx int) int64 {
+	return t.ways[idx]
+}
+
+// weight is the chance of being dealt a hand (by HandIndex)
+func (t *Tables) weight(idx int) float64 {
+	return float64(t.ways[idx]) / float64(t.totalWays)
+}
+
+// Hands calls fn with every hand the shoe can deal
+func (t *Tables) Hands(fn func(idx int, hand [3]Card)) {
+	for _, idx := range t.hands {
+		fn(int(idx), handAt(int(idx)))
+	}
+}
+
+// canonPositions returns the hand's canonical hand and where each of
+// the hand's cards lands in it
+func (t *Tables) canonPositions(hand [3]Card) (int, [3]int) {
+	x, y, z := Sort3(hand[0], hand[1], hand[2])
+	idx := HandIndex(x, y, z)
+	p := int(t.perm[idx])
+	canon := handAt(int(t.canon[idx]))
+
+	// A repeated card takes the first place not already used
+	var pos [3]int
+	var used [3]bool
+	for i, c := range hand {
+		pc := permute(c, p)
+		for j, cc := range canon {
+			if cc == pc && !used[j] {
+				pos[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+	return int(t.canon[idx]), pos
+}
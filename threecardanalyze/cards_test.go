@@ -0,0 +1,37 @@
+package threecardanalyze
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// TestSkillTables checks the tables built for the skill's game against
+// the ranking and winners tables the skill has always shipped
+func TestSkillTables(t *testing.T) {
+	var ranks map[string]int
+	var winners []WinRatio
+	for file, v := range map[string]interface{}{"testdata/ranks.json": &ranks, "testdata/winners.json": &winners} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = json.Unmarshal(data, v); err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+	}
+
+	tables := houseTables(t)
+	if len(tables.Ranking) != len(ranks) {
+		t.Errorf("%d hands ranked, want %d", len(tables.Ranking), len(ranks))
+	}
+	for key, want := range ranks {
+		if got, ok := tables.Ranking[key]; !ok || got != want {
+			t.Errorf("%s: rank %d (%t), want %d", key, got, ok, want)
+		}
+	}
+	if !reflect.DeepEqual(tables.Winners, winners) {
+		t.Errorf("winners differ: %d ranks, want %d", len(tables.Winners), len(winners))
+	}
+}
@@ -0,0 +1,365 @@
+package threecardanalyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+)
+
+// A chart is the strategy boiled down to an ordered list of rules a
+// player can learn: play the first rule the hand matches, and draw
+// three if none do. Each rule holds one kind of card group, optionally
+// only when its top card is high enough.
+
+// holdKind is the kind of card group a chart rule holds
+type holdKind int
+
+const (
+	holdMade holdKind = iota // all three cards, by class
+	holdPair
+	holdStraightFlushDraw // two suited cards that can make a straight
+	holdSuited
+	holdStraightDraw // two unsuited cards that can make a straight
+	holdTwo
+	holdOne
+	holdWild // every wild card
+	numHoldKinds
+)
+
+// ChartRule is one line of a chart
+type ChartRule struct {
+	kind    holdKind
+	class   HandClass // for holdMade
+	minRank int       // the group's top card (or pair) must be at least this
+}
+
+func (r ChartRule) String() string {
+	var desc string
+	switch r.kind {
+	case holdMade:
+		desc = map[HandClass]string{StraightFlush: "a straight flush", Trips: "three of a kind", Straight: "a straight",
+			Flush: "a flush", Pair: "a pair and its kicker", HighCard: "three unpaired cards"}[r.class]
+	case holdPair:
+		desc = "a pair"
+		if r.minRank > 0 {
+			return fmt.Sprintf("Hold a pair of %ss or better", rankNames[r.minRank])
+		}
+	case holdStraightFlushDraw:
+		desc = "two to a straight flush"
+	case holdSuited:
+		desc = "two suited cards"
+	case holdStraightDraw:
+		desc = "two to a straight"
+	case holdTwo:
+		desc = "two unsuited cards"
+	case holdOne:
+		desc = "one card"
+	case holdWild:
+		return "Hold every wild card"
+	}
+	if r.minRank > 0 {
+		if r.kind == holdMade && r.class == Pair {
+			return fmt.Sprintf("Hold a pair of %ss or better and its kicker", rankNames[r.minRank])
+		}
+		return fmt.Sprintf("Hold %s, %s or better", desc, rankNames[r.minRank])
+	}
+	return "Hold " + desc
+}
+
+// chartRules is every rule the learner can choose from, in chart order:
+// made hands strongest first, then the groups from a pair down, each
+// from its lowest threshold up
+func chartRules() []ChartRule {
+	var rules []ChartRule
+	for _, class := range classOrder[3] {
+		for r := 0; r < 13; r++ {
+			rules = append(rules, ChartRule{kind: holdMade, class: class, minRank: r})
+		}
+	}
+	for kind := holdPair; kind < holdWild; kind++ {
+		for r := 0; r < 13; r++ {
+			rules = append(rules, ChartRule{kind: kind, minRank: r})
+		}
+	}
+	return append(rules, ChartRule{kind: holdWild})
+}
+
+// chartGroup is the best group of a kind in a hand: the cards to hold
+// as a mask over the sorted hand, and how high it is
+type chartGroup struct {
+	mask   uint8
+	class  HandClass
+	top    int
+	second int // breaks ties between groups with the same top card
+	found  bool
+}
+
+// chartGroups finds the best group of each kind in a sorted hand
+func (t *Tables) chartGroups(idx int, hand [3]Card) [numHoldKinds]chartGroup {
+	var groups [numHoldKinds]chartGroup
+	played := t.resolved[idx]
+	class := t.Rules.Classify(played[0], played[1], played[2])
+	top := 0
+	for _, c := range played {
+		top = max(top, c.Rank())
+	}
+	if class == Pair {
+		if played[0].Rank() == played[1].Rank() || played[0].Rank() == played[2].Rank() {
+			top = played[0].Rank()
+		} else {
+			top = played[1].Rank()
+		}
+	}
+	groups[holdMade] = chartGroup{mask: 7, class: class, top: top, found: true}
+
+	// Groups of natural cards, keeping the highest of each kind
+	better := func(kind holdKind, mask uint8, top, second int) {
+		g := &groups[kind]
+		if !g.found || top > g.top || (top == g.top && second > g.second) {
+			*g = chartGroup{mask: mask, top: top, second: second, found: true}
+		}
+	}
+	var wild uint8
+	for i, c := range hand {
+		if t.Rules.Wild(c) {
+			wild |= 1 << i
+			continue
+		}
+		better(holdOne, 1<<i, c.Rank(), 0)
+		for j := i + 1; j < 3; j++ {
+			d := hand[j]
+			if t.Rules.Wild(d) {
+				continue
+			}
+			lo, hi := min(c.Rank(), d.Rank()), max(c.Rank(), d.Rank())
+			mask := uint8(1<<i | 1<<j)
+			suited := c.Suit() == d.Suit()
+			switch {
+			case lo == hi:
+				better(holdPair, mask, hi, 0)
+			case suited && t.Rules.canStraight(lo, hi, true):
+				better(holdStraightFlushDraw, mask, hi, lo)
+			case suited:
+				better(holdSuited, mask, hi, lo)
+			case t.Rules.canStraight(lo, hi, false):
+				better(holdStraightDraw, mask, hi, lo)
+			default:
+				better(holdTwo, mask, hi, lo)
+			}
+		}
+	}
+	if wild != 0 {
+		groups[holdWild] = chartGroup{mask: wild, found: true}
+	}
+	return groups
+}
+
+// canStraight says whether two unpaired ranks fit in a straight, or a
+// straight flush if suited
+func (r Rules) canStraight(lo, hi int, suited bool) bool {
+	if hi-lo <= 2 {
+		return true
+	}
+	aceLow := r.A23Straight
+	if suited {
+		aceLow = r.A23StraightFlush
+	}
+	return hi == 12 && lo <= 1 && aceLow != "" && aceLow != AceLowNone
+}
+
+// matches says which cards the rule holds in a hand, given its groups
+func (r ChartRule) matches(groups *[numHoldKinds]chartGroup) (uint8, bool) {
+	g := groups[r.kind]
+	if !g.found || g.top < r.minRank || (r.kind == holdMade && g.class != r.class) {
+		return 0, false
+	}
+	return g.mask, true
+}
+
+// ChartStep is a rule with what the chart loses by stopping there
+type ChartStep struct {
+	Rule string `json:"rule"`
+
+	// Chance of being dealt a hand the rule decides
+	Share float64 `json:"share"`
+
+	// Chance of winning and return per unit bet lost against the
+	// reference strategy by playing the rules so far and drawing three
+	// to every other hand
+	WinLost    float64 `json:"winLost"`
+	ReturnLost float64 `json:"returnLost"`
+}
+
+// chartHand is what the learner needs to know about one hand
+type chartHand struct {
+	weight float64
+	groups [numHoldKinds]chartGroup
+	odds   [8]Odds
+	ref    uint8
+}
+
+// LearnChart builds a chart for the reference holds greedily. Each
+// step adds the rule that loses least, per hand it decides, among the
+// hands no earlier rule decides and where it beats drawing three. When
+// several lose exactly as little, the first in chart order goes first,
+// so made hands are listed strongest first. Otherwise rules within
+// tolerance of the least are weighed by how many hands they decide, so
+// broad rules come before narrow ones that are no better. A rule that
+// only lowers the threshold of the one before it is merged into it.
+func (a *Analyzer) LearnChart(ref *Holds, maxRules int, tolerance float64) []ChartStep {
+	a.Analyze()
+	t := a.Tables
+	var hands []chartHand
+	t.Hands(func(idx int, hand [3]Card) {
+		hands = append(hands, chartHand{weight: t.weight(idx), groups: t.chartGroups(idx, hand), odds: a.HoldOdds(hand), ref: ref[idx]})
+	})
+	ret := func(o Odds) float64 { return o.Win - o.Lose }
+
+	// Start from drawing three to everything
+	var winLost, returnLost float64
+	for _, h := range hands {
+		winLost += h.weight * (h.odds[h.ref].Win - h.odds[0].Win)
+		returnLost += h.weight * (ret(h.odds[h.ref]) - ret(h.odds[0]))
+	}
+
+	candidates := chartRules()
+	decided := make([]bool, len(hands))
+	var steps []ChartStep
+	var last ChartRule
+	for {
+		best, bestShare := -1, 0.0
+		shares := make([]float64, len(candidates))
+		losses := make([]float64, len(candidates))
+		gains := make([]float64, len(candidates))
+		for i, rule := range candidates {
+			for j := range hands {
+				h := &hands[j]
+				if decided[j] {
+					continue
+				}
+				if mask, ok := rule.matches(&h.groups); ok {
+					shares[i] += h.weight
+					losses[i] += h.weight * (a.score(h.odds[h.ref]) - a.score(h.odds[mask]))
+					gains[i] += h.weight * (a.score(h.odds[mask]) - a.score(h.odds[0]))
+				}
+			}
+		}
+		// A rule that does worse than drawing three isn't worth adding
+		least := 0.0
+		for i := range candidates {
+			if gains[i] <= 0 {
+				shares[i] = 0
+			}
+			if shares[i] > 0 && (best < 0 || losses[i]/shares[i] < least) {
+				best, least = i, losses[i]/shares[i]
+			}
+		}
+		if best < 0 {
+			break
+		}
+		tied := 0
+		for i := range candidates {
+			if shares[i] > 0 && losses[i]/shares[i] <= least+tieTolerance {
+				tied++
+			}
+		}
+		for i := range candidates {
+			if shares[i] == 0 {
+				continue
+			}
+			if tied > 1 {
+				// candidates are in chart order
+				if losses[i]/shares[i] <= least+tieTolerance {
+					best, bestShare = i, shares[i]
+					break
+				}
+			} else if losses[i]/shares[i] <= least+tolerance && shares[i] > bestShare {
+				best, bestShare = i, shares[i]
+			}
+		}
+
+		// The same group with a lower threshold extends the rule before,
+		// which already holds the hands above it the same way
+		rule := candidates[best]
+		merge := len(steps) > 0 && rule.kind == last.kind && rule.class == last.class
+		if !merge && len(steps) == maxRules {
+			break
+		}
+		for j := range hands {
+			h := &hands[j]
+			if decided[j] {
+				continue
+			}
+			if mask, ok := rule.matches(&h.groups); ok {
+				decided[j] = true
+				winLost += h.weight * (h.odds[0].Win - h.odds[mask].Win)
+				returnLost += h.weight * (ret(h.odds[0]) - ret(h.odds[mask]))
+			}
+		}
+		candidates = append(candidates[:best], candidates[best+1:]...)
+		if merge {
+			rule.minRank = min(rule.minRank, last.minRank)
+			s := &steps[len(steps)-1]
+			*s = ChartStep{Rule: rule.String(), Share: s.Share + bestShare, WinLost: winLost, ReturnLost: returnLost}
+		} else {
+			steps = append(steps, ChartStep{Rule: rule.String(), Share: bestShare, WinLost: winLost, ReturnLost: returnLost})
+		}
+		last = rule
+	}
+	return steps
+}
+
+// Chart is the chart command: the strategy as an ordered list of rules
+// and what each shorter list costs
+func Chart(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard chart", "Summarizes a strategy as an ordered list of rules.")
+	strategyFile := fs.String("strategy", "", "strategy file to summarize (default the analyzer's best holds)")
+	out := fs.String("o", "", "file to write the chart to as JSON")
+	maxRules := fs.Int("max-rules", 20, "most rules to learn")
+	tolerance := fs.Float64("tolerance", 0.001, "loss per hand, as a chance of winning, within which broader rules are preferred")
+	game := addGameFlags(fs, "removed")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *maxRules < 1 || *tolerance < 0 {
+		return cli.Usagef("-max-rules must be at least 1 and -tolerance can't be negative")
+	}
+
+	tables, err := game.tables()
+	if err != nil {
+		return err
+	}
+	dealerHolds, err := dealer.holds(tables, func() (*Holds, error) {
+		return NewAnalyzer(tables, StaticField(tables)).BestHolds(), nil
+	})
+	if err != nil {
+		return err
+	}
+	analyzer := NewAnalyzer(tables, fieldFor(tables, dealerHolds))
+	ref := analyzer.BestHolds()
+	if *strategyFile != "" {
+		strategy, err := LoadStrategy(*strategyFile)
+		if err != nil {
+			return err
+		}
+		if ref, err = strategy.HoldMasks(tables); err != nil {
+			return err
+		}
+	}
+
+	steps := analyzer.LearnChart(ref, *maxRules, *tolerance)
+	fmt.Printf("Shoe: %s, %s, %s dealer\n\n", tables.Shoe, tables.Rules, dealer.policy)
+	fmt.Printf("%3s  %-48s %8s %10s %12s\n", "#", "Rule", "Hands", "Win lost", "Return lost")
+	for i, s := range steps {
+		fmt.Printf("%3d  %-48s %7.3f%% %9.4f%% %+12.5f\n", i+1, s.Rule, 100*s.Share, 100*s.WinLost, s.ReturnLost)
+	}
+	fmt.Println("     Otherwise draw three")
+	if *out == "" {
+		return nil
+	}
+	result, _ := json.MarshalIndent(steps, "", "  ")
+	return os.WriteFile(*out, result, 0644)
+}
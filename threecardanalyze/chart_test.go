@@ -0,0 +1,43 @@
+package threecardanalyze
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLearnChart(t *testing.T) {
+	a := houseAnalyzer(t)
+	steps := a.LearnChart(a.BestHolds(), 15, 0.001)
+	if len(steps) != 15 {
+		t.Fatalf("%d steps, want 15", len(steps))
+	}
+
+	// Made hands lose nothing, so they tie and go strongest first
+	for i, want := range []string{"Hold a straight flush", "Hold three of a kind", "Hold a straight", "Hold a flush"} {
+		if steps[i].Rule != want {
+			t.Errorf("rule %d is %q, want %q", i+1, steps[i].Rule, want)
+		}
+	}
+
+	share := 0.0
+	for i, s := range steps {
+		share += s.Share
+		if i == 0 {
+			continue
+		}
+		if s.WinLost > steps[i-1].WinLost {
+			t.Errorf("rule %d (%s) loses more than the chart before it", i+1, s.Rule)
+		}
+		// Rules differing only by threshold are merged
+		group := func(rule string) string { return strings.Split(rule, ",")[0] }
+		if group(s.Rule) == group(steps[i-1].Rule) {
+			t.Errorf("rules %d and %d are both %q", i, i+1, group(s.Rule))
+		}
+	}
+	if share > 1+1e-9 {
+		t.Errorf("the rules decide %g of the hands", share)
+	}
+	if last := steps[len(steps)-1]; last.Rule != "Hold two to a straight flush, 6 or better" {
+		t.Errorf("last rule %q", last.Rule)
+	}
+}
@@ -0,0 +1,222 @@
+package threecardanalyze
+
+// HandClass is the kind of hand. The three card classes come first,
+// best first, in the order the ranking table uses; the classes only
+// bigger hands can make follow.
+type HandClass int
+
+const (
+	StraightFlush HandClass = iota
+	Trips
+	Straight
+	Flush
+	Pair
+	HighCard
+	FourOfAKind
+	FullHouse
+	TwoPair
+	NumClasses
+)
+
+var classNames = []string{"straight flush", "three of a kind", "straight", "flush", "pair", "high card",
+	"four of a kind", "full house", "two pair"}
+
+// classOrder is how the classes rank for each hand size, best first.
+// Four card hands rank as in Four Card Poker and five card hands as in
+// five card draw.
+var classOrder = map[int][]HandClass{
+	3: {StraightFlush, Trips, Straight, Flush, Pair, HighCard},
+	4: {FourOfAKind, StraightFlush, Trips, Flush, Straight, TwoPair, Pair, HighCard},
+	5: {StraightFlush, FourOfAKind, FullHouse, Flush, Straight, Trips, TwoPair, Pair, HighCard},
+}
+
+func (h HandClass) String() string {
+	if h < 0 || h >= NumClasses {
+		return "unknown"
+	}
+	return classNames[h]
+}
+
+// Classify returns the class of three natural cards under the skill's
+// rules, where an ace plays high or low in a straight (A-K-Q and A-2-3
+// both count)
+func Classify(a, b, c Card) HandClass {
+	return SkillRules.Classify(a, b, c)
+}
+
+// Classify returns the class of three natural cards under the rules
+func (r Rules) Classify(a, b, c Card) HandClass {
+	a, b, c = Sort3(a, b, c)
+	ra, rb, rc := a.Rank(), b.Rank(), c.Rank()
+	flush := a.Suit() == b.Suit() && b.Suit() == c.Suit()
+	straight := rb == ra+1 && rc == rb+1
+	if ra == 0 && rb == 1 && rc == 12 {
+		if flush {
+			straight = r.A23StraightFlush != "" && r.A23StraightFlush != AceLowNone
+		} else {
+			straight = r.A23Straight != "" && r.A23Straight != AceLowNone
+		}
+	}
+
+	switch {
+	case straight && flush:
+		return StraightFlush
+	case ra == rc:
+		return Trips
+	case straight:
+		return Straight
+	case flush:
+		return Flush
+	case ra == rb || rb == rc:
+		return Pair
+	}
+	return HighCard
+}
+
+// order is where the class ranks under the rules, best first
+func (r Rules) order(class HandClass) int {
+	if r.FlushBeatsStraight {
+		switch class {
+		case Straight:
+			return int(Flush)
+		case Flush:
+			return int(Straight)
+		}
+	}
+	return int(class)
+}
+
+// evaluate scores natural cards so that better hands score lower and
+// hands that tie score the same: the class first, then the ranks that
+// break ties within it from the highest down, then the suits if the
+// rules say they break ties.
+func (r Rules) evaluate(hand [3]Card) int {
+	a, b, c := Sort3(hand[0], hand[1], hand[2])
+	ra, rb, rc := a.Rank(), b.Rank(), c.Rank()
+	class := r.Classify(a, b, c)
+
+	high := [3]int{rc, rb, ra}
+	switch class {
+	case StraightFlush, Straight:
+		high = [3]int{rc, 0, 0}
+		if ra == 0 && rb == 1 && rc == 12 {
+			aceLow := r.A23Straight
+			if class == StraightFlush {
+				aceLow = r.A23StraightFlush
+			}
+			if aceLow == AceLowSecond {
+				high = [3]int{11, 1, 0}
+			} else {
+				high = [3]int{1, 0, 0}
+			}
+		}
+	case Trips:
+		high = [3]int{rc, 0, 0}
+	case Pair:
+		if ra == rb {
+			high = [3]int{ra, rc, 0}
+		} else {
+			high = [3]int{rb, ra, 0}
+		}
+	}
+	v := r.order(class)*2197 + 2196 - (high[0]*169 + high[1]*13 + high[2])
+	if r.SuitTiebreak {
+		// Sorted cards run from clubs to spades within a rank, so
+		// going down from c puts the best suit of a rank first
+		v = v*64 + 63 - (c.Suit()*16 + b.Suit()*4 + a.Suit())
+	}
+	return v
+}
+
+// classifyN returns the class of four or five natural cards. An ace
+// plays high or low in a straight.
+func classifyN(hand []Card) HandClass {
+	class, _ := shapeN(hand)
+	return class
+}
+
+// shapeN works out the class of four or five natural cards and the
+// ranks that break ties within it, most significant first
+func shapeN(hand []Card) (HandClass, []int) {
+	var counts [13]int
+	flush := true
+	for _, c := range hand {
+		counts[c.Rank()]++
+		flush = flush && c.Suit() == hand[0].Suit()
+	}
+
+	// Ranks with more cards first, then higher ranks
+	var ranks []int
+	for n := len(hand); n > 0; n-- {
+		for r := 12; r >= 0; r-- {
+			if counts[r] == n {
+				ranks = append(ranks, r)
+			}
+		}
+	}
+
+	straight := false
+	if len(ranks) == len(hand) {
+		if ranks[0]-ranks[len(ranks)-1] == len(hand)-1 {
+			straight = true
+		} else if ranks[0] == 12 && ranks[1] == len(hand)-2 {
+			// The ace plays low, under the rest
+			straight = true
+			ranks = append(ranks[1:], -1)
+		}
+	}
+
+	switch {
+	case straight && flush:
+		return StraightFlush, ranks[:1]
+	case counts[ranks[0]] == 4:
+		return FourOfAKind, ranks
+	case counts[ranks[0]] == 3 && len(ranks) == 2 && len(hand) == 5:
+		return FullHouse, ranks
+	case flush:
+		return Flush, ranks
+	case straight:
+		return Straight, ranks[:1]
+	case counts[ranks[0]] == 3:
+		return Trips, ranks
+	case counts[ranks[0]] == 2 && counts[ranks[1]] == 2:
+		return TwoPair, ranks
+	case counts[ranks[0]] == 2:
+		return Pair, ranks
+	}
+	return HighCard, ranks
+}
+
+// evaluateN scores three to five natural cards like evaluate, using the
+// class order for the hand size. Three card hands go through evaluate,
+// so all of the rules apply; bigger hands only take the suit tiebreak.
+func (r Rules) evaluateN(hand []Card) int {
+	if len(hand) == 3 {
+		return r.evaluate([3]Card{hand[0], hand[1], hand[2]})
+	}
+	class, ranks := shapeN(hand)
+	order := 0
+	for i, c := range classOrder[len(hand)] {
+		if c == class {
+			order = i
+		}
+	}
+	high := 0
+	for i := 0; i < 5; i++ {
+		high *= 14
+		if i < len(ranks) {
+			// -1 is the low ace of a wheel
+			high += ranks[i] + 1
+		}
+	}
+	v := order*537824 + 537823 - high
+	if r.SuitTiebreak {
+		// The best suit of the highest card first
+		key := 0
+		for i := len(hand) - 1; i >= 0; i-- {
+			key = key*4 + hand[i].Suit()
+		}
+		v = v<<(2*len(hand)) + (1<<(2*len(hand)) - 1 - key)
+	}
+	return v
+}
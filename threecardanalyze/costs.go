@@ -0,0 +1,105 @@
+package threecardanalyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// HoldCost is what a hold gives up against the best hold for a hand
+type HoldCost struct {
+	// Positions within the hand key, as in suggest.json
+	Hold []int `json:"hold"`
+
+	// Chance of winning (or whatever the analyzer maximizes) lost
+	Cost float64 `json:"cost"`
+
+	// Return per unit bet lost
+	Return float64 `json:"return"`
+}
+
+// CostTable maps a hand key to the cost of every hold other than the
+// best, least costly first
+type CostTable map[string][]HoldCost
+
+// Costs works out the cost of every mistake for every hand the shoe
+// can deal
+func (a *Analyzer) Costs() CostTable {
+	a.Analyze()
+	costs := make(CostTable, len(a.Tables.hands))
+	a.Tables.Hands(func(idx int, hand [3]Card) {
+		odds := a.HoldOdds(hand)
+		best, _ := a.pick(hand, a.HoldOdds)
+		ret := func(o Odds) float64 { return o.Win - o.Lose }
+		holds := make([]HoldCost, 0, len(holdOrder)-1)
+		for _, hold := range holdOrder {
+			mask := positionsMask(hold)
+			if mask == best {
+				continue
+			}
+			holds = append(holds, HoldCost{
+				Hold:   holdPositions(hand, mask),
+				Cost:   a.score(odds[best]) - a.score(odds[mask]),
+				Return: ret(odds[best]) - ret(odds[mask]),
+			})
+		}
+		sort.SliceStable(holds, func(i, j int) bool {
+			return holds[i].Cost < holds[j].Cost
+		})
+		costs[HandKey(hand[0], hand[1], hand[2])] = holds
+	})
+	return costs
+}
+
+// LoadCosts reads a cost table written by analyze -costs
+func LoadCosts(filename string) (CostTable, error) {
+	dat, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var c CostTable
+	if err = json.Unmarshal(dat, &c); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return c, nil
+}
+
+// Save writes the cost table out as JSON
+func (c CostTable) Save(filename string) error {
+	result, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, result, 0644)
+}
+
+// Cost returns what holding the positions (within the hand key, in any
+// order) gives up against the best hold. The best hold costs nothing.
+func (c CostTable) Cost(key string, hold []int) (HoldCost, error) {
+	holds, ok := c[key]
+	if !ok {
+		return HoldCost{}, fmt.Errorf("no costs for %s", key)
+	}
+	for _, p := range hold {
+		if p < 0 || p > 2 {
+			return HoldCost{}, fmt.Errorf("%s: bad hold position %d", key, p)
+		}
+	}
+	for _, h := range holds {
+		if positionsMask(h.Hold) == positionsMask(hold) {
+			return h, nil
+		}
+	}
+	return HoldCost{Hold: hold}, nil
+}
+
+// Mistakes returns the hand's mistakes worst first
+func (c CostTable) Mistakes(key string) []HoldCost {
+	holds := c[key]
+	worst := make([]HoldCost, len(holds))
+	for i, h := range holds {
+		worst[len(holds)-1-i] = h
+	}
+	return worst
+}
@@ -0,0 +1,85 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCosts(t *testing.T) {
+	a := houseAnalyzer(t)
+	costs := a.Costs()
+	if len(costs) != NumHands {
+		t.Fatalf("costs for %d hands, want %d", len(costs), NumHands)
+	}
+
+	best := a.BestHolds()
+	a.Tables.Hands(func(idx int, hand [3]Card) {
+		key := HandKey(hand[0], hand[1], hand[2])
+		holds := costs[key]
+		if len(holds) != len(holdOrder)-1 {
+			t.Fatalf("%s: %d mistakes, want %d", key, len(holds), len(holdOrder)-1)
+		}
+		odds := a.HoldOdds(hand)
+		seen := map[uint8]bool{best[idx]: true}
+		for i, h := range holds {
+			// Positions are within the key, which sorts as strings
+			mask, err := holdMask(key, h.Hold, hand)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if seen[mask] {
+				t.Fatalf("%s: hold %v listed twice or is the best hold", key, h.Hold)
+			}
+			seen[mask] = true
+			win := odds[best[idx]].Win - odds[mask].Win
+			ret := (odds[best[idx]].Win - odds[best[idx]].Lose) - (odds[mask].Win - odds[mask].Lose)
+			if h.Cost != win || h.Return != ret || h.Cost < 0 {
+				t.Fatalf("%s %v: cost %g and return %g, want %g and %g", key, h.Hold, h.Cost, h.Return, win, ret)
+			}
+			if i > 0 && h.Cost < holds[i-1].Cost {
+				t.Fatalf("%s: %v is listed after a costlier hold", key, h.Hold)
+			}
+		}
+	})
+
+	// A pair of kings: keeping the pair costs nothing, breaking it up
+	// costs something, and positions can come in any order
+	key := "7C-KD-KS"
+	if c, err := costs.Cost(key, []int{2, 1}); err != nil || c.Cost != 0 || c.Return != 0 {
+		t.Errorf("%s keeping the pair: %+v, %v", key, c, err)
+	}
+	c, err := costs.Cost(key, []int{2})
+	if err != nil || c.Cost <= 0 || c.Return <= 0 || fmt.Sprint(c.Hold) != "[2]" {
+		t.Errorf("%s keeping a king: %+v, %v", key, c, err)
+	}
+	if _, err = costs.Cost("7C-KD-KX", []int{0}); err == nil {
+		t.Error("costed a hand that isn't there")
+	}
+	if _, err = costs.Cost(key, []int{3}); err == nil {
+		t.Error("costed position 3")
+	}
+
+	mistakes := costs.Mistakes(key)
+	if len(mistakes) != len(costs[key]) {
+		t.Fatalf("%d mistakes, want %d", len(mistakes), len(costs[key]))
+	}
+	for i, m := range mistakes {
+		if !reflect.DeepEqual(m, costs[key][len(mistakes)-1-i]) {
+			t.Errorf("mistake %d is %+v, not the worst first", i, m)
+		}
+	}
+	if len(costs.Mistakes("7C-KD-KX")) != 0 {
+		t.Error("mistakes for a hand that isn't there")
+	}
+
+	filename := filepath.Join(t.TempDir(), "costs.json")
+	if err = costs.Save(filename); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadCosts(filename)
+	if err != nil || !reflect.DeepEqual(loaded[key], costs[key]) || len(loaded) != len(costs) {
+		t.Errorf("loaded %d hands, %v", len(loaded), err)
+	}
+}
@@ -0,0 +1,135 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+	"github.com/gsdriver/alexautils/internal/logging"
+)
+
+// HoldChange is a hand whose hold differs between two strategies
+type HoldChange struct {
+	Key   string
+	Class HandClass
+	Old   []int // positions within the key
+	New   []int
+
+	// Chance of being dealt the hand, and the change in its return per
+	// unit bet (win less lose) going from the old hold to the new
+	Weight float64
+	Delta  float64
+}
+
+// StrategyDiff is how two strategies differ
+type StrategyDiff struct {
+	Hands   int
+	Changes []HoldChange // biggest change in value first
+
+	// Change in the return of the game per unit bet, per hand dealt
+	Delta float64
+}
+
+// DiffStrategies compares two sets of holds using the analyzer's odds
+func (a *Analyzer) DiffStrategies(old, new *Holds) StrategyDiff {
+	a.Analyze()
+	var d StrategyDiff
+	ev := func(o Odds) float64 { return o.Win - o.Lose }
+	a.Tables.Hands(func(idx int, hand [3]Card) {
+		d.Hands++
+		if old[idx] == new[idx] {
+			return
+		}
+		odds := a.HoldOdds(hand)
+		c := HoldChange{
+			Key:    HandKey(hand[0], hand[1], hand[2]),
+			Class:  a.Tables.Classify(hand),
+			Old:    holdPositions(hand, old[idx]),
+			New:    holdPositions(hand, new[idx]),
+			Weight: a.Tables.weight(idx),
+			Delta:  ev(odds[new[idx]]) - ev(odds[old[idx]]),
+		}
+		d.Changes = append(d.Changes, c)
+		d.Delta += c.Weight * c.Delta
+	})
+	sort.SliceStable(d.Changes, func(i, j int) bool {
+		return math.Abs(d.Changes[i].Delta) > math.Abs(d.Changes[j].Delta)
+	})
+	return d
+}
+
+// Diff is the diff command: which hands two strategy files play
+// differently and what it's worth
+func Diff(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard diff", "Compares two strategy files hand by hand.")
+	oldFile := fs.String("old", "", "strategy file to compare from")
+	newFile := fs.String("new", "suggest.json", "strategy file to compare to")
+	show := fs.Int("show", 20, "changed hands to list, biggest change in value first (-1 for all)")
+	failChanged := fs.Int("fail-changed", -1, "exit with failures if more than this many hands changed (-1 never)")
+	failValue := fs.Float64("fail-value", -1, "exit with failures if the EV (return per unit bet) per hand dealt moves by more than this (negative never)")
+	game := addGameFlags(fs, "removed")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *oldFile == "" {
+		return cli.Usagef("-old is required")
+	}
+
+	tables, err := game.tables()
+	if err != nil {
+		return err
+	}
+	var holds [2]*Holds
+	for i, file := range []string{*oldFile, *newFile} {
+		strategy, err := LoadStrategy(file)
+		if err != nil {
+			return err
+		}
+		if holds[i], err = strategy.HoldMasks(tables); err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+	}
+	dealerHolds, err := dealer.holds(tables, func() (*Holds, error) {
+		return NewAnalyzer(tables, StaticField(tables)).BestHolds(), nil
+	})
+	if err != nil {
+		return err
+	}
+	analyzer := NewAnalyzer(tables, fieldFor(tables, dealerHolds))
+	d := analyzer.DiffStrategies(holds[0], holds[1])
+
+	fmt.Printf("Shoe: %s, %s, %s dealer\n", tables.Shoe, tables.Rules, dealer.policy)
+	fmt.Printf("%d of %d hands changed, EV %+.6f per unit bet per hand dealt\n", len(d.Changes), d.Hands, d.Delta)
+	if len(d.Changes) == 0 {
+		return nil
+	}
+
+	fmt.Printf("\n  %-14s %-16s %-10s %-10s %9s\n", "Hand", "Class", "Old", "New", "EV")
+	for i, c := range d.Changes {
+		if *show >= 0 && i >= *show {
+			fmt.Printf("  ... %d more\n", len(d.Changes)-i)
+			break
+		}
+		fmt.Printf("  %-14s %-16s %-10s %-10s %+9.5f\n", c.Key, c.Class, fmt.Sprint(c.Old), fmt.Sprint(c.New), c.Delta)
+	}
+
+	var counts [NumClasses]int
+	var deltas [NumClasses]float64
+	for _, c := range d.Changes {
+		counts[c.Class]++
+		deltas[c.Class] += c.Weight * c.Delta
+	}
+	fmt.Printf("\n  %-16s %8s %13s\n", "Class", "Changed", "EV per hand")
+	for _, class := range classOrder[3] {
+		if counts[class] > 0 {
+			fmt.Printf("  %-16s %8d %+13.7f\n", class, counts[class], deltas[class])
+		}
+	}
+
+	if (*failChanged >= 0 && len(d.Changes) > *failChanged) || (*failValue >= 0 && math.Abs(d.Delta) > *failValue) {
+		return &logging.FailuresError{Op: "strategy diff", Processed: d.Hands, Failed: len(d.Changes)}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package threecardanalyze
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiffStrategies(t *testing.T) {
+	a := houseAnalyzer(t)
+	best := a.BestHolds()
+
+	d := a.DiffStrategies(best, best)
+	if d.Hands != NumHands || len(d.Changes) != 0 || d.Delta != 0 {
+		t.Errorf("a strategy against itself: %d hands, %d changes, delta %g", d.Hands, len(d.Changes), d.Delta)
+	}
+
+	// Break up a pair of kings, throw away a queen high flush, and a
+	// mistake worth less than either: keeping a deuce with the ace
+	index := func(key string) int {
+		hand := mustHand(t, key)
+		return HandIndex(hand[0], hand[1], hand[2])
+	}
+	changed := *best
+	changes := map[string]uint8{
+		"7C-KD-KS": 0b010,
+		"2H-9H-QH": 0b000,
+		"2C-9D-AS": 0b101,
+	}
+	for key, mask := range changes {
+		changed[index(key)] = mask
+	}
+
+	d = a.DiffStrategies(best, &changed)
+	if d.Hands != NumHands || len(d.Changes) != len(changes) {
+		t.Fatalf("%d hands, %d changes; want %d and %d", d.Hands, len(d.Changes), NumHands, len(changes))
+	}
+	var total float64
+	for i, c := range d.Changes {
+		hand := mustHand(t, c.Key)
+		idx := index(c.Key)
+		odds := a.HoldOdds(hand)
+		ev := func(mask uint8) float64 { return odds[mask].Win - odds[mask].Lose }
+		if _, ok := changes[c.Key]; !ok {
+			t.Errorf("%s didn't change", c.Key)
+		}
+		if c.Class != a.Tables.Classify(hand) || c.Weight != a.Tables.weight(idx) {
+			t.Errorf("%s: class %v, weight %g", c.Key, c.Class, c.Weight)
+		}
+		if want := ev(changed[idx]) - ev(best[idx]); math.Abs(c.Delta-want) > 1e-15 {
+			t.Errorf("%s: EV change %g, want %g", c.Key, c.Delta, want)
+		}
+		if c.Delta >= 0 {
+			t.Errorf("%s: the optimal hold lost %g going to %v", c.Key, c.Delta, c.New)
+		}
+		if i > 0 && math.Abs(c.Delta) > math.Abs(d.Changes[i-1].Delta) {
+			t.Errorf("%s is listed after a smaller change", c.Key)
+		}
+		total += c.Weight * c.Delta
+	}
+	if math.Abs(d.Delta-total) > 1e-15 {
+		t.Errorf("delta %g, want %g", d.Delta, total)
+	}
+	if last := d.Changes[len(d.Changes)-1]; last.Key != "2C-9D-AS" {
+		t.Errorf("smallest change %s, want 2C-9D-AS", last.Key)
+	}
+
+	back := a.DiffStrategies(&changed, best)
+	if math.Abs(back.Delta+d.Delta) > 1e-15 {
+		t.Errorf("going back: delta %g, want %g", back.Delta, -d.Delta)
+	}
+}
+
+func TestLoadStrategyRejectsSeveralHolds(t *testing.T) {
+	dir := t.TempDir()
+	for name, file := range map[string]interface{}{
+		"alternatives": map[string][][]int{"2C-3D-4H": {{0, 1, 2}, {1, 2}}},
+		"costs":        CostTable{"2C-3D-4H": {{Hold: []int{1}, Cost: 0.1, Return: 0.2}}},
+	} {
+		filename := filepath.Join(dir, name+".json")
+		data, _ := json.Marshal(file)
+		if err := os.WriteFile(filename, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadStrategy(filename); err == nil || !strings.Contains(err.Error(), "several holds") {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,494 @@
+package threecardanalyze
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MinCards and MaxCards bound the hand sizes DrawTables handles
+const (
+	MinCards = 3
+	MaxCards = 5
+)
+
+// choose[n][k] is the binomial coefficient for the 52 card deck
+var choose = func() [Joker + 1][MaxCards + 1]int {
+	var c [Joker + 1][MaxCards + 1]int
+	for n := range c {
+		for k := range c[n] {
+			c[n][k] = binomial(n, k)
+		}
+	}
+	return c
+}()
+
+// subsetIndex numbers a set of sorted, distinct natural cards among the
+// sets of the same size (the same as HandIndex for three cards)
+func subsetIndex(cards []Card) int {
+	idx := 0
+	for i, c := range cards {
+		idx += choose[c][i+1]
+	}
+	return idx
+}
+
+// DrawTables ranks every hand of 3 to 5 cards dealt from a single deck
+// and works out the odds of every hold against an opponent who keeps a
+// random hand. Three card games that need shoes, wild cards or an
+// opponent who draws go through Tables instead.
+//
+// Rather than walking every draw for every hold, it counts, for every
+// smaller set of cards, how many wins and ties the hands containing it
+// add up to. The draws for a hold are the hands containing the held
+// cards and none of the discards, which inclusion-exclusion over the
+// discards gets from those counts. Everything is an exact integer, so
+// holds that are equally good tie exactly.
+type DrawTables struct {
+	Cards   int
+	Rules   Rules
+	Winners []WinRatio
+
+	// Rank of each hand by subsetIndex
+	rank []int16
+
+	// Canonical hand (the suits relabeled in a fixed order) by
+	// subsetIndex, and the relabeling that gets there, two bits for
+	// each suit
+	canon []int32
+	perm  []uint8
+
+	// For every set of fewer than Cards cards by size and subsetIndex,
+	// the wins and ties of the hands containing it
+	wins [][]int64
+	ties [][]int64
+}
+
+// NewDrawTables ranks every hand of the given size. Wild cards aren't
+// supported beyond three cards.
+func NewDrawTables(cards int, rules Rules) (*DrawTables, error) {
+	if cards < MinCards || cards > MaxCards {
+		return nil, fmt.Errorf("hands must have %d to %d cards, not %d", MinCards, MaxCards, cards)
+	}
+	if len(rules.WildRanks) > 0 {
+		return nil, fmt.Errorf("wild cards are only supported in three card hands")
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	n := choose[Joker][cards]
+	t := &DrawTables{Cards: cards, Rules: rules, rank: make([]int16, n), canon: make([]int32, n), perm: make([]uint8, n)}
+
+	// Rank by sorting the distinct values
+	values := make([]int32, n)
+	seen := make(map[int32]bool)
+	var distinct []int
+	eachSubset(cards, func(idx int, hand []Card) {
+		v := int32(rules.evaluateN(hand))
+		values[idx] = v
+		if !seen[v] {
+			seen[v] = true
+			distinct = append(distinct, int(v))
+		}
+		t.canon[idx], t.perm[idx] = canonicalize(hand)
+	})
+	sort.Ints(distinct)
+	rankOf := make(map[int32]int16, len(distinct))
+	for r, v := range distinct {
+		rankOf[int32(v)] = int16(r)
+	}
+	byRank := make([]int, len(distinct))
+	for idx, v := range values {
+		t.rank[idx] = rankOf[v]
+		byRank[t.rank[idx]]++
+	}
+	better := 0
+	for _, c := range byRank {
+		t.Winners = append(t.Winners, WinRatio{Wins: n - better - c, Ties: c, Loses: better})
+		better += c
+	}
+
+	// Add each hand's wins and ties to every smaller set it contains
+	t.wins = make([][]int64, cards)
+	t.ties = make([][]int64, cards)
+	for k := range t.wins {
+		t.wins[k] = make([]int64, choose[Joker][k])
+		t.ties[k] = make([]int64, choose[Joker][k])
+	}
+	var sub [MaxCards]Card
+	eachSubset(cards, func(idx int, hand []Card) {
+		w := t.Winners[t.rank[idx]]
+		for mask := 0; mask < 1<<cards-1; mask++ {
+			k := 0
+			for i, c := range hand {
+				if mask&(1<<i) != 0 {
+					sub[k] = c
+					k++
+				}
+			}
+			s := subsetIndex(sub[:k])
+			t.wins[k][s] += int64(w.Wins)
+			t.ties[k][s] += int64(w.Ties)
+		}
+	})
+	return t, nil
+}
+
+// eachSubset calls fn with every set of k natural cards in subsetIndex
+// order
+func eachSubset(k int, fn func(idx int, cards []Card)) {
+	cards := make([]Card, k)
+	for i := range cards {
+		cards[i] = Card(i)
+	}
+	for idx := 0; ; idx++ {
+		fn(idx, cards)
+		// Bump the lowest card that has room and reset the ones below
+		i := 0
+		for i < k && ((i == k-1 && cards[i]+1 == Joker) || (i < k-1 && cards[i]+1 == cards[i+1])) {
+			i++
+		}
+		if i == k {
+			return
+		}
+		cards[i]++
+		for j := 0; j < i; j++ {
+			cards[j] = Card(j)
+		}
+	}
+}
+
+// canonicalize relabels the suits by how many cards of each suit the
+// hand has and their ranks, so hands that only differ by suit end up
+// the same. It returns the relabeled hand's index and the relabeling.
+func canonicalize(hand []Card) (int32, uint8) {
+	var ranks [4]uint32
+	for _, c := range hand {
+		ranks[c.Suit()] |= 1 << c.Rank()
+	}
+	suits := [4]int{0, 1, 2, 3}
+	sort.SliceStable(suits[:], func(i, j int) bool {
+		a, b := ranks[suits[i]], ranks[suits[j]]
+		if bits.OnesCount32(a) != bits.OnesCount32(b) {
+			return bits.OnesCount32(a) > bits.OnesCount32(b)
+		}
+		return a > b
+	})
+	var perm uint8
+	for to, from := range suits {
+		perm |= uint8(to) << (2 * from)
+	}
+	var canon [MaxCards]Card
+	for i, c := range hand {
+		canon[i] = relabel(c, perm)
+	}
+	sortCards(canon[:len(hand)])
+	return int32(subsetIndex(canon[:len(hand)])), perm
+}
+
+// relabel moves the card to the suit perm gives its suit
+func relabel(c Card, perm uint8) Card {
+	return Card(c.Rank()*4 + int(perm>>(2*c.Suit())&3))
+}
+
+func sortCards(cards []Card) {
+	for i := 1; i < len(cards); i++ {
+		for j := i; j > 0 && cards[j] < cards[j-1]; j-- {
+			cards[j], cards[j-1] = cards[j-1], cards[j]
+		}
+	}
+}
+
+// handFor checks and sorts a hand of the tables' size
+func (t *DrawTables) handFor(cards []Card) ([]Card, error) {
+	if len(cards) != t.Cards {
+		return nil, fmt.Errorf("need %d cards, not %d", t.Cards, len(cards))
+	}
+	sorted := append([]Card(nil), cards...)
+	sortCards(sorted)
+	for i, c := range sorted {
+		if c >= Joker || (i > 0 && c == sorted[i-1]) {
+			return nil, fmt.Errorf("%s isn't a hand from a single deck", describeCards(cards))
+		}
+	}
+	return sorted, nil
+}
+
+// Rank returns the rank of a hand (0 is best)
+func (t *DrawTables) Rank(cards []Card) (int, error) {
+	sorted, err := t.handFor(cards)
+	if err != nil {
+		return 0, err
+	}
+	return int(t.rank[subsetIndex(sorted)]), nil
+}
+
+// Classify returns the class of a hand
+func (t *DrawTables) Classify(cards []Card) HandClass {
+	if len(cards) == 3 {
+		return t.Rules.Classify(cards[0], cards[1], cards[2])
+	}
+	return classifyN(cards)
+}
+
+// HoldOdds returns the odds of every hold for the hand, where bit i of
+// the index means cards[i] is kept
+func (t *DrawTables) HoldOdds(cards []Card) ([]Odds, error) {
+	sorted, err := t.handFor(cards)
+	if err != nil {
+		return nil, err
+	}
+	// Where each card is in the sorted hand
+	pos := make([]int, len(cards))
+	for i, c := range cards {
+		for j, s := range sorted {
+			if c == s {
+				pos[i] = j
+			}
+		}
+	}
+	odds := make([]Odds, 1<<t.Cards)
+	for mask := range odds {
+		var smask int
+		for i := range cards {
+			if mask&(1<<i) != 0 {
+				smask |= 1 << pos[i]
+			}
+		}
+		odds[mask] = t.holdOdds(sorted, smask)
+	}
+	return odds, nil
+}
+
+// holdOdds works out the odds of keeping the cards in mask (bits over
+// the sorted hand) and drawing the rest
+func (t *DrawTables) holdOdds(hand []Card, mask int) Odds {
+	full := 1<<t.Cards - 1
+	held := bits.OnesCount(uint(mask))
+
+	// Sum over every superset of the hold, adding sets with an even
+	// number of discards and taking away sets with an odd number
+	var wins, ties int64
+	var sub [MaxCards]Card
+	for u := mask; u <= full; u = (u + 1) | mask {
+		k := 0
+		for i, c := range hand {
+			if u&(1<<i) != 0 {
+				sub[k] = c
+				k++
+			}
+		}
+		var w, ti int64
+		if k == t.Cards {
+			r := t.Winners[t.rank[subsetIndex(sub[:k])]]
+			w, ti = int64(r.Wins), int64(r.Ties)
+		} else {
+			s := subsetIndex(sub[:k])
+			w, ti = t.wins[k][s], t.ties[k][s]
+		}
+		if (k-held)%2 == 1 {
+			w, ti = -w, -ti
+		}
+		wins += w
+		ties += ti
+	}
+
+	// Every draw is from the cards that weren't dealt
+	total := float64(choose[Joker-Card(t.Cards)][t.Cards-held]) * float64(len(t.rank))
+	o := Odds{Win: float64(wins) / total, Tie: float64(ties) / total}
+	o.Lose = 1 - o.Win - o.Tie
+	return o
+}
+
+// holdOrderN is the order holds are tried in for a hand size, as masks
+// over the hand's cards: more cards kept first. Three card hands keep
+// the order the analyzer has always used.
+func holdOrderN(cards int) []uint8 {
+	var order []uint8
+	if cards == 3 {
+		for _, hold := range holdOrder {
+			order = append(order, positionsMask(hold))
+		}
+		return order
+	}
+	for kept := cards; kept >= 0; kept-- {
+		for mask := 0; mask < 1<<cards; mask++ {
+			if bits.OnesCount(uint(mask)) == kept {
+				order = append(order, uint8(mask))
+			}
+		}
+	}
+	return order
+}
+
+// BestHolds returns the hold with the best chance of winning for every
+// hand by subsetIndex, with bits over the sorted cards. Ties go to the
+// hold tried first (see holdOrderN). Hands that only differ by suit
+// are worked out once.
+func (t *DrawTables) BestHolds() []uint8 {
+	order := holdOrderN(t.Cards)
+	holds := make([]uint8, len(t.rank))
+
+	var canon []int
+	for idx := range t.canon {
+		if int(t.canon[idx]) == idx {
+			canon = append(canon, idx)
+		}
+	}
+	const NumberOfWorkers = 4
+	var wg sync.WaitGroup
+	for w := 0; w < NumberOfWorkers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(canon); i += NumberOfWorkers {
+				hand := handAtN(canon[i], t.Cards)
+				best, bestodds := order[0], -1.0
+				for _, mask := range order {
+					if o := t.holdOdds(hand, int(mask)); o.Win > bestodds+tieTolerance {
+						best, bestodds = mask, o.Win
+					}
+				}
+				holds[canon[i]] = best
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	// Every other hand plays its canonical hand's hold on the same cards
+	eachSubset(t.Cards, func(idx int, hand []Card) {
+		if int(t.canon[idx]) == idx {
+			return
+		}
+		canonHand := handAtN(int(t.canon[idx]), t.Cards)
+		cmask := holds[t.canon[idx]]
+		var mask uint8
+		for i, c := range hand {
+			rc := relabel(c, t.perm[idx])
+			for j, cc := range canonHand {
+				if cc == rc && cmask&(1<<j) != 0 {
+					mask |= 1 << i
+				}
+			}
+		}
+		holds[idx] = mask
+	})
+	return holds
+}
+
+// handAtN returns the sorted cards of a subsetIndex
+func handAtN(idx, k int) []Card {
+	hand := make([]Card, k)
+	for i := k - 1; i >= 0; i-- {
+		c := i
+		for choose[c+1][i+1] <= idx {
+			c++
+		}
+		hand[i] = Card(c)
+		idx -= choose[c][i+1]
+	}
+	return hand
+}
+
+// handKeyN is the hand's key in a strategy file: the cards sorted as
+// strings, separated by dashes
+func handKeyN(hand []Card) (string, []int) {
+	order := make([]int, len(hand))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return hand[order[i]].String() < hand[order[j]].String() })
+	names := make([]string, len(hand))
+	for i, o := range order {
+		names[i] = hand[o].String()
+	}
+	return strings.Join(names, "-"), order
+}
+
+// equivalentHandN is the hand's key with the suits replaced by X, Y, Z
+// and W in the order they first appear, the format of equivalents.json
+func equivalentHandN(key string) string {
+	cards := strings.Split(key, "-")
+	relabeled := map[byte]byte{}
+	for i, c := range cards {
+		suit := c[len(c)-1]
+		if _, ok := relabeled[suit]; !ok {
+			relabeled[suit] = "XYZW"[len(relabeled)]
+		}
+		cards[i] = c[:len(c)-1] + string(relabeled[suit])
+	}
+	sort.Strings(cards)
+	return strings.Join(cards, "-")
+}
+
+// WriteStrategy writes the holds in the suggest.json format, and the
+// first hand of each equivalent set in the equivalents.json format
+func (t *DrawTables) WriteStrategy(holds []uint8, suggestFile, equivalentsFile string) error {
+	equivalents := make(map[string][]int)
+	err := writeObject(suggestFile, func(emit func(key string, value interface{})) {
+		eachSubset(t.Cards, func(idx int, hand []Card) {
+			key, order := handKeyN(hand)
+			hold := []int{}
+			for pos, i := range order {
+				if holds[idx]&(1<<i) != 0 {
+					hold = append(hold, pos)
+				}
+			}
+			emit(key, hold)
+			if equivalent := equivalentHandN(key); equivalents[equivalent] == nil {
+				equivalents[equivalent] = hold
+			}
+		})
+	})
+	if err != nil {
+		return err
+	}
+	result, _ := json.Marshal(equivalents)
+	return os.WriteFile(equivalentsFile, result, 0644)
+}
+
+// WriteRanks writes the rank of every hand in the format of the three
+// card ranking table
+func (t *DrawTables) WriteRanks(filename string) error {
+	return writeObject(filename, func(emit func(key string, value interface{})) {
+		eachSubset(t.Cards, func(idx int, hand []Card) {
+			key, _ := handKeyN(hand)
+			emit(key, t.rank[idx])
+		})
+	})
+}
+
+// writeObject writes a JSON object a key at a time, since five card
+// games have millions of hands. Keys are in the order they're emitted.
+func writeObject(filename string, fill func(emit func(key string, value interface{}))) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	sep := "{"
+	fill(func(key string, value interface{}) {
+		k, _ := json.Marshal(key)
+		v, _ := json.Marshal(value)
+		w.WriteString(sep)
+		w.Write(k)
+		w.WriteString(":")
+		w.Write(v)
+		sep = ","
+	})
+	if sep == "{" {
+		w.WriteString(sep)
+	}
+	w.WriteString("}")
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,112 @@
+package threecardanalyze
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestDrawHoldOdds checks the inclusion-exclusion odds of four card
+// holds against dealing out every draw and counting the hands the
+// final hand beats and ties
+func TestDrawHoldOdds(t *testing.T) {
+	tables, err := NewDrawTables(4, SkillRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// How many hands have each rank, to count what a final hand beats
+	counts := make([]int, len(tables.Winners))
+	eachSubset(4, func(idx int, hand []Card) {
+		counts[tables.rank[idx]]++
+	})
+	n := float64(len(tables.rank))
+	versus := func(rank int) Odds {
+		var o Odds
+		for r, c := range counts {
+			switch {
+			case r > rank:
+				o.Win += float64(c) / n
+			case r == rank:
+				o.Tie += float64(c) / n
+			}
+		}
+		o.Lose = 1 - o.Win - o.Tie
+		return o
+	}
+
+	// Out of order, so the hold bits follow the hand as given
+	for _, key := range []string{"QS-AS-JS-KS", "7H-2C-9S-2D", "3D-AH-8C-2H", "KC-5D-KH-KS"} {
+		var hand []Card
+		for _, s := range strings.Split(key, "-") {
+			c, err := ParseCard(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hand = append(hand, c)
+		}
+		odds, err := tables.HoldOdds(hand)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var deck []Card
+		for _, c := range NewDeck() {
+			if !containsCard(hand, c) {
+				deck = append(deck, c)
+			}
+		}
+		// Every hold, from drawing four to keeping the whole hand
+		for mask := 0; mask < 1<<4; mask++ {
+			var held []Card
+			for i, c := range hand {
+				if mask&(1<<i) != 0 {
+					held = append(held, c)
+				}
+			}
+			var want Odds
+			draws := 0
+			eachDraw(deck, 4-len(held), func(drawn []Card) {
+				rank, err := tables.Rank(append(append([]Card{}, held...), drawn...))
+				if err != nil {
+					t.Fatal(err)
+				}
+				want.add(versus(rank), 1)
+				draws++
+			})
+			want = Odds{Win: want.Win / float64(draws), Tie: want.Tie / float64(draws), Lose: want.Lose / float64(draws)}
+
+			got := odds[mask]
+			if math.Abs(got.Win-want.Win) > 1e-12 || math.Abs(got.Tie-want.Tie) > 1e-12 || math.Abs(got.Lose-want.Lose) > 1e-12 {
+				t.Errorf("%s holding %04b: %+v, want %+v", key, mask, got, want)
+			}
+		}
+	}
+}
+
+func containsCard(cards []Card, c Card) bool {
+	for _, x := range cards {
+		if x == c {
+			return true
+		}
+	}
+	return false
+}
+
+// eachDraw calls fn with every set of k cards from deck
+func eachDraw(deck []Card, k int, fn func(drawn []Card)) {
+	drawn := make([]Card, 0, k)
+	var walk func(from int)
+	walk = func(from int) {
+		if len(drawn) == k {
+			fn(drawn)
+			return
+		}
+		for i := from; i <= len(deck)-(k-len(drawn)); i++ {
+			drawn = append(drawn, deck[i])
+			walk(i + 1)
+			drawn = drawn[:len(drawn)-1]
+		}
+	}
+	walk(0)
+}
@@ -0,0 +1,79 @@
+package threecardanalyze
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// drawTable caches, for every canonical hand and hold, how many ways
+// the draw finishes on each rank. Every analysis and field works from
+// these counts, so changing what the hands play against only costs a
+// pass over the table rather than another trip through the shoe.
+type drawTable struct {
+	once sync.Once
+
+	// Per canonical hand and mask (over its cards in Card order), the
+	// ranks reached and how many draws reach them
+	ranks  [NumSlots][8][]int16
+	counts [NumSlots][8][]int64
+	totals [8]int64
+}
+
+func (t *Tables) drawTable() *drawTable {
+	t.draws.once.Do(func() {
+		var canon []int
+		for _, idx := range t.hands {
+			if t.canon[idx] == idx {
+				canon = append(canon, int(idx))
+			}
+		}
+
+		const NumberOfWorkers = 4
+		var wg sync.WaitGroup
+		for w := 0; w < NumberOfWorkers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				counts := make([]int64, len(t.Winners))
+				for i := w; i < len(canon); i += NumberOfWorkers {
+					hand := handAt(canon[i])
+					for mask := uint8(0); mask < 8; mask++ {
+						for r := range counts {
+							counts[r] = 0
+						}
+						t.eachDraw(hand, mask, func(rank int, ways int64) {
+							counts[rank] += ways
+						})
+						for r, n := range counts {
+							if n > 0 {
+								t.draws.ranks[canon[i]][mask] = append(t.draws.ranks[canon[i]][mask], int16(r))
+								t.draws.counts[canon[i]][mask] = append(t.draws.counts[canon[i]][mask], n)
+							}
+						}
+					}
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		// Every hand draws from the rest of the shoe, so the number of
+		// draws only depends on how many cards are held
+		for mask := uint8(0); mask < 8; mask++ {
+			t.draws.totals[mask] = int64(binomial(t.Shoe.Size()-3, 3-bits.OnesCount8(mask)))
+		}
+	})
+	return &t.draws
+}
+
+// canonicalMask maps a hold on hand (bit i keeps hand[i]) to the
+// canonical hand's index and the same hold over its cards
+func (t *Tables) canonicalMask(hand [3]Card, mask uint8) (int, uint8) {
+	canon, pos := t.canonPositions(hand)
+	var cmask uint8
+	for i := range hand {
+		if mask&(1<<i) != 0 {
+			cmask |= 1 << pos[i]
+		}
+	}
+	return canon, cmask
+}
@@ -0,0 +1,296 @@
+package threecardanalyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/bits"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+)
+
+// Solver methods
+const (
+	// Each side best responds to the average of everything the other
+	// side has played so far, which converges for zero-sum games
+	MethodFictitious = "fictitious"
+	// Each side best responds to the other side's last strategy, which
+	// is faster but can cycle if there's no pure equilibrium
+	MethodAlternate = "alternate"
+)
+
+// Solver finds equilibrium strategies for the heads-up game where both
+// the player and the dealer draw. The player's payoff is +1 for a win
+// and -1 for a loss; ties push, or go to the dealer with TiesToDealer.
+// The dealer's payoff is the negative of the player's.
+type Solver struct {
+	Tables        *Tables
+	TiesToDealer  bool
+	Method        string
+	MaxIterations int
+	// Stop once the gap between the bounds on the game value is smaller
+	Tolerance float64
+}
+
+// Equilibrium is the result of a solve
+type Equilibrium struct {
+	// The game value to the player lies between Lower and Upper. Lower
+	// is what the player's strategy guarantees against any dealer and
+	// Upper what the dealer's strategy holds the player to.
+	Lower      float64
+	Upper      float64
+	Iterations int
+	Converged  bool
+	Player     *MixedStrategy
+	Dealer     *MixedStrategy
+}
+
+// Value is the midpoint of the bounds
+func (e *Equilibrium) Value() float64 {
+	return (e.Lower + e.Upper) / 2
+}
+
+// MixedStrategy counts how often each hold was played for each hand
+type MixedStrategy struct {
+	counts [NumSlots][8]int32
+	total  int32
+}
+
+func (m *MixedStrategy) add(holds *Holds) {
+	for idx, mask := range holds {
+		m.counts[idx][mask]++
+	}
+	m.total++
+}
+
+// Frequency is how often the hand (by HandIndex) plays the hold
+func (m *MixedStrategy) Frequency(idx int, mask uint8) float64 {
+	return float64(m.counts[idx][mask]) / float64(m.total)
+}
+
+// Modal returns the hold each hand plays most often. Equal counts go to
+// the hold that keeps more cards, then to the lower mask.
+func (m *MixedStrategy) Modal() *Holds {
+	var holds Holds
+	for idx := range m.counts {
+		best := uint8(0)
+		for mask := uint8(1); mask < 8; mask++ {
+			c, bc := m.counts[idx][mask], m.counts[idx][best]
+			if c > bc || (c == bc && c > 0 && bits.OnesCount8(mask) > bits.OnesCount8(best)) {
+				best = mask
+			}
+		}
+		holds[idx] = best
+	}
+	return &holds
+}
+
+// Mixed returns how many hands play more than one hold
+func (m *MixedStrategy) Mixed() int {
+	n := 0
+	for idx := range m.counts {
+		played := 0
+		for _, c := range m.counts[idx] {
+			if c > 0 {
+				played++
+			}
+		}
+		if played > 1 {
+			n++
+		}
+	}
+	return n
+}
+
+// Payoffs to the player and the dealer given the odds of their own hand
+func (s *Solver) playerPayoff(o Odds) float64 {
+	if s.TiesToDealer {
+		return o.Win - o.Lose - o.Tie
+	}
+	return o.Win - o.Lose
+}
+
+func (s *Solver) dealerPayoff(o Odds) float64 {
+	if s.TiesToDealer {
+		return o.Win + o.Tie - o.Lose
+	}
+	return o.Win - o.Lose
+}
+
+// bestResponse returns the holds that maximize payoff against an
+// opponent whose final ranks follow dist, and what they're worth
+func (s *Solver) bestResponse(dist []float64, payoff func(Odds) float64) (*Holds, float64) {
+	a := NewAnalyzer(s.Tables, newField(dist))
+	a.Objective = payoff
+	holds := a.BestHolds()
+
+	value := 0.0
+	s.Tables.Hands(func(idx int, hand [3]Card) {
+		value += payoff(a.HoldOdds(hand)[holds[idx]]) * s.Tables.weight(idx)
+	})
+	return holds, value
+}
+
+// Solve runs the solver until the bounds meet or it runs out of
+// iterations. progress, if not nil, is called after every iteration.
+func (s *Solver) Solve(progress func(iteration int, lower, upper float64)) (*Equilibrium, error) {
+	if s.Method != MethodFictitious && s.Method != MethodAlternate {
+		return nil, fmt.Errorf("unknown method %q", s.Method)
+	}
+
+	// Both sides start with what's best against a dealer who doesn't draw
+	start, _ := s.bestResponse(StaticField(s.Tables).Dist, s.playerPayoff)
+	player, dealer := start, start
+	playerDist := s.Tables.drawnDist(player)
+	dealerDist := append([]float64(nil), playerDist...)
+
+	eq := &Equilibrium{Player: &MixedStrategy{}, Dealer: &MixedStrategy{}}
+	eq.Player.add(player)
+	eq.Dealer.add(dealer)
+	for eq.Iterations = 1; eq.Iterations <= s.MaxIterations; eq.Iterations++ {
+		// Best responses to where each side currently stands bound the
+		// value of the game from above and below
+		nextPlayer, upper := s.bestResponse(dealerDist, s.playerPayoff)
+		nextDealer, dealerValue := s.bestResponse(playerDist, s.dealerPayoff)
+		eq.Upper, eq.Lower = upper, -dealerValue
+		if progress != nil {
+			progress(eq.Iterations, eq.Lower, eq.Upper)
+		}
+		if eq.Upper-eq.Lower <= s.Tolerance {
+			eq.Converged = true
+			break
+		}
+
+		if s.Method == MethodAlternate {
+			if *nextPlayer == *player && *nextDealer == *dealer {
+				// Neither side wants to change - a pure equilibrium
+				eq.Converged = true
+				break
+			}
+			player, dealer = nextPlayer, nextDealer
+			playerDist = s.Tables.drawnDist(player)
+			dealerDist = s.Tables.drawnDist(dealer)
+			eq.Player, eq.Dealer = &MixedStrategy{}, &MixedStrategy{}
+			eq.Player.add(player)
+			eq.Dealer.add(dealer)
+			continue
+		}
+
+		// Fold the best responses into the running averages
+		eq.Player.add(nextPlayer)
+		eq.Dealer.add(nextDealer)
+		weight := 1 / float64(eq.Player.total)
+		for r, p := range s.Tables.drawnDist(nextPlayer) {
+			playerDist[r] += (p - playerDist[r]) * weight
+		}
+		for r, p := range s.Tables.drawnDist(nextDealer) {
+			dealerDist[r] += (p - dealerDist[r]) * weight
+		}
+	}
+	if eq.Iterations > s.MaxIterations {
+		eq.Iterations = s.MaxIterations
+	}
+	return eq, nil
+}
+
+// mixedHold is one hold in a mixed strategy as written to JSON
+type mixedHold struct {
+	Hold      []int   `json:"hold"`
+	Frequency float64 `json:"frequency"`
+}
+
+// mixedJSON lists the hands that play more than one hold
+func (m *MixedStrategy) mixedJSON() map[string][]mixedHold {
+	out := make(map[string][]mixedHold)
+	for idx := range m.counts {
+		var holds []mixedHold
+		hand := handAt(idx)
+		key := HandKey(hand[0], hand[1], hand[2])
+		for mask := uint8(0); mask < 8; mask++ {
+			if m.counts[idx][mask] == 0 {
+				continue
+			}
+			holds = append(holds, mixedHold{
+				Hold:      holdPositions(hand, mask),
+				Frequency: m.Frequency(idx, mask),
+			})
+		}
+		if len(holds) > 1 {
+			sort.SliceStable(holds, func(i, j int) bool { return holds[i].Frequency > holds[j].Frequency })
+			out[key] = holds
+		}
+	}
+	return out
+}
+
+// SolveEquilibrium is the equilibrium command
+func SolveEquilibrium(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard equilibrium", "Solves for player and dealer strategies when both sides draw.")
+	method := fs.String("method", MethodFictitious, "fictitious (fictitious play) or alternate (alternating best responses)")
+	ties := fs.String("ties", "push", "what happens on a tie: push or dealer (the dealer wins ties)")
+	maxIterations := fs.Int("max-iterations", 200, "give up after this many iterations")
+	tolerance := fs.Float64("tolerance", 1e-4, "stop when the bounds on the game value are this close")
+	playerOut := fs.String("player-out", "equilibrium-player.json", "file to write the player's strategy to (most played hold per hand)")
+	dealerOut := fs.String("dealer-out", "equilibrium-dealer.json", "file to write the dealer's strategy to (most played hold per hand)")
+	out := fs.String("o", "equilibrium.json", "file to write the value and any mixed holds to")
+	game := addGameFlags(fs, "removed")
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *ties != "push" && *ties != "dealer" {
+		return cli.Usagef("-ties must be push or dealer")
+	}
+
+	tables, err := game.tables()
+	if err != nil {
+		return err
+	}
+	solver := &Solver{
+		Tables:        tables,
+		TiesToDealer:  *ties == "dealer",
+		Method:        *method,
+		MaxIterations: *maxIterations,
+		Tolerance:     *tolerance,
+	}
+
+	started := time.Now()
+	eq, err := solver.Solve(func(iteration int, lower, upper float64) {
+		slog.Info("iteration", "n", iteration, "lower", lower, "upper", upper, "gap", upper-lower)
+	})
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+
+	if err = StrategyFromHolds(tables, eq.Player.Modal()).Save(*playerOut); err != nil {
+		return err
+	}
+	if err = StrategyFromHolds(tables, eq.Dealer.Modal()).Save(*dealerOut); err != nil {
+		return err
+	}
+	result, _ := json.MarshalIndent(map[string]interface{}{
+		"method":     *method,
+		"ties":       *ties,
+		"iterations": eq.Iterations,
+		"converged":  eq.Converged,
+		"value":      eq.Value(),
+		"lower":      eq.Lower,
+		"upper":      eq.Upper,
+		"player":     eq.Player.mixedJSON(),
+		"dealer":     eq.Dealer.mixedJSON(),
+	}, "", "  ")
+	if err = os.WriteFile(*out, result, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("Method:      %s, ties %s\n", *method, *ties)
+	fmt.Printf("Iterations:  %d (%v)\n", eq.Iterations, time.Since(started).Round(time.Millisecond))
+	fmt.Printf("Value:       %+.5f to the player (between %+.5f and %+.5f)\n", eq.Value(), eq.Lower, eq.Upper)
+	fmt.Printf("Mixed hands: %d player, %d dealer\n", eq.Player.Mixed(), eq.Dealer.Mixed())
+	if !eq.Converged {
+		return fmt.Errorf("didn't converge to within %g in %d iterations", *tolerance, *maxIterations)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package threecardanalyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	tables := houseTables(t)
+
+	// Ties going to the dealer make the game lopsided, so the value
+	// isn't simply zero
+	fictitious := &Solver{Tables: tables, TiesToDealer: true, Method: MethodFictitious, MaxIterations: 6}
+	var gaps []float64
+	eq, err := fictitious.Solve(func(iteration int, lower, upper float64) {
+		if lower > upper {
+			t.Errorf("fictitious iteration %d: lower bound %g above upper %g", iteration, lower, upper)
+		}
+		gaps = append(gaps, upper-lower)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := eq.Value(); eq.Lower > v || v > eq.Upper {
+		t.Errorf("fictitious: value %g outside [%g, %g]", v, eq.Lower, eq.Upper)
+	}
+	if eq.Iterations != 6 || len(gaps) != 6 {
+		t.Fatalf("fictitious: %d iterations, %d reported, want 6", eq.Iterations, len(gaps))
+	}
+	for i := 1; i < len(gaps); i++ {
+		if gaps[i] > gaps[i-1] {
+			t.Errorf("fictitious iteration %d: gap grew from %g to %g", i+1, gaps[i-1], gaps[i])
+		}
+	}
+	if gaps[5] > gaps[0]/4 {
+		t.Errorf("fictitious: gap %g after 6 iterations, from %g after 1", gaps[5], gaps[0])
+	}
+
+	// Alternating best responses find a pure equilibrium here, which
+	// has to be inside the bounds fictitious play proves
+	alternate := &Solver{Tables: tables, TiesToDealer: true, Method: MethodAlternate, MaxIterations: 10, Tolerance: 1e-4}
+	alt, err := alternate.Solve(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !alt.Converged || alt.Lower > alt.Upper || alt.Upper-alt.Lower > 1e-4 {
+		t.Fatalf("alternate: bounds [%g, %g] after %d iterations, converged %v", alt.Lower, alt.Upper, alt.Iterations, alt.Converged)
+	}
+	if alt.Upper < eq.Lower || alt.Lower > eq.Upper {
+		t.Errorf("alternate's [%g, %g] and fictitious' [%g, %g] don't overlap", alt.Lower, alt.Upper, eq.Lower, eq.Upper)
+	}
+	if d := math.Abs(alt.Value() - eq.Value()); d > eq.Upper-eq.Lower {
+		t.Errorf("values %g and %g differ by %g, more than the fictitious gap %g", alt.Value(), eq.Value(), d, eq.Upper-eq.Lower)
+	}
+
+	if _, err = (&Solver{Tables: tables, Method: "guess"}).Solve(nil); err == nil {
+		t.Error("unknown method: no error")
+	}
+}
@@ -0,0 +1,57 @@
+package threecardanalyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+)
+
+// ExportTables is the tables command: it writes the ranking and winners
+// tables for a shoe and rules in the format of the skill's original ones
+func ExportTables(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard tables", "Writes the ranking and winners tables for a shoe and rules.")
+	ranksFile := fs.String("ranks", "ranks.json", "file to write the rank of every hand to")
+	winnersFile := fs.String("winners", "winners.json", "file to write the winners table to")
+	cards := fs.Int("cards", 3, "cards in a hand, 3 to 5; bigger hands are dealt from a single deck")
+	game := addGameFlags(fs, "removed")
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *cards != 3 {
+		return exportDrawTables(*cards, game, *ranksFile, *winnersFile)
+	}
+
+	tables, err := game.tables()
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	result, _ := json.Marshal(tables.Ranking)
+	if err = os.WriteFile(*ranksFile, result, 0644); err != nil {
+		return err
+	}
+	result, _ = json.Marshal(tables.Winners)
+	if err = os.WriteFile(*winnersFile, result, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("%d hands in %d ranks (%s, %s)\n", len(tables.Ranking), len(tables.Winners), tables.Shoe, tables.Rules)
+	return nil
+}
+
+// exportDrawTables writes the tables for hands of more than three cards
+func exportDrawTables(cards int, game *gameOptions, ranksFile, winnersFile string) error {
+	tables, err := game.drawTables(cards)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	if err = tables.WriteRanks(ranksFile); err != nil {
+		return err
+	}
+	result, _ := json.Marshal(tables.Winners)
+	if err = os.WriteFile(winnersFile, result, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("%d hands of %d cards in %d ranks (1 deck, %s)\n", len(tables.rank), cards, len(tables.Winners), tables.Rules)
+	return nil
+}
@@ -0,0 +1,267 @@
+package threecardanalyze
+
+import (
+	"flag"
+	"fmt"
+)
+
+// Odds are the chances a hand wins, ties or loses
+type Odds struct {
+	Win  float64
+	Tie  float64
+	Lose float64
+}
+
+func (o *Odds) add(p Odds, weight float64) {
+	o.Win += p.Win * weight
+	o.Tie += p.Tie * weight
+	o.Lose += p.Lose * weight
+}
+
+// Field is what a finished hand plays against: for each rank, the odds
+// a player hand of that rank beats the opponent's final hand. Like the
+// winners table, the opponent's hand is treated as independent of the
+// player's cards.
+type Field struct {
+	// Dist is the probability the opponent finishes with each rank
+	Dist []float64
+	// ByRank is the player's odds for a final hand of each rank
+	ByRank []Odds
+}
+
+// StaticField is an opponent who keeps a random three card hand, which
+// is what the winners table describes
+func StaticField(t *Tables) *Field {
+	dist := make([]float64, len(t.Winners))
+	for r, w := range t.Winners {
+		// The winners table counts every hand with each rank's
+		// hands as ties, so the ties are how many hands have it
+		dist[r] = float64(w.Ties) / float64(t.totalWays)
+	}
+	return newField(dist)
+}
+
+// DrawnField is an opponent who draws to each starting hand according
+// to holds
+func DrawnField(t *Tables, holds *Holds) *Field {
+	return newField(t.drawnDist(holds))
+}
+
+// drawnDist is the distribution of final ranks for a player who draws
+// according to holds
+func (t *Tables) drawnDist(holds *Holds) []float64 {
+	draws := t.drawTable()
+
+	// Equivalent hands holding equivalent cards finish the same way,
+	// so add up how often each canonical hold is played first
+	var used [NumSlots][8]int64
+	for _, idx := range t.hands {
+		canon, cmask := t.canonicalMask(handAt(int(idx)), holds[idx])
+		used[canon][cmask] += t.ways[idx]
+	}
+
+	dist := make([]float64, len(t.Winners))
+	for canon := range used {
+		for mask, n := range used[canon] {
+			if n == 0 {
+				continue
+			}
+			weight := float64(n) / (float64(t.totalWays) * float64(draws.totals[mask]))
+			for i, r := range draws.ranks[canon][mask] {
+				dist[r] += float64(draws.counts[canon][mask][i]) * weight
+			}
+		}
+	}
+	return dist
+}
+
+func newField(dist []float64) *Field {
+	f := &Field{Dist: dist, ByRank: make([]Odds, len(dist))}
+
+	// Lower ranks are better, so a hand wins against everything after it
+	below := 1.0
+	for r, p := range dist {
+		below -= p
+		if below < 0 {
+			below = 0
+		}
+		f.ByRank[r] = Odds{Win: below, Tie: p, Lose: 1 - below - p}
+	}
+	return f
+}
+
+// eachDraw calls fn with the rank of every hand that can come from
+// keeping the cards in mask and drawing the rest from the shoe, along
+// with how many ways there are to draw it. Discards can't be drawn
+// again.
+func (t *Tables) eachDraw(hand [3]Card, mask uint8, fn func(rank int, ways int64)) {
+	t.eachDrawHand(hand, mask, func(idx int, ways int64) {
+		fn(int(t.rank[idx]), ways)
+	})
+}
+
+// eachDrawHand is eachDraw with the HandIndex of the hand drawn to
+// rather than its rank
+func (t *Tables) eachDrawHand(hand [3]Card, mask uint8, fn func(idx int, ways int64)) {
+	var held [3]Card
+	nheld := 0
+	for i, c := range hand {
+		if mask&(1<<i) != 0 {
+			held[nheld] = c
+			nheld++
+		}
+	}
+	if nheld == 3 {
+		a, b, c := Sort3(hand[0], hand[1], hand[2])
+		fn(HandIndex(a, b, c), 1)
+		return
+	}
+
+	left := t.Shoe.counts
+	for _, c := range hand {
+		left[c]--
+	}
+
+	switch nheld {
+	case 2:
+		for x := Card(0); x < NumCards; x++ {
+			if left[x] > 0 {
+				a, b, c := Sort3(held[0], held[1], x)
+				fn(HandIndex(a, b, c), int64(left[x]))
+			}
+		}
+	case 1:
+		for x := Card(0); x < NumCards; x++ {
+			for y := x; y < NumCards; y++ {
+				if ways := combos(&left, []Card{x, y}); ways > 0 {
+					a, b, c := Sort3(held[0], x, y)
+					fn(HandIndex(a, b, c), ways)
+				}
+			}
+		}
+	default:
+		for x := Card(0); x < NumCards; x++ {
+			if left[x] == 0 {
+				continue
+			}
+			for y := x; y < NumCards; y++ {
+				for z := y; z < NumCards; z++ {
+					if ways := combos(&left, []Card{x, y, z}); ways > 0 {
+						fn(HandIndex(x, y, z), ways)
+					}
+				}
+			}
+		}
+	}
+}
+
+// HouseRules is a fixed drawing policy for the dealer
+type HouseRules struct {
+	// Keep a pair rather than drawing to it. Straights, flushes, trips
+	// and straight flushes always stand.
+	KeepPairs bool
+	// Unpaired cards of this rank (0 for a deuce to 12 for an ace) or
+	// higher are kept, everything else is drawn to
+	MinHold int
+}
+
+// DefaultHouseRules keep any pair and any queen or better
+var DefaultHouseRules = HouseRules{KeepPairs: true, MinHold: 10}
+
+// Holds applies the rules to every hand the tables' shoe can deal
+func (h HouseRules) Holds(t *Tables) *Holds {
+	var holds Holds
+	for _, idx := range t.hands {
+		played := t.resolved[idx]
+		holds[idx] = h.hold(played, t.Rules.Classify(played[0], played[1], played[2]))
+	}
+	return &holds
+}
+
+// hold works on the cards a hand plays as, so a wild card counts as
+// whatever it stands for
+func (h HouseRules) hold(hand [3]Card, class HandClass) uint8 {
+	switch class {
+	case HighCard:
+	case Pair:
+		if h.KeepPairs {
+			var mask uint8
+			for i := range hand {
+				for j := range hand {
+					if i != j && hand[i].Rank() == hand[j].Rank() {
+						mask |= 1 << i
+					}
+				}
+			}
+			return mask
+		}
+	default:
+		return 7
+	}
+
+	var mask uint8
+	for i, c := range hand {
+		if c.Rank() >= h.MinHold {
+			mask |= 1 << i
+		}
+	}
+	return mask
+}
+
+// Dealer draw policies
+const (
+	DealerStatic  = "static"
+	DealerHouse   = "house"
+	DealerOptimal = "optimal"
+)
+
+// dealerOptions are the flags that pick the opponent's drawing policy
+type dealerOptions struct {
+	policy    string
+	strategy  string
+	minHold   string
+	keepPairs bool
+}
+
+func addDealerFlags(fs *flag.FlagSet) *dealerOptions {
+	o := &dealerOptions{}
+	fs.StringVar(&o.policy, "dealer", DealerStatic, "dealer draw policy: static (no draw), house (fixed house rules) or optimal (a strategy file)")
+	fs.StringVar(&o.strategy, "dealer-strategy", "", "strategy file the dealer plays with -dealer optimal")
+	fs.StringVar(&o.minHold, "dealer-hold-min", rankNames[DefaultHouseRules.MinHold], "with -dealer house, lowest unpaired card the dealer keeps")
+	fs.BoolVar(&o.keepPairs, "dealer-keep-pairs", DefaultHouseRules.KeepPairs, "with -dealer house, whether the dealer stands on a pair")
+	return o
+}
+
+// holds returns the dealer's holds, or nil for a dealer who doesn't
+// draw. optimal is called if -dealer optimal has no strategy file.
+func (o *dealerOptions) holds(t *Tables, optimal func() (*Holds, error)) (*Holds, error) {
+	switch o.policy {
+	case DealerStatic:
+		return nil, nil
+	case DealerHouse:
+		minHold, err := parseRank(o.minHold)
+		if err != nil {
+			return nil, fmt.Errorf("bad -dealer-hold-min: %v", err)
+		}
+		rules := HouseRules{KeepPairs: o.keepPairs, MinHold: minHold}
+		return rules.Holds(t), nil
+	case DealerOptimal:
+		if o.strategy == "" {
+			return optimal()
+		}
+		s, err := LoadStrategy(o.strategy)
+		if err != nil {
+			return nil, err
+		}
+		return s.HoldMasks(t)
+	}
+	return nil, fmt.Errorf("unknown dealer policy %q", o.policy)
+}
+
+// field returns the field for the dealer's holds
+func fieldFor(t *Tables, holds *Holds) *Field {
+	if holds == nil {
+		return StaticField(t)
+	}
+	return DrawnField(t, holds)
+}
@@ -0,0 +1,66 @@
+package threecardanalyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDrawnField(t *testing.T) {
+	tables := houseTables(t)
+	static := StaticField(tables)
+
+	// Standing on every hand is the static field
+	var stand Holds
+	for idx := range stand {
+		stand[idx] = 7
+	}
+	for r, p := range DrawnField(tables, &stand).Dist {
+		if math.Abs(p-static.Dist[r]) > 1e-12 {
+			t.Errorf("standing pat: rank %d has chance %g, want %g", r, p, static.Dist[r])
+		}
+	}
+
+	for name, f := range map[string]*Field{
+		"static": static,
+		"house":  DrawnField(tables, DefaultHouseRules.Holds(tables)),
+		"draw":   DrawnField(tables, &Holds{}),
+	} {
+		sum := 0.0
+		for r, p := range f.Dist {
+			sum += p
+			if o := f.ByRank[r]; math.Abs(o.Win+o.Tie+o.Lose-1) > 1e-9 || o.Tie != p {
+				t.Errorf("%s: rank %d has odds %+v with chance %g", name, r, o, p)
+			}
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("%s: chances sum to %g", name, sum)
+		}
+	}
+}
+
+func TestHouseRulesHold(t *testing.T) {
+	tables := houseTables(t)
+	noPairs := HouseRules{MinHold: 10}
+	for _, test := range []struct {
+		rules HouseRules
+		hand  string
+		want  uint8
+	}{
+		{DefaultHouseRules, "5S-5D-QC", 0b011},
+		{noPairs, "5S-5D-QC", 0b100},
+		{DefaultHouseRules, "KS-3D-KC", 0b101},
+		{DefaultHouseRules, "AS-7D-QC", 0b101},
+		{DefaultHouseRules, "JS-7D-3C", 0},
+		{noPairs, "JS-7D-3C", 0},
+		{HouseRules{MinHold: 9}, "JS-7D-3C", 0b001},
+		{noPairs, "4S-5S-6S", 0b111},
+		{noPairs, "2S-7S-9S", 0b111},
+		{noPairs, "3S-4D-5C", 0b111},
+		{noPairs, "8S-8D-8C", 0b111},
+	} {
+		hand := mustHand(t, test.hand)
+		if got := test.rules.hold(hand, tables.Rules.Classify(hand[0], hand[1], hand[2])); got != test.want {
+			t.Errorf("%+v %s: hold %03b, want %03b", test.rules, test.hand, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package threecardanalyze
+
+import (
+	"sync"
+	"testing"
+)
+
+var (
+	houseOnce     sync.Once
+	houseAnalyzed *Analyzer
+)
+
+// houseTables is the skill's game: one deck and house rules
+func houseTables(t *testing.T) *Tables {
+	t.Helper()
+	tables, err := NewTables(NewShoe(1), SkillRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tables
+}
+
+// houseAnalyzer is the skill's game against a dealer who doesn't draw,
+// analyzed once for every test. Each caller gets its own copy, so it
+// can change the tie policy or objective.
+func houseAnalyzer(t *testing.T) *Analyzer {
+	t.Helper()
+	houseOnce.Do(func() {
+		tables := houseTables(t)
+		houseAnalyzed = NewAnalyzer(tables, StaticField(tables))
+		houseAnalyzed.Analyze()
+	})
+	a := *houseAnalyzed
+	return &a
+}
+
+// mustHand parses a hand key
+func mustHand(t *testing.T, key string) [3]Card {
+	t.Helper()
+	hand, err := ParseHand(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hand
+}
@@ -0,0 +1,133 @@
+package threecardanalyze
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+	"github.com/gsdriver/alexautils/internal/logging"
+)
+
+// LoadTest is the loadtest command: it sends random requests to a
+// strategy service, or to one it starts itself, and reports throughput
+// and latency per endpoint
+func LoadTest(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard loadtest", "Sends random requests to the strategy service and reports latency.")
+	url := fs.String("url", "", "service to test, for example http://localhost:8080 (default start one in process)")
+	requests := fs.Int("requests", 100000, "requests to send")
+	concurrency := fs.Int("concurrency", 8, "requests in flight at once")
+	seed := fs.Uint64("seed", 1, "RNG seed for the hands requested")
+	game := addGameFlags(fs, "removed")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *requests <= 0 || *concurrency <= 0 {
+		return cli.Usagef("-requests and -concurrency must be positive")
+	}
+
+	// The in process server uses the game flags; so do the hands sent,
+	// so they can all be dealt
+	tables, err := game.tables()
+	if err != nil {
+		return err
+	}
+	base := strings.TrimSuffix(*url, "/")
+	if base == "" {
+		server, err := newServer(game, dealer, "", *requests)
+		if err != nil {
+			return err
+		}
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return err
+		}
+		ctx, stop := context.WithCancel(context.Background())
+		defer stop()
+		go runServer(ctx, ln, server.Handler())
+		base = "http://" + ln.Addr().String()
+	}
+	slog.Info("load testing", "url", base, "requests", *requests, "concurrency", *concurrency)
+
+	var deck []Card
+	for c := Card(0); c < NumCards; c++ {
+		for i := 0; i < tables.Shoe.Count(c); i++ {
+			deck = append(deck, c)
+		}
+	}
+	client := &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{MaxIdleConnsPerHost: *concurrency},
+	}
+	report := logging.NewReport("load test")
+	latencies := make(map[string][]time.Duration)
+	var mux sync.Mutex
+	var wg sync.WaitGroup
+	start := time.Now()
+	for w := 0; w < *concurrency; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			rng := rand.New(rand.NewPCG(*seed, uint64(w)))
+			deck := append([]Card(nil), deck...)
+			hand := func() string {
+				shuffle(rng, deck, 3)
+				return describeHand([3]Card{deck[0], deck[1], deck[2]})
+			}
+			for i := w; i < *requests; i += *concurrency {
+				// Mostly holds, as the skill asks for those most
+				var endpoint, query string
+				switch n := rng.IntN(10); {
+				case n < 6:
+					endpoint, query = "hold", "hand="+hand()
+				case n < 9:
+					endpoint, query = "odds", "hand="+hand()
+				default:
+					endpoint, query = "evaluate", "hands="+hand()+","+hand()
+				}
+				t := time.Now()
+				resp, err := client.Get(base + "/" + endpoint + "?" + query)
+				if err == nil {
+					io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+					if resp.StatusCode != http.StatusOK {
+						err = fmt.Errorf("status %s", resp.Status)
+					}
+				}
+				elapsed := time.Since(t)
+				if err != nil {
+					report.Fail(err, "endpoint", endpoint, "query", query)
+					continue
+				}
+				report.Succeeded()
+				mux.Lock()
+				latencies[endpoint] = append(latencies[endpoint], elapsed)
+				mux.Unlock()
+			}
+		}(w)
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	fmt.Printf("%d requests in %v, %.0f per second\n\n", *requests, elapsed.Round(time.Millisecond), float64(*requests)/elapsed.Seconds())
+	fmt.Printf("  %-10s %8s %10s %10s %10s %10s\n", "Endpoint", "Count", "p50", "p90", "p99", "Max")
+	for _, endpoint := range []string{"hold", "odds", "evaluate"} {
+		l := latencies[endpoint]
+		if len(l) == 0 {
+			continue
+		}
+		sort.Slice(l, func(i, j int) bool { return l[i] < l[j] })
+		pct := func(p float64) time.Duration { return l[int(p*float64(len(l)-1))] }
+		fmt.Printf("  %-10s %8d %10v %10v %10v %10v\n", endpoint, len(l), pct(0.5), pct(0.9), pct(0.99), l[len(l)-1])
+	}
+	return report.Err()
+}
@@ -1,236 +1,486 @@
-package main
+// Package threecardanalyze works out the best cards to hold for every
+// starting hand in three card draw.
+package threecardanalyze
 
 import (
-    "fmt"
-    "encoding/json"
-    "strings"
-    "strconv"
-    "time"
-    "io/ioutil"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log/slog"
+	"math"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+	"github.com/gsdriver/alexautils/internal/logging"
 )
 
 type WinRatio struct {
-    Wins int
-    Ties int
-    Loses int
+	Wins  int
+	Ties  int
+	Loses int
 }
 
-// SafeCounter is safe to use concurrently.
-type EquivalentSuggestion struct {
-	suggestions map[string][]int
-	mux sync.Mutex
-}
+// Analyzer works out the odds of every hold against a field
+type Analyzer struct {
+	Tables *Tables
+	Field  *Field
+
+	// What a hold is worth given its odds. Nil means the chance of
+	// winning, which is what the analyzer has always maximized.
+	Objective func(Odds) float64
+
+	// Which of several equally good holds to play; empty means
+	// fewest-drawn
+	Ties TiePolicy
 
-var ranking map[string]int
-var winners []WinRatio
-var deck []string
-var equivalents EquivalentSuggestion
-var equivhits int
-
-// Value returns the current value of the counter for the given key.
-func (c *EquivalentSuggestion) Value(key string) []int {
-	c.mux.Lock()
-	defer c.mux.Unlock()
-	return c.suggestions[key]
+	// Odds of each hold for the canonical hands (see Tables.canon),
+	// with mask bits over the cards in Card order
+	canonOdds [NumSlots][8]Odds
+	analyzed  bool
 }
 
-func (c *EquivalentSuggestion) Put(key string, value []int) {
-	c.mux.Lock()
-	defer c.mux.Unlock()
-	c.suggestions[key] = value
+// NewAnalyzer returns an analyzer for the field
+func NewAnalyzer(t *Tables, f *Field) *Analyzer {
+	return &Analyzer{Tables: t, Field: f}
 }
 
-func main() {
-	// Load the JSON files in
-	dat := Ranks()
-	err := json.Unmarshal(dat, &ranking)
+// Run analyzes every hand and writes the suggested holds and the
+// equivalent hand mapping out to JSON
+func Run(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard analyze", "Computes the best hold for every hand.")
+	suggestFile := fs.String("suggest", "suggest.json", "file to write the suggested holds to")
+	equivalentsFile := fs.String("equivalents", "equivalents.json", "file to write the equivalent hand mapping to")
+	costsFile := fs.String("costs", "", "file to write the cost of every non-optimal hold to (three card hands, one round)")
+	cards := fs.Int("cards", 3, "cards in a hand, 3 to 5; bigger hands are dealt from a single deck against a dealer who doesn't draw (five card suggest.json is about 60 MB)")
+	rounds := fs.Int("rounds", 1, "draw rounds, for games that shuffle discards back into the shoe before each draw; with more than one, a strategy file is written for each round")
+	ties := fs.String("ties", string(TieFewestDrawn), "which of several equally good holds to play: fewest-drawn or highest-card")
+	alternativesFile := fs.String("alternatives", "", "file to write every equally good hold to, for hands with more than one")
+	summary := fs.Bool("summary", false, "print the game's odds under optimal play, by hand class and by cards held (three card hands, one round)")
+	game := addGameFlags(fs, "removed")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	policy, err := parseTiePolicy(*ties)
 	if err != nil {
-		fmt.Println(err.Error())
-		return
+		return cli.Usagef("%v", err)
+	}
+	if *cards != 3 {
+		if dealer.policy != DealerStatic {
+			return cli.Usagef("the dealer only draws in three card hands")
+		}
+		if *rounds != 1 {
+			return cli.Usagef("hands of more than three cards only draw once")
+		}
+		if *costsFile != "" {
+			return cli.Usagef("-costs is only written for three card hands")
+		}
+		if *summary {
+			return cli.Usagef("-summary is only printed for three card hands")
+		}
+		// Bigger hands always play the fewest-drawn of equally good holds
+		if policy != TieFewestDrawn {
+			return cli.Usagef("-ties is only %s for hands of more than three cards", TieFewestDrawn)
+		}
+		if *alternativesFile != "" {
+			return cli.Usagef("-alternatives is only written for three card hands")
+		}
+		return analyzeDraw(*cards, game, *suggestFile, *equivalentsFile)
+	}
+	if *rounds < 1 {
+		return cli.Usagef("-rounds must be at least 1")
+	}
+	if *alternativesFile != "" && *rounds > 1 {
+		return cli.Usagef("-alternatives is only written for one round")
+	}
+	if *summary && *rounds > 1 {
+		return cli.Usagef("-summary is only printed for one round")
+	}
+	if *costsFile != "" && *rounds > 1 {
+		return cli.Usagef("-costs is only written for one round")
 	}
 
-	dat = Winners()
-	err = json.Unmarshal(dat, &winners)
+	tables, err := game.tables()
 	if err != nil {
-		fmt.Println(err.Error())
-		return
+		return err
 	}
 
-	// Set up the deck
-	var card string
-	suits := []string{"C", "D", "H", "S"}
-	for i := 0; i < 13; i++ {
-		for j := 0; j < 4; j++ {
-			if (i < 9) {
-				card = strconv.Itoa(i + 2)
-			} else if (i == 9) {
-				card = "J"
-			} else if (i == 10) {
-				card = "Q"
-			} else if (i == 11) {
-				card = "K"
-			} else {
-				card = "A"
-			}
+	start := time.Now()
+	dealerHolds, err := dealer.holds(tables, func() (*Holds, error) {
+		// The dealer plays what's optimal against a static hand
+		slog.Info("computing the dealer's strategy")
+		return NewAnalyzer(tables, StaticField(tables)).BestHolds(), nil
+	})
+	if err != nil {
+		return err
+	}
+	analyzer := NewAnalyzer(tables, fieldFor(tables, dealerHolds))
+	analyzer.Ties = policy
+	analyzer.Analyze()
+	if *costsFile != "" {
+		if err = analyzer.Costs().Save(*costsFile); err != nil {
+			return err
+		}
+	}
+	if *rounds > 1 {
+		return analyzeRounds(analyzer, *rounds, *suggestFile, *equivalentsFile, start)
+	}
 
-			card += suits[j]
-			deck = append(deck, card)
+	// Go through the hands in order so the first of each equivalent
+	// set is the one that lands in equivalents.json
+	keys := make([]string, 0, len(tables.Ranking))
+	for k := range tables.Ranking {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	report := logging.NewReport("analyzing hands")
+	suggestions := make(map[string][]int)
+	equivalents := make(map[string][]int)
+	alternatives := make(map[string][][]int)
+	for _, key := range keys {
+		hand, err := ParseHand(key)
+		if err != nil {
+			report.Fail(err, "hand", key)
+			continue
+		}
+		optimal := analyzer.optimalHolds(hand, analyzer.HandOdds(hand))
+		bestplay := optimal[0]
+		suggestions[key] = bestplay
+		if len(optimal) > 1 {
+			alternatives[key] = optimal
+		}
+		equivalent := equivalentHand(strings.Split(key, "-"))
+		if _, ok := equivalents[equivalent]; !ok {
+			equivalents[equivalent] = bestplay
 		}
+		report.Succeeded()
 	}
+	elapsed := time.Since(start)
 
-	// Mapping of equivalent hands to speed things up
-	equivalents = EquivalentSuggestion{suggestions: make(map[string][]int)}
+	result, _ := json.Marshal(suggestions)
+	if err = ioutil.WriteFile(*suggestFile, result, 0644); err != nil {
+		return err
+	}
+	result, _ = json.Marshal(equivalents)
+	if err = ioutil.WriteFile(*equivalentsFile, result, 0644); err != nil {
+		return err
+	}
+	files := []string{*suggestFile, *equivalentsFile}
+	if *alternativesFile != "" {
+		result, _ = json.Marshal(alternatives)
+		if err = ioutil.WriteFile(*alternativesFile, result, 0644); err != nil {
+			return err
+		}
+		files = append(files, *alternativesFile)
+	}
+	hash, err := contentHash(files...)
+	if err != nil {
+		return err
+	}
+	slog.Info("analysis complete", "hands", len(suggestions), "shoe", tables.Shoe, "rules", tables.Rules, "dealer", dealer.policy,
+		"ties", policy, "tiedHands", len(alternatives), "equivalentHits", len(tables.hands)-analyzer.canonicalCount(),
+		"elapsed", elapsed, "sha256", hash)
+	if *summary {
+		fmt.Printf("Optimal play: %s, %s, %s dealer\n", tables.Shoe, tables.Rules, dealer.policy)
+		printSummary(analyzer.Summarize(analyzer.BestHolds()))
+	}
+	return report.Err()
+}
 
-	// And start analyzing hands
-	// Parallelize over mutlple channels
-	const NumberOfChannels = 4
-	var i int
-	var bestplay []int
-	var suggestions = make(map[string][]int)
+// analyzeDraw is the analyze command for hands of more than three cards
+func analyzeDraw(cards int, game *gameOptions, suggestFile, equivalentsFile string) error {
 	start := time.Now()
-	channels := make([]chan []int, NumberOfChannels)
-	for i = 0; i < len(channels); i++ {
-		channels[i] = make(chan []int)
+	tables, err := game.drawTables(cards)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	slog.Info("ranked hands", "cards", cards, "ranks", len(tables.Winners), "elapsed", time.Since(start))
+	holds := tables.BestHolds()
+	elapsed := time.Since(start)
+	if err = tables.WriteStrategy(holds, suggestFile, equivalentsFile); err != nil {
+		return err
 	}
+	hash, err := contentHash(suggestFile, equivalentsFile)
+	if err != nil {
+		return err
+	}
+	slog.Info("analysis complete", "hands", len(holds), "cards", cards, "rules", tables.Rules, "elapsed", elapsed, "sha256", hash)
+	return nil
+}
 
-	keys := make([]string, len(ranking))
-	i = 0
-	for k := range ranking {
-		keys[i] = k
-		i++
+// analyzeRounds writes a strategy and equivalents file for each round,
+// named after the -suggest and -equivalents files
+func analyzeRounds(analyzer *Analyzer, rounds int, suggestFile, equivalentsFile string, start time.Time) error {
+	tables := analyzer.Tables
+	rs := analyzer.AnalyzeRounds(rounds)
+	elapsed := time.Since(start)
+
+	for r, holds := range rs.Holds {
+		suggestions := StrategyFromHolds(tables, holds)
+		keys := make([]string, 0, len(suggestions))
+		for k := range suggestions {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		equivalents := make(map[string][]int)
+		for _, key := range keys {
+			equivalent := equivalentHand(strings.Split(key, "-"))
+			if _, ok := equivalents[equivalent]; !ok {
+				equivalents[equivalent] = suggestions[key]
+			}
+		}
+
+		if err := suggestions.Save(roundFile(suggestFile, r+1)); err != nil {
+			return err
+		}
+		result, _ := json.Marshal(equivalents)
+		if err := ioutil.WriteFile(roundFile(equivalentsFile, r+1), result, 0644); err != nil {
+			return err
+		}
 	}
+	var files []string
+	for r := range rs.Holds {
+		files = append(files, roundFile(suggestFile, r+1), roundFile(equivalentsFile, r+1))
+	}
+	hash, err := contentHash(files...)
+	if err != nil {
+		return err
+	}
+	value := rs.Value(tables)
+	slog.Info("analysis complete", "rounds", rounds, "hands", len(tables.hands), "shoe", tables.Shoe, "rules", tables.Rules,
+		"win", value.Win, "tie", value.Tie, "lose", value.Lose, "elapsed", elapsed, "sha256", hash)
+	return nil
+}
 
-	for i = 0; i < len(keys) - (NumberOfChannels - 1); i += NumberOfChannels {
-		for j, ch := range channels {
-			go analyzehand(ch, keys[i + j])
+// contentHash is the SHA-256 of the files' contents, one after the
+// other. The output only depends on the inputs, so a rerun with nothing
+// changed gives the same hash.
+func contentHash(files ...string) (string, error) {
+	h := sha256.New()
+	for _, file := range files {
+		dat, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
 		}
-		for j, ch := range channels {
-			bestplay = <- ch
-			suggestions[keys[i + j]] = bestplay
+		h.Write(dat)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// roundFile names the file for a round: suggest.json becomes
+// suggest-round1.json and so on
+func roundFile(filename string, round int) string {
+	ext := filepath.Ext(filename)
+	return fmt.Sprintf("%s-round%d%s", strings.TrimSuffix(filename, ext), round, ext)
+}
+
+// Analyze works out the odds of every hold for every hand. Equivalent
+// hands (the same apart from suits) are only computed once.
+func (a *Analyzer) Analyze() {
+	if a.analyzed {
+		return
+	}
+
+	var canon []int
+	for _, idx := range a.Tables.hands {
+		if a.Tables.canon[idx] == idx {
+			canon = append(canon, int(idx))
 		}
 	}
 
-	// May need to do one more if there were odd number of keys
-	for j := 0; j < len(keys) % NumberOfChannels; j++ {
-		go analyzehand(channels[0], keys[len(keys) - j - 1])
-		bestplay = <- channels[0]
-		suggestions[keys[len(keys) - j - 1]] = bestplay
+	// Parallelize over multiple workers, each taking every
+	// NumberOfWorkers'th hand
+	const NumberOfWorkers = 4
+	var wg sync.WaitGroup
+	for w := 0; w < NumberOfWorkers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(canon); i += NumberOfWorkers {
+				hand := handAt(canon[i])
+				for mask := uint8(0); mask < 8; mask++ {
+					a.canonOdds[canon[i]][mask] = a.oddstowin(hand, mask)
+				}
+			}
+		}(w)
 	}
+	wg.Wait()
+	a.analyzed = true
+}
 
-	t := time.Now()
-	elapsed := t.Sub(start)
+func (a *Analyzer) canonicalCount() int {
+	n := 0
+	for _, idx := range a.Tables.hands {
+		if a.Tables.canon[idx] == idx {
+			n++
+		}
+	}
+	return n
+}
 
-	result, _ := json.Marshal(suggestions)
-	ioutil.WriteFile("suggest.json", result, 0644)
-	result, _ = json.Marshal(equivalents)
-	ioutil.WriteFile("equivalents.json", result, 0644)
-	fmt.Println(equivhits)
-	fmt.Println(elapsed)
+// HoldOdds returns the odds of every hold for the hand, where bit i of
+// the index means hand[i] is kept. Analyze must have been called.
+func (a *Analyzer) HoldOdds(hand [3]Card) [8]Odds {
+	return a.Tables.mapOdds(&a.canonOdds, hand)
 }
 
-func analyzehand(ch chan []int, cards string) {
-    hand := strings.Split(cards, "-")
-    var bestplay []int
-    bestodds := 0.0
-    var odds []float64
-    var bestMapping [][]int
-
-	// First, have we seen an equivalent?  If so, just add it here
-	equivalent := equivalentHand(hand)
-	if equivalents.Value(equivalent) != nil {
-		equivhits++
-		bestplay = equivalents.Value(equivalent)
-	} else {
-		bestMapping = append(bestMapping, []int{0, 1, 2})
-		bestMapping = append(bestMapping, []int{0, 1})
-		bestMapping = append(bestMapping, []int{1, 2})
-		bestMapping = append(bestMapping, []int{0, 2})
-		bestMapping = append(bestMapping, []int{2})
-		bestMapping = append(bestMapping, []int{0})
-		bestMapping = append(bestMapping, []int{1})
-		bestMapping = append(bestMapping, []int{})
-
-		// OK, first hold all
-		odds = append(odds, oddstowin(hand, 3))
-
-		// Try holding two cards
-		odds = append(odds, oddstowin(hand, 2))
-		hand[0], hand[2] = hand[2], hand[0]
-		odds = append(odds, oddstowin(hand, 2))
-		hand[1], hand[2] = hand[1], hand[2]
-		odds = append(odds, oddstowin(hand, 2))
-
-		// Try holding one card (note array is reversed at this point)
-		odds = append(odds, oddstowin(hand, 1))
-		hand[0], hand[2] = hand[2], hand[0]
-		odds = append(odds, oddstowin(hand, 1))
-		hand[1], hand[0] = hand[0], hand[1]
-		odds = append(odds, oddstowin(hand, 1))
-
-		// Try discarding all
-		odds = append(odds, oddstowin(hand, 0))
-
-		// Which one is highest?
-		for index, odd := range odds {
-			if (odd > bestodds) {
-				bestodds = odd
-				bestplay = bestMapping[index]
+// mapOdds looks up the odds of every hold for the hand in a table of
+// odds for the canonical hands
+func (t *Tables) mapOdds(canonOdds *[NumSlots][8]Odds, hand [3]Card) [8]Odds {
+	canon, pos := t.canonPositions(hand)
+	var odds [8]Odds
+	for mask := uint8(0); mask < 8; mask++ {
+		var cmask uint8
+		for i := range hand {
+			if mask&(1<<i) != 0 {
+				cmask |= 1 << pos[i]
 			}
 		}
-		// And save this
-		equivalents.Put(equivalent, bestplay)
+		odds[mask] = canonOdds[canon][cmask]
+	}
+	return odds
+}
+
+// HandOdds works out the odds of every hold for one hand without
+// analyzing the rest, drawing straight from the shoe
+func (a *Analyzer) HandOdds(hand [3]Card) [8]Odds {
+	if a.analyzed {
+		return a.HoldOdds(hand)
 	}
+	var odds [8]Odds
+	for mask := uint8(0); mask < 8; mask++ {
+		total := float64(binomial(a.Tables.Shoe.Size()-3, 3-bits.OnesCount8(mask)))
+		a.Tables.eachDraw(hand, mask, func(rank int, ways int64) {
+			odds[mask].add(a.Field.ByRank[rank], float64(ways)/total)
+		})
+	}
+	return odds
+}
 
-	ch <- bestplay
+// BestHolds returns the best hold for every hand the shoe can deal
+func (a *Analyzer) BestHolds() *Holds {
+	a.Analyze()
+	var holds Holds
+	a.Tables.Hands(func(idx int, hand [3]Card) {
+		holds[idx], _ = a.pick(hand, a.HandOdds)
+	})
+	return &holds
 }
 
-// For the given set of cards, the opponent's shown card
-// and an array of cards to hold, calculates the probability
-// of winning your hand
-// Dealer up card is only used to exclude that card as a
-// possibility for a drawn card - not in determining
-// probability of winning (could be a future optimization)
-func oddstowin(cards []string, hold int) float64 {
-	var total float64
-	var evaluated float64
-
-	if (hold == 3) {
-		// Holding all three is easy
-		total = float64(winners[ranking[handtostring(cards)]].Wins) / 22100.0
-		evaluated = 1.0
-	} else {
-		// Create array of all cards we could be dealt
-		// Figure out the odds of winning for each outcome
-		// And average the result to provide overall odds of winning
-		total = 0.0
-		evaluated = 0.0
-		var newhand []string
-		for i, card := range cards {
-			if (i == hold) {
-				// Will replace with drawn card
-				newhand = append(newhand, "AS")
+// holdOrder is the order holds are tried in, as positions in the hand
+// key: all three cards, then two, then one, then none, earlier
+// positions first. Holds whose odds are within tieTolerance of the
+// best are all optimal and the tie policy picks between them. The
+// tolerance is far above the rounding error in adding up the odds, so
+// equal holds don't get separated by the order the additions happened
+// in, and far below any real difference.
+var holdOrder = [][]int{{0, 1, 2}, {0, 1}, {1, 2}, {0, 2}, {2}, {0}, {1}, {}}
+
+const tieTolerance = 1e-12
+
+// TiePolicy says which of several equally good holds to play
+type TiePolicy string
+
+const (
+	// Keep the most cards, then the earliest positions in the hand key
+	TieFewestDrawn TiePolicy = "fewest-drawn"
+	// Keep the highest card (then the next highest and so on), then
+	// as fewest-drawn
+	TieHighestCard TiePolicy = "highest-card"
+)
+
+func parseTiePolicy(s string) (TiePolicy, error) {
+	switch p := TiePolicy(s); p {
+	case TieFewestDrawn, TieHighestCard:
+		return p, nil
+	}
+	return "", fmt.Errorf("-ties must be %s or %s, not %q", TieFewestDrawn, TieHighestCard, s)
+}
+
+func positionsMask(positions []int) uint8 {
+	var mask uint8
+	for _, p := range positions {
+		mask |= 1 << p
+	}
+	return mask
+}
+
+// bestplay picks the positions to hold given the odds of every hold.
+// It always picks one.
+func (a *Analyzer) bestplay(hand [3]Card, odds [8]Odds) []int {
+	return a.optimalHolds(hand, odds)[0]
+}
+
+// optimalHolds returns every hold within tieTolerance of the best, as
+// positions in the hand, in the order the tie policy prefers them. If
+// no hold has a score that can be compared (the objective gave NaN for
+// every one) it keeps all three cards.
+func (a *Analyzer) optimalHolds(hand [3]Card, odds [8]Odds) [][]int {
+	best := math.Inf(-1)
+	for _, hold := range holdOrder {
+		best = math.Max(best, a.score(odds[positionsMask(hold)]))
+	}
+	var holds [][]int
+	for _, hold := range holdOrder {
+		if a.score(odds[positionsMask(hold)]) >= best-tieTolerance {
+			holds = append(holds, hold)
+		}
+	}
+	if len(holds) == 0 {
+		return [][]int{holdOrder[0]}
+	}
+	if a.Ties == TieHighestCard {
+		// Ranks held, highest first; holdOrder already has more cards
+		// first, so the stable sort falls back to fewest-drawn
+		ranks := func(hold []int) []int {
+			var r []int
+			for _, pos := range hold {
+				r = append(r, hand[pos].Rank())
 			}
-			newhand = append(newhand, card)
+			sort.Sort(sort.Reverse(sort.IntSlice(r)))
+			return r
 		}
-		for _, newcard := range deck {
-			iscardnew := true
-			for _, card := range cards {
-				if (newcard == card) {
-					iscardnew = false
-					break
+		sort.SliceStable(holds, func(i, j int) bool {
+			ri, rj := ranks(holds[i]), ranks(holds[j])
+			for k := 0; k < len(ri) && k < len(rj); k++ {
+				if ri[k] != rj[k] {
+					return ri[k] > rj[k]
 				}
 			}
-			if iscardnew {
-				newhand[hold] = newcard
-				evaluated += 1.0
-				total += oddstowin(newhand, hold + 1)
-			}
-		}
+			return false
+		})
+	}
+	return holds
+}
+
+func (a *Analyzer) score(odds Odds) float64 {
+	if a.Objective == nil {
+		return odds.Win
 	}
+	return a.Objective(odds)
+}
 
-	// Now average all the odds - we should have assessed 49 different hands
-	return (total / evaluated)
+// For the given set of cards and a mask of which to hold, calculates
+// the probability of the final hand winning, tying and losing against
+// the field by averaging over every possible draw
+func (a *Analyzer) oddstowin(hand [3]Card, mask uint8) Odds {
+	var odds Odds
+	draws := a.Tables.drawTable()
+	canon, cmask := a.Tables.canonicalMask(hand, mask)
+	total := float64(draws.totals[cmask])
+	for i, r := range draws.ranks[canon][cmask] {
+		odds.add(a.Field.ByRank[r], float64(draws.counts[canon][cmask][i])/total)
+	}
+	return odds
 }
 
 func handtostring(hand []string) string {
@@ -0,0 +1,48 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestOptimalHoldsTies(t *testing.T) {
+	a := houseAnalyzer(t)
+	hand := mustHand(t, "2C-KD-9H")
+
+	// Keeping the two and nine ties with keeping the king, and keeping
+	// the king and nine is a rounding error behind them
+	var odds [8]Odds
+	for mask := range odds {
+		odds[mask] = Odds{Win: 0.1, Lose: 0.9}
+	}
+	odds[positionsMask([]int{0, 2})] = Odds{Win: 0.4, Lose: 0.6}
+	odds[positionsMask([]int{1})] = Odds{Win: 0.4, Tie: 0.1, Lose: 0.5}
+	odds[positionsMask([]int{1, 2})] = Odds{Win: 0.4 - tieTolerance/2, Lose: 0.6 + tieTolerance/2}
+
+	for _, test := range []struct {
+		ties TiePolicy
+		want string
+	}{
+		// More cards first, then in holdOrder
+		{TieFewestDrawn, "[[1 2] [0 2] [1]]"},
+		// The king first, then the king and nine over the king alone
+		{TieHighestCard, "[[1 2] [1] [0 2]]"},
+	} {
+		a.Ties = test.ties
+		holds := a.optimalHolds(hand, odds)
+		if got := fmt.Sprint(holds); got != test.want {
+			t.Errorf("%s: %s, want %s", test.ties, got, test.want)
+		}
+		if got := fmt.Sprint(a.bestplay(hand, odds)); got != fmt.Sprint(holds[0]) {
+			t.Errorf("%s: played %s, not the first optimal hold", test.ties, got)
+		}
+	}
+
+	// An objective that can't tell holds apart ties them all
+	a.Ties = TieHighestCard
+	a.Objective = func(Odds) float64 { return 0 }
+	want := "[[0 1 2] [1 2] [0 1] [1] [0 2] [2] [0] []]"
+	if got := fmt.Sprint(a.optimalHolds(hand, odds)); got != want {
+		t.Errorf("every hold tied: %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,202 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// MemoryTable is a strategy table in memory, for dry runs and for trying
+// a publisher without DynamoDB. It understands the expressions the
+// publisher uses: SET and ADD updates, and conditions on whether an
+// attribute exists or equals a value, joined with OR.
+type MemoryTable struct {
+	// BatchLimit, if positive, is the most items a batch write
+	// processes; the rest come back unprocessed, as when DynamoDB
+	// throttles a write
+	BatchLimit int
+
+	mux   sync.Mutex
+	items map[[2]string]map[string]*dynamodb.AttributeValue
+}
+
+// NewMemoryTable returns an empty table
+func NewMemoryTable() *MemoryTable {
+	return &MemoryTable{items: make(map[[2]string]map[string]*dynamodb.AttributeValue)}
+}
+
+// Len is how many items the table holds
+func (m *MemoryTable) Len() int {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return len(m.items)
+}
+
+func memoryKey(item map[string]*dynamodb.AttributeValue) ([2]string, error) {
+	version, hand := item["version"], item["hand"]
+	if version == nil || version.S == nil || hand == nil || hand.S == nil {
+		return [2]string{}, awserr.New("ValidationException", "item is missing its version or hand key", nil)
+	}
+	return [2]string{*version.S, *hand.S}, nil
+}
+
+func (m *MemoryTable) BatchWriteItem(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	out := &dynamodb.BatchWriteItemOutput{UnprocessedItems: map[string][]*dynamodb.WriteRequest{}}
+	for table, requests := range input.RequestItems {
+		if len(requests) > 25 {
+			return nil, awserr.New("ValidationException", "too many items in a batch write", nil)
+		}
+		for i, r := range requests {
+			if m.BatchLimit > 0 && i >= m.BatchLimit {
+				out.UnprocessedItems[table] = requests[i:]
+				break
+			}
+			if r.PutRequest == nil {
+				return nil, awserr.New("ValidationException", "only puts are supported", nil)
+			}
+			key, err := memoryKey(r.PutRequest.Item)
+			if err != nil {
+				return nil, err
+			}
+			m.items[key] = r.PutRequest.Item
+		}
+	}
+	return out, nil
+}
+
+func (m *MemoryTable) GetItem(input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	key, err := memoryKey(input.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &dynamodb.GetItemOutput{Item: copyItem(m.items[key])}, nil
+}
+
+func (m *MemoryTable) PutItem(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	key, err := memoryKey(input.Item)
+	if err != nil {
+		return nil, err
+	}
+	if input.ConditionExpression != nil {
+		ok, err := memoryCondition(m.items[key], *input.ConditionExpression, input.ExpressionAttributeNames, input.ExpressionAttributeValues)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "the conditional request failed", nil)
+		}
+	}
+	m.items[key] = copyItem(input.Item)
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (m *MemoryTable) UpdateItem(input *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	key, err := memoryKey(input.Key)
+	if err != nil {
+		return nil, err
+	}
+	names, values := input.ExpressionAttributeNames, input.ExpressionAttributeValues
+	if input.ConditionExpression != nil {
+		ok, err := memoryCondition(m.items[key], *input.ConditionExpression, names, values)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "the conditional request failed", nil)
+		}
+	}
+
+	item := copyItem(m.items[key])
+	if item == nil {
+		item = copyItem(input.Key)
+	}
+	action, assignments, _ := strings.Cut(aws.StringValue(input.UpdateExpression), " ")
+	for _, a := range strings.Split(assignments, ",") {
+		switch action {
+		case "SET":
+			name, value, ok := strings.Cut(a, "=")
+			v := values[strings.TrimSpace(value)]
+			if !ok || v == nil {
+				return nil, fmt.Errorf("memory table: can't SET %q", a)
+			}
+			item[memoryName(name, names)] = v
+		case "ADD":
+			fields := strings.Fields(a)
+			if len(fields) != 2 || values[fields[1]] == nil || values[fields[1]].N == nil {
+				return nil, fmt.Errorf("memory table: can't ADD %q", a)
+			}
+			name := memoryName(fields[0], names)
+			sum, _ := new(big.Float).SetString(*values[fields[1]].N)
+			if old := item[name]; old != nil && old.N != nil {
+				n, _ := new(big.Float).SetString(*old.N)
+				sum.Add(sum, n)
+			}
+			item[name] = &dynamodb.AttributeValue{N: aws.String(sum.Text('f', -1))}
+		default:
+			return nil, fmt.Errorf("memory table: unsupported update %q", aws.StringValue(input.UpdateExpression))
+		}
+	}
+	m.items[key] = item
+
+	out := &dynamodb.UpdateItemOutput{}
+	if aws.StringValue(input.ReturnValues) == dynamodb.ReturnValueAllNew {
+		out.Attributes = copyItem(item)
+	}
+	return out, nil
+}
+
+// memoryName resolves an attribute name placeholder
+func memoryName(name string, names map[string]*string) string {
+	name = strings.TrimSpace(name)
+	if n := names[name]; n != nil {
+		return *n
+	}
+	return name
+}
+
+// memoryCondition evaluates a condition: attribute_not_exists(a) or
+// a = :v clauses, joined with OR
+func memoryCondition(item map[string]*dynamodb.AttributeValue, cond string, names map[string]*string, values map[string]*dynamodb.AttributeValue) (bool, error) {
+	for _, clause := range strings.Split(cond, " OR ") {
+		clause = strings.TrimSpace(clause)
+		if name, ok := strings.CutPrefix(clause, "attribute_not_exists("); ok {
+			if item[memoryName(strings.TrimSuffix(name, ")"), names)] == nil {
+				return true, nil
+			}
+			continue
+		}
+		name, value, ok := strings.Cut(clause, "=")
+		want := values[strings.TrimSpace(value)]
+		if !ok || want == nil {
+			return false, fmt.Errorf("memory table: unsupported condition %q", cond)
+		}
+		if got := item[memoryName(name, names)]; got != nil && got.String() == want.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func copyItem(item map[string]*dynamodb.AttributeValue) map[string]*dynamodb.AttributeValue {
+	if item == nil {
+		return nil
+	}
+	c := make(map[string]*dynamodb.AttributeValue, len(item))
+	for k, v := range item {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,442 @@
+package threecardanalyze
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+	"gopkg.in/yaml.v3"
+)
+
+// PayRange is the payouts the optimizer may give a class, to 1 on a win
+type PayRange struct {
+	Min   float64  `json:"min" yaml:"min"`
+	Max   float64  `json:"max" yaml:"max"`
+	Start *float64 `json:"start,omitempty" yaml:"start,omitempty"` // where the search starts, default Min
+}
+
+// ParamPaytable is a main game paytable with payouts to search over.
+// A win pays by the class of the player's final hand; a tie pushes and
+// classes not listed pay even money.
+type ParamPaytable struct {
+	Name string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Pays map[string]PayRange `json:"pays" yaml:"pays"`
+
+	// Payouts are multiples of Step (default 1, whole numbers)
+	Step float64 `json:"step,omitempty" yaml:"step,omitempty"`
+
+	// Better hands pay at least as much as worse ones
+	Monotonic bool `json:"monotonic,omitempty" yaml:"monotonic,omitempty"`
+}
+
+// LoadParamPaytable reads a parametrized paytable. Files ending in
+// .json are read as JSON, anything else as YAML.
+func LoadParamPaytable(name string) (ParamPaytable, error) {
+	dat, err := os.ReadFile(name)
+	if err != nil {
+		return ParamPaytable{}, err
+	}
+	var p ParamPaytable
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(dat))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&p)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(dat))
+		dec.KnownFields(true)
+		err = dec.Decode(&p)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if p.Step == 0 {
+		p.Step = 1
+	}
+	if err == nil {
+		err = p.Validate()
+	}
+	if err != nil {
+		return ParamPaytable{}, fmt.Errorf("%s: %v", name, err)
+	}
+	if p.Name == "" {
+		p.Name = filepath.Base(name)
+	}
+	return p, nil
+}
+
+// Validate checks the ranges make sense
+func (p ParamPaytable) Validate() error {
+	if p.Step <= 0 {
+		return fmt.Errorf("step must be positive")
+	}
+	known := make(map[string]bool)
+	for _, class := range classOrder[3] {
+		known[class.String()] = true
+	}
+	for h, r := range p.Pays {
+		if !known[h] {
+			return fmt.Errorf("%q isn't a three card class", h)
+		}
+		start := r.start()
+		if r.Min < 0 || r.Max < r.Min || start < r.Min || start > r.Max {
+			return fmt.Errorf("%s: need 0 <= min <= start <= max", h)
+		}
+	}
+	return nil
+}
+
+// start is where the search starts for the class
+func (r PayRange) start() float64 {
+	if r.Start == nil {
+		return r.Min
+	}
+	return *r.Start
+}
+
+// Payouts are what a win pays, to 1, for each class
+type Payouts [NumClasses]float64
+
+// classOdds are a hold's chance of winning with each class of final
+// hand and of losing
+type classOdds struct {
+	win  [NumClasses]float64
+	lose float64
+}
+
+func (o *classOdds) value(pays *Payouts) float64 {
+	v := -o.lose
+	for c, w := range o.win {
+		v += w * pays[c]
+	}
+	return v
+}
+
+// PaytableSearch finds payouts for a target return. The odds of every
+// hold by final class don't depend on the payouts, so they're worked
+// out once and each candidate only redoes the choice of hold.
+type PaytableSearch struct {
+	Tables *Tables
+
+	canon  []int          // canonical hands
+	weight []float64      // chance of being dealt each
+	odds   [][8]classOdds // by canonical hand and hold over its cards
+	index  map[int]int    // canonical hand to its place in canon
+	evals  int
+}
+
+// NewPaytableSearch works out the odds of every hold by final class
+// against the field
+func NewPaytableSearch(t *Tables, f *Field) *PaytableSearch {
+	s := &PaytableSearch{Tables: t, index: make(map[int]int)}
+	for _, idx := range t.hands {
+		canon := int(t.canon[idx])
+		i, ok := s.index[canon]
+		if !ok {
+			i = len(s.canon)
+			s.index[canon] = i
+			s.canon = append(s.canon, canon)
+			s.weight = append(s.weight, 0)
+		}
+		s.weight[i] += t.weight(int(idx))
+	}
+
+	rankClass := make([]HandClass, len(t.Winners))
+	t.Hands(func(idx int, hand [3]Card) {
+		rankClass[t.rank[idx]] = t.Classify(hand)
+	})
+
+	draws := t.drawTable()
+	s.odds = make([][8]classOdds, len(s.canon))
+	for i, canon := range s.canon {
+		for mask := uint8(0); mask < 8; mask++ {
+			o := &s.odds[i][mask]
+			total := float64(draws.totals[mask])
+			for j, r := range draws.ranks[canon][mask] {
+				p := float64(draws.counts[canon][mask][j]) / total
+				o.win[rankClass[r]] += f.ByRank[r].Win * p
+				o.lose += f.ByRank[r].Lose * p
+			}
+		}
+	}
+	return s
+}
+
+// Return is what a unit bet pays back, stake included, when every hand
+// holds what's best for the payouts
+func (s *PaytableSearch) Return(pays *Payouts) float64 {
+	s.evals++
+	ev := 0.0
+	for i := range s.canon {
+		best := math.Inf(-1)
+		for mask := range s.odds[i] {
+			best = math.Max(best, s.odds[i][mask].value(pays))
+		}
+		ev += s.weight[i] * best
+	}
+	return 1 + ev
+}
+
+// Holds is the best hold for every hand given the payouts, breaking
+// ties the way the analyzer does
+func (s *PaytableSearch) Holds(pays *Payouts) *Holds {
+	var holds Holds
+	s.Tables.Hands(func(idx int, hand [3]Card) {
+		best := math.Inf(-1)
+		order := keyOrder(hand)
+		for _, hold := range holdOrder {
+			var mask uint8
+			for _, pos := range hold {
+				mask |= 1 << order[pos]
+			}
+			canon, cmask := s.Tables.canonicalMask(hand, mask)
+			if v := s.odds[s.index[canon]][cmask].value(pays); v > best+tieTolerance {
+				best, holds[idx] = v, mask
+			}
+		}
+	})
+	return &holds
+}
+
+// maxGrid is the most payout combinations Search tries one by one
+const maxGrid = 1 << 14
+
+// Search looks for the payouts whose return is closest to the target
+// (and, between payouts as close, nearest the starting ones). When the
+// free classes have at most maxGrid combinations of payouts it tries
+// them all. Otherwise it walks from several starts (the given one and
+// every payout at its least, most and middle), moving one payout or two
+// together by strides that halve down to a single step, until no move
+// gets closer, and keeps the best end. That can still miss a better answer, so ok says whether the
+// return is within tolerance of the target.
+func (s *PaytableSearch) Search(p ParamPaytable, target, tolerance float64) (best Payouts, ok bool) {
+	var pays, low, high, mid Payouts
+	var free []HandClass
+	for c := range pays {
+		pays[c] = 1
+	}
+	low, high, mid = pays, pays, pays
+	grid := 1
+	for h, r := range p.Pays {
+		c := classByName(h)
+		pays[c] = r.start()
+		low[c], high[c] = r.Min, r.Max
+		mid[c] = r.Min + math.Round((r.Max-r.Min)/2/p.Step)*p.Step
+		if r.Max > r.Min {
+			free = append(free, c)
+			grid *= int(math.Floor((r.Max-r.Min)/p.Step+1e-9)) + 1
+			if grid > maxGrid {
+				grid = maxGrid + 1
+			}
+		}
+	}
+	sort.Slice(free, func(i, j int) bool { return free[i] < free[j] })
+	start := pays
+
+	// Classes best first, for the monotonic check
+	order := append([]HandClass(nil), classOrder[3]...)
+	sort.Slice(order, func(i, j int) bool {
+		return s.Tables.Rules.order(order[i]) < s.Tables.Rules.order(order[j])
+	})
+	allowed := func(pays *Payouts) bool {
+		for h, r := range p.Pays {
+			if v := pays[classByName(h)]; v < r.Min-1e-9 || v > r.Max+1e-9 {
+				return false
+			}
+		}
+		if p.Monotonic {
+			for i := 1; i < len(order); i++ {
+				if pays[order[i]] > pays[order[i-1]] {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	distance := func(pays *Payouts) float64 {
+		d := 0.0
+		for c := range pays {
+			d += math.Abs(pays[c] - start[c])
+		}
+		return d
+	}
+	// Keep to the grid so repeated steps don't drift
+	snap := func(v float64) float64 {
+		return math.Round(v*1e9) / 1e9
+	}
+
+	miss := func(pays *Payouts) float64 {
+		return math.Abs(s.Return(pays) - target)
+	}
+	closer := func(m, d, than, thanDistance float64) bool {
+		return m < than-1e-12 || (math.Abs(m-than) <= 1e-12 && d < thanDistance)
+	}
+
+	best = start
+	bestMiss, bestDistance := math.Inf(1), 0.0
+	if allowed(&best) {
+		bestMiss = miss(&best)
+	}
+
+	if grid <= maxGrid {
+		try := low
+		var walk func(i int)
+		walk = func(i int) {
+			if i == len(free) {
+				if allowed(&try) {
+					if m, d := miss(&try), distance(&try); closer(m, d, bestMiss, bestDistance) {
+						best, bestMiss, bestDistance = try, m, d
+					}
+				}
+				return
+			}
+			c := free[i]
+			for v := low[c]; v <= high[c]+1e-9; v = snap(v + p.Step) {
+				try[c] = v
+				walk(i + 1)
+			}
+		}
+		walk(0)
+		return best, bestMiss <= tolerance
+	}
+
+	// Moves of one payout, or two at once in either direction
+	var moves [][]float64
+	widest := 0.0
+	for i, c := range free {
+		widest = math.Max(widest, high[c]-low[c])
+		for _, step := range []float64{-1, 1} {
+			move := make([]float64, len(free))
+			move[i] = step
+			moves = append(moves, move)
+		}
+		for j := i + 1; j < len(free); j++ {
+			for _, si := range []float64{-1, 1} {
+				for _, sj := range []float64{-1, 1} {
+					move := make([]float64, len(free))
+					move[i], move[j] = si, sj
+					moves = append(moves, move)
+				}
+			}
+		}
+	}
+	// Strides start near a quarter of the widest range and halve down
+	// to one step, so a long way to go doesn't take a step at a time
+	stride := 1.0
+	for 2*stride*p.Step <= widest/4 {
+		stride *= 2
+	}
+
+	for _, pays := range []Payouts{start, low, high, mid} {
+		if !allowed(&pays) {
+			continue
+		}
+		m, d := miss(&pays), distance(&pays)
+		for k := stride; k >= 1; k /= 2 {
+			for {
+				next, nextMiss, nextDistance := pays, m, d
+				for _, move := range moves {
+					try := pays
+					for i, c := range free {
+						try[c] = snap(try[c] + k*p.Step*move[i])
+					}
+					if !allowed(&try) {
+						continue
+					}
+					if tm, td := miss(&try), distance(&try); closer(tm, td, nextMiss, nextDistance) {
+						next, nextMiss, nextDistance = try, tm, td
+					}
+				}
+				if next == pays {
+					break
+				}
+				pays, m, d = next, nextMiss, nextDistance
+			}
+		}
+		if closer(m, d, bestMiss, bestDistance) {
+			best, bestMiss, bestDistance = pays, m, d
+		}
+	}
+	return best, bestMiss <= tolerance
+}
+
+func classByName(name string) HandClass {
+	for c, n := range classNames {
+		if n == name {
+			return HandClass(c)
+		}
+	}
+	return NumClasses
+}
+
+// Optimize is the optimize command: searches a paytable's payouts for a
+// target return under optimal play
+func Optimize(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard optimize", "Searches paytable payouts for a target return under optimal play.")
+	paytableFile := fs.String("paytable", "", "YAML or JSON file with the range of payouts for each class")
+	target := fs.Float64("target", 0.975, "return to aim for, stake included (0.975 is a 2.5% house edge)")
+	tolerance := fs.Float64("tolerance", 0.0005, "how far from the target the return may end up before the search fails")
+	suggestFile := fs.String("suggest", "", "file to write the optimal strategy for the payouts found to")
+	game := addGameFlags(fs, "removed")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *paytableFile == "" {
+		return cli.Usagef("-paytable is required")
+	}
+	if *target <= 0 {
+		return cli.Usagef("-target must be positive")
+	}
+	if *tolerance < 0 {
+		return cli.Usagef("-tolerance can't be negative")
+	}
+	p, err := LoadParamPaytable(*paytableFile)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	tables, err := game.tables()
+	if err != nil {
+		return err
+	}
+	dealerHolds, err := dealer.holds(tables, func() (*Holds, error) {
+		return NewAnalyzer(tables, StaticField(tables)).BestHolds(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	search := NewPaytableSearch(tables, fieldFor(tables, dealerHolds))
+	slog.Info("worked out hold odds by class", "hands", len(search.canon), "elapsed", time.Since(start))
+	pays, ok := search.Search(p, *target, *tolerance)
+	ret := search.Return(&pays)
+	slog.Info("search complete", "candidates", search.evals, "elapsed", time.Since(start))
+
+	fmt.Printf("Paytable: %s\n", p.Name)
+	fmt.Printf("Shoe:     %s, %s, %s dealer\n\n", tables.Shoe, tables.Rules, dealer.policy)
+	fmt.Printf("  %-16s %8s\n", "Win with", "Pays")
+	for _, class := range classOrder[3] {
+		fmt.Printf("  %-16s %8g\n", class, pays[class])
+	}
+	fmt.Printf("\nReturn %.5f (target %.5f, house edge %.4f%%)\n", ret, *target, 100*(1-ret))
+	if !ok {
+		return fmt.Errorf("return %.5f is more than %g from the target %.5f", ret, *tolerance, *target)
+	}
+
+	if *suggestFile != "" {
+		if err = StrategyFromHolds(tables, search.Holds(&pays)).Save(*suggestFile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
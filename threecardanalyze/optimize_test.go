@@ -0,0 +1,106 @@
+package threecardanalyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPaytableSearch(t *testing.T) {
+	a := houseAnalyzer(t)
+	s := NewPaytableSearch(a.Tables, a.Field)
+
+	// Even money for everything is the game the analyzer plays
+	var even Payouts
+	for c := range even {
+		even[c] = 1
+	}
+	if r, want := s.Return(&even), 1+houseEV(a); math.Abs(r-want) > 1e-12 {
+		t.Errorf("even money returns %.12f, want %.12f", r, want)
+	}
+
+	// With one class free the answer is the payout whose return is
+	// closest to the target
+	sf := even
+	sf[StraightFlush] = 37
+	target := s.Return(&sf) + 1e-7
+	p := ParamPaytable{Step: 1, Pays: map[string]PayRange{"straight flush": {Min: 1, Max: 100, Start: pay(5)}}}
+	got, ok := s.Search(p, target, 1e-6)
+	if !ok || got != sf {
+		t.Errorf("straight flush pays %g (reached %v), want 37", got[StraightFlush], ok)
+	}
+
+	// Chasing an unreachable return, trips would pay as much as they
+	// can, but monotonic payouts keep them below the straight flush
+	p = ParamPaytable{Step: 1, Pays: map[string]PayRange{
+		"straight flush":  {Min: 5, Max: 5},
+		"three of a kind": {Min: 1, Max: 100},
+	}}
+	if got, ok = s.Search(p, 3, 0.01); got[Trips] != 100 || ok {
+		t.Errorf("trips pay %g (reached %v), want 100 and a miss", got[Trips], ok)
+	}
+	p.Monotonic = true
+	got, _ = s.Search(p, 3, 0.01)
+	if got[Trips] != 5 {
+		t.Errorf("monotonic: trips pay %g, want 5", got[Trips])
+	}
+	order := classOrder[3]
+	for i := 1; i < len(order); i++ {
+		if got[order[i]] > got[order[i-1]] {
+			t.Errorf("monotonic: %v pays %g, more than %v's %g", order[i], got[order[i]], order[i-1], got[order[i-1]])
+		}
+	}
+
+	// Two classes free on a small grid: every combination is tried, so
+	// the search lands on the target exactly
+	p = ParamPaytable{Step: 1, Pays: map[string]PayRange{
+		"straight":        {Min: 1, Max: 12, Start: pay(1)},
+		"three of a kind": {Min: 1, Max: 12, Start: pay(1)},
+	}}
+	want := even
+	want[Straight], want[Trips] = 4, 9
+	target = s.Return(&want)
+	got, ok = s.Search(p, target, 1e-9)
+	if miss := math.Abs(s.Return(&got) - target); !ok || miss > 1e-12 {
+		t.Errorf("straight %g, trips %g miss by %g", got[Straight], got[Trips], miss)
+	}
+
+	// Too many combinations to try them all, so it walks from several
+	// starts; it still ends close to the target
+	p = ParamPaytable{Step: 0.01, Pays: map[string]PayRange{
+		"straight flush":  {Min: 1, Max: 50, Start: pay(1)},
+		"three of a kind": {Min: 1, Max: 50, Start: pay(1)},
+		"straight":        {Min: 1, Max: 10, Start: pay(1)},
+	}}
+	got, ok = s.Search(p, 1.6, 0.001)
+	if !ok {
+		t.Errorf("returns %.5f, want 1.6", s.Return(&got))
+	}
+}
+
+func TestParamPaytableValidate(t *testing.T) {
+	for _, test := range []struct {
+		r   PayRange
+		err bool
+	}{
+		{PayRange{Min: 0, Max: 2, Start: pay(0)}, false},
+		{PayRange{Min: 1, Max: 2}, false},
+		{PayRange{Min: 1, Max: 2, Start: pay(0)}, true},
+		{PayRange{Min: 1, Max: 2, Start: pay(3)}, true},
+		{PayRange{Min: 2, Max: 1}, true},
+	} {
+		p := ParamPaytable{Step: 1, Pays: map[string]PayRange{"pair": test.r}}
+		if err := p.Validate(); (err != nil) != test.err {
+			t.Errorf("%+v: got %v", test.r, err)
+		}
+	}
+}
+
+func pay(v float64) *float64 {
+	return &v
+}
+
+// houseEV is the return per unit bet of the analyzer's best holds
+func houseEV(a *Analyzer) float64 {
+	s := a.Summarize(a.BestHolds())
+	return s.Overall.EV()
+}
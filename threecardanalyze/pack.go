@@ -0,0 +1,93 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+	"github.com/gsdriver/alexautils/threecardstrategy"
+)
+
+// Pack is the pack command: it writes a strategy file with the ranks
+// and winners for its shoe in the compact format the skill loads
+func Pack(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard pack", "Writes a strategy and its rank tables in the compact binary format.")
+	strategyFile := fs.String("strategy", "suggest.json", "strategy file to pack (analyzer output or hand written)")
+	out := fs.String("o", "strategy.tcs", "file to write")
+	game := addGameFlags(fs, "removed")
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	tables, err := game.tables()
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	strategy, err := LoadStrategy(*strategyFile)
+	if err != nil {
+		return err
+	}
+	holds, err := strategy.HoldMasks(tables)
+	if err != nil {
+		return err
+	}
+
+	data, err := threecardstrategy.Encode(tables.Artifact(holds))
+	if err != nil {
+		return err
+	}
+	if err = tables.checkPacked(strategy, data); err != nil {
+		return err
+	}
+	if err = os.WriteFile(*out, data, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("%d hands, %d ranks, %d bytes (%s, %s)\n", len(tables.hands), len(tables.Winners), len(data), tables.Shoe, tables.Rules)
+	return nil
+}
+
+// Artifact is what a strategy file holds for the holds
+func (t *Tables) Artifact(holds *Holds) *threecardstrategy.Artifact {
+	a := &threecardstrategy.Artifact{}
+	for id := range a.Ranks {
+		a.Ranks[id] = -1
+	}
+	t.Hands(func(idx int, hand [3]Card) {
+		a.Holds[idx] = holds[idx]
+		a.Ranks[idx] = int(t.rank[idx])
+	})
+	for _, w := range t.Winners {
+		a.Winners = append(a.Winners, threecardstrategy.Winners{Wins: uint32(w.Wins), Ties: uint32(w.Ties), Loses: uint32(w.Loses)})
+	}
+	return a
+}
+
+// checkPacked reads the packed strategy back and checks every hand
+// against the strategy and ranking it came from
+func (t *Tables) checkPacked(strategy Strategy, data []byte) error {
+	packed, err := threecardstrategy.Load(data)
+	if err != nil {
+		return err
+	}
+	for key, hold := range strategy {
+		hand, err := ParseHand(key)
+		if err != nil {
+			return err
+		}
+		cards := [3]string{hand[0].String(), hand[1].String(), hand[2].String()}
+		got, err := packed.Hold(cards)
+		if err != nil {
+			// Hands the shoe can't deal aren't packed
+			continue
+		}
+		want := slices.Clone(hold)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("%s packed as %v, not %v", key, got, hold)
+		}
+		if r, _ := packed.Rank(cards); r != t.Ranking[key] {
+			return fmt.Errorf("%s packed with rank %d, not %d", key, r, t.Ranking[key])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package threecardanalyze
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gsdriver/alexautils/threecardstrategy"
+)
+
+// The strategy built into threecardstrategy is the analyzer's for the
+// skill's game; this fails if either drifts
+func TestHouseStrategy(t *testing.T) {
+	a := houseAnalyzer(t)
+	strategy := StrategyFromHolds(a.Tables, a.BestHolds())
+	packed := threecardstrategy.Default()
+
+	if packed.Ranks() != len(a.Tables.Winners) {
+		t.Fatalf("%d ranks packed, want %d", packed.Ranks(), len(a.Tables.Winners))
+	}
+	for rank, w := range a.Tables.Winners {
+		got, err := packed.Winners(rank)
+		want := threecardstrategy.Winners{Wins: uint32(w.Wins), Ties: uint32(w.Ties), Loses: uint32(w.Loses)}
+		if err != nil || got != want {
+			t.Fatalf("rank %d: winners %+v, %v; want %+v", rank, got, err, want)
+		}
+	}
+
+	if len(strategy) != NumHands {
+		t.Fatalf("%d hands, want %d", len(strategy), NumHands)
+	}
+	for key, hold := range strategy {
+		hand := mustHand(t, key)
+		cards := [3]string{hand[0].String(), hand[1].String(), hand[2].String()}
+		got, err := packed.Hold(cards)
+		want := append([]int{}, hold...)
+		sort.Ints(want)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: packed hold %v, %v; want %v", key, got, err, want)
+		}
+		if rank, err := packed.Rank(cards); err != nil || rank != a.Tables.Ranking[key] {
+			t.Fatalf("%s: packed rank %d, %v; want %d", key, rank, err, a.Tables.Ranking[key])
+		}
+	}
+}
+
+func TestPackRoundTrip(t *testing.T) {
+	// Two decks and a joker deal hands one deck can't
+	tables, err := NewTables(func() *Shoe { s := NewShoe(2); s.AddJokers(1); return s }(), SkillRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAnalyzer(tables, StaticField(tables))
+	holds := a.BestHolds()
+	data, err := threecardstrategy.Encode(tables.Artifact(holds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tables.checkPacked(StrategyFromHolds(tables, holds), data); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,345 @@
+package threecardanalyze
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/gsdriver/alexautils/config"
+	"github.com/gsdriver/alexautils/internal/cli"
+)
+
+// A strategy table holds every published version of the strategy,
+// keyed by version and hand:
+//
+//	version    hand         attributes
+//	"3"        "10D-KS-AS"  hold (positions within the key), rank
+//	"3"        "manifest"   hands, sha256, shoe, rules, published
+//	"current"  "current"    live, previous, latest
+//
+// A version's manifest is written after its hands, so a version with a
+// manifest is complete. The skill reads the current item to find the
+// version to play, which Activate switches in a single conditional
+// update.
+const (
+	currentKey  = "current"
+	manifestKey = "manifest"
+)
+
+// StrategyDB is the part of the DynamoDB API publishing uses. The
+// DynamoDB client satisfies it, as does MemoryTable.
+type StrategyDB interface {
+	BatchWriteItem(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
+	GetItem(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+	PutItem(*dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
+	UpdateItem(*dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error)
+}
+
+// StrategyPublisher writes strategies to a strategy table
+type StrategyPublisher struct {
+	DB    StrategyDB
+	Table string
+
+	// Retries is how many times a batch's unprocessed items are sent
+	// again, waiting Backoff and then twice as long each time
+	Retries int
+	Backoff time.Duration
+}
+
+// NewStrategyPublisher returns a publisher for the table
+func NewStrategyPublisher(db StrategyDB, table string) *StrategyPublisher {
+	return &StrategyPublisher{DB: db, Table: table, Retries: 8, Backoff: 50 * time.Millisecond}
+}
+
+// Manifest describes a published version
+type Manifest struct {
+	Version   string `dynamodbav:"version"`
+	Hand      string `dynamodbav:"hand"`
+	Hands     int    `dynamodbav:"hands"`
+	SHA256    string `dynamodbav:"sha256"`
+	Shoe      string `dynamodbav:"shoe"`
+	Rules     string `dynamodbav:"rules"`
+	Published string `dynamodbav:"published"`
+}
+
+// Versions is the current item: the version the skill plays, the one
+// it played before, and the last one handed out
+type Versions struct {
+	Live     int `dynamodbav:"live"`
+	Previous int `dynamodbav:"previous"`
+	Latest   int `dynamodbav:"latest"`
+}
+
+type strategyItem struct {
+	Version string `dynamodbav:"version"`
+	Hand    string `dynamodbav:"hand"`
+	Hold    []int  `dynamodbav:"hold"`
+	Rank    int    `dynamodbav:"rank"`
+}
+
+func itemKey(version, hand string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"version": {S: aws.String(version)},
+		"hand":    {S: aws.String(hand)},
+	}
+}
+
+// Versions reads the current item. Nothing published yet is all zeros.
+func (p *StrategyPublisher) Versions() (Versions, error) {
+	var v Versions
+	out, err := p.DB.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(p.Table),
+		Key:            itemKey(currentKey, currentKey),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return v, err
+	}
+	err = dynamodbattribute.UnmarshalMap(out.Item, &v)
+	return v, err
+}
+
+// Manifest reads a version's manifest, or returns nil if the version
+// wasn't published completely
+func (p *StrategyPublisher) Manifest(version int) (*Manifest, error) {
+	out, err := p.DB.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(p.Table),
+		Key:            itemKey(strconv.Itoa(version), manifestKey),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil || out.Item == nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	err = dynamodbattribute.UnmarshalMap(out.Item, m)
+	return m, err
+}
+
+// Publish writes the strategy, with the tables' ranks, as a new version
+// and returns it. The version isn't played until it's activated.
+func (p *StrategyPublisher) Publish(t *Tables, strategy Strategy, sha string) (int, error) {
+	// Take the next version number, so publishers never share one
+	out, err := p.DB.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName:                 aws.String(p.Table),
+		Key:                       itemKey(currentKey, currentKey),
+		UpdateExpression:          aws.String("ADD #latest :one"),
+		ExpressionAttributeNames:  map[string]*string{"#latest": aws.String("latest")},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{":one": {N: aws.String("1")}},
+		ReturnValues:              aws.String(dynamodb.ReturnValueAllNew),
+	})
+	if err != nil {
+		return 0, err
+	}
+	var v Versions
+	if err = dynamodbattribute.UnmarshalMap(out.Attributes, &v); err != nil {
+		return 0, err
+	}
+	version := strconv.Itoa(v.Latest)
+
+	var batch []*dynamodb.WriteRequest
+	written := 0
+	var hands []string
+	t.Hands(func(idx int, hand [3]Card) {
+		hands = append(hands, HandKey(hand[0], hand[1], hand[2]))
+	})
+	for _, key := range hands {
+		hold, ok := strategy[key]
+		if !ok {
+			return 0, fmt.Errorf("strategy has no play for %s", key)
+		}
+		item, err := dynamodbattribute.MarshalMap(strategyItem{Version: version, Hand: key, Hold: hold, Rank: t.Ranking[key]})
+		if err != nil {
+			return 0, err
+		}
+		if len(hold) == 0 {
+			// Drawing three is an empty list, not a null
+			item["hold"] = &dynamodb.AttributeValue{L: []*dynamodb.AttributeValue{}}
+		}
+		batch = append(batch, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: item}})
+		// A batch write takes at most 25 items
+		if len(batch) == 25 {
+			if err = p.writeBatch(batch); err != nil {
+				return 0, err
+			}
+			written += len(batch)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		if err = p.writeBatch(batch); err != nil {
+			return 0, err
+		}
+		written += len(batch)
+	}
+
+	item, err := dynamodbattribute.MarshalMap(Manifest{
+		Version:   version,
+		Hand:      manifestKey,
+		Hands:     written,
+		SHA256:    sha,
+		Shoe:      t.Shoe.String(),
+		Rules:     t.Rules.String(),
+		Published: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if _, err = p.DB.PutItem(&dynamodb.PutItemInput{TableName: aws.String(p.Table), Item: item}); err != nil {
+		return 0, err
+	}
+	slog.Info("published", "table", p.Table, "version", v.Latest, "hands", written)
+	return v.Latest, nil
+}
+
+// writeBatch writes the items, sending any DynamoDB leaves unprocessed
+// again until they're all written or the retries run out
+func (p *StrategyPublisher) writeBatch(batch []*dynamodb.WriteRequest) error {
+	wait := p.Backoff
+	for try := 0; ; try++ {
+		out, err := p.DB.BatchWriteItem(&dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{p.Table: batch},
+		})
+		if err != nil {
+			return err
+		}
+		batch = out.UnprocessedItems[p.Table]
+		if len(batch) == 0 {
+			return nil
+		}
+		if try == p.Retries {
+			return fmt.Errorf("%d items still unprocessed after %d retries", len(batch), p.Retries)
+		}
+		slog.Debug("retrying unprocessed items", "items", len(batch), "wait", wait)
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+// ErrVersionChanged is returned by Activate when the live version isn't
+// the one expected, because someone else switched it first
+var ErrVersionChanged = errors.New("the live version changed")
+
+// Activate makes version the live one, as long as the live version is
+// still expected (0 if nothing has been activated yet)
+func (p *StrategyPublisher) Activate(version, expected int) error {
+	m, err := p.Manifest(version)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("version %d wasn't published completely", version)
+	}
+
+	input := &dynamodb.UpdateItemInput{
+		TableName:        aws.String(p.Table),
+		Key:              itemKey(currentKey, currentKey),
+		UpdateExpression: aws.String("SET #live = :version, #previous = :expected"),
+		ExpressionAttributeNames: map[string]*string{
+			"#live":     aws.String("live"),
+			"#previous": aws.String("previous"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":version":  {N: aws.String(strconv.Itoa(version))},
+			":expected": {N: aws.String(strconv.Itoa(expected))},
+		},
+		ConditionExpression: aws.String("#live = :expected"),
+	}
+	if expected == 0 {
+		input.ConditionExpression = aws.String("attribute_not_exists(#live) OR #live = :expected")
+	}
+	_, err = p.DB.UpdateItem(input)
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+		return fmt.Errorf("%w from %d", ErrVersionChanged, expected)
+	}
+	return err
+}
+
+// Publish is the publish command: it writes a strategy file to the
+// strategy table as a new version and makes it the live one
+func Publish(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard publish", "Publishes a strategy to DynamoDB and switches the skill to it.")
+	strategyFile := fs.String("strategy", "suggest.json", "strategy file to publish (analyzer output or hand written)")
+	skill := fs.String("skill", "threecard", "skill whose strategy table to write")
+	table := fs.String("table", "", "DynamoDB strategy table (default from the skill's configuration)")
+	activate := fs.Int("activate", 0, "make this published version live instead of publishing")
+	noSwitch := fs.Bool("no-switch", false, "publish without making the new version live")
+	dryRun := fs.Bool("dry-run", false, "publish to an in memory table instead of DynamoDB")
+	awsOpts := cli.AddAWSFlags(fs)
+	game := addGameFlags(fs, "removed")
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+
+	var db StrategyDB
+	if *dryRun {
+		db = NewMemoryTable()
+		if *table == "" {
+			*table = "dry-run"
+		}
+	} else {
+		cfg, err := awsOpts.Config()
+		if err != nil {
+			return err
+		}
+		if *table == "" {
+			s, err := cfg.Skill(*skill)
+			if err != nil {
+				return err
+			}
+			if *table, err = s.Table(config.TableStrategy); err != nil {
+				return err
+			}
+		}
+		sess, err := awsOpts.Session()
+		if err != nil {
+			return err
+		}
+		db = dynamodb.New(sess)
+	}
+	p := NewStrategyPublisher(db, *table)
+
+	versions, err := p.Versions()
+	if err != nil {
+		return err
+	}
+	version := *activate
+	if version == 0 {
+		tables, err := game.tables()
+		if err != nil {
+			return cli.Usagef("%v", err)
+		}
+		strategy, err := LoadStrategy(*strategyFile)
+		if err != nil {
+			return err
+		}
+		// Check the strategy covers the shoe before writing any of it
+		if _, err = strategy.HoldMasks(tables); err != nil {
+			return err
+		}
+		sha, err := contentHash(*strategyFile)
+		if err != nil {
+			return err
+		}
+		if version, err = p.Publish(tables, strategy, sha); err != nil {
+			return err
+		}
+		fmt.Printf("Published version %d of %s to %s (%s, %s)\n", version, *strategyFile, *table, tables.Shoe, tables.Rules)
+		if *noSwitch {
+			fmt.Printf("Version %d is still live\n", versions.Live)
+			return nil
+		}
+	}
+
+	if err = p.Activate(version, versions.Live); err != nil {
+		return err
+	}
+	fmt.Printf("Version %d is live, replacing version %d\n", version, versions.Live)
+	return nil
+}
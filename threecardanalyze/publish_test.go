@@ -0,0 +1,180 @@
+package threecardanalyze
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// recordingTable is a memory table that records every write, in order
+type recordingTable struct {
+	*MemoryTable
+	writes []string // "batch" or the hand key of a put
+
+	// Whether batch writes process nothing at all, as when a table is
+	// throttled for good
+	stuck bool
+}
+
+func (r *recordingTable) BatchWriteItem(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+	r.writes = append(r.writes, "batch")
+	if r.stuck {
+		return &dynamodb.BatchWriteItemOutput{UnprocessedItems: input.RequestItems}, nil
+	}
+	return r.MemoryTable.BatchWriteItem(input)
+}
+
+func (r *recordingTable) PutItem(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	r.writes = append(r.writes, aws.StringValue(input.Item["hand"].S))
+	return r.MemoryTable.PutItem(input)
+}
+
+func testPublisher(db StrategyDB) *StrategyPublisher {
+	p := NewStrategyPublisher(db, "strategy")
+	p.Backoff = time.Microsecond
+	return p
+}
+
+// drawAll is a strategy that draws three to every hand
+func drawAll(t *Tables) Strategy {
+	return StrategyFromHolds(t, &Holds{})
+}
+
+func TestPublishRetriesUnprocessed(t *testing.T) {
+	tables := houseTables(t)
+	table := &recordingTable{MemoryTable: NewMemoryTable()}
+	// Each batch of 25 takes three writes of 10, 10 and 5
+	table.BatchLimit = 10
+	p := testPublisher(table)
+
+	version, err := p.Publish(tables, drawAll(tables), "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 1 {
+		t.Errorf("first version is %d, want 1", version)
+	}
+	// Every hand, its manifest and the current item
+	if got, want := table.Len(), NumHands+2; got != want {
+		t.Errorf("%d items, want %d", got, want)
+	}
+	batches := 0
+	for _, w := range table.writes {
+		if w == "batch" {
+			batches++
+		}
+	}
+	if want := 3 * ((NumHands + 24) / 25); batches != want {
+		t.Errorf("%d batch writes, want %d", batches, want)
+	}
+
+	out, err := table.GetItem(&dynamodb.GetItemInput{Key: itemKey("1", "10C-2C-3D")})
+	if err != nil || out.Item == nil {
+		t.Fatalf("10C-2C-3D wasn't written: %v", err)
+	}
+	if hold := out.Item["hold"]; hold == nil || hold.L == nil || len(hold.L) != 0 {
+		t.Errorf("drawing three is written as %v, want an empty list", hold)
+	}
+}
+
+func TestPublishGivesUpOnStuckWrites(t *testing.T) {
+	tables := houseTables(t)
+	table := &recordingTable{MemoryTable: NewMemoryTable(), stuck: true}
+	p := testPublisher(table)
+	p.Retries = 3
+	_, err := p.Publish(tables, drawAll(tables), "abc")
+	if err == nil || !strings.Contains(err.Error(), "unprocessed after 3 retries") {
+		t.Fatalf("got %v, want unprocessed items", err)
+	}
+	if len(table.writes) != 4 {
+		t.Errorf("%d writes, want the first and 3 retries", len(table.writes))
+	}
+	// Without its manifest the version can't be made live
+	if err = p.Activate(1, 0); err == nil || !strings.Contains(err.Error(), "wasn't published completely") {
+		t.Errorf("activating a partial version: %v", err)
+	}
+}
+
+func TestPublishVersions(t *testing.T) {
+	tables := houseTables(t)
+	table := &recordingTable{MemoryTable: NewMemoryTable()}
+	p := testPublisher(table)
+
+	for want := 1; want <= 2; want++ {
+		table.writes = nil
+		version, err := p.Publish(tables, drawAll(tables), fmt.Sprint("sha", want))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != want {
+			t.Errorf("publish %d made version %d", want, version)
+		}
+		// The manifest is the last write, after every hand
+		if n := len(table.writes); n == 0 || table.writes[n-1] != manifestKey {
+			t.Errorf("last write of version %d is %v, want the manifest", version, table.writes[n-1:])
+		}
+		for _, w := range table.writes[:len(table.writes)-1] {
+			if w != "batch" {
+				t.Errorf("version %d wrote %s before its hands were done", version, w)
+			}
+		}
+
+		m, err := p.Manifest(version)
+		if err != nil || m == nil {
+			t.Fatalf("manifest of version %d: %v, %v", version, m, err)
+		}
+		if m.Hands != NumHands || m.SHA256 != fmt.Sprint("sha", want) || m.Shoe != "1 deck" || m.Rules != "house" {
+			t.Errorf("manifest of version %d: %+v", version, m)
+		}
+	}
+	v, err := p.Versions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Latest != 2 || v.Live != 0 {
+		t.Errorf("versions %+v, want latest 2 and nothing live", v)
+	}
+}
+
+func TestActivate(t *testing.T) {
+	tables := houseTables(t)
+	p := testPublisher(NewMemoryTable())
+	for i := 0; i < 2; i++ {
+		if _, err := p.Publish(tables, drawAll(tables), "abc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := p.Activate(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	// Someone else made 1 live, so switching from nothing fails
+	if err := p.Activate(2, 0); !errors.Is(err, ErrVersionChanged) {
+		t.Errorf("switching from a stale version: %v", err)
+	}
+	if err := p.Activate(2, 3); !errors.Is(err, ErrVersionChanged) {
+		t.Errorf("switching from the wrong version: %v", err)
+	}
+	if v, _ := p.Versions(); v.Live != 1 {
+		t.Errorf("a failed switch changed the live version to %d", v.Live)
+	}
+
+	if err := p.Activate(2, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Activate(9, 2); err == nil {
+		t.Error("activated a version that was never published")
+	}
+	v, err := p.Versions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != (Versions{Live: 2, Previous: 1, Latest: 2}) {
+		t.Errorf("versions %+v", v)
+	}
+}
@@ -0,0 +1,115 @@
+package threecardanalyze
+
+import (
+	"sync"
+)
+
+// RoundStrategy is the best hold for every hand in each round of a game
+// with more than one draw that shuffles the discards back into the shoe
+// before each draw, so every round draws from the shoe less the hand
+// being drawn to. A game that keeps discards out has no such table: its
+// best hold in later rounds depends on the cards thrown away.
+type RoundStrategy struct {
+	// Holds for each round, the first round first
+	Holds []*Holds
+	// Odds of every hand (by HandIndex) playing every round optimally
+	// from the first
+	Odds *[NumSlots]Odds
+}
+
+// AnalyzeRounds works back from the last draw, which is the ordinary
+// analysis, so each earlier hold is the one that leaves the best odds
+// of the hands it can draw to played optimally from there on
+func (a *Analyzer) AnalyzeRounds(rounds int) *RoundStrategy {
+	a.Analyze()
+	t := a.Tables
+	rs := &RoundStrategy{Holds: make([]*Holds, rounds)}
+
+	odds := &[NumSlots]Odds{}
+	holds := &Holds{}
+	t.Hands(func(idx int, hand [3]Card) {
+		holds[idx], odds[idx] = a.pick(hand, a.HoldOdds)
+	})
+	rs.Holds[rounds-1] = holds
+
+	for r := rounds - 2; r >= 0; r-- {
+		odds, rs.Holds[r] = a.round(odds)
+	}
+	rs.Odds = odds
+	return rs
+}
+
+// round works out the best holds for a draw whose hands go on to have
+// the odds in next
+func (a *Analyzer) round(next *[NumSlots]Odds) (*[NumSlots]Odds, *Holds) {
+	t := a.Tables
+	var canon []int
+	for _, idx := range t.hands {
+		if t.canon[idx] == idx {
+			canon = append(canon, int(idx))
+		}
+	}
+
+	var canonOdds [NumSlots][8]Odds
+	const NumberOfWorkers = 4
+	var wg sync.WaitGroup
+	for w := 0; w < NumberOfWorkers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(canon); i += NumberOfWorkers {
+				hand := handAt(canon[i])
+				for mask := uint8(0); mask < 8; mask++ {
+					var o Odds
+					total := 0.0
+					t.eachDrawHand(hand, mask, func(idx int, ways int64) {
+						o.add(next[idx], float64(ways))
+						total += float64(ways)
+					})
+					o.Win /= total
+					o.Tie /= total
+					o.Lose /= total
+					canonOdds[canon[i]][mask] = o
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	odds := &[NumSlots]Odds{}
+	holds := &Holds{}
+	t.Hands(func(idx int, hand [3]Card) {
+		holds[idx], odds[idx] = a.pick(hand, func(hand [3]Card) [8]Odds {
+			return t.mapOdds(&canonOdds, hand)
+		})
+	})
+	return odds, holds
+}
+
+// pick chooses the hold for a sorted hand the way Run does, trying the
+// holds in the order of the hand's key so equal holds break the same
+// way. It returns the hold as a mask over the sorted cards.
+func (a *Analyzer) pick(sorted [3]Card, holdOdds func(hand [3]Card) [8]Odds) (uint8, Odds) {
+	order := keyOrder(sorted)
+	var hand [3]Card
+	for pos, i := range order {
+		hand[pos] = sorted[i]
+	}
+	odds := holdOdds(hand)
+	positions := a.bestplay(hand, odds)
+	var mask uint8
+	for _, pos := range positions {
+		mask |= 1 << order[pos]
+	}
+	return mask, odds[positionsMask(positions)]
+}
+
+// Value is the odds of a hand dealt from the shoe played optimally
+// through every round
+func (rs *RoundStrategy) Value(t *Tables) Odds {
+	var total Odds
+	t.Hands(func(idx int, hand [3]Card) {
+		total.add(rs.Odds[idx], t.weight(idx))
+	})
+	return total
+}
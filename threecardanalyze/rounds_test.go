@@ -0,0 +1,52 @@
+package threecardanalyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAnalyzeRounds(t *testing.T) {
+	a := houseAnalyzer(t)
+	best := a.BestHolds()
+
+	// One round is the ordinary analysis
+	one := a.AnalyzeRounds(1)
+	if len(one.Holds) != 1 || *one.Holds[0] != *best {
+		t.Fatal("one round: holds differ from the analyzer's best holds")
+	}
+	a.Tables.Hands(func(idx int, hand [3]Card) {
+		if got, want := one.Odds[idx], a.HoldOdds(hand)[best[idx]]; got != want {
+			t.Errorf("one round: %v has odds %+v, want %+v", hand, got, want)
+		}
+	})
+	if got, want := one.Value(a.Tables), a.Summarize(best).Overall.Odds; !closeOdds(got, want) {
+		t.Errorf("one round: value %+v, want %+v", got, want)
+	}
+
+	// The last of two rounds is the ordinary analysis too, and the first
+	// can always stand pat, so every hand does at least as well
+	two := a.AnalyzeRounds(2)
+	if len(two.Holds) != 2 || *two.Holds[1] != *best {
+		t.Fatal("two rounds: last round's holds differ from the analyzer's best holds")
+	}
+	a.Tables.Hands(func(idx int, hand [3]Card) {
+		o := two.Odds[idx]
+		if sum := o.Win + o.Tie + o.Lose; math.Abs(sum-1) > 1e-9 {
+			t.Errorf("two rounds: %v's odds sum to %g", hand, sum)
+		}
+		if o.Win < one.Odds[idx].Win-1e-12 {
+			t.Errorf("two rounds: %v wins %g, less than one round's %g", hand, o.Win, one.Odds[idx].Win)
+		}
+	})
+	v := two.Value(a.Tables)
+	if sum := v.Win + v.Tie + v.Lose; math.Abs(sum-1) > 1e-9 {
+		t.Errorf("two rounds: value sums to %g", sum)
+	}
+	if v.Win <= one.Value(a.Tables).Win {
+		t.Errorf("two rounds win %g, no more than one round's %g", v.Win, one.Value(a.Tables).Win)
+	}
+}
+
+func closeOdds(a, b Odds) bool {
+	return math.Abs(a.Win-b.Win) < 1e-12 && math.Abs(a.Tie-b.Tie) < 1e-12 && math.Abs(a.Lose-b.Lose) < 1e-12
+}
@@ -0,0 +1,231 @@
+package threecardanalyze
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AceLow says whether A-2-3 counts as a straight and where it ranks
+// among them
+type AceLow string
+
+const (
+	// A-2-3 isn't a straight
+	AceLowNone AceLow = "none"
+	// A-2-3 is the lowest straight
+	AceLowBottom AceLow = "low"
+	// A-2-3 is the second best straight, behind A-K-Q
+	AceLowSecond AceLow = "second"
+)
+
+// Rules are what a variant changes about how hands rank. The zero value
+// has no wild cards and doesn't count A-2-3 as a straight.
+type Rules struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Ranks (0 for a deuce up to 12 for an ace) where every card is
+	// wild. Jokers are always wild.
+	WildRanks []int `json:"-" yaml:"-"`
+
+	// Whether unsuited and suited A-2-3 are straights and straight
+	// flushes, and where they rank
+	A23Straight      AceLow `json:"a23Straight,omitempty" yaml:"a23Straight,omitempty"`
+	A23StraightFlush AceLow `json:"a23StraightFlush,omitempty" yaml:"a23StraightFlush,omitempty"`
+
+	// Flushes rank above straights, as they do in five card poker
+	FlushBeatsStraight bool `json:"flushBeatsStraight,omitempty" yaml:"flushBeatsStraight,omitempty"`
+
+	// Hands of the same value are separated by the suits of their
+	// cards from the highest rank down, spades, hearts, diamonds then
+	// clubs, instead of tying
+	SuitTiebreak bool `json:"suitTiebreak,omitempty" yaml:"suitTiebreak,omitempty"`
+}
+
+// SkillRules are the house rules the skill has always used, which is
+// what its original ranking table (testdata/ranks.json) describes.
+// A-2-3 is the lowest straight flush but the second best straight.
+var SkillRules = Rules{Name: "house", A23Straight: AceLowSecond, A23StraightFlush: AceLowBottom}
+
+// ThreeCardPokerRules are standard Three Card Poker, where A-2-3 is the
+// lowest straight and straight flush
+var ThreeCardPokerRules = Rules{Name: "three-card-poker", A23Straight: AceLowBottom, A23StraightFlush: AceLowBottom}
+
+// Presets are the rules that can be picked by name
+var Presets = map[string]Rules{
+	SkillRules.Name:          SkillRules,
+	ThreeCardPokerRules.Name: ThreeCardPokerRules,
+}
+
+// rulesFile is the rules as written in a file, with wild ranks by name
+type rulesFile struct {
+	Rules `yaml:",inline"`
+	Wild  []string `json:"wild,omitempty" yaml:"wild,omitempty"`
+}
+
+// LoadRules returns the preset with the name, or reads the rules from
+// a file. Files ending in .json are read as JSON, anything else as YAML.
+func LoadRules(name string) (Rules, error) {
+	if r, ok := Presets[name]; ok {
+		return r, nil
+	}
+	dat, err := os.ReadFile(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Rules{}, fmt.Errorf("%q isn't a preset (%s) or a rules file", name, strings.Join(presetNames(), ", "))
+		}
+		return Rules{}, err
+	}
+
+	var f rulesFile
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(dat))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&f)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(dat))
+		dec.KnownFields(true)
+		err = dec.Decode(&f)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err == nil {
+		f.WildRanks, err = parseWildRanks(strings.Join(f.Wild, ","))
+	}
+	if err == nil {
+		err = f.Rules.Validate()
+	}
+	if err != nil {
+		return Rules{}, fmt.Errorf("%s: %v", name, err)
+	}
+	if f.Name == "" {
+		f.Name = filepath.Base(name)
+	}
+	return f.Rules, nil
+}
+
+func presetNames() []string {
+	var names []string
+	for n := range Presets {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks the rules make sense
+func (r Rules) Validate() error {
+	for _, a := range []AceLow{r.A23Straight, r.A23StraightFlush} {
+		switch a {
+		case "", AceLowNone, AceLowBottom, AceLowSecond:
+		default:
+			return fmt.Errorf("A-2-3 must be %s, %s or %s, not %q", AceLowNone, AceLowBottom, AceLowSecond, a)
+		}
+	}
+	for _, w := range r.WildRanks {
+		if w < 0 || w >= len(rankNames) {
+			return fmt.Errorf("bad wild rank %d", w)
+		}
+	}
+	return nil
+}
+
+// parseRank reads a rank name like "Q" or "10"
+func parseRank(s string) (int, error) {
+	for i, r := range rankNames {
+		if strings.EqualFold(strings.TrimSpace(s), r) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("bad rank %q", s)
+}
+
+// parseWildRanks reads rank names separated by commas
+func parseWildRanks(s string) ([]int, error) {
+	var ranks []int
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		r, err := parseRank(f)
+		if err != nil {
+			return nil, err
+		}
+		ranks = append(ranks, r)
+	}
+	return ranks, nil
+}
+
+// Wild says whether the card is wild under the rules
+func (r Rules) Wild(c Card) bool {
+	if c == Joker {
+		return true
+	}
+	for _, w := range r.WildRanks {
+		if c.Rank() == w {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve finds the best hand the cards can make, with each wild card
+// standing for any card not already among the natural cards. It
+// returns the hand's value (see evaluate) and the cards it plays as, in
+// the same positions. A wild hand ties a natural hand of the same value.
+func (r Rules) resolve(hand [3]Card) (int, [3]Card) {
+	var wild []int
+	for i, c := range hand {
+		if r.Wild(c) {
+			wild = append(wild, i)
+		}
+	}
+	if len(wild) == 0 {
+		return r.evaluate(hand), hand
+	}
+
+	best, played := -1, hand
+	try := hand
+	var substitute func(n int)
+	substitute = func(n int) {
+		if n == len(wild) {
+			if v := r.evaluate(try); best < 0 || v < best {
+				best, played = v, try
+			}
+			return
+		}
+		for c := Card(0); c < Joker; c++ {
+			natural := false
+			for _, h := range hand {
+				natural = natural || (h == c && !r.Wild(h))
+			}
+			if !natural {
+				try[wild[n]] = c
+				substitute(n + 1)
+			}
+		}
+	}
+	substitute(0)
+	return best, played
+}
+
+func (r Rules) String() string {
+	desc := r.Name
+	if desc == "" {
+		desc = "custom rules"
+	}
+	if len(r.WildRanks) > 0 {
+		names := make([]string, len(r.WildRanks))
+		for i, w := range r.WildRanks {
+			names[i] = rankNames[w]
+		}
+		desc += ", " + strings.Join(names, ",") + " wild"
+	}
+	return desc
+}
@@ -0,0 +1,118 @@
+package threecardanalyze
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveWild(t *testing.T) {
+	deuces := SkillRules
+	deuces.WildRanks = []int{0}
+	for _, test := range []struct {
+		name    string
+		rules   Rules
+		hand    string
+		natural string // the hand it plays as
+		class   HandClass
+	}{
+		{"joker makes a straight", SkillRules, "QS-KD-JKR", "QS-KD-AC", Straight},
+		{"joker makes a flush", SkillRules, "2H-9H-JKR", "2H-9H-AH", Flush},
+		{"joker makes trips", SkillRules, "7H-7C-JKR", "7H-7C-7D", Trips},
+		{"deuce makes trips", deuces, "2C-KS-KD", "KH-KS-KD", Trips},
+		{"deuce and joker", deuces, "2C-JKR-7H", "9H-8H-7H", StraightFlush},
+		{"two jokers", SkillRules, "JKR-JKR-AS", "KS-QS-AS", StraightFlush},
+		{"deuces aren't wild", SkillRules, "2C-KS-KD", "2C-KS-KD", Pair},
+	} {
+		hand, natural := mustHand(t, test.hand), mustHand(t, test.natural)
+		v, played := test.rules.resolve(hand)
+		if want := test.rules.evaluate(natural); v != want {
+			t.Errorf("%s: %s has value %d, want %s's %d", test.name, test.hand, v, test.natural, want)
+		}
+		if class := test.rules.Classify(played[0], played[1], played[2]); class != test.class {
+			t.Errorf("%s: %s plays as %s, a %s, want a %s", test.name, test.hand, describeCards(played[:]), class, test.class)
+		}
+		// Natural cards stay where they are
+		for i, c := range hand {
+			if !test.rules.Wild(c) && played[i] != c {
+				t.Errorf("%s: %s plays as %s", test.name, test.hand, describeCards(played[:]))
+			}
+		}
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	for name, want := range Presets {
+		r, err := LoadRules(name)
+		if err != nil || r.String() != want.String() || r.A23Straight != want.A23Straight || r.A23StraightFlush != want.A23StraightFlush {
+			t.Errorf("%s: %+v, %v", name, r, err)
+		}
+	}
+	if _, err := LoadRules("nosuch"); err == nil || err.Error() != `"nosuch" isn't a preset (house, three-card-poker) or a rules file` {
+		t.Errorf("unknown preset: %v", err)
+	}
+
+	dir := t.TempDir()
+	for name, test := range map[string]struct {
+		data string
+		want string // the rules' String, or the error
+	}{
+		"deuces.yaml":  {"a23Straight: low\nflushBeatsStraight: true\nwild: [2, J]\n", "deuces.yaml, 2,J wild"},
+		"named.json":   {`{"name": "bonus", "suitTiebreak": true}`, "bonus"},
+		"typo.yaml":    {"flushBeatStraight: true\n", "field flushBeatStraight not found"},
+		"badwild.yaml": {"wild: [1]\n", `bad rank "1"`},
+		"badace.json":  {`{"a23Straight": "high"}`, `A-2-3 must be none, low or second, not "high"`},
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(test.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		r, err := LoadRules(path)
+		got := r.String()
+		if err != nil {
+			got = err.Error()
+		}
+		if !strings.Contains(got, test.want) {
+			t.Errorf("%s: %q, want %q", name, got, test.want)
+		}
+	}
+}
+
+func TestRulesOrder(t *testing.T) {
+	value := func(r Rules, key string) int {
+		return r.evaluate(mustHand(t, key))
+	}
+	straight, flush := "4S-5D-6C", "2H-9H-KH"
+	if value(ThreeCardPokerRules, straight) >= value(ThreeCardPokerRules, flush) {
+		t.Error("three card poker: a flush beats a straight")
+	}
+	flushFirst := ThreeCardPokerRules
+	flushFirst.FlushBeatsStraight = true
+	if value(flushFirst, flush) >= value(flushFirst, straight) {
+		t.Error("flush beats straight: a straight beats a flush")
+	}
+
+	// A-2-3 is the second best straight in the house game and the
+	// lowest in three card poker
+	if value(SkillRules, "AS-2D-3C") >= value(SkillRules, "KS-QD-JC") {
+		t.Error("house: K-Q-J beats A-2-3")
+	}
+	if value(ThreeCardPokerRules, "AS-2D-3C") <= value(ThreeCardPokerRules, "2S-3D-4C") {
+		t.Error("three card poker: A-2-3 beats 4-3-2")
+	}
+
+	// The same ranks in different suits tie unless suits break it,
+	// spades first
+	suits := ThreeCardPokerRules
+	suits.SuitTiebreak = true
+	if value(ThreeCardPokerRules, "AS-KD-9C") != value(ThreeCardPokerRules, "AH-KD-9C") {
+		t.Error("no suit tiebreak: A-K-9 hands don't tie")
+	}
+	if value(suits, "AS-KD-9C") >= value(suits, "AH-KD-9C") {
+		t.Error("suit tiebreak: the ace of hearts beats the ace of spades")
+	}
+	if value(suits, "AC-KS-9C") >= value(suits, "AC-KH-9S") {
+		t.Error("suit tiebreak: the king breaks the tie before the nine")
+	}
+}
@@ -0,0 +1,360 @@
+package threecardanalyze
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+)
+
+// StrategyServer answers strategy questions over HTTP. Everything is
+// worked out when it starts, and responses are cached by request.
+type StrategyServer struct {
+	tables   *Tables
+	analyzer *Analyzer
+	holds    *Holds
+	etag     string
+
+	cacheSize int64
+	entries   atomic.Int64
+	cache     sync.Map // request URI to the response body
+}
+
+// NewStrategyServer returns a server for the holds, analyzing the game
+// if it hasn't been already. cacheSize is how many responses to keep.
+func NewStrategyServer(a *Analyzer, holds *Holds, cacheSize int) *StrategyServer {
+	a.Analyze()
+	h := sha256.New()
+	json.NewEncoder(h).Encode(StrategyFromHolds(a.Tables, holds))
+	fmt.Fprintf(h, "%s %s", a.Tables.Shoe, a.Tables.Rules)
+	// The odds it serves depend on how the dealer plays too
+	binary.Write(h, binary.LittleEndian, a.Field.Dist)
+	return &StrategyServer{
+		tables:    a.Tables,
+		analyzer:  a,
+		holds:     holds,
+		etag:      `"` + hex.EncodeToString(h.Sum(nil))[:16] + `"`,
+		cacheSize: int64(cacheSize),
+	}
+}
+
+// Handler routes the server's endpoints:
+//
+//	/hold?hand=AS-KS-10D               the cards to keep
+//	/odds?hand=AS-KS-10D[&hold=0,1]    the odds of one hold or all of them
+//	/evaluate?hands=AS-KS-QS,2C-3D-4H  rank, class and winners of each hand
+//	/healthz                           whether the server is up, and what it serves
+//
+// Positions are within the hand as given.
+func (s *StrategyServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hold", s.cached(s.hold))
+	mux.HandleFunc("/odds", s.cached(s.odds))
+	mux.HandleFunc("/evaluate", s.cached(s.evaluate))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status": "ok",
+			"hands":  len(s.tables.hands),
+			"shoe":   s.tables.Shoe.String(),
+			"rules":  s.tables.Rules.String(),
+			"etag":   s.etag,
+			"cached": s.entries.Load(),
+		})
+	})
+	return mux
+}
+
+// requestError is a bad request, reported to the caller
+type requestError struct{ msg string }
+
+func (e *requestError) Error() string { return e.msg }
+
+func badRequest(format string, a ...interface{}) error {
+	return &requestError{fmt.Sprintf(format, a...)}
+}
+
+// cached answers GETs from the cache, or with fn and caches the answer.
+// Answers only change with the strategy, game and dealer, which the
+// ETag names, so callers can cache them too. Only answers get an ETag:
+// a bad request is always a 400, whatever the caller has cached.
+func (s *StrategyServer) cached(fn func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "only GET is supported"})
+			return
+		}
+		key := r.URL.RequestURI()
+		cached, ok := s.cache.Load(key)
+		var body []byte
+		if ok {
+			body = cached.([]byte)
+		} else {
+			v, err := fn(r)
+			var re *requestError
+			if errors.As(err, &re) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": re.msg})
+				return
+			} else if err != nil {
+				slog.Error("request failed", "uri", key, "err", err)
+				writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "internal error"})
+				return
+			}
+			body, _ = json.Marshal(v)
+			if s.entries.Load() < s.cacheSize {
+				if _, loaded := s.cache.LoadOrStore(key, body); !loaded {
+					s.entries.Add(1)
+				}
+			}
+		}
+
+		w.Header().Set("ETag", s.etag)
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+		if r.Header.Get("If-None-Match") == s.etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		writeBody(w, http.StatusOK, body)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	body, _ := json.Marshal(v)
+	writeBody(w, status, body)
+}
+
+func writeBody(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// handParam reads a three card hand the shoe can deal
+func (s *StrategyServer) handParam(value string) ([3]Card, error) {
+	var hand [3]Card
+	cards, err := ParseCards(value)
+	if err != nil {
+		return hand, badRequest("%v", err)
+	}
+	if len(cards) != 3 {
+		return hand, badRequest("a hand is three cards, for example AS-KS-10D")
+	}
+	copy(hand[:], cards)
+	a, b, c := Sort3(hand[0], hand[1], hand[2])
+	if s.tables.Ways(HandIndex(a, b, c)) == 0 {
+		return hand, badRequest("%s can't be dealt from %s", value, s.tables.Shoe)
+	}
+	return hand, nil
+}
+
+// sortedPositions returns where each of the hand's sorted cards is in
+// the hand, keeping repeated cards in order
+func sortedPositions(hand [3]Card) [3]int {
+	order := [3]int{0, 1, 2}
+	sort.SliceStable(order[:], func(i, j int) bool { return hand[order[i]] < hand[order[j]] })
+	return order
+}
+
+type holdResponse struct {
+	Hand string   `json:"hand"`
+	Hold []int    `json:"hold"`
+	Keep []string `json:"keep"`
+}
+
+func (s *StrategyServer) hold(r *http.Request) (interface{}, error) {
+	hand, err := s.handParam(r.URL.Query().Get("hand"))
+	if err != nil {
+		return nil, err
+	}
+	resp := holdResponse{Hand: describeHand(hand), Hold: s.bestHold(hand), Keep: []string{}}
+	for _, pos := range resp.Hold {
+		resp.Keep = append(resp.Keep, hand[pos].String())
+	}
+	return resp, nil
+}
+
+// bestHold is the strategy's hold for the hand, as positions in it
+func (s *StrategyServer) bestHold(hand [3]Card) []int {
+	a, b, c := Sort3(hand[0], hand[1], hand[2])
+	mask := s.holds[HandIndex(a, b, c)]
+	hold := []int{}
+	for i, pos := range sortedPositions(hand) {
+		if mask&(1<<i) != 0 {
+			hold = append(hold, pos)
+		}
+	}
+	sort.Ints(hold)
+	return hold
+}
+
+type oddsResponse struct {
+	Hold []int   `json:"hold"`
+	Win  float64 `json:"win"`
+	Tie  float64 `json:"tie"`
+	Lose float64 `json:"lose"`
+	Best bool    `json:"best"`
+}
+
+func (s *StrategyServer) odds(r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	hand, err := s.handParam(q.Get("hand"))
+	if err != nil {
+		return nil, err
+	}
+	odds := s.analyzer.HandOdds(hand)
+	best := positionsMask(s.bestHold(hand))
+	result := func(mask uint8) oddsResponse {
+		hold := []int{}
+		for i := 0; i < 3; i++ {
+			if mask&(1<<i) != 0 {
+				hold = append(hold, i)
+			}
+		}
+		o := odds[mask]
+		return oddsResponse{Hold: hold, Win: o.Win, Tie: o.Tie, Lose: o.Lose, Best: mask == best}
+	}
+
+	if q.Has("hold") {
+		var mask uint8
+		for _, f := range strings.FieldsFunc(q.Get("hold"), func(r rune) bool { return r == ',' }) {
+			pos, err := strconv.Atoi(strings.TrimSpace(f))
+			if err != nil || pos < 0 || pos > 2 {
+				return nil, badRequest("bad hold position %q", f)
+			}
+			mask |= 1 << pos
+		}
+		return result(mask), nil
+	}
+	all := make([]oddsResponse, 0, len(holdOrder))
+	for _, hold := range holdOrder {
+		all = append(all, result(positionsMask(hold)))
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].Win > all[j].Win })
+	return all, nil
+}
+
+type evaluateResponse struct {
+	Hand  string `json:"hand"`
+	Rank  int    `json:"rank"`
+	Class string `json:"class"`
+	Wins  int    `json:"wins"`
+	Ties  int    `json:"ties"`
+	Loses int    `json:"loses"`
+}
+
+// maxEvaluate is the most hands one evaluate request can take
+const maxEvaluate = 100
+
+func (s *StrategyServer) evaluate(r *http.Request) (interface{}, error) {
+	hands := strings.Split(r.URL.Query().Get("hands"), ",")
+	if len(hands) > maxEvaluate {
+		return nil, badRequest("at most %d hands at a time", maxEvaluate)
+	}
+	var resp []evaluateResponse
+	for _, h := range hands {
+		hand, err := s.handParam(h)
+		if err != nil {
+			return nil, err
+		}
+		rank := s.tables.Rank(hand[0], hand[1], hand[2])
+		w := s.tables.Winners[rank]
+		resp = append(resp, evaluateResponse{Hand: describeHand(hand), Rank: rank, Class: s.tables.Classify(hand).String(),
+			Wins: w.Wins, Ties: w.Ties, Loses: w.Loses})
+	}
+	return resp, nil
+}
+
+// describeHand writes a hand with dashes, as requests give it
+func describeHand(hand [3]Card) string {
+	return strings.ReplaceAll(describeCards(hand[:]), " ", "-")
+}
+
+// Serve is the serve command: runs the strategy service until
+// interrupted
+func Serve(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard serve", "Serves holds, odds and hand ranks over HTTP.")
+	addr := fs.String("addr", ":8080", "address to listen on")
+	strategyFile := fs.String("strategy", "", "strategy file to serve (default the analyzer's best holds)")
+	cacheSize := fs.Int("cache", 100000, "responses to cache")
+	game := addGameFlags(fs, "removed")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	server, err := newServer(game, dealer, *strategyFile, *cacheSize)
+	if err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	slog.Info("serving", "addr", ln.Addr().String(), "shoe", server.tables.Shoe, "rules", server.tables.Rules,
+		"dealer", dealer.policy, "elapsed", time.Since(start))
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	return runServer(ctx, ln, server.Handler())
+}
+
+// newServer builds a strategy server from the command line options
+func newServer(game *gameOptions, dealer *dealerOptions, strategyFile string, cacheSize int) (*StrategyServer, error) {
+	tables, err := game.tables()
+	if err != nil {
+		return nil, err
+	}
+	dealerHolds, err := dealer.holds(tables, func() (*Holds, error) {
+		return NewAnalyzer(tables, StaticField(tables)).BestHolds(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	analyzer := NewAnalyzer(tables, fieldFor(tables, dealerHolds))
+	var holds *Holds
+	if strategyFile == "" {
+		holds = analyzer.BestHolds()
+	} else {
+		strategy, err := LoadStrategy(strategyFile)
+		if err != nil {
+			return nil, err
+		}
+		if holds, err = strategy.HoldMasks(tables); err != nil {
+			return nil, err
+		}
+	}
+	return NewStrategyServer(analyzer, holds, cacheSize), nil
+}
+
+// runServer serves on ln until ctx is done, then lets requests in
+// flight finish
+func runServer(ctx context.Context, ln net.Listener, h http.Handler) error {
+	srv := &http.Server{Handler: h, ReadHeaderTimeout: 5 * time.Second, IdleTimeout: time.Minute}
+	errc := make(chan error, 1)
+	go func() { errc <- srv.Serve(ln) }()
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return srv.Shutdown(shutdown)
+}
@@ -0,0 +1,205 @@
+package threecardanalyze
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func testServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	a := houseAnalyzer(t)
+	srv := httptest.NewServer(NewStrategyServer(a, a.BestHolds(), 100).Handler())
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// get requests path and decodes the JSON answer into v
+func get(t *testing.T, srv *httptest.Server, path string, header http.Header, v interface{}) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, vs := range header {
+		req.Header[k] = vs
+	}
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if v != nil && resp.StatusCode != http.StatusNotModified {
+		if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+	}
+	return resp
+}
+
+func TestServeHold(t *testing.T) {
+	srv := testServer(t)
+	var hold holdResponse
+	// The strategy keeps the king and ace, wherever they're given
+	for _, test := range []struct {
+		hand string
+		want string
+	}{
+		{"10D-KS-AS", "[1 2]"},
+		{"AS-10D-KS", "[0 2]"},
+	} {
+		resp := get(t, srv, "/hold?hand="+test.hand, nil, &hold)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: status %d", test.hand, resp.StatusCode)
+		}
+		if got := fmt.Sprint(hold.Hold); got != test.want {
+			t.Errorf("%s: hold %s, want %s", test.hand, got, test.want)
+		}
+		if len(hold.Keep) != 2 || hold.Keep[0] == "10D" || hold.Keep[1] == "10D" {
+			t.Errorf("%s: keep %v", test.hand, hold.Keep)
+		}
+	}
+
+	var e map[string]string
+	for _, bad := range []string{"", "AS-KS", "AS-XX-KS", "AS-AS-KS"} {
+		if resp := get(t, srv, "/hold?hand="+bad, nil, &e); resp.StatusCode != http.StatusBadRequest || e["error"] == "" {
+			t.Errorf("%q: status %d, error %q", bad, resp.StatusCode, e["error"])
+		}
+	}
+}
+
+func TestServeOdds(t *testing.T) {
+	srv := testServer(t)
+	a := houseAnalyzer(t)
+	hand := mustHand(t, "10D-KS-AS")
+	odds := a.HandOdds(hand)
+
+	var one oddsResponse
+	if resp := get(t, srv, "/odds?hand=10D-KS-AS&hold=1,2", nil, &one); resp.StatusCode != http.StatusOK {
+		t.Fatalf("status %d", resp.StatusCode)
+	}
+	if want := odds[positionsMask([]int{1, 2})]; one.Win != want.Win || one.Lose != want.Lose || !one.Best {
+		t.Errorf("got %+v, want %+v and best", one, want)
+	}
+
+	var all []oddsResponse
+	get(t, srv, "/odds?hand=10D-KS-AS", nil, &all)
+	if len(all) != 8 {
+		t.Fatalf("%d holds, want 8", len(all))
+	}
+	best := 0
+	for i, o := range all {
+		if i > 0 && o.Win > all[i-1].Win {
+			t.Errorf("holds aren't best first: %+v", all)
+		}
+		if o.Best {
+			best++
+		}
+	}
+	if best != 1 || !all[0].Best {
+		t.Errorf("best hold isn't first and only: %+v", all)
+	}
+
+	var e map[string]string
+	if resp := get(t, srv, "/odds?hand=10D-KS-AS&hold=3", nil, &e); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("hold=3: status %d", resp.StatusCode)
+	}
+}
+
+func TestServeEvaluate(t *testing.T) {
+	srv := testServer(t)
+	a := houseAnalyzer(t)
+	var got []evaluateResponse
+	if resp := get(t, srv, "/evaluate?hands=AS-KS-QS,2C-3D-5H", nil, &got); resp.StatusCode != http.StatusOK {
+		t.Fatalf("status %d", resp.StatusCode)
+	}
+	if len(got) != 2 {
+		t.Fatalf("%d hands, want 2", len(got))
+	}
+	if got[0].Class != "straight flush" || got[0].Rank != a.Tables.Ranking["QS-KS-AS"] || got[0].Rank >= got[1].Rank {
+		t.Errorf("AS-KS-QS: %+v", got[0])
+	}
+	if got[1].Class != "high card" || got[1].Wins+got[1].Ties+got[1].Loses == 0 {
+		t.Errorf("2C-3D-5H: %+v", got[1])
+	}
+
+	hands := make([]string, maxEvaluate+1)
+	for i := range hands {
+		hands[i] = "AS-KS-QS"
+	}
+	var e map[string]string
+	if resp := get(t, srv, "/evaluate?hands="+strings.Join(hands, ","), nil, &e); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("%d hands: status %d", len(hands), resp.StatusCode)
+	}
+	if resp := get(t, srv, "/evaluate?hands="+strings.Join(hands[1:], ","), nil, &got); resp.StatusCode != http.StatusOK || len(got) != maxEvaluate {
+		t.Errorf("%d hands: status %d, %d answers", maxEvaluate, resp.StatusCode, len(got))
+	}
+	// One deck can't deal two aces of spades
+	if resp := get(t, srv, "/evaluate?hands=AS-KS-QS,AS-AS-KS", nil, &e); resp.StatusCode != http.StatusBadRequest || !strings.Contains(e["error"], "can't be dealt") {
+		t.Errorf("AS-AS-KS: status %d, error %q", resp.StatusCode, e["error"])
+	}
+}
+
+func TestServeHealthz(t *testing.T) {
+	srv := testServer(t)
+	var h map[string]interface{}
+	if resp := get(t, srv, "/healthz", nil, &h); resp.StatusCode != http.StatusOK {
+		t.Fatalf("status %d", resp.StatusCode)
+	}
+	if h["status"] != "ok" || h["hands"] != float64(NumHands) || h["shoe"] != "1 deck" || h["rules"] != "house" || h["etag"] == "" {
+		t.Errorf("healthz %v", h)
+	}
+}
+
+func TestServeCaching(t *testing.T) {
+	srv := testServer(t)
+	var hold holdResponse
+	resp := get(t, srv, "/hold?hand=10D-KS-AS", nil, &hold)
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("no ETag")
+	}
+
+	match := http.Header{"If-None-Match": {etag}}
+	if resp = get(t, srv, "/hold?hand=10D-KS-AS", match, nil); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("If-None-Match: status %d, want 304", resp.StatusCode)
+	}
+	// A bad request is a 400 even with the strategy's ETag
+	var e map[string]string
+	if resp = get(t, srv, "/hold?hand=AS-XX-KS", match, &e); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("bad hand with If-None-Match: status %d, want 400", resp.StatusCode)
+	}
+	if resp = get(t, srv, "/hold?hand=10D-KS-AS", http.Header{"If-None-Match": {`"other"`}}, &hold); resp.StatusCode != http.StatusOK {
+		t.Errorf("other ETag: status %d, want 200", resp.StatusCode)
+	}
+}
+
+func TestServeETagDealer(t *testing.T) {
+	a := houseAnalyzer(t)
+	holds := a.BestHolds()
+	static := NewStrategyServer(a, holds, 0)
+	if again := NewStrategyServer(houseAnalyzer(t), holds, 0); again.etag != static.etag {
+		t.Errorf("same game: ETags %s and %s", static.etag, again.etag)
+	}
+
+	// Only the dealer changes, so the strategy does not
+	drawn := NewStrategyServer(NewAnalyzer(a.Tables, DrawnField(a.Tables, DefaultHouseRules.Holds(a.Tables))), holds, 0)
+	if drawn.etag == static.etag {
+		t.Errorf("a dealer who draws has the static dealer's ETag %s", static.etag)
+	}
+}
+
+func TestServeMethod(t *testing.T) {
+	srv := testServer(t)
+	resp, err := srv.Client().Post(srv.URL+"/hold?hand=10D-KS-AS", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST: status %d, want 405", resp.StatusCode)
+	}
+}
@@ -0,0 +1,162 @@
+package threecardanalyze
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// Shoe is what the cards are dealt from: some number of decks and
+// jokers with any cards that have already been seen taken out
+type Shoe struct {
+	Decks   int
+	Jokers  int
+	Removed []Card
+	counts  [NumCards]int
+}
+
+// NewShoe returns a full shoe of decks with no jokers
+func NewShoe(decks int) *Shoe {
+	s := &Shoe{Decks: decks}
+	for c := Card(0); c < Joker; c++ {
+		s.counts[c] = decks
+	}
+	return s
+}
+
+// AddJokers puts jokers in the shoe
+func (s *Shoe) AddJokers(n int) {
+	s.Jokers += n
+	s.counts[Joker] += n
+}
+
+// Remove takes cards out of the shoe
+func (s *Shoe) Remove(cards ...Card) error {
+	for _, c := range cards {
+		if s.counts[c] == 0 {
+			return fmt.Errorf("no %s left to remove", c)
+		}
+		s.counts[c]--
+		s.Removed = append(s.Removed, c)
+	}
+	return nil
+}
+
+// Count is how many of the card are left
+func (s *Shoe) Count(c Card) int {
+	return s.counts[c]
+}
+
+// Size is how many cards are left
+func (s *Shoe) Size() int {
+	n := 0
+	for _, c := range s.counts {
+		n += c
+	}
+	return n
+}
+
+func (s *Shoe) String() string {
+	desc := "1 deck"
+	if s.Decks != 1 {
+		desc = fmt.Sprintf("%d decks", s.Decks)
+	}
+	if s.Jokers == 1 {
+		desc += ", 1 joker"
+	} else if s.Jokers > 1 {
+		desc += fmt.Sprintf(", %d jokers", s.Jokers)
+	}
+	if len(s.Removed) > 0 {
+		desc += fmt.Sprintf(", %d cards removed", len(s.Removed))
+	}
+	return desc
+}
+
+// ways is how many ways the shoe can deal the sorted hand
+func (s *Shoe) ways(hand [3]Card) int64 {
+	return combos(&s.counts, hand[:])
+}
+
+// combos is how many ways there are to pick the sorted cards from
+// counts, with a repeated card coming from the copies of it
+func combos(counts *[NumCards]int, cards []Card) int64 {
+	ways := int64(1)
+	for i := 0; i < len(cards); {
+		j := i + 1
+		for j < len(cards) && cards[j] == cards[i] {
+			j++
+		}
+		ways *= int64(binomial(counts[cards[i]], j-i))
+		i = j
+	}
+	return ways
+}
+
+// gameOptions are the flags that set up the shoe and the rules
+type gameOptions struct {
+	rules   string
+	decks   int
+	jokers  int
+	wild    string
+	removed string
+}
+
+// addGameFlags adds the shoe and rules flags, with the flag for cards
+// already out of the shoe called removed
+func addGameFlags(fs *flag.FlagSet, removed string) *gameOptions {
+	o := &gameOptions{}
+	fs.StringVar(&o.rules, "rules", SkillRules.Name, "ranking rules: a preset (house or three-card-poker) or a YAML or JSON rules file")
+	fs.IntVar(&o.decks, "decks", 1, "number of decks in the shoe")
+	fs.IntVar(&o.jokers, "jokers", 0, "number of jokers in the shoe (jokers are wild)")
+	fs.StringVar(&o.wild, "wild", "", "ranks that are wild, separated by commas (for example 2 for deuces wild); replaces any in -rules")
+	fs.StringVar(&o.removed, removed, "", "cards already out of the shoe, separated by commas (for example AS,10D)")
+	return o
+}
+
+// tables builds the shoe and rules and their tables
+func (o *gameOptions) tables() (*Tables, error) {
+	if o.decks < 1 || o.jokers < 0 {
+		return nil, fmt.Errorf("-decks must be at least 1 and -jokers can't be negative")
+	}
+	shoe := NewShoe(o.decks)
+	shoe.AddJokers(o.jokers)
+	cards, err := ParseCards(o.removed)
+	if err != nil {
+		return nil, fmt.Errorf("bad removed cards: %v", err)
+	}
+	if err = shoe.Remove(cards...); err != nil {
+		return nil, fmt.Errorf("bad removed cards: %v", err)
+	}
+	rules, err := LoadRules(o.rules)
+	if err != nil {
+		return nil, err
+	}
+	if o.wild != "" {
+		if rules.WildRanks, err = parseWildRanks(o.wild); err != nil {
+			return nil, fmt.Errorf("bad -wild: %v", err)
+		}
+	}
+	return NewTables(shoe, rules)
+}
+
+// describeCards lists cards for output
+func describeCards(cards []Card) string {
+	s := make([]string, len(cards))
+	for i, c := range cards {
+		s[i] = c.String()
+	}
+	return strings.Join(s, " ")
+}
+
+// drawTables builds the tables for hands of more than three cards,
+// which are only dealt from a single deck with nothing wild
+func (o *gameOptions) drawTables(cards int) (*DrawTables, error) {
+	if o.decks != 1 || o.jokers != 0 || o.wild != "" || o.removed != "" {
+		return nil, fmt.Errorf("hands of %d cards are dealt from a single deck with no jokers, wild cards or removed cards", cards)
+	}
+	rules, err := LoadRules(o.rules)
+	if err != nil {
+		return nil, err
+	}
+	return NewDrawTables(cards, rules)
+}
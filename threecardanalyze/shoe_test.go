@@ -0,0 +1,164 @@
+package threecardanalyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestShoeCounts(t *testing.T) {
+	shoe := NewShoe(6)
+	shoe.AddJokers(2)
+	cards := mustHand(t, "AS-KD-2C")
+	as, kd := cards[0], cards[1]
+	if shoe.Count(as) != 6 || shoe.Count(Joker) != 2 || shoe.Size() != 6*52+2 {
+		t.Errorf("six decks and two jokers: %d aces of spades, %d jokers, %d cards", shoe.Count(as), shoe.Count(Joker), shoe.Size())
+	}
+	for _, test := range []struct {
+		hand [3]Card
+		want int64
+	}{
+		{[3]Card{kd, as, Joker}, 6 * 6 * 2},
+		{[3]Card{as, as, kd}, 15 * 6},
+		{[3]Card{as, as, as}, 20},
+		{[3]Card{Joker, Joker, as}, 6},
+	} {
+		a, b, c := Sort3(test.hand[0], test.hand[1], test.hand[2])
+		if got := shoe.ways([3]Card{a, b, c}); got != test.want {
+			t.Errorf("%s: %d ways, want %d", describeCards(test.hand[:]), got, test.want)
+		}
+	}
+
+	tables, err := NewTables(NewShoe(2), SkillRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := int64(binomial(104, 3)); tables.totalWays != want {
+		t.Errorf("two decks deal %d hands, want %d", tables.totalWays, want)
+	}
+}
+
+func TestShoeRemove(t *testing.T) {
+	shoe := NewShoe(2)
+	as := mustHand(t, "AS-KD-2C")[0]
+	if err := shoe.Remove(as, as); err != nil {
+		t.Fatal(err)
+	}
+	if shoe.Count(as) != 0 || shoe.Size() != 102 || len(shoe.Removed) != 2 {
+		t.Errorf("after removing both: %d left, %d cards, %d removed", shoe.Count(as), shoe.Size(), len(shoe.Removed))
+	}
+	if err := shoe.Remove(as); err == nil || err.Error() != "no AS left to remove" {
+		t.Errorf("a third ace of spades: %v", err)
+	}
+	if err := NewShoe(1).Remove(Joker); err == nil {
+		t.Error("a joker from a shoe without one: no error")
+	}
+}
+
+// TestSeenOdds checks the odds of every hold against dealing out a
+// small two deck shoe card by card
+func TestSeenOdds(t *testing.T) {
+	shoe := NewShoe(2)
+	for c := Card(0); c < Joker; c++ {
+		if c.Rank() >= 10 {
+			continue
+		}
+		if err := shoe.Remove(c, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	kh := mustHand(t, "KH-AS-AS")[0]
+	if err := shoe.Remove(kh); err != nil {
+		t.Fatal(err)
+	}
+	tables, err := NewTables(shoe, SkillRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAnalyzer(tables, StaticField(tables))
+
+	var cards []Card
+	for c := Card(0); c < NumCards; c++ {
+		for i := 0; i < shoe.Count(c); i++ {
+			cards = append(cards, c)
+		}
+	}
+	// How many of the dealer's hands have each rank
+	dealer := make([]float64, len(tables.Winners))
+	total := 0.0
+	for i := range cards {
+		for j := i + 1; j < len(cards); j++ {
+			for k := j + 1; k < len(cards); k++ {
+				dealer[tables.Rank(cards[i], cards[j], cards[k])]++
+				total++
+			}
+		}
+	}
+
+	for _, key := range []string{"KH-AS-AS", "QD-KD-AD", "QC-KS-AH", "QS-QH-KC"} {
+		hand := mustHand(t, key)
+		got := a.HandOdds(hand)
+
+		// What's left to draw from
+		left := append([]Card(nil), cards...)
+		for _, c := range hand {
+			for i := range left {
+				if left[i] == c {
+					left = append(left[:i], left[i+1:]...)
+					break
+				}
+			}
+		}
+		for mask := uint8(0); mask < 8; mask++ {
+			var held []Card
+			for i, c := range hand {
+				if mask&(1<<i) != 0 {
+					held = append(held, c)
+				}
+			}
+			var want Odds
+			draws := 0.0
+			eachPick(len(left), 3-len(held), func(picked []int) {
+				final := append([]Card(nil), held...)
+				for _, i := range picked {
+					final = append(final, left[i])
+				}
+				r := tables.Rank(final[0], final[1], final[2])
+				for dr, n := range dealer {
+					switch {
+					case r < dr:
+						want.Win += n
+					case r == dr:
+						want.Tie += n
+					default:
+						want.Lose += n
+					}
+				}
+				draws++
+			})
+			want.Win /= draws * total
+			want.Tie /= draws * total
+			want.Lose /= draws * total
+			if o := got[mask]; math.Abs(o.Win-want.Win) > 1e-12 || math.Abs(o.Tie-want.Tie) > 1e-12 || math.Abs(o.Lose-want.Lose) > 1e-12 {
+				t.Errorf("%s hold %03b: %+v, want %+v", key, mask, o, want)
+			}
+		}
+	}
+}
+
+// eachPick calls fn with every way to pick k of n things, in order
+func eachPick(n, k int, fn func(picked []int)) {
+	picked := make([]int, 0, k)
+	var pick func(from int)
+	pick = func(from int) {
+		if len(picked) == k {
+			fn(picked)
+			return
+		}
+		for i := from; i < n; i++ {
+			picked = append(picked, i)
+			pick(i + 1)
+			picked = picked[:len(picked)-1]
+		}
+	}
+	pick(0)
+}
@@ -0,0 +1,372 @@
+package threecardanalyze
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+	"gopkg.in/yaml.v3"
+)
+
+// SideBet is what a side bet pays on
+type SideBet string
+
+const (
+	// The player's three cards, losing if they don't pay
+	BetPairPlus SideBet = "pair-plus"
+	// The player's three cards, paid on top of the ante so hands that
+	// don't pay push
+	BetAnteBonus SideBet = "ante-bonus"
+	// The best five of the player's and dealer's six cards, losing if
+	// they don't pay
+	BetSixCard SideBet = "six-card"
+)
+
+// RoyalFlush is the ace high straight flush, which paytables can pay
+// separately from other straight flushes
+const RoyalFlush = "royal flush"
+
+// Paytable is what a side bet pays, to 1, for each hand. Hands are
+// class names or royal flush; a royal flush not in the table is paid
+// as a straight flush.
+type Paytable struct {
+	Name string             `json:"name,omitempty" yaml:"name,omitempty"`
+	Bet  SideBet            `json:"bet" yaml:"bet"`
+	Pays map[string]float64 `json:"pays" yaml:"pays"`
+}
+
+// PaytablePresets are the paytables that can be picked by name
+var PaytablePresets = map[string]Paytable{
+	"pair-plus": {Name: "pair-plus", Bet: BetPairPlus, Pays: map[string]float64{
+		"straight flush": 40, "three of a kind": 30, "straight": 6, "flush": 3, "pair": 1}},
+	"ante-bonus": {Name: "ante-bonus", Bet: BetAnteBonus, Pays: map[string]float64{
+		"straight flush": 5, "three of a kind": 4, "straight": 1}},
+	"six-card-bonus": {Name: "six-card-bonus", Bet: BetSixCard, Pays: map[string]float64{
+		RoyalFlush: 1000, "straight flush": 200, "four of a kind": 100, "full house": 20, "flush": 15, "straight": 10, "three of a kind": 7}},
+}
+
+// LoadPaytable returns the preset with the name, or reads the paytable
+// from a file. Files ending in .json are read as JSON, anything else as
+// YAML.
+func LoadPaytable(name string) (Paytable, error) {
+	if p, ok := PaytablePresets[name]; ok {
+		return p, nil
+	}
+	dat, err := os.ReadFile(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			var names []string
+			for n := range PaytablePresets {
+				names = append(names, n)
+			}
+			sort.Strings(names)
+			return Paytable{}, fmt.Errorf("%q isn't a preset (%s) or a paytable file", name, strings.Join(names, ", "))
+		}
+		return Paytable{}, err
+	}
+
+	var p Paytable
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(dat))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&p)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(dat))
+		dec.KnownFields(true)
+		err = dec.Decode(&p)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err == nil {
+		err = p.Validate()
+	}
+	if err != nil {
+		return Paytable{}, fmt.Errorf("%s: %v", name, err)
+	}
+	if p.Name == "" {
+		p.Name = filepath.Base(name)
+	}
+	return p, nil
+}
+
+// hands lists the hands the bet can pay on, best first
+func (p Paytable) hands() []string {
+	order := classOrder[3]
+	if p.Bet == BetSixCard {
+		order = classOrder[5]
+	}
+	hands := []string{RoyalFlush}
+	for _, class := range order {
+		hands = append(hands, class.String())
+	}
+	return hands
+}
+
+// Validate checks the paytable makes sense
+func (p Paytable) Validate() error {
+	switch p.Bet {
+	case BetPairPlus, BetAnteBonus, BetSixCard:
+	default:
+		return fmt.Errorf("bet must be %s, %s or %s, not %q", BetPairPlus, BetAnteBonus, BetSixCard, p.Bet)
+	}
+	known := make(map[string]bool)
+	for _, h := range p.hands() {
+		known[h] = true
+	}
+	for h, pays := range p.Pays {
+		if !known[h] {
+			return fmt.Errorf("%s doesn't pay on %q", p.Bet, h)
+		}
+		if pays < 0 {
+			return fmt.Errorf("%s can't pay less than nothing", h)
+		}
+	}
+	return nil
+}
+
+// SideBetLine is one hand in a side bet report
+type SideBetLine struct {
+	Hand        string
+	Pays        float64
+	Probability float64
+}
+
+// SideBetStats is how a side bet plays per unit bet
+type SideBetStats struct {
+	Paytable     Paytable
+	Lines        []SideBetLine // hands the table lists, best first
+	HitFrequency float64
+	Return       float64
+	Variance     float64
+}
+
+// HouseEdge is what the house keeps per unit bet
+func (s SideBetStats) HouseEdge() float64 {
+	return -s.Return
+}
+
+// AnalyzeSideBet works out the side bet's odds from the shoe. Six card
+// bets are dealt from a single deck with nothing wild.
+func AnalyzeSideBet(t *Tables, p Paytable) (SideBetStats, error) {
+	var probs map[string]float64
+	if p.Bet == BetSixCard {
+		var err error
+		if probs, err = sixCardOdds(t); err != nil {
+			return SideBetStats{}, err
+		}
+	} else {
+		probs = make(map[string]float64)
+		t.Hands(func(idx int, hand [3]Card) {
+			probs[t.sideBetHand(idx)] += t.weight(idx)
+		})
+	}
+
+	// A royal flush the table doesn't list is just a straight flush
+	if _, ok := p.Pays[RoyalFlush]; !ok {
+		probs[StraightFlush.String()] += probs[RoyalFlush]
+		delete(probs, RoyalFlush)
+	}
+
+	stats := SideBetStats{Paytable: p}
+	var square float64
+	for _, h := range p.hands() {
+		pays, ok := p.Pays[h]
+		if !ok || probs[h] == 0 {
+			continue
+		}
+		stats.Lines = append(stats.Lines, SideBetLine{Hand: h, Pays: pays, Probability: probs[h]})
+		stats.HitFrequency += probs[h]
+		stats.Return += pays * probs[h]
+		square += pays * pays * probs[h]
+	}
+	if p.Bet != BetAnteBonus {
+		stats.Return -= 1 - stats.HitFrequency
+		square += 1 - stats.HitFrequency
+	}
+	stats.Variance = square - stats.Return*stats.Return
+	return stats, nil
+}
+
+// sideBetHand names the hand the cards play as (by HandIndex)
+func (t *Tables) sideBetHand(idx int) string {
+	played := t.resolved[idx]
+	class := t.Rules.Classify(played[0], played[1], played[2])
+	if class == StraightFlush {
+		a, b, c := Sort3(played[0], played[1], played[2])
+		if a.Rank() == 10 && b.Rank() == 11 && c.Rank() == 12 {
+			return RoyalFlush
+		}
+	}
+	return class.String()
+}
+
+// sixCardOdds is the chance of each best five card hand among six
+// cards dealt from what's left of a single deck
+func sixCardOdds(t *Tables) (map[string]float64, error) {
+	if t.Shoe.Decks != 1 || t.Shoe.Jokers != 0 || len(t.Rules.WildRanks) != 0 {
+		return nil, fmt.Errorf("six card bets are dealt from a single deck with nothing wild")
+	}
+	var deck []Card
+	for c := Card(0); c < Joker; c++ {
+		if t.Shoe.Count(c) > 0 {
+			deck = append(deck, c)
+		}
+	}
+	if len(deck) < 6 {
+		return nil, fmt.Errorf("fewer than six cards left")
+	}
+
+	var counts [NumClasses + 1]int64 // the last counts royal flushes
+	var hand [6]Card
+	var deal func(start, n int)
+	deal = func(start, n int) {
+		if n == 6 {
+			counts[bestOfSix(&hand)]++
+			return
+		}
+		for i := start; i <= len(deck)-6+n; i++ {
+			hand[n] = deck[i]
+			deal(i+1, n+1)
+		}
+	}
+	deal(0, 0)
+
+	total := float64(binomial(len(deck), 6))
+	probs := make(map[string]float64)
+	for class, n := range counts {
+		name := RoyalFlush
+		if class < int(NumClasses) {
+			name = HandClass(class).String()
+		}
+		probs[name] = float64(n) / total
+	}
+	return probs, nil
+}
+
+// bestOfSix classes the best five of six natural cards, with
+// NumClasses standing for a royal flush
+func bestOfSix(hand *[6]Card) HandClass {
+	var counts [13]int
+	var suits [4]uint16
+	var ranks uint16
+	for _, c := range hand {
+		counts[c.Rank()]++
+		suits[c.Suit()] |= 1 << c.Rank()
+		ranks |= 1 << c.Rank()
+	}
+
+	flush := false
+	for _, s := range suits {
+		if onesCount(s) < 5 {
+			continue
+		}
+		flush = true
+		if top := straightTop(s); top == 12 {
+			return NumClasses
+		} else if top >= 0 {
+			return StraightFlush
+		}
+	}
+
+	var pairs, trips int
+	for _, n := range counts {
+		switch {
+		case n == 4:
+			return FourOfAKind
+		case n == 3:
+			trips++
+		case n == 2:
+			pairs++
+		}
+	}
+	switch {
+	case trips > 1 || (trips == 1 && pairs > 0):
+		return FullHouse
+	case flush:
+		return Flush
+	case straightTop(ranks) >= 0:
+		return Straight
+	case trips == 1:
+		return Trips
+	case pairs > 1:
+		return TwoPair
+	case pairs == 1:
+		return Pair
+	}
+	return HighCard
+}
+
+// straightTop is the top rank of the best five card straight among the
+// ranks, with A-2-3-4-5 topped by the five, or -1 if there's none
+func straightTop(ranks uint16) int {
+	for top := 12; top >= 4; top-- {
+		run := uint16(0x1f) << (top - 4)
+		if ranks&run == run {
+			return top
+		}
+	}
+	if wheel := uint16(1<<12 | 0xf); ranks&wheel == wheel {
+		return 3
+	}
+	return -1
+}
+
+func onesCount(bits uint16) int {
+	n := 0
+	for ; bits != 0; bits &= bits - 1 {
+		n++
+	}
+	return n
+}
+
+// SideBets is the sidebets command: a report per paytable
+func SideBets(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard sidebets", "Reports the house edge, hit frequency and variance of side bets.")
+	paytables := fs.String("paytables", "pair-plus,ante-bonus,six-card-bonus", "paytables to report, separated by commas: presets or YAML or JSON paytable files")
+	game := addGameFlags(fs, "removed")
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	tables, err := game.tables()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Shoe: %s, %s\n", tables.Shoe, tables.Rules)
+	for _, name := range strings.Split(*paytables, ",") {
+		p, err := LoadPaytable(strings.TrimSpace(name))
+		if err != nil {
+			return cli.Usagef("%v", err)
+		}
+		stats, err := AnalyzeSideBet(tables, p)
+		if err != nil {
+			return cli.Usagef("%s: %v", p.Name, err)
+		}
+
+		if string(p.Bet) == p.Name {
+			fmt.Printf("\n%s\n", p.Name)
+		} else {
+			fmt.Printf("\n%s (%s)\n", p.Name, p.Bet)
+		}
+		fmt.Printf("  %-16s %8s %12s %10s\n", "Hand", "Pays", "Probability", "Return")
+		for _, l := range stats.Lines {
+			fmt.Printf("  %-16s %8g %11.5f%% %+10.5f\n", l.Hand, l.Pays, 100*l.Probability, l.Pays*l.Probability)
+		}
+		if p.Bet == BetAnteBonus {
+			fmt.Printf("  Hit frequency %.4f%%, pays %.5f per unit ante, variance %.4f\n",
+				100*stats.HitFrequency, stats.Return, stats.Variance)
+		} else {
+			fmt.Printf("  Hit frequency %.4f%%, return %+.5f, house edge %.4f%%, variance %.4f\n",
+				100*stats.HitFrequency, stats.Return, 100*stats.HouseEdge(), stats.Variance)
+		}
+	}
+	return nil
+}
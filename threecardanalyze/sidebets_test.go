@@ -0,0 +1,50 @@
+package threecardanalyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAnalyzeSideBet(t *testing.T) {
+	tables := houseTables(t)
+	lines := func(p Paytable) map[string]SideBetLine {
+		stats, err := AnalyzeSideBet(tables, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := make(map[string]SideBetLine)
+		for _, l := range stats.Lines {
+			if _, ok := p.Pays[l.Hand]; !ok {
+				t.Errorf("%s: %s is listed but not in the paytable", p.Name, l.Hand)
+			}
+			m[l.Hand] = l
+		}
+		return m
+	}
+
+	// Pair Plus doesn't pay a royal flush separately, so all 48 straight
+	// flushes pay 40
+	pairPlus := PaytablePresets["pair-plus"]
+	got := lines(pairPlus)
+	if len(got) != len(pairPlus.Pays) {
+		t.Errorf("pair-plus: %d lines, want %d", len(got), len(pairPlus.Pays))
+	}
+	if sf := got["straight flush"]; math.Abs(sf.Probability-48.0/22100) > 1e-15 || sf.Pays != 40 {
+		t.Errorf("pair-plus straight flush: %+v", sf)
+	}
+	stats, _ := AnalyzeSideBet(tables, pairPlus)
+	if math.Abs(stats.HouseEdge()-0.072760) > 5e-7 {
+		t.Errorf("pair-plus house edge %.6f, want 0.072760", stats.HouseEdge())
+	}
+
+	// With a royal flush listed, the other 44 straight flushes are paid
+	// as straight flushes
+	royal := Paytable{Name: "mini-royal", Bet: BetPairPlus, Pays: map[string]float64{RoyalFlush: 200, "straight flush": 40}}
+	got = lines(royal)
+	if r := got[RoyalFlush]; math.Abs(r.Probability-4.0/22100) > 1e-15 {
+		t.Errorf("mini-royal royal flush: %+v", r)
+	}
+	if sf := got["straight flush"]; math.Abs(sf.Probability-44.0/22100) > 1e-15 {
+		t.Errorf("mini-royal straight flush: %+v", sf)
+	}
+}
@@ -0,0 +1,263 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+)
+
+// SimResult totals the outcome of simulated hands. Each hand is an even
+// money bet: +1 for a win, 0 for a tie and -1 for a loss.
+type SimResult struct {
+	Hands  int64
+	Wins   int64
+	Ties   int64
+	Losses int64
+}
+
+func (r *SimResult) add(o SimResult) {
+	r.Hands += o.Hands
+	r.Wins += o.Wins
+	r.Ties += o.Ties
+	r.Losses += o.Losses
+}
+
+// Return is the average amount won per hand
+func (r SimResult) Return() float64 {
+	return float64(r.Wins-r.Losses) / float64(r.Hands)
+}
+
+// Variance of the amount won per hand
+func (r SimResult) Variance() float64 {
+	mean := r.Return()
+	return float64(r.Wins+r.Losses)/float64(r.Hands) - mean*mean
+}
+
+// Confidence returns the half width of the z-score confidence interval
+// around Return (z = 1.96 for 95%)
+func (r SimResult) Confidence(z float64) float64 {
+	return z * math.Sqrt(r.Variance()/float64(r.Hands))
+}
+
+// Dealer models for the simulator
+const (
+	// The dealer's cards come out of the same shoe as the player's
+	ModelDeck = "deck"
+	// The dealer holds a random hand from a separate shoe, which is
+	// what the analyzer assumes when it computes odds
+	ModelIndependent = "independent"
+)
+
+// Simulator deals hands and plays them with a fixed strategy
+type Simulator struct {
+	Tables *Tables
+	Holds  *Holds
+	Model  string
+
+	// How the dealer draws, or nil if the dealer keeps their hand
+	DealerHolds *Holds
+}
+
+// Run plays hands split across streams. Each stream has its own RNG
+// seeded from seed and the stream number, so the result only depends on
+// the seed, the number of hands and the number of streams.
+func (s *Simulator) Run(hands int64, seed uint64, streams int) SimResult {
+	results := make([]SimResult, streams)
+	var wg sync.WaitGroup
+	for i := 0; i < streams; i++ {
+		n := hands / int64(streams)
+		if int64(i) < hands%int64(streams) {
+			n++
+		}
+		wg.Add(1)
+		go func(i int, n int64) {
+			defer wg.Done()
+			rng := rand.New(rand.NewPCG(seed, uint64(i)))
+			results[i] = s.play(rng, n)
+		}(i, n)
+	}
+	wg.Wait()
+
+	var total SimResult
+	for _, r := range results {
+		total.add(r)
+	}
+	return total
+}
+
+func (s *Simulator) play(rng *rand.Rand, hands int64) SimResult {
+	var result SimResult
+	deck := s.deck()
+	dealerDeck := s.deck()
+
+	for h := int64(0); h < hands; h++ {
+		// Only shuffle as far into the shoe as we can deal: three each
+		// for the player and dealer and up to three draws for each
+		shuffle(rng, deck, 12)
+
+		player := [3]Card{deck[0], deck[1], deck[2]}
+		next := 3
+		var dealer [3]Card
+		if s.Model == ModelIndependent {
+			shuffle(rng, dealerDeck, 6)
+			dealer = [3]Card{dealerDeck[0], dealerDeck[1], dealerDeck[2]}
+		} else {
+			dealer = [3]Card{deck[3], deck[4], deck[5]}
+			next = 6
+		}
+
+		next = draw(&player, s.Holds, deck, next)
+		if s.DealerHolds != nil {
+			if s.Model == ModelIndependent {
+				draw(&dealer, s.DealerHolds, dealerDeck, 3)
+			} else {
+				draw(&dealer, s.DealerHolds, deck, next)
+			}
+		}
+
+		rank := s.Tables.Rank(player[0], player[1], player[2])
+		dealerRank := s.Tables.Rank(dealer[0], dealer[1], dealer[2])
+		result.Hands++
+		switch {
+		case rank < dealerRank:
+			result.Wins++
+		case rank == dealerRank:
+			result.Ties++
+		default:
+			result.Losses++
+		}
+	}
+	return result
+}
+
+// deck lays out every card left in the shoe
+func (s *Simulator) deck() []Card {
+	var deck []Card
+	for c := Card(0); c < NumCards; c++ {
+		for i := 0; i < s.Tables.Shoe.Count(c); i++ {
+			deck = append(deck, c)
+		}
+	}
+	return deck
+}
+
+// shuffle puts random cards in the first n places of deck
+func shuffle(rng *rand.Rand, deck []Card, n int) {
+	for i := 0; i < n; i++ {
+		j := i + rng.IntN(len(deck)-i)
+		deck[i], deck[j] = deck[j], deck[i]
+	}
+}
+
+// Replaces the cards holds doesn't keep with cards from deck[next:] and
+// returns where the next card to deal is
+func draw(hand *[3]Card, holds *Holds, deck []Card, next int) int {
+	a, b, c := Sort3(hand[0], hand[1], hand[2])
+	*hand = [3]Card{a, b, c}
+	mask := holds[HandIndex(a, b, c)]
+	for i := range hand {
+		if mask&(1<<i) == 0 {
+			hand[i] = deck[next]
+			next++
+		}
+	}
+	return next
+}
+
+// Exact works out the win, tie and loss probability of the strategy
+// under the analyzer's model (a dealer hand independent of the player's)
+// by enumerating every starting hand and every draw
+func (s *Simulator) Exact() Odds {
+	a := NewAnalyzer(s.Tables, fieldFor(s.Tables, s.DealerHolds))
+	a.Analyze()
+
+	var total Odds
+	s.Tables.Hands(func(idx int, hand [3]Card) {
+		total.add(a.HoldOdds(hand)[s.Holds[idx]], s.Tables.weight(idx))
+	})
+	return total
+}
+
+// Simulate is the simulate command
+func Simulate(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard simulate", "Plays a strategy against the dealer with a seeded RNG.")
+	strategyFile := fs.String("strategy", "suggest.json", "strategy file to play (analyzer output or hand written)")
+	hands := fs.Int64("hands", 10000000, "number of hands to deal")
+	seed := fs.Uint64("seed", 1, "RNG seed")
+	streams := fs.Int("streams", 8, "independent RNG streams to run in parallel (part of what makes a run reproducible)")
+	model := fs.String("model", ModelDeck, "where the dealer's hand comes from: deck (same deck as the player) or independent (the analyzer's assumption)")
+	exact := fs.Bool("exact", false, "also compute the strategy's exact odds under the analyzer's model and compare")
+	game := addGameFlags(fs, "removed")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *model != ModelDeck && *model != ModelIndependent {
+		return cli.Usagef("unknown model %q", *model)
+	}
+	if *hands <= 0 || *streams <= 0 {
+		return cli.Usagef("hands and streams must be positive")
+	}
+
+	tables, err := game.tables()
+	if err != nil {
+		return err
+	}
+	strategy, err := LoadStrategy(*strategyFile)
+	if err != nil {
+		return err
+	}
+	holds, err := strategy.HoldMasks(tables)
+	if err != nil {
+		return fmt.Errorf("%s: %v", *strategyFile, err)
+	}
+
+	dealerHolds, err := dealer.holds(tables, func() (*Holds, error) {
+		// Without a strategy file of its own the dealer plays the
+		// same strategy as the player
+		return holds, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sim := &Simulator{Tables: tables, Holds: holds, Model: *model, DealerHolds: dealerHolds}
+	start := time.Now()
+	r := sim.Run(*hands, *seed, *streams)
+	elapsed := time.Since(start)
+
+	n := float64(r.Hands)
+	ci := r.Confidence(1.96)
+	fmt.Printf("Strategy:  %s\n", *strategyFile)
+	fmt.Printf("Shoe:      %s, %s\n", tables.Shoe, tables.Rules)
+	fmt.Printf("Model:     %s, %s dealer (seed %d, %d streams, %v)\n", *model, dealer.policy, *seed, *streams, elapsed.Round(time.Millisecond))
+	fmt.Printf("Hands:     %d\n", r.Hands)
+	fmt.Printf("Win:       %.4f%%\n", 100*float64(r.Wins)/n)
+	fmt.Printf("Tie:       %.4f%%\n", 100*float64(r.Ties)/n)
+	fmt.Printf("Loss:      %.4f%%\n", 100*float64(r.Losses)/n)
+	fmt.Printf("Return:    %+.5f per unit bet (95%% CI %+.5f to %+.5f)\n", r.Return(), r.Return()-ci, r.Return()+ci)
+	fmt.Printf("Variance:  %.5f (std dev %.5f)\n", r.Variance(), math.Sqrt(r.Variance()))
+
+	if *exact {
+		odds := sim.Exact()
+		ev := odds.Win - odds.Lose
+		fmt.Printf("\nExact odds under the analyzer's model (independent dealer):\n")
+		fmt.Printf("Win:       %.4f%%\n", 100*odds.Win)
+		fmt.Printf("Tie:       %.4f%%\n", 100*odds.Tie)
+		fmt.Printf("Loss:      %.4f%%\n", 100*odds.Lose)
+		fmt.Printf("Return:    %+.5f\n", ev)
+		if *model == ModelIndependent {
+			if math.Abs(ev-r.Return()) <= ci {
+				fmt.Println("The exact return is inside the simulated 95% confidence interval.")
+			} else {
+				fmt.Println("The exact return is OUTSIDE the simulated 95% confidence interval.")
+				return fmt.Errorf("simulated return %+.5f doesn't match exact return %+.5f", r.Return(), ev)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package threecardanalyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSimulate(t *testing.T) {
+	a := houseAnalyzer(t)
+	best := a.BestHolds()
+	sim := &Simulator{Tables: a.Tables, Holds: best, Model: ModelIndependent}
+
+	const hands = 200000
+	r := sim.Run(hands, 1, 4)
+	if r.Hands != hands || r.Wins+r.Ties+r.Losses != hands {
+		t.Fatalf("%d hands, %d outcomes, want %d", r.Hands, r.Wins+r.Ties+r.Losses, hands)
+	}
+	if again := sim.Run(hands, 1, 4); again != r {
+		t.Errorf("same seed: %+v, then %+v", r, again)
+	}
+	if other := sim.Run(hands, 2, 4); other == r {
+		t.Errorf("seeds 1 and 2 both gave %+v", r)
+	}
+
+	// Against a dealer from a separate shoe the simulation plays the
+	// game the analyzer works out exactly
+	exact := a.Summarize(best).Overall.Odds
+	ev := exact.Win - exact.Lose
+	if ci := r.Confidence(3.29); math.Abs(r.Return()-ev) > ci {
+		t.Errorf("return %.5f ± %.5f, exact %.5f", r.Return(), ci, ev)
+	}
+	if w := float64(r.Wins) / hands; math.Abs(w-exact.Win) > 3.29*math.Sqrt(exact.Win*(1-exact.Win)/hands) {
+		t.Errorf("wins %.5f of hands, exact %.5f", w, exact.Win)
+	}
+
+	// A dealer who draws by the house rules, against the same strategy
+	dealer := DefaultHouseRules.Holds(a.Tables)
+	sim.DealerHolds = dealer
+	drawn := NewAnalyzer(a.Tables, DrawnField(a.Tables, dealer))
+	exact = drawn.Summarize(best).Overall.Odds
+	ev = exact.Win - exact.Lose
+	r = sim.Run(hands, 1, 4)
+	if ci := r.Confidence(3.29); math.Abs(r.Return()-ev) > ci {
+		t.Errorf("drawing dealer: return %.5f ± %.5f, exact %.5f", r.Return(), ci, ev)
+	}
+}
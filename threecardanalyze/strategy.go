@@ -0,0 +1,111 @@
+package threecardanalyze
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Strategy maps a hand key to the positions (within the key) to hold,
+// the format of suggest.json
+type Strategy map[string][]int
+
+// LoadStrategy reads a strategy file - either the analyzer's output or
+// a hand written strategy in the same format
+func LoadStrategy(filename string) (Strategy, error) {
+	dat, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var s Strategy
+	if err = json.Unmarshal(dat, &s); err != nil {
+		if severalHolds(dat) {
+			return nil, fmt.Errorf("%s lists several holds for a hand (an -alternatives or -costs file); strategy files have one hold per hand, like suggest.json", filename)
+		}
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return s, nil
+}
+
+// severalHolds says whether a file that isn't a strategy maps hand keys
+// to lists of holds, as the alternatives and costs files do
+func severalHolds(dat []byte) bool {
+	var file map[string]json.RawMessage
+	if json.Unmarshal(dat, &file) != nil {
+		return false
+	}
+	for _, v := range file {
+		var holds []json.RawMessage
+		if json.Unmarshal(v, &holds) != nil || len(holds) == 0 {
+			return false
+		}
+		first := bytes.TrimSpace(holds[0])
+		return len(first) > 0 && (first[0] == '[' || first[0] == '{')
+	}
+	return false
+}
+
+// HoldMasks turns the strategy into a bitmask of cards to keep for each
+// hand the tables' shoe can deal
+func (s Strategy) HoldMasks(t *Tables) (*Holds, error) {
+	var masks Holds
+	for _, idx := range t.hands {
+		hand := handAt(int(idx))
+		key := HandKey(hand[0], hand[1], hand[2])
+		hold, ok := s[key]
+		if !ok {
+			return nil, fmt.Errorf("strategy has no play for %s", key)
+		}
+		mask, err := holdMask(key, hold, hand)
+		if err != nil {
+			return nil, err
+		}
+		masks[idx] = mask
+	}
+	return &masks, nil
+}
+
+// Converts positions within a hand key into a mask over sorted cards
+func holdMask(key string, hold []int, sorted [3]Card) (uint8, error) {
+	order := keyOrder(sorted)
+	var mask uint8
+	for _, pos := range hold {
+		if pos < 0 || pos > 2 {
+			return 0, fmt.Errorf("%s: bad hold position %d", key, pos)
+		}
+		mask |= 1 << order[pos]
+	}
+	return mask, nil
+}
+
+// StrategyFromHolds turns holds back into positions within the key of
+// each hand the tables' shoe can deal
+func StrategyFromHolds(t *Tables, holds *Holds) Strategy {
+	s := make(Strategy, len(t.hands))
+	t.Hands(func(idx int, hand [3]Card) {
+		s[HandKey(hand[0], hand[1], hand[2])] = holdPositions(hand, holds[idx])
+	})
+	return s
+}
+
+// holdPositions converts a mask over sorted cards into positions
+// within the hand's key
+func holdPositions(sorted [3]Card, mask uint8) []int {
+	hold := []int{}
+	for pos, i := range keyOrder(sorted) {
+		if mask&(1<<i) != 0 {
+			hold = append(hold, pos)
+		}
+	}
+	return hold
+}
+
+// Save writes the strategy in the suggest.json format
+func (s Strategy) Save(filename string) error {
+	result, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, result, 0644)
+}
@@ -0,0 +1,85 @@
+package threecardanalyze
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Outcome is how some of the hands dealt turn out
+type Outcome struct {
+	Share float64 // chance of being dealt one of them
+	Odds  Odds    // once one is dealt
+}
+
+// EV is the average amount won per unit bet at even money
+func (o Outcome) EV() float64 {
+	return o.Odds.Win - o.Odds.Lose
+}
+
+// GameSummary is how the game turns out when played with a strategy,
+// overall and broken down
+type GameSummary struct {
+	Overall Outcome
+	ByClass [NumClasses]Outcome // by the class of hand dealt
+	ByHeld  [4]Outcome          // by how many cards are held
+}
+
+// Summarize works out the odds of the game played with the holds,
+// against the analyzer's dealer
+func (a *Analyzer) Summarize(holds *Holds) GameSummary {
+	a.Analyze()
+	var s GameSummary
+	a.Tables.Hands(func(idx int, hand [3]Card) {
+		w := a.Tables.weight(idx)
+		odds := a.HoldOdds(hand)[holds[idx]]
+		class := a.Tables.Classify(hand)
+		for _, o := range []*Outcome{&s.Overall, &s.ByClass[class], &s.ByHeld[bits.OnesCount8(holds[idx])]} {
+			o.Share += w
+			o.Odds.add(odds, w)
+		}
+	})
+
+	// The odds so far are weighted by the chance of the whole deal;
+	// make them odds given a hand from the group
+	given := func(o *Outcome) {
+		if o.Share > 0 {
+			o.Odds = Odds{Win: o.Odds.Win / o.Share, Tie: o.Odds.Tie / o.Share, Lose: o.Odds.Lose / o.Share}
+		}
+	}
+	given(&s.Overall)
+	for i := range s.ByClass {
+		given(&s.ByClass[i])
+	}
+	for i := range s.ByHeld {
+		given(&s.ByHeld[i])
+	}
+	return s
+}
+
+var heldNames = [4]string{"draw three", "keep one", "keep two", "keep all three"}
+
+// printSummary prints the summary as tables
+func printSummary(s GameSummary) {
+	row := func(name string, o Outcome) {
+		fmt.Printf("  %-16s %8.3f%% %8.3f%% %8.3f%% %8.3f%% %+9.5f\n", name, 100*o.Share, 100*o.Odds.Win, 100*o.Odds.Tie, 100*o.Odds.Lose, o.EV())
+	}
+	header := func(name string) {
+		fmt.Printf("\n  %-16s %9s %9s %9s %9s %9s\n", name, "Dealt", "Win", "Tie", "Lose", "EV")
+	}
+
+	fmt.Printf("Win %.3f%%, tie %.3f%%, lose %.3f%%, EV %+.5f per unit bet\n",
+		100*s.Overall.Odds.Win, 100*s.Overall.Odds.Tie, 100*s.Overall.Odds.Lose, s.Overall.EV())
+	header("Class")
+	for _, class := range classOrder[3] {
+		if s.ByClass[class].Share > 0 {
+			row(class.String(), s.ByClass[class])
+		}
+	}
+	header("Hold")
+	for held := 3; held >= 0; held-- {
+		if s.ByHeld[held].Share > 0 {
+			row(heldNames[held], s.ByHeld[held])
+		}
+	}
+	row("all hands", s.Overall)
+}
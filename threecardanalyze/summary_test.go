@@ -0,0 +1,37 @@
+package threecardanalyze
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	a := houseAnalyzer(t)
+	for name, holds := range map[string]*Holds{
+		"best":  a.BestHolds(),
+		"house": DefaultHouseRules.Holds(a.Tables),
+	} {
+		s := a.Summarize(holds)
+
+		// Straight from the odds of every hand's hold
+		ev := 0.0
+		a.Tables.Hands(func(idx int, hand [3]Card) {
+			o := a.HoldOdds(hand)[holds[idx]]
+			ev += a.Tables.weight(idx) * (o.Win - o.Lose)
+		})
+		if math.Abs(s.Overall.EV()-ev) > 1e-12 || math.Abs(s.Overall.Share-1) > 1e-12 {
+			t.Errorf("%s: EV %.12f of share %g, want %.12f of 1", name, s.Overall.EV(), s.Overall.Share, ev)
+		}
+
+		for by, rows := range map[string][]Outcome{"class": s.ByClass[:], "held": s.ByHeld[:]} {
+			share, ev := 0.0, 0.0
+			for _, o := range rows {
+				share += o.Share
+				ev += o.Share * o.EV()
+			}
+			if math.Abs(share-1) > 1e-12 || math.Abs(ev-s.Overall.EV()) > 1e-12 {
+				t.Errorf("%s by %s: shares sum to %g and EVs to %.12f, want 1 and %.12f", name, by, share, ev, s.Overall.EV())
+			}
+		}
+	}
+}
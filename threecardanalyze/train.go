@@ -0,0 +1,332 @@
+package threecardanalyze
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gsdriver/alexautils/internal/cli"
+)
+
+// ClassStats is how a player has done with one class of hand
+type ClassStats struct {
+	Hands   int
+	Correct int
+	Loss    float64 // total chance of winning given up
+	EVLoss  float64 // total expected value given up, win less lose
+}
+
+// Answer is a hold scored against the best one
+type Answer struct {
+	Hand     [3]Card
+	Class    HandClass
+	Hold     []int // positions in the hand as dealt
+	Best     []int
+	Odds     Odds
+	BestOdds Odds
+	Correct  bool    // as good as the best hold
+	Loss     float64 // chance of winning given up
+	EVLoss   float64 // expected value given up, win less lose
+}
+
+// Trainer deals practice hands and scores the holds chosen for them,
+// dealing more of the classes the player gets wrong
+type Trainer struct {
+	Analyzer *Analyzer
+	Stats    [NumClasses]ClassStats
+
+	// Focus is the share of hands dealt from classes the player has got
+	// wrong, rather than at random
+	Focus float64
+
+	rng  *rand.Rand
+	deck []Card
+
+	// Every hand the shoe deals by class, with the running total of
+	// the ways to deal them for picking one at random
+	byClass [NumClasses][]int32
+	ways    [NumClasses][]int64
+}
+
+// NewTrainer returns a trainer dealing from the analyzer's shoe
+func NewTrainer(a *Analyzer, seed uint64, focus float64) *Trainer {
+	a.Analyze()
+	t := &Trainer{Analyzer: a, Focus: focus, rng: rand.New(rand.NewPCG(seed, 0))}
+	for c := Card(0); c < NumCards; c++ {
+		for i := 0; i < a.Tables.Shoe.Count(c); i++ {
+			t.deck = append(t.deck, c)
+		}
+	}
+	a.Tables.Hands(func(idx int, hand [3]Card) {
+		class := a.Tables.Classify(hand)
+		total := a.Tables.Ways(idx)
+		if n := len(t.ways[class]); n > 0 {
+			total += t.ways[class][n-1]
+		}
+		t.byClass[class] = append(t.byClass[class], int32(idx))
+		t.ways[class] = append(t.ways[class], total)
+	})
+	return t
+}
+
+// Deal returns the next hand to practice
+func (t *Trainer) Deal() [3]Card {
+	deal := func() [3]Card {
+		shuffle(t.rng, t.deck, 3)
+		return [3]Card{t.deck[0], t.deck[1], t.deck[2]}
+	}
+	if t.rng.Float64() >= t.Focus {
+		return deal()
+	}
+
+	// Pick a class in proportion to how often it's played wrong, so
+	// rare classes aren't crowded out by common ones, then one of its
+	// hands as often as the shoe deals it, in a random order
+	var class HandClass
+	total := 0.0
+	for c, s := range t.Stats {
+		if s.Correct < s.Hands {
+			miss := float64(s.Hands-s.Correct) / float64(s.Hands)
+			total += miss
+			if t.rng.Float64()*total < miss {
+				class = HandClass(c)
+			}
+		}
+	}
+	if total == 0 {
+		return deal()
+	}
+	ways := t.ways[class]
+	pick := t.rng.Int64N(ways[len(ways)-1])
+	i := sort.Search(len(ways), func(i int) bool { return ways[i] > pick })
+	hand := handAt(int(t.byClass[class][i]))
+	t.rng.Shuffle(len(hand), func(i, j int) { hand[i], hand[j] = hand[j], hand[i] })
+	return hand
+}
+
+// Score scores a hold, as positions in the hand, and adds it to the
+// player's stats
+func (t *Trainer) Score(hand [3]Card, hold []int) Answer {
+	a := t.Analyzer
+	odds := a.HandOdds(hand)
+	best := a.bestplay(hand, odds)
+	ans := Answer{
+		Hand:     hand,
+		Class:    a.Tables.Classify(hand),
+		Hold:     hold,
+		Best:     best,
+		Odds:     odds[positionsMask(hold)],
+		BestOdds: odds[positionsMask(best)],
+	}
+	ans.Loss = a.score(ans.BestOdds) - a.score(ans.Odds)
+	ans.Correct = ans.Loss <= tieTolerance
+	if ans.Loss < 0 {
+		ans.Loss = 0
+	}
+	// With the analyzer maximizing the chance of winning, a hold can
+	// give some of that up and still gain EV, so this can be negative
+	ans.EVLoss = (ans.BestOdds.Win - ans.BestOdds.Lose) - (ans.Odds.Win - ans.Odds.Lose)
+
+	s := &t.Stats[ans.Class]
+	s.Hands++
+	s.Loss += ans.Loss
+	s.EVLoss += ans.EVLoss
+	if ans.Correct {
+		s.Correct++
+	}
+	return ans
+}
+
+// parseHold reads a hold typed by the player: positions from 1 ("1 3"
+// or "13"), the cards themselves ("KS AS"), "all" or "none"
+func parseHold(hand [3]Card, input string) ([]int, error) {
+	input = strings.TrimSpace(strings.ToLower(input))
+	switch input {
+	case "all":
+		return []int{0, 1, 2}, nil
+	case "none", "draw", "0", "-":
+		return []int{}, nil
+	}
+
+	var hold []int
+	used := [3]bool{}
+	add := func(pos int) error {
+		if used[pos] {
+			return fmt.Errorf("%s is held twice", hand[pos])
+		}
+		used[pos] = true
+		hold = append(hold, pos)
+		return nil
+	}
+	fields := strings.FieldsFunc(input, func(r rune) bool { return r == ',' || r == ' ' })
+	if len(fields) == 1 && len(fields[0]) <= 3 && strings.Trim(fields[0], "123") == "" {
+		// Positions run together, like 13
+		fields = strings.Split(fields[0], "")
+	}
+	for _, f := range fields {
+		if n, err := strconv.Atoi(f); err == nil {
+			if n < 1 || n > 3 {
+				return nil, fmt.Errorf("positions are 1 to 3, not %d", n)
+			}
+			if err = add(n - 1); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		c, err := ParseCard(strings.ToUpper(f))
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for pos := range hand {
+			if hand[pos] == c && !used[pos] {
+				if err = add(pos); err != nil {
+					return nil, err
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%s isn't in the hand", c)
+		}
+	}
+	if len(hold) == 0 {
+		return nil, fmt.Errorf("nothing to hold in %q", input)
+	}
+	return hold, nil
+}
+
+// holdName says what a hold keeps
+func holdName(hand [3]Card, hold []int) string {
+	switch len(hold) {
+	case 0:
+		return "draw three"
+	case 3:
+		return "keep all three"
+	}
+	var held []Card
+	for pos := range hand {
+		for _, h := range hold {
+			if h == pos {
+				held = append(held, hand[pos])
+			}
+		}
+	}
+	return "keep " + describeCards(held)
+}
+
+// Train is the train command: it deals hands, asks which cards to hold
+// and scores each answer against the best hold
+func Train(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard train", "Practices holds: deals hands, asks for a hold and scores it.")
+	hands := fs.Int("hands", 0, "hands to deal (0 until you quit)")
+	seed := fs.Uint64("seed", 0, "RNG seed (0 picks one from the clock)")
+	focus := fs.Float64("focus", 0.5, "share of hands dealt from the classes you get wrong")
+	ties := fs.String("ties", string(TieFewestDrawn), "which of several equally good holds to show: fewest-drawn or highest-card")
+	game := addGameFlags(fs, "removed")
+	dealer := addDealerFlags(fs)
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	if *hands < 0 || *focus < 0 || *focus > 1 {
+		return cli.Usagef("-hands can't be negative and -focus is from 0 to 1")
+	}
+	policy, err := parseTiePolicy(*ties)
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	tables, err := game.tables()
+	if err != nil {
+		return cli.Usagef("%v", err)
+	}
+	dealerHolds, err := dealer.holds(tables, func() (*Holds, error) {
+		return NewAnalyzer(tables, StaticField(tables)).BestHolds(), nil
+	})
+	if err != nil {
+		return err
+	}
+	analyzer := NewAnalyzer(tables, fieldFor(tables, dealerHolds))
+	analyzer.Ties = policy
+	if *seed == 0 {
+		*seed = uint64(time.Now().UnixNano())
+	}
+	slog.Info("training", "shoe", tables.Shoe, "rules", tables.Rules, "dealer", dealer.policy, "seed", *seed)
+
+	t := NewTrainer(analyzer, *seed, *focus)
+	t.session(os.Stdin, os.Stdout, *hands)
+	return nil
+}
+
+// session runs the question and answer loop until hands are dealt, the
+// player quits or the input ends, then prints how they did
+func (t *Trainer) session(in io.Reader, out io.Writer, hands int) {
+	fmt.Fprintln(out, "Hold cards by position (1 3), by name (KS AS), all or none. q quits.")
+	scanner := bufio.NewScanner(in)
+	for n := 1; hands == 0 || n <= hands; n++ {
+		hand := t.Deal()
+		fmt.Fprintf(out, "\nHand %d: %s\n", n, describeCards(hand[:]))
+
+		var hold []int
+		for hold == nil {
+			fmt.Fprint(out, "Hold? ")
+			if !scanner.Scan() {
+				fmt.Fprintln(out)
+				t.summary(out)
+				return
+			}
+			line := strings.TrimSpace(scanner.Text())
+			if line == "q" || line == "quit" {
+				t.summary(out)
+				return
+			}
+			var err error
+			if hold, err = parseHold(hand, line); err != nil {
+				fmt.Fprintf(out, "%v\n", err)
+			}
+		}
+
+		ans := t.Score(hand, hold)
+		if ans.Correct {
+			fmt.Fprintf(out, "Right: %s wins %.1f%% (%s)\n", holdName(hand, hold), 100*ans.Odds.Win, ans.Class)
+		} else {
+			fmt.Fprintf(out, "Not quite: %s wins %.1f%%, %s wins %.1f%%, so that cost %.2f%% chance of winning and %.4f EV (%s)\n",
+				holdName(hand, ans.Best), 100*ans.BestOdds.Win, holdName(hand, hold), 100*ans.Odds.Win, 100*ans.Loss, ans.EVLoss, ans.Class)
+		}
+		s := t.Stats[ans.Class]
+		fmt.Fprintf(out, "%d of %d %s hands right so far\n", s.Correct, s.Hands, ans.Class)
+	}
+	t.summary(out)
+}
+
+// summary prints the player's accuracy overall and by class
+func (t *Trainer) summary(out io.Writer) {
+	var total ClassStats
+	for _, s := range t.Stats {
+		total.Hands += s.Hands
+		total.Correct += s.Correct
+		total.Loss += s.Loss
+		total.EVLoss += s.EVLoss
+	}
+	if total.Hands == 0 {
+		return
+	}
+	fmt.Fprintf(out, "\n%d of %d hands right (%.1f%%), giving up %.3f%% chance of winning and %.5f EV per hand\n",
+		total.Correct, total.Hands, 100*float64(total.Correct)/float64(total.Hands), 100*total.Loss/float64(total.Hands), total.EVLoss/float64(total.Hands))
+	fmt.Fprintf(out, "\n  %-16s %6s %6s %9s %11s %9s\n", "Class", "Hands", "Right", "Accuracy", "Cost/hand", "EV/hand")
+	for _, class := range classOrder[3] {
+		s := t.Stats[class]
+		if s.Hands == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "  %-16s %6d %6d %8.1f%% %10.3f%% %9.5f\n", class, s.Hands, s.Correct,
+			100*float64(s.Correct)/float64(s.Hands), 100*s.Loss/float64(s.Hands), s.EVLoss/float64(s.Hands))
+	}
+}
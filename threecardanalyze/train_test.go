@@ -0,0 +1,176 @@
+package threecardanalyze
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseHold(t *testing.T) {
+	hand := mustHand(t, "KS-AS-KD")
+	for _, test := range []struct {
+		input string
+		want  []int
+		err   string
+	}{
+		{"0", []int{}, ""},
+		{"none", []int{}, ""},
+		{"all", []int{0, 1, 2}, ""},
+		{"13", []int{0, 2}, ""},
+		{"1, 3", []int{0, 2}, ""},
+		{"as ks", []int{1, 0}, ""},
+		{"KD KS", []int{2, 0}, ""},
+		{"1 1", nil, "KS is held twice"},
+		{"4", nil, "positions are 1 to 3, not 4"},
+		{"QS", nil, "QS isn't in the hand"},
+		{"KS KS KS", nil, "KS isn't in the hand"},
+		{"", nil, `nothing to hold in ""`},
+	} {
+		got, err := parseHold(hand, test.input)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("%q: %v, %v; want error %q", test.input, got, err, test.err)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: %v, %v; want %v", test.input, got, err, test.want)
+		}
+	}
+}
+
+// scriptedInput answers a session's questions as it asks them, reading
+// the hand from what the session has printed
+type scriptedInput struct {
+	out    *bytes.Buffer
+	answer func(hand [3]Card, tries int) string
+	line   []byte
+}
+
+func (s *scriptedInput) Read(p []byte) (int, error) {
+	if len(s.line) == 0 {
+		printed := s.out.String()
+		at := strings.LastIndex(printed, "\nHand ")
+		header, _, _ := strings.Cut(printed[at+1:], "\n")
+		_, cards, _ := strings.Cut(header, ": ")
+		dealt, err := ParseCards(cards)
+		if err != nil || len(dealt) != 3 {
+			return 0, fmt.Errorf("no hand in %q: %v", header, err)
+		}
+		tries := strings.Count(printed[at:], "Hold? ")
+		s.line = []byte(s.answer([3]Card{dealt[0], dealt[1], dealt[2]}, tries) + "\n")
+	}
+	n := copy(p, s.line)
+	s.line = s.line[n:]
+	return n, nil
+}
+
+func TestTrainSession(t *testing.T) {
+	a := houseAnalyzer(t)
+	tr := NewTrainer(a, 7, 0)
+
+	// Every other hand is played as badly as it can be, after a typo
+	var want [NumClasses]ClassStats
+	n := 0
+	var out bytes.Buffer
+	in := &scriptedInput{out: &out, answer: func(hand [3]Card, tries int) string {
+		if n%2 == 1 && tries == 1 {
+			return "4"
+		}
+		odds := a.HandOdds(hand)
+		best := positionsMask(a.bestplay(hand, odds))
+		mask := best
+		if n%2 == 1 {
+			for m := uint8(0); m < 8; m++ {
+				if a.score(odds[m]) < a.score(odds[mask]) {
+					mask = m
+				}
+			}
+		}
+		n++
+
+		s := &want[a.Tables.Classify(hand)]
+		s.Hands++
+		if a.score(odds[best])-a.score(odds[mask]) <= tieTolerance {
+			s.Correct++
+		}
+		s.EVLoss += (odds[best].Win - odds[best].Lose) - (odds[mask].Win - odds[mask].Lose)
+
+		var hold []string
+		for i := range hand {
+			if mask&(1<<i) != 0 {
+				hold = append(hold, fmt.Sprint(i+1))
+			}
+		}
+		if len(hold) == 0 {
+			return "none"
+		}
+		return strings.Join(hold, " ")
+	}}
+	tr.session(in, &out, 40)
+
+	correct := 0
+	for c := range want {
+		got := tr.Stats[c]
+		if got.Hands != want[c].Hands || got.Correct != want[c].Correct || math.Abs(got.EVLoss-want[c].EVLoss) > 1e-12 {
+			t.Errorf("%s: %+v, want %+v", HandClass(c), got, want[c])
+		}
+		correct += want[c].Correct
+	}
+	if n != 40 || correct < 20 || correct == 40 {
+		t.Fatalf("%d hands answered, %d right", n, correct)
+	}
+	for _, s := range []string{
+		fmt.Sprintf("\n%d of 40 hands right (%.1f%%)", correct, 100*float64(correct)/40),
+		"positions are 1 to 3, not 4\nHold? ",
+		"Not quite: ",
+	} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("output doesn't have %q", s)
+		}
+	}
+
+	// Quitting ends the session early
+	out.Reset()
+	NewTrainer(a, 7, 0).session(strings.NewReader("all\nq\n"), &out, 0)
+	if !strings.Contains(out.String(), "of 1 hands right") {
+		t.Errorf("after quitting: %s", out.String())
+	}
+}
+
+func TestTrainFocus(t *testing.T) {
+	a := houseAnalyzer(t)
+	tr := NewTrainer(a, 3, 1)
+	tr.Stats[Pair] = ClassStats{Hands: 4, Correct: 4}
+	tr.Stats[StraightFlush] = ClassStats{Hands: 2, Correct: 1}
+	tr.Stats[Flush] = ClassStats{Hands: 2, Correct: 1}
+
+	// Only the classes played wrong are dealt, and in about the share
+	// they're missed
+	var dealt [NumClasses]int
+	seen := make(map[[3]Card]bool)
+	for i := 0; i < 1000; i++ {
+		hand := tr.Deal()
+		x, y, z := Sort3(hand[0], hand[1], hand[2])
+		if a.Tables.Ways(HandIndex(x, y, z)) == 0 {
+			t.Fatalf("%s can't be dealt", describeCards(hand[:]))
+		}
+		dealt[a.Tables.Classify(hand)]++
+		seen[hand] = true
+	}
+	for c, n := range dealt {
+		if c != int(StraightFlush) && c != int(Flush) && n > 0 {
+			t.Errorf("%d %s hands dealt", n, HandClass(c))
+		}
+	}
+	if dealt[StraightFlush] < 400 || dealt[Flush] < 400 {
+		t.Errorf("%d straight flushes and %d flushes dealt, want about 500 each", dealt[StraightFlush], dealt[Flush])
+	}
+	// Hands come in any order, not just sorted
+	if len(seen) < 500 {
+		t.Errorf("only %d different hands dealt", len(seen))
+	}
+}
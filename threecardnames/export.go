@@ -0,0 +1,217 @@
+package threecardnames
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/gsdriver/alexautils/internal/cli"
+	"github.com/gsdriver/alexautils/internal/logging"
+)
+
+// Manifest describes a snapshot file and is written next to it
+// as <file>.manifest.json
+type Manifest struct {
+	Table      string    `json:"table"`
+	Region     string    `json:"region"`
+	File       string    `json:"file"`
+	Format     string    `json:"format"`
+	Compressed bool      `json:"compressed"`
+	Items      int       `json:"items"`
+	SHA256     string    `json:"sha256"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+const snapshotFormat = "jsonl"
+
+// Export snapshots the table to a JSON Lines file plus a manifest
+func Export(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard names export", "Snapshots the hands table to a JSON Lines file.")
+	opts := addTableFlags(fs)
+	out := fs.String("o", "", "snapshot file to write (default <table>-<timestamp>.jsonl)")
+	compress := fs.Bool("gzip", false, "gzip the snapshot (implied by a .gz file name)")
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+	svc, err := opts.service()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	filename := *out
+	if filename == "" {
+		filename = fmt.Sprintf("%s-%s.jsonl", opts.table, now.Format("20060102T150405Z"))
+	}
+	if strings.HasSuffix(filename, ".gz") {
+		*compress = true
+	} else if *compress {
+		filename += ".gz"
+	}
+
+	manifest := Manifest{
+		Table:      opts.table,
+		Region:     opts.aws.Region,
+		File:       filename,
+		Compressed: *compress,
+		ExportedAt: now,
+	}
+	report := logging.NewReport("exporting hands")
+	err = writeSnapshot(&manifest, func(emit func(line []byte) error) error {
+		return scanTable(svc, opts.table, func(i map[string]*dynamodb.AttributeValue) error {
+			var record map[string]interface{}
+			if err := dynamodbattribute.UnmarshalMap(i, &record); err != nil {
+				report.Fail(fmt.Errorf("Couldn't unmarshal record, %v", err), "table", opts.table, "token", token(i))
+				return nil
+			}
+			line, err := json.Marshal(record)
+			if err != nil {
+				report.Fail(err, "table", opts.table, "token", token(i))
+				return nil
+			}
+			if err = emit(line); err != nil {
+				return err
+			}
+			report.Succeeded()
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.Info("exported snapshot", "table", opts.table, "items", manifest.Items, "file", filename)
+	return report.Err()
+}
+
+// writeSnapshot creates m.File, gzipped if m.Compressed, and writes
+// each line scan emits to it, then fills in the rest of the manifest
+// and writes it next to the file. Anything short of a finished
+// snapshot and manifest is removed, so a manifest never describes a
+// partial file.
+func writeSnapshot(m *Manifest, scan func(emit func(line []byte) error) error) error {
+	f, err := os.Create(m.File)
+	if err != nil {
+		return err
+	}
+	finished := false
+	defer func() {
+		if !finished {
+			f.Close()
+			os.Remove(m.File)
+			os.Remove(m.File + ".manifest.json")
+		}
+	}()
+
+	// Hash what actually lands on disk so the manifest can verify it
+	hash := sha256.New()
+	var w io.Writer = io.MultiWriter(f, hash)
+	var zw *gzip.Writer
+	if m.Compressed {
+		zw = gzip.NewWriter(w)
+		w = zw
+	}
+	bw := bufio.NewWriter(w)
+
+	items := 0
+	err = scan(func(line []byte) error {
+		if _, err := bw.Write(append(line, '\n')); err != nil {
+			return fmt.Errorf("writing %s: %v", m.File, err)
+		}
+		items++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err = bw.Flush(); err != nil {
+		return err
+	}
+	if zw != nil {
+		if err = zw.Close(); err != nil {
+			return err
+		}
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	m.Format = snapshotFormat
+	m.Items = items
+	m.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	result, _ := json.MarshalIndent(m, "", "  ")
+	if err = os.WriteFile(m.File+".manifest.json", result, 0644); err != nil {
+		return err
+	}
+	finished = true
+	return nil
+}
+
+// Calls fn with each JSON line of a snapshot. Gzipped snapshots are
+// detected from their header, and if a manifest sits next to the file
+// the item count and checksum are checked against it
+func readSnapshot(filename string, fn func([]byte) error) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	br := bufio.NewReader(io.TeeReader(f, hash))
+	var r io.Reader = br
+	if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	items := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		items++
+		if err = fn(line); err != nil {
+			return fmt.Errorf("%s line %d: %v", filename, items, err)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+
+	dat, err := os.ReadFile(filename + ".manifest.json")
+	if err != nil {
+		// No manifest, nothing to check against
+		return nil
+	}
+	var manifest Manifest
+	if err = json.Unmarshal(dat, &manifest); err != nil {
+		return fmt.Errorf("bad manifest for %s: %v", filename, err)
+	}
+	io.Copy(io.Discard, br)
+	if manifest.Items != items {
+		return fmt.Errorf("%s has %d items but its manifest says %d", filename, items, manifest.Items)
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); manifest.SHA256 != sum {
+		return fmt.Errorf("%s checksum %s doesn't match its manifest", filename, sum)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package threecardnames
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testRecords = []string{
+	`{"name":"Pat","token":"AS-KS-QS"}`,
+	`{"name":"Lee","token":"2C-3D-4H"}`,
+	`{"name":"Sam","token":"10D-10H-10S"}`,
+}
+
+// testSnapshot writes the test records to a snapshot in a temp directory
+func testSnapshot(t *testing.T, name string, compress bool) *Manifest {
+	t.Helper()
+	m := &Manifest{Table: "ThreeCardHands", File: filepath.Join(t.TempDir(), name), Compressed: compress}
+	err := writeSnapshot(m, func(emit func(line []byte) error) error {
+		for _, r := range testRecords {
+			if err := emit([]byte(r)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func readLines(filename string) ([]string, error) {
+	var lines []string
+	err := readSnapshot(filename, func(line []byte) error {
+		lines = append(lines, string(line))
+		return nil
+	})
+	return lines, err
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		m := testSnapshot(t, "hands.jsonl", compress)
+		if m.Items != len(testRecords) || m.Format != snapshotFormat || len(m.SHA256) != 64 {
+			t.Errorf("gzip %v: manifest %+v", compress, m)
+		}
+		dat, err := os.ReadFile(m.File + ".manifest.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var written Manifest
+		if err = json.Unmarshal(dat, &written); err != nil || written != *m {
+			t.Errorf("gzip %v: manifest file %+v, %v; want %+v", compress, written, err, *m)
+		}
+
+		raw, err := os.ReadFile(m.File)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gzipped := len(raw) > 2 && raw[0] == 0x1f && raw[1] == 0x8b; gzipped != compress {
+			t.Errorf("gzip %v: file starts %q", compress, raw[:2])
+		}
+		lines, err := readLines(m.File)
+		if err != nil || !reflect.DeepEqual(lines, testRecords) {
+			t.Errorf("gzip %v: read %q, %v", compress, lines, err)
+		}
+	}
+}
+
+func TestSnapshotManifestChecks(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		change func(m *Manifest)
+		want   string
+	}{
+		{"count", func(m *Manifest) { m.Items++ }, "has 3 items but its manifest says 4"},
+		{"checksum", func(m *Manifest) { m.SHA256 = strings.Repeat("0", 64) }, "doesn't match its manifest"},
+	} {
+		m := testSnapshot(t, "hands.jsonl.gz", true)
+		test.change(m)
+		dat, _ := json.Marshal(m)
+		if err := os.WriteFile(m.File+".manifest.json", dat, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := readLines(m.File); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: %v, want %q", test.name, err, test.want)
+		}
+	}
+
+	// Without a manifest there's nothing to check
+	m := testSnapshot(t, "hands.jsonl", false)
+	if err := os.Remove(m.File + ".manifest.json"); err != nil {
+		t.Fatal(err)
+	}
+	if lines, err := readLines(m.File); err != nil || len(lines) != len(testRecords) {
+		t.Errorf("no manifest: %d lines, %v", len(lines), err)
+	}
+}
+
+func TestSnapshotRemovedOnFailure(t *testing.T) {
+	m := &Manifest{File: filepath.Join(t.TempDir(), "hands.jsonl")}
+	err := writeSnapshot(m, func(emit func(line []byte) error) error {
+		if err := emit([]byte(testRecords[0])); err != nil {
+			return err
+		}
+		return errors.New("scan interrupted")
+	})
+	if err == nil || err.Error() != "scan interrupted" {
+		t.Errorf("got %v", err)
+	}
+	for _, name := range []string{m.File, m.File + ".manifest.json"} {
+		if _, err = os.Stat(name); !os.IsNotExist(err) {
+			t.Errorf("%s is still there: %v", filepath.Base(name), err)
+		}
+	}
+}
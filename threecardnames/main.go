@@ -1,51 +1,155 @@
-package main
+// Package threecardnames counts the hand names the three card skill
+// records in DynamoDB, either from the live table or from a snapshot.
+package threecardnames
 
 import (
-  "fmt"
-  "github.com/aws/aws-sdk-go/aws"
-  "github.com/aws/aws-sdk-go/aws/session"
-  "github.com/aws/aws-sdk-go/service/dynamodb"
-  "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/gsdriver/alexautils/config"
+	"github.com/gsdriver/alexautils/internal/cli"
+	"github.com/gsdriver/alexautils/internal/logging"
 )
 
 type HandInfo struct {
-  Name string`json:"name"`
+	Name string `json:"name"`
 }
 
 type Item struct {
-  Token string`json:"token"`
-  Hands []HandInfo`json:"hands"`
-}
-
-func main() {
-  sess, err := session.NewSession(&aws.Config{
-    Region: aws.String("us-east-1")},
-  )
-
-  // Create DynamoDB client
-  svc := dynamodb.New(sess)
-
-  input := &dynamodb.ScanInput{
-    TableName: aws.String("ThreeCardHands"),
-  }
-
-  result, err := svc.Scan(input)
-  if err != nil {
-    fmt.Println(err.Error())
-    return
-  }
-
-  m := make(map[string]int)
-  for _, i := range result.Items {
-    item := Item{}
-    err = dynamodbattribute.UnmarshalMap(i, &item)
-    if err != nil {
-      panic(fmt.Sprintf("Couldn't unmarshal record, %v", err))
-    }
-    for _, v := range item.Hands {
-      m[v.Name]++
-    }
-  }
-
-  fmt.Println(m)
+	Token string     `json:"token"`
+	Hands []HandInfo `json:"hands"`
+}
+
+// Skill is the configured skill whose hands table is read by default
+const Skill = "threecard"
+
+// Flags shared by count and export
+type tableOptions struct {
+	aws   *cli.AWSOptions
+	skill string
+	table string
+}
+
+func addTableFlags(fs *flag.FlagSet) *tableOptions {
+	opts := &tableOptions{aws: cli.AddAWSFlags(fs)}
+	fs.StringVar(&opts.skill, "skill", Skill, "skill whose hands table to read")
+	fs.StringVar(&opts.table, "table", "", "DynamoDB table holding the hands (default from the skill's configuration)")
+	return opts
+}
+
+func (o *tableOptions) service() (*dynamodb.DynamoDB, error) {
+	cfg, err := o.aws.Config()
+	if err != nil {
+		return nil, err
+	}
+	if o.table == "" {
+		skill, err := cfg.Skill(o.skill)
+		if err != nil {
+			return nil, err
+		}
+		if o.table, err = skill.Table(config.TableHands); err != nil {
+			return nil, err
+		}
+	}
+
+	sess, err := o.aws.Session()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create DynamoDB client
+	return dynamodb.New(sess), nil
+}
+
+// Count prints how often each hand name appears
+func Count(args []string) error {
+	fs := cli.NewFlagSet("alexautils threecard names count", "Counts hand names in the live table or a snapshot.")
+	opts := addTableFlags(fs)
+	from := fs.String("from", "", "count from a snapshot file written by export instead of the live table")
+	if err := cli.Parse(fs, args, false); err != nil {
+		return err
+	}
+
+	m := make(map[string]int)
+	report := logging.NewReport("counting hands")
+	err := eachItem(opts, *from, report, func(item Item) {
+		for _, v := range item.Hands {
+			m[v.Name]++
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(m)
+	return report.Err()
+}
+
+// Calls fn for every record, either from the live table (if from
+// is empty) or from a snapshot file. Records that can't be read are
+// logged to the report and skipped.
+func eachItem(opts *tableOptions, from string, report *logging.Report, fn func(Item)) error {
+	if from != "" {
+		line := 0
+		return readSnapshot(from, func(dat []byte) error {
+			line++
+			item := Item{}
+			if err := json.Unmarshal(dat, &item); err != nil {
+				report.Fail(fmt.Errorf("Couldn't unmarshal record, %v", err), "file", from, "line", line)
+				return nil
+			}
+			report.Succeeded()
+			fn(item)
+			return nil
+		})
+	}
+
+	svc, err := opts.service()
+	if err != nil {
+		return err
+	}
+	return scanTable(svc, opts.table, func(i map[string]*dynamodb.AttributeValue) error {
+		item := Item{}
+		if err := dynamodbattribute.UnmarshalMap(i, &item); err != nil {
+			report.Fail(fmt.Errorf("Couldn't unmarshal record, %v", err), "table", opts.table, "token", token(i))
+			return nil
+		}
+		report.Succeeded()
+		fn(item)
+		return nil
+	})
+}
+
+// Best effort at identifying a raw record for logging
+func token(i map[string]*dynamodb.AttributeValue) string {
+	if v := i["token"]; (v != nil) && (v.S != nil) {
+		return *v.S
+	}
+	return "unknown"
+}
+
+// Scans every page of the table, not just the first 1MB
+func scanTable(svc *dynamodb.DynamoDB, table string, fn func(map[string]*dynamodb.AttributeValue) error) error {
+	var ferr error
+	input := &dynamodb.ScanInput{
+		TableName: aws.String(table),
+	}
+
+	err := svc.ScanPages(input,
+		func(page *dynamodb.ScanOutput, lastPage bool) bool {
+			for _, i := range page.Items {
+				if ferr = fn(i); ferr != nil {
+					return false
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+	return ferr
 }
@@ -0,0 +1,51 @@
+package threecardspeech
+
+import "github.com/gsdriver/alexautils/threecardanalyze"
+
+// holdKind is why a hold is played, which decides how it's explained
+type holdKind int
+
+const (
+	holdMade              holdKind = iota // all three cards of a made hand
+	holdAll                               // all three cards of a high card hand
+	holdPair                              // two cards of a rank
+	holdStraightFlushDraw                 // two suited cards a straight can be made from
+	holdSuited                            // two suited cards
+	holdStraightDraw                      // two cards a straight can be made from
+	holdTwo                               // any other two cards
+	holdOne
+	holdDraw // nothing
+	numHolds
+)
+
+// kindOf works out the kind of hold. held is positions in the hand,
+// highest card first.
+func kindOf(hand [3]threecardanalyze.Card, held []int, class threecardanalyze.HandClass) holdKind {
+	switch len(held) {
+	case 0:
+		return holdDraw
+	case 1:
+		return holdOne
+	case 3:
+		if class == threecardanalyze.HighCard {
+			return holdAll
+		}
+		return holdMade
+	}
+	hi, lo := hand[held[0]], hand[held[1]]
+	suited := hi.Suit() == lo.Suit()
+	// An ace plays low too, so A-2 and A-3 can still make A-2-3
+	gap := hi.Rank() - lo.Rank()
+	straight := (gap == 1 || gap == 2) || (hi.Rank() == 12 && lo.Rank() <= 1)
+	switch {
+	case gap == 0:
+		return holdPair
+	case suited && straight:
+		return holdStraightFlushDraw
+	case suited:
+		return holdSuited
+	case straight:
+		return holdStraightDraw
+	}
+	return holdTwo
+}
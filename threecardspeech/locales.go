@@ -0,0 +1,93 @@
+package threecardspeech
+
+import "github.com/gsdriver/alexautils/threecardanalyze"
+
+var locales = []*Locale{english("en-US", "percent"), english("en-GB", "per cent"), german()}
+
+// english is the same in the US and UK, apart from how a percentage is
+// said
+func english(tag, percent string) *Locale {
+	l := &Locale{
+		Tag:     tag,
+		ranks:   [13]string{"two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "jack", "queen", "king", "ace"},
+		plurals: [13]string{"twos", "threes", "fours", "fives", "sixes", "sevens", "eights", "nines", "tens", "jacks", "queens", "kings", "aces"},
+		suits:   [4]string{"clubs", "diamonds", "hearts", "spades"},
+		and:     " and the ",
+		youHave: "you have {have}",
+		chance:  "that wins {pct} " + percent + " of the time",
+	}
+	l.card = func(rank int) string { return l.ranks[rank] }
+	l.suited = func(rank, suit int) string { return l.ranks[rank] + " of " + l.suits[suit] }
+
+	l.classes[threecardanalyze.StraightFlush] = "{rank} high straight flush"
+	l.classes[threecardanalyze.Trips] = "three {ranks}"
+	l.classes[threecardanalyze.Straight] = "{rank} high straight"
+	l.classes[threecardanalyze.Flush] = "{rank} high flush"
+	l.classes[threecardanalyze.Pair] = "pair of {ranks}"
+	l.classes[threecardanalyze.HighCard] = "{rank} high"
+
+	l.have[threecardanalyze.StraightFlush] = "{a rank} high straight flush"
+	l.have[threecardanalyze.Trips] = "three {ranks}"
+	l.have[threecardanalyze.Straight] = "{a rank} high straight"
+	l.have[threecardanalyze.Flush] = "{a rank} high flush"
+	l.have[threecardanalyze.Pair] = "a pair of {ranks}"
+	l.have[threecardanalyze.HighCard] = "{rank} high"
+
+	l.holds = [numHolds]string{
+		holdMade:              "keep your {name}",
+		holdAll:               "keep all three cards",
+		holdPair:              "hold the pair of {ranks}",
+		holdStraightFlushDraw: "hold the {first} and {second} of {suits} for a straight flush draw",
+		holdSuited:            "hold the two {suits} for a flush draw",
+		holdStraightDraw:      "hold the {cards} for a straight draw",
+		holdTwo:               "hold the {cards}",
+		holdOne:               "hold the {card}",
+		holdDraw:              "draw three new cards",
+	}
+	return l
+}
+
+func german() *Locale {
+	l := &Locale{
+		Tag:     "de-DE",
+		ranks:   [13]string{"Zwei", "Drei", "Vier", "Fünf", "Sechs", "Sieben", "Acht", "Neun", "Zehn", "Bube", "Dame", "König", "Ass"},
+		plurals: [13]string{"Zweien", "Dreien", "Vieren", "Fünfen", "Sechsen", "Siebenen", "Achten", "Neunen", "Zehnen", "Buben", "Damen", "Könige", "Asse"},
+		suits:   [4]string{"Kreuz", "Karo", "Herz", "Pik"},
+		and:     " und ",
+		youHave: "Sie haben {have}",
+		chance:  "damit gewinnen Sie in {pct} Prozent der Fälle",
+	}
+	// Held cards take the accusative: die Zehn, den Buben, das Ass
+	articles := [13]string{"die", "die", "die", "die", "die", "die", "die", "die", "die", "den", "die", "den", "das"}
+	nouns := l.ranks
+	nouns[9] = "Buben"
+	l.card = func(rank int) string { return articles[rank] + " " + nouns[rank] }
+	l.suited = func(rank, suit int) string { return articles[rank] + " " + l.suits[suit] + "-" + nouns[rank] }
+
+	l.classes[threecardanalyze.StraightFlush] = "Straight Flush mit {rank} hoch"
+	l.classes[threecardanalyze.Trips] = "Drilling {ranks}"
+	l.classes[threecardanalyze.Straight] = "Straße mit {rank} hoch"
+	l.classes[threecardanalyze.Flush] = "Flush mit {rank} hoch"
+	l.classes[threecardanalyze.Pair] = "Paar {ranks}"
+	l.classes[threecardanalyze.HighCard] = "{rank} hoch"
+
+	l.have[threecardanalyze.StraightFlush] = "einen Straight Flush mit {rank} hoch"
+	l.have[threecardanalyze.Trips] = "einen Drilling {ranks}"
+	l.have[threecardanalyze.Straight] = "eine Straße mit {rank} hoch"
+	l.have[threecardanalyze.Flush] = "einen Flush mit {rank} hoch"
+	l.have[threecardanalyze.Pair] = "ein Paar {ranks}"
+	l.have[threecardanalyze.HighCard] = "{rank} hoch"
+
+	l.holds = [numHolds]string{
+		holdMade:              "behalten Sie Ihre Hand, {name}",
+		holdAll:               "behalten Sie alle drei Karten",
+		holdPair:              "halten Sie das Paar {ranks}",
+		holdStraightFlushDraw: "halten Sie {first} und {second} in {suits} für einen möglichen Straight Flush",
+		holdSuited:            "halten Sie die beiden {suits}-Karten für einen möglichen Flush",
+		holdStraightDraw:      "halten Sie {cards} für eine mögliche Straße",
+		holdTwo:               "halten Sie {cards}",
+		holdOne:               "halten Sie {card}",
+		holdDraw:              "tauschen Sie alle drei Karten",
+	}
+	return l
+}
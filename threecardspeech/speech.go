@@ -0,0 +1,217 @@
+// Package threecardspeech turns three card hands and holds into text
+// the skill can speak, so the skill and the tools that read its tables
+// name hands the same way. Hands are named as the skill ranks them, with
+// an ace high or low in a straight.
+//
+//	l, _ := threecardspeech.Lookup("en-US")
+//	hand, _ := threecardanalyze.ParseHand("KH-KD-7C")
+//	l.HandName(hand)                    // pair of kings
+//	s, _ := l.Advice(hand, []int{0, 1}) // You have a pair of kings. Hold the pair of kings.
+//	s = append(s, l.Chance(0.72))       // That wins 72 percent of the time.
+//	s.SSML()                            // <speak><s>You have a pair of kings.</s>...</speak>
+package threecardspeech
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gsdriver/alexautils/threecardanalyze"
+)
+
+// Locales lists the locales with speech, in the form Lookup takes
+var Locales = []string{"en-US", "en-GB", "de-DE"}
+
+// Locale holds the words for one language
+type Locale struct {
+	Tag string
+
+	ranks   [13]string // deuce to ace
+	plurals [13]string
+	suits   [4]string // clubs, diamonds, hearts, spades
+
+	// How a held card is named, alone and with its suit, and the word
+	// joining two of them
+	card   func(rank int) string
+	suited func(rank, suit int) string
+	and    string
+
+	// Templates, filled in by fill
+	classes [threecardanalyze.NumClasses]string // a hand's name
+	have    [threecardanalyze.NumClasses]string // after "you have"
+	holds   [numHolds]string
+	youHave string
+	chance  string
+}
+
+// ErrJoker is returned for hands with a joker, which can only be named
+// once the rules say what it plays as
+var ErrJoker = errors.New("hands with a joker can't be named")
+
+// Lookup returns the locale for a tag like "en-US" or "de_DE"
+func Lookup(tag string) (*Locale, error) {
+	tag = strings.ReplaceAll(tag, "_", "-")
+	for _, l := range locales {
+		if strings.EqualFold(l.Tag, tag) {
+			return l, nil
+		}
+	}
+	return nil, fmt.Errorf("no speech for locale %q (have %s)", tag, strings.Join(Locales, ", "))
+}
+
+// Speech is text to say, one sentence at a time
+type Speech []string
+
+// Text is the speech as plain text
+func (s Speech) Text() string {
+	return strings.Join(s, " ")
+}
+
+// SSML is the speech as SSML, a sentence per <s> element
+func (s Speech) SSML() string {
+	var b strings.Builder
+	b.WriteString("<speak>")
+	for _, sentence := range s {
+		b.WriteString("<s>")
+		b.WriteString(escape(sentence))
+		b.WriteString("</s>")
+	}
+	b.WriteString("</speak>")
+	return b.String()
+}
+
+var escape = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "'", "&apos;").Replace
+
+// HandName names a hand, as in "pair of kings" or "queen high straight"
+func (l *Locale) HandName(hand [3]threecardanalyze.Card) (string, error) {
+	class, top, err := classify(hand)
+	if err != nil {
+		return "", err
+	}
+	return l.fill(l.classes[class], top, -1), nil
+}
+
+// Advice says what the hand is and what to hold. hold is positions in
+// the hand, as the strategy files give them.
+func (l *Locale) Advice(hand [3]threecardanalyze.Card, hold []int) (Speech, error) {
+	class, top, err := classify(hand)
+	if err != nil {
+		return nil, err
+	}
+	h, err := l.Hold(hand, hold)
+	if err != nil {
+		return nil, err
+	}
+	have := strings.Replace(l.youHave, "{have}", l.fill(l.have[class], top, -1), 1)
+	return Speech{l.sentence(have), l.sentence(h)}, nil
+}
+
+// Hold says what to hold and why, as in "hold the two hearts for a
+// flush draw"
+func (l *Locale) Hold(hand [3]threecardanalyze.Card, hold []int) (string, error) {
+	class, top, err := classify(hand)
+	if err != nil {
+		return "", err
+	}
+	seen := [3]bool{}
+	for _, pos := range hold {
+		if pos < 0 || pos > 2 || seen[pos] {
+			return "", fmt.Errorf("bad hold %v", hold)
+		}
+		seen[pos] = true
+	}
+	// Highest card first
+	held := make([]int, len(hold))
+	copy(held, hold)
+	sort.SliceStable(held, func(i, j int) bool { return hand[held[i]].Rank() > hand[held[j]].Rank() })
+
+	kind := kindOf(hand, held, class)
+	text := l.holds[kind]
+	switch kind {
+	case holdMade:
+		text = strings.Replace(text, "{name}", l.fill(l.classes[class], top, -1), 1)
+	case holdOne:
+		text = strings.Replace(text, "{card}", l.name(hand, held[0]), 1)
+	case holdPair, holdSuited, holdStraightFlushDraw:
+		text = l.fill(text, hand[held[0]].Rank(), hand[held[0]].Suit())
+		text = strings.Replace(text, "{second}", l.card(hand[held[1]].Rank()), 1)
+		text = strings.Replace(text, "{first}", l.card(hand[held[0]].Rank()), 1)
+	case holdStraightDraw, holdTwo:
+		text = strings.Replace(text, "{cards}", l.name(hand, held[0])+l.and+l.name(hand, held[1]), 1)
+	}
+	return text, nil
+}
+
+// Chance says how often a play wins
+func (l *Locale) Chance(win float64) string {
+	pct := fmt.Sprint(int(math.Round(100 * win)))
+	return l.sentence(strings.Replace(l.chance, "{pct}", pct, 1))
+}
+
+// name is a held card, with its suit if another card in the hand has
+// the same rank
+func (l *Locale) name(hand [3]threecardanalyze.Card, pos int) string {
+	for i, c := range hand {
+		if i != pos && c.Rank() == hand[pos].Rank() {
+			return l.suited(hand[pos].Rank(), hand[pos].Suit())
+		}
+	}
+	return l.card(hand[pos].Rank())
+}
+
+// fill puts a rank and suit into a template
+func (l *Locale) fill(template string, rank, suit int) string {
+	if rank >= 0 {
+		template = strings.NewReplacer("{rank}", l.ranks[rank], "{ranks}", l.plurals[rank],
+			"{a rank}", article(l.ranks[rank])).Replace(template)
+	}
+	if suit >= 0 {
+		template = strings.Replace(template, "{suits}", l.suits[suit], 1)
+	}
+	return template
+}
+
+// sentence capitalizes s and ends it with a full stop
+func (l *Locale) sentence(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:] + "."
+}
+
+// article puts "a" or "an" before an English word
+func article(word string) string {
+	if strings.ContainsRune("aeiou", rune(word[0])) {
+		return "an " + word
+	}
+	return "a " + word
+}
+
+// classify returns the hand's class under the skill's rules and the
+// rank it's named by: the top of a straight, the rank of a pair or
+// trips, otherwise the highest card
+func classify(hand [3]threecardanalyze.Card) (threecardanalyze.HandClass, int, error) {
+	for _, c := range hand {
+		if c == threecardanalyze.Joker {
+			return 0, 0, ErrJoker
+		}
+		if c < 0 || c >= threecardanalyze.Joker {
+			return 0, 0, fmt.Errorf("bad card %d", c)
+		}
+	}
+	a, b, c := threecardanalyze.Sort3(hand[0], hand[1], hand[2])
+	class := threecardanalyze.Classify(a, b, c)
+	top := c.Rank()
+	switch {
+	case class == threecardanalyze.Pair && a.Rank() != b.Rank():
+		top = b.Rank()
+	case class == threecardanalyze.Pair:
+		top = a.Rank()
+	case (class == threecardanalyze.Straight || class == threecardanalyze.StraightFlush) && c.Rank() == 12 && a.Rank() == 0:
+		// A-2-3 is a three high straight
+		top = b.Rank()
+	}
+	return class, top, nil
+}
@@ -0,0 +1,210 @@
+package threecardspeech
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/gsdriver/alexautils/threecardanalyze"
+)
+
+func hand(t *testing.T, s string) [3]threecardanalyze.Card {
+	t.Helper()
+	h, err := threecardanalyze.ParseHand(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func locale(t *testing.T, tag string) *Locale {
+	t.Helper()
+	l, err := Lookup(tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+// Every three card class, with the rank it's named by in the middle of
+// the hand or at an edge
+var classHands = []struct {
+	hand  string
+	class threecardanalyze.HandClass
+	names map[string]string
+	have  map[string]string
+}{
+	{"QH-KH-JH", threecardanalyze.StraightFlush,
+		map[string]string{"en-US": "king high straight flush", "de-DE": "Straight Flush mit König hoch"},
+		map[string]string{"en-US": "You have a king high straight flush.", "de-DE": "Sie haben einen Straight Flush mit König hoch."}},
+	{"AS-2S-3S", threecardanalyze.StraightFlush,
+		map[string]string{"en-US": "three high straight flush", "de-DE": "Straight Flush mit Drei hoch"},
+		map[string]string{"en-US": "You have a three high straight flush.", "de-DE": "Sie haben einen Straight Flush mit Drei hoch."}},
+	{"8C-8D-8S", threecardanalyze.Trips,
+		map[string]string{"en-US": "three eights", "de-DE": "Drilling Achten"},
+		map[string]string{"en-US": "You have three eights.", "de-DE": "Sie haben einen Drilling Achten."}},
+	{"AD-KS-QC", threecardanalyze.Straight,
+		map[string]string{"en-US": "ace high straight", "de-DE": "Straße mit Ass hoch"},
+		map[string]string{"en-US": "You have an ace high straight.", "de-DE": "Sie haben eine Straße mit Ass hoch."}},
+	{"2D-AC-3H", threecardanalyze.Straight,
+		map[string]string{"en-US": "three high straight", "de-DE": "Straße mit Drei hoch"},
+		map[string]string{"en-US": "You have a three high straight.", "de-DE": "Sie haben eine Straße mit Drei hoch."}},
+	{"AH-9H-4H", threecardanalyze.Flush,
+		map[string]string{"en-US": "ace high flush", "de-DE": "Flush mit Ass hoch"},
+		map[string]string{"en-US": "You have an ace high flush.", "de-DE": "Sie haben einen Flush mit Ass hoch."}},
+	{"KH-KD-7C", threecardanalyze.Pair,
+		map[string]string{"en-US": "pair of kings", "de-DE": "Paar Könige"},
+		map[string]string{"en-US": "You have a pair of kings.", "de-DE": "Sie haben ein Paar Könige."}},
+	{"6S-JC-6D", threecardanalyze.Pair,
+		map[string]string{"en-US": "pair of sixes", "de-DE": "Paar Sechsen"},
+		map[string]string{"en-US": "You have a pair of sixes.", "de-DE": "Sie haben ein Paar Sechsen."}},
+	{"10D-7S-2C", threecardanalyze.HighCard,
+		map[string]string{"en-US": "ten high", "de-DE": "Zehn hoch"},
+		map[string]string{"en-US": "You have ten high.", "de-DE": "Sie haben Zehn hoch."}},
+	{"8D-5S-3C", threecardanalyze.HighCard,
+		map[string]string{"en-US": "eight high", "de-DE": "Acht hoch"},
+		map[string]string{"en-US": "You have eight high.", "de-DE": "Sie haben Acht hoch."}},
+}
+
+func TestHandNames(t *testing.T) {
+	covered := map[threecardanalyze.HandClass]bool{}
+	for _, c := range classHands {
+		h := hand(t, c.hand)
+		if got := threecardanalyze.Classify(h[0], h[1], h[2]); got != c.class {
+			t.Fatalf("%s is a %v, not a %v", c.hand, got, c.class)
+		}
+		covered[c.class] = true
+		// The UK names hands as the US does
+		c.names["en-GB"], c.have["en-GB"] = c.names["en-US"], c.have["en-US"]
+		for _, tag := range Locales {
+			l := locale(t, tag)
+			name, err := l.HandName(h)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name != c.names[tag] {
+				t.Errorf("%s %s: named %q, want %q", tag, c.hand, name, c.names[tag])
+			}
+			s, err := l.Advice(h, []int{0, 1, 2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s[0] != c.have[tag] {
+				t.Errorf("%s %s: said %q, want %q", tag, c.hand, s[0], c.have[tag])
+			}
+		}
+	}
+	for class := threecardanalyze.StraightFlush; class <= threecardanalyze.HighCard; class++ {
+		if !covered[class] {
+			t.Errorf("no test hand for %v", class)
+		}
+	}
+}
+
+func TestHolds(t *testing.T) {
+	tests := []struct {
+		hand string
+		hold []int
+		kind holdKind
+		en   string
+		de   string
+	}{
+		{"QH-KH-JH", []int{0, 1, 2}, holdMade, "keep your king high straight flush", "behalten Sie Ihre Hand, Straight Flush mit König hoch"},
+		{"KS-QD-9C", []int{0, 1, 2}, holdAll, "keep all three cards", "behalten Sie alle drei Karten"},
+		{"KH-7C-KD", []int{0, 2}, holdPair, "hold the pair of kings", "halten Sie das Paar Könige"},
+		{"9S-JH-QH", []int{2, 1}, holdStraightFlushDraw, "hold the queen and jack of hearts for a straight flush draw",
+			"halten Sie die Dame und den Buben in Herz für einen möglichen Straight Flush"},
+		{"AC-3C-KD", []int{0, 1}, holdStraightFlushDraw, "hold the ace and three of clubs for a straight flush draw",
+			"halten Sie das Ass und die Drei in Kreuz für einen möglichen Straight Flush"},
+		{"2H-9H-KS", []int{0, 1}, holdSuited, "hold the two hearts for a flush draw", "halten Sie die beiden Herz-Karten für einen möglichen Flush"},
+		{"5D-QC-KS", []int{1, 2}, holdStraightDraw, "hold the king and the queen for a straight draw", "halten Sie den König und die Dame für eine mögliche Straße"},
+		{"AS-2D-9C", []int{0, 1}, holdStraightDraw, "hold the ace and the two for a straight draw", "halten Sie das Ass und die Zwei für eine mögliche Straße"},
+		{"AS-9D-5C", []int{0, 1}, holdTwo, "hold the ace and the nine", "halten Sie das Ass und die Neun"},
+		{"JS-4D-2C", []int{0}, holdOne, "hold the jack", "halten Sie den Buben"},
+		{"AS-AD-KS", []int{1}, holdOne, "hold the ace of diamonds", "halten Sie das Karo-Ass"},
+		{"AS-AD-KS", []int{0, 2}, holdStraightFlushDraw, "hold the ace and king of spades for a straight flush draw",
+			"halten Sie das Ass und den König in Pik für einen möglichen Straight Flush"},
+		{"JS-JD-QC", []int{2, 1}, holdStraightDraw, "hold the queen and the jack of diamonds for a straight draw",
+			"halten Sie die Dame und den Karo-Buben für eine mögliche Straße"},
+		{"4S-7D-10C", []int{}, holdDraw, "draw three new cards", "tauschen Sie alle drei Karten"},
+	}
+	for _, test := range tests {
+		h := hand(t, test.hand)
+		held := append([]int(nil), test.hold...)
+		sort.Slice(held, func(i, j int) bool { return h[held[i]].Rank() > h[held[j]].Rank() })
+		if kind := kindOf(h, held, threecardanalyze.Classify(h[0], h[1], h[2])); kind != test.kind {
+			t.Errorf("%s %v: kind %d, want %d", test.hand, test.hold, kind, test.kind)
+		}
+		for tag, want := range map[string]string{"en-US": test.en, "en-GB": test.en, "de-DE": test.de} {
+			got, err := locale(t, tag).Hold(h, test.hold)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("%s %s %v: %q, want %q", tag, test.hand, test.hold, got, want)
+			}
+		}
+	}
+
+	covered := map[holdKind]bool{}
+	for _, test := range tests {
+		covered[test.kind] = true
+	}
+	for kind := holdKind(0); kind < numHolds; kind++ {
+		if !covered[kind] {
+			t.Errorf("no test hold of kind %d", kind)
+		}
+	}
+}
+
+func TestAdvice(t *testing.T) {
+	h := hand(t, "2H-9H-KS")
+	for _, test := range []struct {
+		tag, text, ssml string
+	}{
+		{"en-US", "You have king high. Hold the two hearts for a flush draw. That wins 46 percent of the time.",
+			"<speak><s>You have king high.</s><s>Hold the two hearts for a flush draw.</s><s>That wins 46 percent of the time.</s></speak>"},
+		{"en-GB", "You have king high. Hold the two hearts for a flush draw. That wins 46 per cent of the time.",
+			"<speak><s>You have king high.</s><s>Hold the two hearts for a flush draw.</s><s>That wins 46 per cent of the time.</s></speak>"},
+		{"de-DE", "Sie haben König hoch. Halten Sie die beiden Herz-Karten für einen möglichen Flush. Damit gewinnen Sie in 46 Prozent der Fälle.",
+			"<speak><s>Sie haben König hoch.</s><s>Halten Sie die beiden Herz-Karten für einen möglichen Flush.</s><s>Damit gewinnen Sie in 46 Prozent der Fälle.</s></speak>"},
+	} {
+		l := locale(t, test.tag)
+		s, err := l.Advice(h, []int{0, 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		s = append(s, l.Chance(0.4567))
+		if s.Text() != test.text {
+			t.Errorf("%s: %q, want %q", test.tag, s.Text(), test.text)
+		}
+		if s.SSML() != test.ssml {
+			t.Errorf("%s: %q, want %q", test.tag, s.SSML(), test.ssml)
+		}
+	}
+}
+
+func TestSSMLEscapes(t *testing.T) {
+	want := "<speak><s>Tom &amp; Jerry&apos;s &lt;break&gt;</s></speak>"
+	if got := (Speech{"Tom & Jerry's <break>"}).SSML(); got != want {
+		t.Errorf("%q, want %q", got, want)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := Lookup("fr-FR"); err == nil {
+		t.Error("fr-FR has no speech")
+	}
+	if l, err := Lookup("de_de"); err != nil || l.Tag != "de-DE" {
+		t.Errorf("de_de looked up %v, %v", l, err)
+	}
+	l := locale(t, "en-US")
+	if _, err := l.HandName(hand(t, "JKR-AS-KS")); !errors.Is(err, ErrJoker) {
+		t.Errorf("a joker gave %v", err)
+	}
+	for _, hold := range [][]int{{3}, {-1}, {0, 0}} {
+		if _, err := l.Hold(hand(t, "AS-KS-QS"), hold); err == nil {
+			t.Errorf("hold %v is bad", hold)
+		}
+	}
+}
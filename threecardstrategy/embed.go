@@ -0,0 +1,32 @@
+package threecardstrategy
+
+import (
+	_ "embed"
+	"sync"
+)
+
+// house.tcs is the skill's own game: one deck, house rules and a dealer
+// who doesn't draw. Rebuild it with
+//
+//	alexautils threecard analyze
+//	alexautils threecard pack -o threecardstrategy/house.tcs
+//
+//go:embed house.tcs
+var house []byte
+
+var (
+	defaultOnce     sync.Once
+	defaultStrategy *Strategy
+)
+
+// Default returns the strategy built into the package
+func Default() *Strategy {
+	defaultOnce.Do(func() {
+		s, err := Load(house)
+		if err != nil {
+			panic("threecardstrategy: built in strategy: " + err.Error())
+		}
+		defaultStrategy = s
+	})
+	return defaultStrategy
+}
@@ -0,0 +1,218 @@
+// Package threecardstrategy reads the compact binary strategy written
+// by alexautils threecard pack, so the skill can look up the best hold,
+// rank and winners for a hand without parsing JSON at startup.
+//
+// The file is a header followed by three tables, all little endian:
+//
+//	magic    "TCSB"
+//	version  uint16
+//	reserved uint16
+//	ranks    uint32   number of ranks in the winners table
+//	crc      uint32   CRC-32 (IEEE) of everything after the header
+//	holds    one nibble per hand ID, low nibble first: the cards to keep
+//	         as a mask over the sorted cards, or 0xF if it can't be dealt
+//	rank     uint16 per hand ID, 0xFFFF if it can't be dealt
+//	winners  wins, ties, loses as uint32 per rank
+//
+// A hand's ID is the colex index of its cards in sorted order, where a
+// card is its rank (2 up to ace) times four plus its suit (C, D, H, S)
+// and the joker is 52.
+package threecardstrategy
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+)
+
+// Magic starts every strategy file
+const Magic = "TCSB"
+
+// Version is the format this package reads and writes
+const Version = 1
+
+// NumIDs is how many hand IDs there are
+const NumIDs = 26235
+
+const headerSize = 16
+
+const notDealt = 0xF
+
+// Winners is how many hands a rank beats, ties and loses to
+type Winners struct {
+	Wins  uint32
+	Ties  uint32
+	Loses uint32
+}
+
+// Strategy is a loaded strategy file. It reads straight from the
+// file's bytes, so loading doesn't copy or decode anything.
+type Strategy struct {
+	holds   []byte
+	ranks   []byte
+	winners []byte
+}
+
+// ErrNotDealt is returned for hands the strategy's shoe can't deal
+var ErrNotDealt = errors.New("hand can't be dealt")
+
+// Load checks the header and checksum of a strategy file
+func Load(data []byte) (*Strategy, error) {
+	if len(data) < headerSize || string(data[:4]) != Magic {
+		return nil, errors.New("not a strategy file")
+	}
+	if v := binary.LittleEndian.Uint16(data[4:]); v != Version {
+		return nil, fmt.Errorf("strategy file version %d, want %d", v, Version)
+	}
+	nranks := int(binary.LittleEndian.Uint32(data[8:]))
+	holdsSize, ranksSize := (NumIDs+1)/2, 2*NumIDs
+	if len(data) != headerSize+holdsSize+ranksSize+12*nranks {
+		return nil, errors.New("strategy file is the wrong size")
+	}
+	if crc32.ChecksumIEEE(data[headerSize:]) != binary.LittleEndian.Uint32(data[12:]) {
+		return nil, errors.New("strategy file checksum doesn't match")
+	}
+	body := data[headerSize:]
+	return &Strategy{
+		holds:   body[:holdsSize],
+		ranks:   body[holdsSize : holdsSize+ranksSize],
+		winners: body[holdsSize+ranksSize:],
+	}, nil
+}
+
+// Hold returns the positions, within the cards as given, to keep
+func (s *Strategy) Hold(cards [3]string) ([]int, error) {
+	id, order, err := handID(cards)
+	if err != nil {
+		return nil, err
+	}
+	mask := s.holds[id/2] >> (4 * (id % 2)) & 0xF
+	if mask == notDealt {
+		return nil, ErrNotDealt
+	}
+	hold := []int{}
+	for i, pos := range order {
+		if mask&(1<<i) != 0 {
+			hold = append(hold, pos)
+		}
+	}
+	sort.Ints(hold)
+	return hold, nil
+}
+
+// Rank returns the hand's rank, 0 being the best
+func (s *Strategy) Rank(cards [3]string) (int, error) {
+	id, _, err := handID(cards)
+	if err != nil {
+		return 0, err
+	}
+	r := binary.LittleEndian.Uint16(s.ranks[2*id:])
+	if r == 0xFFFF {
+		return 0, ErrNotDealt
+	}
+	return int(r), nil
+}
+
+// Ranks is how many ranks there are
+func (s *Strategy) Ranks() int {
+	return len(s.winners) / 12
+}
+
+// Winners returns how many hands the rank beats, ties and loses to
+func (s *Strategy) Winners(rank int) (Winners, error) {
+	if rank < 0 || rank >= s.Ranks() {
+		return Winners{}, fmt.Errorf("no rank %d", rank)
+	}
+	w := s.winners[12*rank:]
+	return Winners{
+		Wins:  binary.LittleEndian.Uint32(w),
+		Ties:  binary.LittleEndian.Uint32(w[4:]),
+		Loses: binary.LittleEndian.Uint32(w[8:]),
+	}, nil
+}
+
+// Artifact is what goes into a strategy file, by hand ID
+type Artifact struct {
+	Holds   [NumIDs]uint8 // mask over the sorted cards
+	Ranks   [NumIDs]int   // -1 if the hand can't be dealt
+	Winners []Winners
+}
+
+// Encode writes the artifact in the strategy file format
+func Encode(a *Artifact) ([]byte, error) {
+	holdsSize := (NumIDs + 1) / 2
+	data := make([]byte, headerSize+holdsSize+2*NumIDs+12*len(a.Winners))
+	copy(data, Magic)
+	binary.LittleEndian.PutUint16(data[4:], Version)
+	binary.LittleEndian.PutUint32(data[8:], uint32(len(a.Winners)))
+
+	body := data[headerSize:]
+	for id := 0; id < NumIDs; id++ {
+		nibble, rank := a.Holds[id], uint16(0xFFFF)
+		switch {
+		case a.Ranks[id] < 0:
+			nibble = notDealt
+		case a.Ranks[id] >= len(a.Winners) || a.Ranks[id] >= 0xFFFF:
+			return nil, fmt.Errorf("hand %d has rank %d of %d", id, a.Ranks[id], len(a.Winners))
+		case nibble > 7:
+			return nil, fmt.Errorf("hand %d has hold mask %d", id, nibble)
+		default:
+			rank = uint16(a.Ranks[id])
+		}
+		body[id/2] |= nibble << (4 * (id % 2))
+		binary.LittleEndian.PutUint16(body[holdsSize+2*id:], rank)
+	}
+	w := body[holdsSize+2*NumIDs:]
+	for i, win := range a.Winners {
+		binary.LittleEndian.PutUint32(w[12*i:], win.Wins)
+		binary.LittleEndian.PutUint32(w[12*i+4:], win.Ties)
+		binary.LittleEndian.PutUint32(w[12*i+8:], win.Loses)
+	}
+	binary.LittleEndian.PutUint32(data[12:], crc32.ChecksumIEEE(body))
+	return data, nil
+}
+
+// handID returns the hand's ID and, for each of its sorted cards, where
+// it was in cards
+func handID(cards [3]string) (int, [3]int, error) {
+	var nums [3]int
+	order := [3]int{0, 1, 2}
+	for i, c := range cards {
+		n, err := cardNumber(c)
+		if err != nil {
+			return 0, order, err
+		}
+		nums[i] = n
+	}
+	sort.SliceStable(order[:], func(i, j int) bool { return nums[order[i]] < nums[order[j]] })
+	a, b, c := nums[order[0]], nums[order[1]], nums[order[2]]
+	return ID(a, b, c), order, nil
+}
+
+// ID is the hand ID of three sorted card numbers
+func ID(a, b, c int) int {
+	y, z := b+1, c+2
+	return a + y*(y-1)/2 + z*(z-1)*(z-2)/6
+}
+
+var rankNames = []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "J", "Q", "K", "A"}
+
+// cardNumber reads a card like "10C" or "AS", or "JKR" for the joker
+func cardNumber(s string) (int, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if s == "JKR" {
+		return 52, nil
+	}
+	if len(s) >= 2 {
+		suit := strings.IndexByte("CDHS", s[len(s)-1])
+		for r, name := range rankNames {
+			if suit >= 0 && name == s[:len(s)-1] {
+				return 4*r + suit, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("bad card %q", s)
+}
@@ -0,0 +1,139 @@
+package threecardstrategy
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testArtifact deals just three hands, ranked by two ranks
+func testArtifact(t *testing.T) (*Artifact, map[string]int) {
+	t.Helper()
+	a := &Artifact{Winners: []Winners{{Wins: 10, Ties: 1, Loses: 0}, {Wins: 0, Ties: 2, Loses: 9}}}
+	for id := range a.Ranks {
+		a.Ranks[id] = -1
+	}
+	ids := map[string]int{}
+	for _, h := range []struct {
+		cards [3]string
+		hold  uint8
+		rank  int
+	}{
+		{[3]string{"QS", "KS", "AS"}, 0b111, 0},
+		{[3]string{"2C", "7D", "JKR"}, 0b100, 1},
+		{[3]string{"2C", "3D", "5H"}, 0b000, 1},
+	} {
+		id, _, err := handID(h.cards)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.Holds[id], a.Ranks[id] = h.hold, h.rank
+		ids[strings.Join(h.cards[:], "-")] = id
+	}
+	return a, ids
+}
+
+func TestRoundTrip(t *testing.T) {
+	a, _ := testArtifact(t)
+	data, err := Encode(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := headerSize + (NumIDs+1)/2 + 2*NumIDs + 12*len(a.Winners); len(data) != want {
+		t.Errorf("%d bytes, want %d", len(data), want)
+	}
+	s, err := Load(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Ranks() != 2 {
+		t.Errorf("%d ranks, want 2", s.Ranks())
+	}
+	for rank, want := range a.Winners {
+		if w, err := s.Winners(rank); err != nil || w != want {
+			t.Errorf("rank %d: winners %+v, %v; want %+v", rank, w, err, want)
+		}
+	}
+	if _, err = s.Winners(2); err == nil {
+		t.Error("rank 2 has winners")
+	}
+
+	for _, test := range []struct {
+		cards [3]string
+		hold  []int
+		rank  int
+	}{
+		{[3]string{"AS", "KS", "QS"}, []int{0, 1, 2}, 0},
+		// The joker sorts last, wherever it's given
+		{[3]string{"JKR", "7D", "2C"}, []int{0}, 1},
+		{[3]string{"2c", "7d", "jkr"}, []int{2}, 1},
+		{[3]string{"5H", "2C", "3D"}, []int{}, 1},
+	} {
+		hold, err := s.Hold(test.cards)
+		if err != nil || !reflect.DeepEqual(hold, test.hold) {
+			t.Errorf("%v: hold %v, %v; want %v", test.cards, hold, err, test.hold)
+		}
+		if rank, err := s.Rank(test.cards); err != nil || rank != test.rank {
+			t.Errorf("%v: rank %d, %v; want %d", test.cards, rank, err, test.rank)
+		}
+	}
+
+	notDealt := [3]string{"2C", "2C", "2D"}
+	if _, err = s.Hold(notDealt); !errors.Is(err, ErrNotDealt) {
+		t.Errorf("hold of a hand not dealt: %v", err)
+	}
+	if _, err = s.Rank(notDealt); !errors.Is(err, ErrNotDealt) {
+		t.Errorf("rank of a hand not dealt: %v", err)
+	}
+	if _, err = s.Hold([3]string{"AS", "KS", "1S"}); err == nil {
+		t.Error("1S is a card")
+	}
+}
+
+func TestEncodeChecks(t *testing.T) {
+	a, ids := testArtifact(t)
+	a.Holds[ids["QS-KS-AS"]] = 8
+	if _, err := Encode(a); err == nil {
+		t.Error("encoded a hold mask of 8")
+	}
+	a, ids = testArtifact(t)
+	a.Ranks[ids["QS-KS-AS"]] = 2
+	if _, err := Encode(a); err == nil {
+		t.Error("encoded a rank with no winners")
+	}
+}
+
+func TestLoadRejectsDamage(t *testing.T) {
+	a, _ := testArtifact(t)
+	data, err := Encode(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	damaged := func(change func([]byte) []byte) []byte {
+		return change(append([]byte(nil), data...))
+	}
+
+	for _, test := range []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"flipped hold", damaged(func(d []byte) []byte { d[headerSize] ^= 0x01; return d }), "checksum"},
+		{"flipped rank", damaged(func(d []byte) []byte { d[headerSize+(NumIDs+1)/2+7] ^= 0x80; return d }), "checksum"},
+		{"flipped winners", damaged(func(d []byte) []byte { d[len(d)-1] ^= 0xFF; return d }), "checksum"},
+		{"flipped checksum", damaged(func(d []byte) []byte { d[12] ^= 0x01; return d }), "checksum"},
+		{"truncated", data[:len(data)-1], "wrong size"},
+		{"truncated to the header", data[:headerSize], "wrong size"},
+		{"truncated header", data[:headerSize-1], "not a strategy file"},
+		{"extended", append(append([]byte(nil), data...), 0), "wrong size"},
+		{"empty", nil, "not a strategy file"},
+		{"bad magic", damaged(func(d []byte) []byte { d[0] = 'X'; return d }), "not a strategy file"},
+		{"new version", damaged(func(d []byte) []byte { d[4] = Version + 1; return d }), "version"},
+	} {
+		if _, err := Load(test.data); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: %v, want %q", test.name, err, test.want)
+		}
+	}
+}
@@ -1,13 +1,18 @@
-package main
+// Package upsell summarizes the upsell session records the skills
+// write to S3.
+package upsell
 
 import (
   "fmt"
-  "strings"
   "encoding/json"
   "io/ioutil"
+  "log/slog"
+  "strings"
   "github.com/aws/aws-sdk-go/aws"
-  "github.com/aws/aws-sdk-go/aws/session"
   "github.com/aws/aws-sdk-go/service/s3"
+  "github.com/gsdriver/alexautils/config"
+  "github.com/gsdriver/alexautils/internal/cli"
+  "github.com/gsdriver/alexautils/internal/logging"
 )
 
 type Upsell struct {
@@ -32,27 +37,129 @@ func (up Upsell) String() string {
   return str
 }
 
-const Bucket = "garrett-alexa-upsell"
+// Run reads every upsell record for each configured skill, writes them
+// out as CSV and prints a summary per skill. Each record goes to the
+// skill with the longest upsell prefix it starts with; a skill with no
+// prefix takes the records no other skill in its bucket does.
+func Run(args []string) error {
+  fs := cli.NewFlagSet("alexautils upsell", "Summarizes upsell sessions stored in S3.")
+  awsOpts := cli.AddAWSFlags(fs)
+  only := fs.String("skill", "", "only summarize this skill (default every skill with an upsell bucket)")
+  if err := cli.Parse(fs, args, false); err != nil {
+    return err
+  }
 
-func main() {
-  var slots []Upsell
-  var blackjack []Upsell
+  cfg, err := awsOpts.Config()
+  if err != nil {
+    return err
+  }
+  sess, err := awsOpts.Session()
+  if err != nil {
+    return err
+  }
+  svc := s3.New(sess)
+  report := logging.NewReport("reading upsell records")
 
-  sess, _ := session.NewSession(&aws.Config{
-    Region: aws.String("us-east-1")},
-  )
+  // Every skill in a bucket is routed together, so a skill without a
+  // prefix doesn't pick up another skill's records
+  var buckets []string
+  byBucket := make(map[string][]config.Skill)
+  found := false
+  for _, skill := range cfg.Skills {
+    if skill.Upsell == nil {
+      continue
+    }
+    if byBucket[skill.Upsell.Bucket] == nil {
+      buckets = append(buckets, skill.Upsell.Bucket)
+    }
+    byBucket[skill.Upsell.Bucket] = append(byBucket[skill.Upsell.Bucket], skill)
+    if (*only == "") || (skill.Name == *only) {
+      found = true
+    }
+  }
 
-  // List S3 buckets
-  svc := s3.New(sess)
-  params := &s3.ListObjectsInput{Bucket: aws.String(Bucket)}
+  for _, bucket := range buckets {
+    skills := byBucket[bucket]
+    wanted := false
+    for _, skill := range skills {
+      wanted = wanted || (*only == "") || (skill.Name == *only)
+    }
+    if !wanted {
+      continue
+    }
+
+    keys, err := listKeys(svc, bucket)
+    if err != nil {
+      return fmt.Errorf("listing %s: %v", bucket, err)
+    }
+    routed, skipped := routeKeys(keys, skills)
+    if len(skipped) > 0 {
+      fmt.Println(len(skipped), "records in", bucket, "match no skill's upsell prefix and were skipped")
+      for _, key := range skipped {
+        slog.Debug("skipping record outside every skill's prefix", "bucket", bucket, "key", key)
+      }
+    }
+
+    for _, skill := range skills {
+      if (*only != "") && (skill.Name != *only) {
+        continue
+      }
+      ups := readSkill(svc, report, skill.Name, bucket, routed[skill.Name])
+      SaveToFile("upsell-" + skill.Name + ".csv", ups)
+      fmt.Println(len(ups), skill.Name, "sessions")
+      Summarize(ups)
+    }
+  }
+
+  if !found {
+    if *only != "" {
+      return fmt.Errorf("skill %s has no upsell bucket configured", *only)
+    }
+    return fmt.Errorf("no skills have an upsell bucket configured")
+  }
+  return report.Err()
+}
+
+// Lists every object in the bucket
+func listKeys(svc *s3.S3, bucket string) ([]string, error) {
+  params := &s3.ListObjectsInput{Bucket: aws.String(bucket)}
   var keys []string
-  svc.ListObjectsPages(params,
+  err := svc.ListObjectsPages(params,
     func(page *s3.ListObjectsOutput, lastPage bool) bool {
       for _, obj := range page.Contents {
         keys = append(keys, *obj.Key)
       }
       return true
   })
+  return keys, err
+}
+
+// routeKeys gives each key to the skill with the longest upsell prefix
+// it starts with, by skill name. Keys no skill takes are returned
+// separately, in the order given.
+func routeKeys(keys []string, skills []config.Skill) (map[string][]string, []string) {
+  routed := make(map[string][]string)
+  var skipped []string
+  for _, key := range keys {
+    best := -1
+    for i, skill := range skills {
+      prefix := skill.Upsell.Prefix
+      if strings.HasPrefix(key, prefix) && ((best < 0) || (len(prefix) > len(skills[best].Upsell.Prefix))) {
+        best = i
+      }
+    }
+    if best < 0 {
+      skipped = append(skipped, key)
+    } else {
+      routed[skills[best].Name] = append(routed[skills[best].Name], key)
+    }
+  }
+  return routed, skipped
+}
+
+// Reads the skill's records
+func readSkill(svc *s3.S3, report *logging.Report, name string, bucket string, keys []string) []Upsell {
+  var ups []Upsell
 
 	// Parallelize over multiple channels
 	const NumberOfChannels = 4
@@ -65,39 +172,28 @@ func main() {
 
   for i = 0; i < len(keys) - (NumberOfChannels - 1); i += NumberOfChannels {
     for j, ch := range channels {
-      go readFromS3(ch, svc, keys[i + j])
+      go readFromS3(ch, report, svc, bucket, keys[i + j])
     }
     for _, ch := range channels {
       upResult = <- ch
       if upResult != nil {
-        if upResult.Skill == "slots" {
-          slots = append(slots, *upResult)
-        } else {
-          blackjack = append(blackjack, *upResult)
-        }
+        upResult.Skill = name
+        ups = append(ups, *upResult)
       }
     }
   }
 
 	// May need to do one more if there were odd number of keys
 	for j := 0; j < len(keys) % NumberOfChannels; j++ {
-		go readFromS3(channels[0], svc, keys[len(keys) - j - 1])
+		go readFromS3(channels[0], report, svc, bucket, keys[len(keys) - j - 1])
 		upResult = <- channels[0]
 		if upResult != nil {
-      if upResult.Skill == "slots" {
-        slots = append(slots, *upResult)
-      } else {
-        blackjack = append(blackjack, *upResult)
-      }
+      upResult.Skill = name
+      ups = append(ups, *upResult)
     }
 	}
 
-  SaveToFile("upsell-blackjack.csv", blackjack)
-  SaveToFile("upsell-slots.csv", slots)
-  fmt.Println(len(blackjack), " blackjack sessions")
-  Summarize(blackjack)
-  fmt.Println(len(slots), " slot sessions")
-  Summarize(slots)
+  return ups
 }
 
 func Summarize(ups []Upsell) {
@@ -132,79 +228,109 @@ func SaveToFile(filename string, ups []Upsell) {
   ioutil.WriteFile(filename, []byte(text), 0644)
 }
 
-func readFromS3(ch chan *Upsell, svc *s3.S3, key string) {
+func readFromS3(ch chan *Upsell, report *logging.Report, svc *s3.S3, bucket string, key string) {
   var up *Upsell
 
   // Read the contents of the file
   input := &s3.GetObjectInput{
-    Bucket: aws.String(Bucket),
+    Bucket: aws.String(bucket),
     Key: aws.String(key),
   }
   item, err := svc.GetObject(input)
   if err != nil {
-    fmt.Println(err)
+    report.Fail(err, "bucket", bucket, "key", key)
   } else {
     defer item.Body.Close()
     var data interface{}
 
     decoder := json.NewDecoder(item.Body)
     if err := decoder.Decode(&data); err != nil {
-      // handle error
-      fmt.Println(err)
+      report.Fail(fmt.Errorf("bad JSON: %v", err), "bucket", bucket, "key", key)
+    } else if up, err = readUpsell(data); err != nil {
+      report.Fail(err, "bucket", bucket, "key", key)
     } else {
-      s := strings.Split(key, "/")
-      up = readUpsell(data, (s[0] == "slots"))
-      if (up != nil) {
-        up.Skill = s[0]
+      if up == nil {
+        slog.Debug("skipping session without start and end", "bucket", bucket, "key", key)
       }
+      report.Succeeded()
     }
   }
 
   ch <- up
 }
 
-func readUpsell(data interface{}, slots bool) *Upsell {
+// Returns nil without an error for sessions that haven't ended
+func readUpsell(data interface{}) (*Upsell, error) {
   var up Upsell
+  var err error
   triggers := 0.0
 
-  m := data.(map[string]interface{})
-  if (m["end"] != nil) && (m["start"] != nil) {
-    up.Duration = m["end"].(float64) - m["start"].(float64)
+  m, ok := data.(map[string]interface{})
+  if !ok {
+    return nil, fmt.Errorf("record is a %T, not an object", data)
+  }
+  if (m["end"] == nil) || (m["start"] == nil) {
+    return nil, nil
+  }
 
-    if m["bucket"] != nil {
-      up.Bucket = m["bucket"].(string)
+  var start, end float64
+  if start, err = number(m["start"], "start"); err != nil {
+    return nil, err
+  }
+  if end, err = number(m["end"], "end"); err != nil {
+    return nil, err
+  }
+  up.Duration = end - start
+
+  if m["bucket"] != nil {
+    if up.Bucket, ok = m["bucket"].(string); !ok {
+      return nil, fmt.Errorf("bucket is a %T, not a string", m["bucket"])
     }
-    if m["version"] != nil {
-      up.Version = m["version"].(string)
-    } else {
-      up.Version = "1.0"
-    }
-
-    for _, v := range m {
-      switch v.(type) {
-        case map[string]interface{}:
-          sub := v.(map[string]interface{})
-          if sub["impression"] != nil {
-            up.Impression = true
-            if slots {
-              // For slots, the impression is an interface too!
-              impress := sub["impression"].(map[string]interface{})
-              if impress["time"] != nil {
-                up.DurationPostImpression = m["end"].(float64) - impress["time"].(float64)
-              }
-            } else {
-              up.DurationPostImpression = m["end"].(float64) - sub["impression"].(float64)
+  }
+  if m["version"] != nil {
+    if up.Version, ok = m["version"].(string); !ok {
+      return nil, fmt.Errorf("version is a %T, not a string", m["version"])
+    }
+  } else {
+    up.Version = "1.0"
+  }
+
+  for _, v := range m {
+    switch sub := v.(type) {
+      case map[string]interface{}:
+        if sub["impression"] != nil {
+          up.Impression = true
+          impress := sub["impression"]
+          if obj, ok := impress.(map[string]interface{}); ok {
+            // Some skills (slots) record the impression as an object
+            impress = obj["time"]
+          }
+          if impress != nil {
+            when, err := number(impress, "impression time")
+            if err != nil {
+              return nil, err
             }
+            up.DurationPostImpression = end - when
           }
-          if sub["count"] != nil {
-            triggers += sub["count"].(float64)
+        }
+        if sub["count"] != nil {
+          count, err := number(sub["count"], "count")
+          if err != nil {
+            return nil, err
           }
-      }
+          triggers += count
+        }
     }
+  }
 
-    up.Triggers = triggers
-    return &up
-  } else {
-    return nil
+  up.Triggers = triggers
+  return &up, nil
+}
+
+func number(v interface{}, field string) (float64, error) {
+  f, ok := v.(float64)
+  if !ok {
+    return 0, fmt.Errorf("%s is a %T, not a number", field, v)
   }
-}
\ No newline at end of file
+  return f, nil
+}
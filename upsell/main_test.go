@@ -0,0 +1,67 @@
+package upsell
+
+import (
+  "reflect"
+  "testing"
+
+  "github.com/gsdriver/alexautils/config"
+)
+
+func skill(name, prefix string) config.Skill {
+  return config.Skill{Name: name, Upsell: &config.Upsell{Bucket: "upsell", Prefix: prefix}}
+}
+
+func TestRouteKeys(t *testing.T) {
+  keys := []string{"slots/1.json", "blackjack/2.json", "3.json", "slots-old/4.json", "slots/vip/5.json", "roulette/6.json"}
+
+  tests := []struct {
+    name    string
+    skills  []config.Skill
+    routed  map[string][]string
+    skipped []string
+  }{
+    {
+      // As the tool always counted them: anything outside slots/ is
+      // blackjack
+      name:   "defaults",
+      skills: []config.Skill{skill("blackjack", ""), skill("slots", "slots/")},
+      routed: map[string][]string{
+        "blackjack": {"blackjack/2.json", "3.json", "slots-old/4.json", "roulette/6.json"},
+        "slots":     {"slots/1.json", "slots/vip/5.json"},
+      },
+    },
+    {
+      name:   "every skill prefixed",
+      skills: []config.Skill{skill("blackjack", "blackjack/"), skill("slots", "slots/")},
+      routed: map[string][]string{
+        "blackjack": {"blackjack/2.json"},
+        "slots":     {"slots/1.json", "slots/vip/5.json"},
+      },
+      skipped: []string{"3.json", "slots-old/4.json", "roulette/6.json"},
+    },
+    {
+      // The longest prefix wins, whatever order the skills are in
+      name:   "nested prefixes",
+      skills: []config.Skill{skill("vip", "slots/vip/"), skill("slots", "slots"), skill("other", "")},
+      routed: map[string][]string{
+        "other": {"blackjack/2.json", "3.json", "roulette/6.json"},
+        "slots": {"slots/1.json", "slots-old/4.json"},
+        "vip":   {"slots/vip/5.json"},
+      },
+    },
+    {
+      name:    "no skills",
+      routed:  map[string][]string{},
+      skipped: keys,
+    },
+  }
+  for _, test := range tests {
+    routed, skipped := routeKeys(keys, test.skills)
+    if !reflect.DeepEqual(routed, test.routed) {
+      t.Errorf("%s: routed %v, want %v", test.name, routed, test.routed)
+    }
+    if !reflect.DeepEqual(skipped, test.skipped) {
+      t.Errorf("%s: skipped %v, want %v", test.name, skipped, test.skipped)
+    }
+  }
+}